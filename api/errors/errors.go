@@ -28,6 +28,9 @@ var ErrGetValueForKey = errors.New("get value for key error")
 // ErrGetKeyValuePairs signals an error in getting the key-value pairs of a key for an account
 var ErrGetKeyValuePairs = errors.New("get key-value pairs error")
 
+// ErrGetAccountStorage signals an error in getting a page of the key-value pairs of an account
+var ErrGetAccountStorage = errors.New("get account storage error")
+
 // ErrGetESDTBalance signals an error in getting esdt balance for given address
 var ErrGetESDTBalance = errors.New("get esdt balance for account error")
 
@@ -61,6 +64,12 @@ var ErrValidation = errors.New("validation error")
 // ErrTxGenerationFailed signals an error generating a transaction
 var ErrTxGenerationFailed = errors.New("transaction generation failed")
 
+// ErrInvalidStateOverrides signals that invalid state overrides have been provided
+var ErrInvalidStateOverrides = errors.New("invalid state overrides")
+
+// ErrEmptyTransactionsBundle signals that an empty bundle of transactions has been provided
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
 // ErrValidationEmptyTxHash signals that an empty tx hash was provided
 var ErrValidationEmptyTxHash = errors.New("TxHash is empty")
 
@@ -88,6 +97,9 @@ var ErrGetValidatorsInfo = errors.New("validators info failed")
 // ErrGetAlteredAccountsForBlock signals an error happening when trying to fetch the altered accounts for a block
 var ErrGetAlteredAccountsForBlock = errors.New("getting altered accounts for block failed")
 
+// ErrGetStateDiff signals an error happening when trying to fetch the accounts changed between two root hashes
+var ErrGetStateDiff = errors.New("getting state diff failed")
+
 // ErrQueryError signals a general query error
 var ErrQueryError = errors.New("query error")
 
@@ -97,6 +109,33 @@ var ErrGetPidInfo = errors.New("error getting peer id info")
 // ErrGetEpochStartData signals that an error occurred while getting the epoch start data for a provided epoch
 var ErrGetEpochStartData = errors.New("error getting epoch start data for epoch")
 
+// ErrGetStorageStats signals that an error occurred while getting the storage statistics
+var ErrGetStorageStats = errors.New("error getting storage statistics")
+
+// ErrEmptyStorageUnit signals that an empty storage unit name has been provided
+var ErrEmptyStorageUnit = errors.New("empty storage unit")
+
+// ErrCompactStorageUnit signals that an error occurred while compacting a storage unit
+var ErrCompactStorageUnit = errors.New("error compacting storage unit")
+
+// ErrVerifyTrieIntegrity signals that an error occurred while verifying the trie integrity
+var ErrVerifyTrieIntegrity = errors.New("error verifying trie integrity")
+
+// ErrGetTrieSnapshotsStatus signals that an error occurred while getting the trie snapshots status
+var ErrGetTrieSnapshotsStatus = errors.New("error getting trie snapshots status")
+
+// ErrEmptyTrieSnapshotsAction signals that an empty trie snapshots action has been provided
+var ErrEmptyTrieSnapshotsAction = errors.New("empty trie snapshots action")
+
+// ErrControlTrieSnapshots signals that an error occurred while pausing, resuming or canceling the trie snapshots
+var ErrControlTrieSnapshots = errors.New("error controlling trie snapshots")
+
+// ErrGetConsensusRounds signals that an error occurred while getting the consensus rounds timeline
+var ErrGetConsensusRounds = errors.New("error getting consensus rounds")
+
+// ErrGetNetworkTopology signals that an error occurred while getting the network topology
+var ErrGetNetworkTopology = errors.New("error getting network topology")
+
 // ErrTooManyRequests signals that too many requests were simultaneously received
 var ErrTooManyRequests = errors.New("too many requests")
 
@@ -109,6 +148,9 @@ var ErrValidationEmptyAddress = errors.New("address is empty")
 // ErrValidationEmptyKey signals that an empty key was provided
 var ErrValidationEmptyKey = errors.New("key is empty")
 
+// ErrValidationInvalidNumKeys signals that an invalid number of keys was provided
+var ErrValidationInvalidNumKeys = errors.New("invalid number of keys")
+
 // ErrGetProof signals an error happening when trying to compute a Merkle proof
 var ErrGetProof = errors.New("getting proof failed")
 
@@ -153,3 +195,6 @@ var ErrFetchingNonceGapsCannotIncludeFields = errors.New("fetching nonce gaps ca
 
 // ErrInvalidFields signals that invalid fields were provided
 var ErrInvalidFields = errors.New("invalid fields")
+
+// ErrTooManyBlockCoordinates signals that more than one block coordinate was provided
+var ErrTooManyBlockCoordinates = errors.New("only one block coordinate (blockNonce, blockHash or rootHash) can be specified at a time")
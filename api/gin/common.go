@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/gin-gonic/gin/binding"
@@ -12,10 +13,13 @@ import (
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/logs"
+	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/config"
 	"gopkg.in/go-playground/validator.v8"
 )
 
+const allowAnyOrigin = "*"
+
 type validatorInput struct {
 	Name      string
 	Validator validator.Func
@@ -42,18 +46,30 @@ func checkArgs(args ArgsNewWebServer) error {
 	if check.IfNil(args.Facade) {
 		return errHandler("nil facade")
 	}
+	if check.IfNil(args.SubscriptionsHandler) {
+		return errHandler("nil subscriptions handler")
+	}
 
 	return nil
 }
 
 func isLogRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
-	logConfig, ok := routesConfig.APIPackages["log"]
+	return isRouteEnabled(routesConfig, "log", "/log")
+}
+
+// IsSubscriptionsRouteEnabled returns true if the web socket subscriptions route is open
+func IsSubscriptionsRouteEnabled(routesConfig config.ApiRoutesConfig) bool {
+	return isRouteEnabled(routesConfig, "subscriptions", "/subscriptions")
+}
+
+func isRouteEnabled(routesConfig config.ApiRoutesConfig, packageName string, routeName string) bool {
+	packageConfig, ok := routesConfig.APIPackages[packageName]
 	if !ok {
 		return false
 	}
 
-	for _, cfg := range logConfig.Routes {
-		if cfg.Name == "/log" && cfg.Open {
+	for _, cfg := range packageConfig.Routes {
+		if cfg.Name == routeName && cfg.Open {
 			return true
 		}
 	}
@@ -103,3 +119,51 @@ func registerLoggerWsRoute(ws *gin.Engine, marshalizer marshal.Marshalizer) {
 		ls.StartSendingBlocking()
 	})
 }
+
+func registerSubscriptionsWsRoute(ws *gin.Engine, subscriptionsHandler shared.SubscriptionsHandler, allowedOrigins []string) {
+	upgrader := websocket.Upgrader{
+		CheckOrigin: createOriginChecker(allowedOrigins),
+	}
+
+	ws.GET("/subscriptions", func(c *gin.Context) {
+		conn, err := upgrader.Upgrade(c.Writer, c.Request, nil)
+		if err != nil {
+			log.Debug("cannot upgrade the subscriptions connection", "error", err)
+			return
+		}
+
+		err = subscriptionsHandler.ServeSubscriberBlocking(conn)
+		if err != nil {
+			log.Debug("cannot serve subscriber", "error", err)
+		}
+	})
+}
+
+// createOriginChecker returns the web socket origin check function for the provided allowed origins. A nil function
+// is returned for an empty list, letting the upgrader apply its default same origin policy
+func createOriginChecker(allowedOrigins []string) func(r *http.Request) bool {
+	if len(allowedOrigins) == 0 {
+		return nil
+	}
+
+	origins := make(map[string]struct{}, len(allowedOrigins))
+	for _, origin := range allowedOrigins {
+		if origin == allowAnyOrigin {
+			return func(_ *http.Request) bool {
+				return true
+			}
+		}
+
+		origins[strings.ToLower(strings.TrimSuffix(origin, "/"))] = struct{}{}
+	}
+
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if len(origin) == 0 {
+			return true
+		}
+
+		_, ok := origins[strings.ToLower(origin)]
+		return ok
+	}
+}
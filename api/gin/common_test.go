@@ -2,9 +2,12 @@ package gin
 
 import (
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/facade/initial"
 	"github.com/multiversx/mx-chain-go/testscommon"
@@ -25,6 +28,10 @@ func TestCommon_checkArgs(t *testing.T) {
 	args.Facade, err = initial.NewInitialNodeFacade("api interface", false, &testscommon.StatusMetricsStub{})
 	require.NoError(t, err)
 	err = checkArgs(args)
+	require.True(t, errors.Is(err, apiErrors.ErrCannotCreateGinWebServer))
+
+	args.SubscriptionsHandler = &mock.SubscriptionsHandlerStub{}
+	err = checkArgs(args)
 	require.NoError(t, err)
 }
 
@@ -47,3 +54,64 @@ func TestCommon_isLogRouteEnabled(t *testing.T) {
 	}
 	require.True(t, isLogRouteEnabled(routesConfig))
 }
+
+func TestCommon_IsSubscriptionsRouteEnabled(t *testing.T) {
+	t.Parallel()
+
+	routesConfigWithMissingSubscriptions := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{},
+	}
+	require.False(t, IsSubscriptionsRouteEnabled(routesConfigWithMissingSubscriptions))
+
+	routesConfig := config.ApiRoutesConfig{
+		APIPackages: map[string]config.APIPackageConfig{
+			"subscriptions": {
+				Routes: []config.RouteConfig{
+					{Name: "/subscriptions", Open: false},
+				},
+			},
+		},
+	}
+	require.False(t, IsSubscriptionsRouteEnabled(routesConfig))
+
+	routesConfig.APIPackages["subscriptions"].Routes[0].Open = true
+	require.True(t, IsSubscriptionsRouteEnabled(routesConfig))
+}
+
+func TestCommon_createOriginChecker(t *testing.T) {
+	t.Parallel()
+
+	createRequest := func(origin string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/subscriptions", nil)
+		if len(origin) > 0 {
+			req.Header.Set("Origin", origin)
+		}
+
+		return req
+	}
+
+	t.Run("empty allowed origins should use the default same origin policy", func(t *testing.T) {
+		t.Parallel()
+
+		require.Nil(t, createOriginChecker(nil))
+		require.Nil(t, createOriginChecker(make([]string, 0)))
+	})
+	t.Run("wildcard should accept any origin", func(t *testing.T) {
+		t.Parallel()
+
+		checker := createOriginChecker([]string{"https://explorer.multiversx.com", "*"})
+		require.True(t, checker(createRequest("https://any.origin.com")))
+		require.True(t, checker(createRequest("")))
+	})
+	t.Run("should accept only the configured origins", func(t *testing.T) {
+		t.Parallel()
+
+		checker := createOriginChecker([]string{"https://explorer.multiversx.com/", "http://localhost:3000"})
+		require.True(t, checker(createRequest("https://explorer.multiversx.com")))
+		require.True(t, checker(createRequest("https://Explorer.MultiversX.com")))
+		require.True(t, checker(createRequest("http://localhost:3000")))
+		require.True(t, checker(createRequest("")))
+		require.False(t, checker(createRequest("http://localhost:3001")))
+		require.False(t, checker(createRequest("https://evil.com")))
+	})
+}
@@ -24,19 +24,21 @@ var log = logger.GetOrCreate("api/gin")
 
 // ArgsNewWebServer holds the arguments needed to create a new instance of webServer
 type ArgsNewWebServer struct {
-	Facade          shared.FacadeHandler
-	ApiConfig       config.ApiRoutesConfig
-	AntiFloodConfig config.WebServerAntifloodConfig
+	Facade               shared.FacadeHandler
+	ApiConfig            config.ApiRoutesConfig
+	AntiFloodConfig      config.WebServerAntifloodConfig
+	SubscriptionsHandler shared.SubscriptionsHandler
 }
 
 type webServer struct {
 	sync.RWMutex
-	facade          shared.FacadeHandler
-	apiConfig       config.ApiRoutesConfig
-	antiFloodConfig config.WebServerAntifloodConfig
-	httpServer      shared.HttpServerCloser
-	groups          map[string]shared.GroupHandler
-	cancelFunc      func()
+	facade               shared.FacadeHandler
+	apiConfig            config.ApiRoutesConfig
+	antiFloodConfig      config.WebServerAntifloodConfig
+	subscriptionsHandler shared.SubscriptionsHandler
+	httpServer           shared.HttpServerCloser
+	groups               map[string]shared.GroupHandler
+	cancelFunc           func()
 }
 
 // NewGinWebServerHandler returns a new instance of webServer
@@ -47,9 +49,10 @@ func NewGinWebServerHandler(args ArgsNewWebServer) (*webServer, error) {
 	}
 
 	gws := &webServer{
-		facade:          args.Facade,
-		antiFloodConfig: args.AntiFloodConfig,
-		apiConfig:       args.ApiConfig,
+		facade:               args.Facade,
+		antiFloodConfig:      args.AntiFloodConfig,
+		apiConfig:            args.ApiConfig,
+		subscriptionsHandler: args.SubscriptionsHandler,
 	}
 
 	return gws, nil
@@ -93,6 +96,12 @@ func (ws *webServer) StartHttpServer() error {
 	engine = gin.Default()
 	engine.Use(cors.Default())
 
+	// the subscriptions route is registered before the throttlers as each subscriber holds its connection open for
+	// as long as it stays subscribed and would otherwise occupy one of the simultaneous requests slots
+	if IsSubscriptionsRouteEnabled(ws.apiConfig) {
+		registerSubscriptionsWsRoute(engine, ws.subscriptionsHandler, ws.apiConfig.Subscriptions.AllowedOrigins)
+	}
+
 	processors, err := ws.createMiddlewareLimiters()
 	if err != nil {
 		return err
@@ -14,6 +14,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -23,6 +24,7 @@ const (
 	getUsernamePath           = "/:address/username"
 	getCodeHashPath           = "/:address/code-hash"
 	getKeysPath               = "/:address/keys"
+	getStoragePath            = "/:address/storage"
 	getKeyPath                = "/:address/key/:key"
 	getESDTTokensPath         = "/:address/esdt"
 	getESDTBalancePath        = "/:address/esdt/:tokenIdentifier"
@@ -37,6 +39,12 @@ const (
 	urlParamBlockHash         = "blockHash"
 	urlParamBlockRootHash     = "blockRootHash"
 	urlParamHintEpoch         = "hintEpoch"
+	urlParamContinuationToken = "continuationToken"
+	urlParamPageSize          = "pageSize"
+	urlParamFrom              = "from"
+	urlParamLimit             = "limit"
+	defaultStoragePageSize    = 1000
+	maxStoragePageSize        = 10000
 )
 
 // addressFacadeHandler defines the methods to be implemented by a facade for handling address requests
@@ -53,6 +61,8 @@ type addressFacadeHandler interface {
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, fromKey string, limit int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error)
+	GetAccountStoragePage(address string, options api.AccountQueryOptions, continuationToken string, pageSize int) (*common.AccountStoragePage, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	IsInterfaceNil() bool
 }
@@ -129,6 +139,11 @@ func NewAddressGroup(facade addressFacadeHandler) (*addressGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ag.getKeyValuePairs,
 		},
+		{
+			Path:    getStoragePath,
+			Method:  http.MethodGet,
+			Handler: ag.getAccountStoragePage,
+		},
 		{
 			Path:    getESDTBalancePath,
 			Method:  http.MethodGet,
@@ -353,6 +368,11 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 		return
 	}
 
+	if isKeyValuePairsPageRequested(c) {
+		ag.getKeyValuePairsPage(c, addr, options)
+		return
+	}
+
 	value, blockInfo, err := ag.getFacade().GetKeyValuePairs(addr, options)
 	if err != nil {
 		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
@@ -362,6 +382,82 @@ func (ag *addressGroup) getKeyValuePairs(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"pairs": value, "blockInfo": blockInfo})
 }
 
+func isKeyValuePairsPageRequested(c *gin.Context) bool {
+	query := c.Request.URL.Query()
+	return query.Has(urlParamFrom) || query.Has(urlParamLimit)
+}
+
+// getKeyValuePairsPage returns at most limit key-value pairs for the given address, starting with the key provided as
+// cursor, together with the cursor of the next page
+func (ag *addressGroup) getKeyValuePairsPage(c *gin.Context, addr string, options api.AccountQueryOptions) {
+	limit, err := parsePageSize(c, urlParamLimit)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	fromKey := c.Request.URL.Query().Get(urlParamFrom)
+	_, err = hex.DecodeString(fromKey)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetKeyValuePairs, fmt.Errorf("%w: %s is not hex encoded", errors.ErrBadUrlParams, urlParamFrom))
+		return
+	}
+
+	pairs, nextCursor, blockInfo, err := ag.getFacade().GetKeyValuePairsPage(addr, options, fromKey, limit)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetKeyValuePairs, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"pairs": pairs, "nextCursor": nextCursor, "blockInfo": blockInfo})
+}
+
+// getAccountStoragePage returns a page of the key-value pairs for the given address, together with the token needed
+// for fetching the next page
+func (ag *addressGroup) getAccountStoragePage(c *gin.Context) {
+	addr := c.Param("address")
+	if addr == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountStorage, errors.ErrEmptyAddress)
+		return
+	}
+
+	options, err := extractAccountQueryOptions(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountStorage, err)
+		return
+	}
+
+	pageSize, err := parsePageSize(c, urlParamPageSize)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetAccountStorage, err)
+		return
+	}
+
+	continuationToken := c.Request.URL.Query().Get(urlParamContinuationToken)
+	page, blockInfo, err := ag.getFacade().GetAccountStoragePage(addr, options, continuationToken, pageSize)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetAccountStorage, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"pairs": page.Pairs, "continuationToken": page.ContinuationToken, "blockInfo": blockInfo})
+}
+
+func parsePageSize(c *gin.Context, name string) (int, error) {
+	pageSize, err := parseUint32UrlParam(c, name)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !pageSize.HasValue {
+		return defaultStoragePageSize, nil
+	}
+	if pageSize.Value == 0 || pageSize.Value > maxStoragePageSize {
+		return 0, fmt.Errorf("%w: %s should be between 1 and %d", errors.ErrBadUrlParams, name, maxStoragePageSize)
+	}
+
+	return int(pageSize.Value), nil
+}
+
 // getESDTBalance returns the balance for the given address and esdt token
 func (ag *addressGroup) getESDTBalance(c *gin.Context) {
 	addr := c.Param("address")
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -131,6 +132,28 @@ type keyValuePairsResponse struct {
 	Code  string
 }
 
+type keyValuePairsPageResponseData struct {
+	Pairs      []*common.KeyValuePairAPI `json:"pairs"`
+	NextCursor string                    `json:"nextCursor"`
+}
+
+type keyValuePairsPageResponse struct {
+	Data  keyValuePairsPageResponseData `json:"data"`
+	Error string                        `json:"error"`
+	Code  string
+}
+
+type accountStoragePageResponseData struct {
+	Pairs             []*common.KeyValuePairAPI `json:"pairs"`
+	ContinuationToken string                    `json:"continuationToken"`
+}
+
+type accountStoragePageResponse struct {
+	Data  accountStoragePageResponseData `json:"data"`
+	Error string                         `json:"error"`
+	Code  string
+}
+
 type esdtRolesResponseData struct {
 	Roles map[string][]string `json:"roles"`
 }
@@ -1057,6 +1080,211 @@ func TestGetKeyValuePairs_ShouldWork(t *testing.T) {
 	assert.Equal(t, pairs, response.Data.Pairs)
 }
 
+func TestGetKeyValuePairs_WithCursor(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, _ string, _ int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, "", api.BlockInfo{}, nil
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		for _, limit := range []string{"0", "10001", "abc"} {
+			req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys?limit=%s", testAddress, limit), nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := &shared.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetKeyValuePairs.Error()))
+		}
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, _ string, _ int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, "", api.BlockInfo{}, nil
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys?from=xyz", testAddress), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("node fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetKeyValuePairsPageCalled: func(_ string, _ api.AccountQueryOptions, _ string, _ int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+				return nil, "", api.BlockInfo{}, expectedErr
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys?limit=10", testAddress), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pairs := []*common.KeyValuePairAPI{
+			{Key: "6b32", Value: "7632"},
+			{Key: "6b31", Value: "7631"},
+		}
+		facade := mock.FacadeStub{
+			GetKeyValuePairsCalled: func(_ string, _ api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, api.BlockInfo{}, nil
+			},
+			GetKeyValuePairsPageCalled: func(address string, _ api.AccountQueryOptions, fromKey string, limit int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+				assert.Equal(t, testAddress, address)
+				assert.Equal(t, "6b30", fromKey)
+				assert.Equal(t, 2, limit)
+				return pairs, "6b33", api.BlockInfo{}, nil
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/keys?from=6b30&limit=2", testAddress), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := keyValuePairsPageResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, pairs, response.Data.Pairs)
+		assert.Equal(t, "6b33", response.Data.NextCursor)
+	})
+}
+
+func TestGetAccountStoragePage(t *testing.T) {
+	t.Parallel()
+
+	testAddress := "address"
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetAccountStoragePageCalled: func(_ string, _ api.AccountQueryOptions, _ string, _ int) (*common.AccountStoragePage, api.BlockInfo, error) {
+				require.Fail(t, "should have not been called")
+				return nil, api.BlockInfo{}, nil
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		for _, pageSize := range []string{"0", "10001", "abc"} {
+			req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/storage?pageSize=%s", testAddress, pageSize), nil)
+			resp := httptest.NewRecorder()
+			ws.ServeHTTP(resp, req)
+
+			response := &shared.GenericAPIResponse{}
+			loadResponse(resp.Body, &response)
+			assert.Equal(t, http.StatusBadRequest, resp.Code)
+			assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetAccountStorage.Error()))
+		}
+	})
+	t.Run("node fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetAccountStoragePageCalled: func(_ string, _ api.AccountQueryOptions, _ string, _ int) (*common.AccountStoragePage, api.BlockInfo, error) {
+				return nil, api.BlockInfo{}, expectedErr
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/storage", testAddress), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		page := &common.AccountStoragePage{
+			Pairs: []*common.KeyValuePairAPI{
+				{Key: "6b31", Value: "7631"},
+				{Key: "6b32", Value: "7632"},
+			},
+			ContinuationToken: "next",
+		}
+		facade := mock.FacadeStub{
+			GetAccountStoragePageCalled: func(address string, _ api.AccountQueryOptions, continuationToken string, pageSize int) (*common.AccountStoragePage, api.BlockInfo, error) {
+				assert.Equal(t, testAddress, address)
+				assert.Equal(t, "token", continuationToken)
+				assert.Equal(t, 2, pageSize)
+				return page, api.BlockInfo{}, nil
+			},
+		}
+
+		addrGroup, err := groups.NewAddressGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(addrGroup, "address", getAddressRoutesConfig())
+
+		req, _ := http.NewRequest("GET", fmt.Sprintf("/address/%s/storage?continuationToken=token&pageSize=2", testAddress), nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := accountStoragePageResponse{}
+		loadResponse(resp.Body, &response)
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, page.Pairs, response.Data.Pairs)
+		assert.Equal(t, page.ContinuationToken, response.Data.ContinuationToken)
+	})
+}
+
 func TestGetGuardianData(t *testing.T) {
 	t.Parallel()
 
@@ -1275,6 +1503,7 @@ func getAddressRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:address/username", Open: true},
 					{Name: "/:address/code-hash", Open: true},
 					{Name: "/:address/keys", Open: true},
+					{Name: "/:address/storage", Open: true},
 					{Name: "/:address/key/:key", Open: true},
 					{Name: "/:address/esdt", Open: true},
 					{Name: "/:address/esdts/roles", Open: true},
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/api/shared/logging"
+	"github.com/multiversx/mx-chain-go/common"
 )
 
 const (
@@ -23,6 +24,7 @@ const (
 	getBlockByRoundPath       = "/by-round/:round"
 	getAlteredAccountsByNonce = "/altered-accounts/by-nonce/:nonce"
 	getAlteredAccountsByHash  = "/altered-accounts/by-hash/:hash"
+	getStateDiffPath          = "/state-diff/:fromRootHash/:toRootHash"
 	urlParamTokensFilter      = "tokens"
 	urlParamWithTxs           = "withTxs"
 	urlParamWithLogs          = "withLogs"
@@ -34,6 +36,7 @@ type blockFacadeHandler interface {
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetAlteredAccountsForBlock(options api.GetAlteredAccountsForBlockOptions) ([]*outport.AlteredAccount, error)
+	GetStateDiff(fromRootHash string, toRootHash string, fromAddress string, limit int) (*common.StateDiffAPI, error)
 	IsInterfaceNil() bool
 }
 
@@ -80,6 +83,11 @@ func NewBlockGroup(facade blockFacadeHandler) (*blockGroup, error) {
 			Method:  http.MethodGet,
 			Handler: bg.getAlteredAccountsByHash,
 		},
+		{
+			Path:    getStateDiffPath,
+			Method:  http.MethodGet,
+			Handler: bg.getStateDiff,
+		},
 	}
 	bg.endpoints = endpoints
 
@@ -217,6 +225,40 @@ func (bg *blockGroup) getAlteredAccountsByHash(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"accounts": alteredAccountsResponse})
 }
 
+// getStateDiff returns at most limit accounts changed between the two root hashes of the accounts trie, starting with
+// the address provided as cursor, together with the cursor of the next page
+func (bg *blockGroup) getStateDiff(c *gin.Context) {
+	fromRootHash := c.Param("fromRootHash")
+	toRootHash := c.Param("toRootHash")
+	if fromRootHash == "" || toRootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, errors.ErrValidationEmptyRootHash)
+		return
+	}
+	_, errFrom := hex.DecodeString(fromRootHash)
+	_, errTo := hex.DecodeString(toRootHash)
+	if errFrom != nil || errTo != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, fmt.Errorf("%w: root hashes should be hex encoded", errors.ErrBadUrlParams))
+		return
+	}
+
+	limit, err := parsePageSize(c, urlParamLimit)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	fromAddress := c.Request.URL.Query().Get(urlParamFrom)
+	start := time.Now()
+	stateDiff, err := bg.getFacade().GetStateDiff(fromRootHash, toRootHash, fromAddress, limit)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: GetStateDiff")
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStateDiff, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"accounts": stateDiff.Accounts, "nextAddress": stateDiff.NextAddress})
+}
+
 func parseBlockQueryOptions(c *gin.Context) (api.BlockQueryOptions, error) {
 	withTxs, err := parseBoolUrlParam(c, urlParamWithTxs)
 	if err != nil {
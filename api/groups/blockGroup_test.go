@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -247,6 +248,7 @@ func getBlockRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/by-round/:round", Open: true},
 					{Name: "/altered-accounts/by-nonce/:nonce", Open: true},
 					{Name: "/altered-accounts/by-hash/:hash", Open: true},
+					{Name: "/state-diff/:fromRootHash/:toRootHash", Open: true},
 				},
 			},
 		},
@@ -500,6 +502,113 @@ func TestGetAlteredAccountsByHash_ShouldWork(t *testing.T) {
 	require.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
 }
 
+// ---- state diff
+
+type stateDiffResponse struct {
+	Data struct {
+		Accounts    []*common.AccountDiffAPI `json:"accounts"`
+		NextAddress string                   `json:"nextAddress"`
+	} `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+}
+
+func TestGetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+		response, code := httpGetStateDiff(ws, "/block/state-diff/aabb/not-hex")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetStateDiff.Error()))
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockGroup, err := groups.NewBlockGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+		response, code := httpGetStateDiff(ws, "/block/state-diff/aabb/ccdd?limit=0")
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetStateDiffCalled: func(_ string, _ string, _ string, _ int) (*common.StateDiffAPI, error) {
+				return nil, expectedErr
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+		response, code := httpGetStateDiff(ws, "/block/state-diff/aabb/ccdd")
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedDiff := &common.StateDiffAPI{
+			Accounts: []*common.AccountDiffAPI{
+				{
+					Address:    "alice",
+					OldBalance: "10",
+					NewBalance: "15",
+					OldNonce:   1,
+					NewNonce:   2,
+					DataTrieChanges: []*common.KeyValueDiffAPI{
+						{Key: "6b", OldValue: "76", NewValue: "77"},
+					},
+				},
+			},
+			NextAddress: "bob",
+		}
+		facade := mock.FacadeStub{
+			GetStateDiffCalled: func(fromRootHash string, toRootHash string, fromAddress string, limit int) (*common.StateDiffAPI, error) {
+				assert.Equal(t, "aabb", fromRootHash)
+				assert.Equal(t, "ccdd", toRootHash)
+				assert.Equal(t, "alice", fromAddress)
+				assert.Equal(t, 1, limit)
+				return expectedDiff, nil
+			},
+		}
+		blockGroup, err := groups.NewBlockGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(blockGroup, "block", getBlockRoutesConfig())
+
+		response, code := httpGetStateDiff(ws, "/block/state-diff/aabb/ccdd?from=alice&limit=1")
+		require.Equal(t, http.StatusOK, code)
+		assert.Equal(t, expectedDiff.Accounts, response.Data.Accounts)
+		assert.Equal(t, "bob", response.Data.NextAddress)
+		assert.Equal(t, string(shared.ReturnCodeSuccess), response.Code)
+	})
+}
+
+func httpGetStateDiff(ws *gin.Engine, url string) (stateDiffResponse, int) {
+	httpRequest, _ := http.NewRequest("GET", url, nil)
+	httpResponse := httptest.NewRecorder()
+	ws.ServeHTTP(httpResponse, httpRequest)
+
+	response := stateDiffResponse{}
+	loadResponse(httpResponse.Body, &response)
+	return response, httpResponse.Code
+}
+
 func httpGetBlock(ws *gin.Engine, url string) (blockResponse, int) {
 	httpRequest, _ := http.NewRequest("GET", url, nil)
 	httpResponse := httptest.NewRecorder()
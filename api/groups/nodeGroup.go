@@ -6,18 +6,20 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/heartbeat/topology"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 )
 
 const (
 	pidQueryParam          = "pid"
+	formatQueryParam       = "format"
 	debugPath              = "/debug"
 	heartbeatStatusPath    = "/heartbeatstatus"
 	metricsPath            = "/metrics"
@@ -26,6 +28,13 @@ const (
 	statusPath             = "/status"
 	epochStartDataForEpoch = "/epoch-start/:epoch"
 	bootstrapStatusPath    = "/bootstrapstatus"
+	storageStatsPath       = "/storage-stats"
+	storageCompactPath     = "/storage-compact"
+	trieIntegrityPath      = "/trie-integrity"
+	trieSnapshotsPath      = "/trie-snapshots"
+	snapshotsControlPath   = "/trie-snapshots/control"
+	consensusRoundsPath    = "/consensus/rounds"
+	topologyPath           = "/topology"
 )
 
 // nodeFacadeHandler defines the methods to be implemented by a facade for node requests
@@ -34,7 +43,14 @@ type nodeFacadeHandler interface {
 	StatusMetrics() external.StatusMetricsHandler
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeerInfo(pid string) ([]common.PeerInfoAPI, error)
+	GetStorageStats() ([]*common.StorageUnitStats, error)
+	CompactStorageUnit(unitName string) error
+	VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error)
+	GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error)
+	ControlTrieSnapshots(trieName string, action string) error
+	GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error)
+	GetNetworkTopology() (*data.NetworkTopology, error)
 	IsInterfaceNil() bool
 }
 
@@ -44,6 +60,25 @@ type QueryDebugRequest struct {
 	Search string `form:"search" json:"search"`
 }
 
+// CompactStorageRequest represents the structure on which user input for compacting a storage unit will validate against
+type CompactStorageRequest struct {
+	Unit string `form:"unit" json:"unit"`
+}
+
+// TrieIntegrityRequest represents the structure on which user input for verifying the accounts trie will validate
+// against. An empty root hash verifies the current accounts trie
+type TrieIntegrityRequest struct {
+	RootHash string `form:"rootHash" json:"rootHash"`
+	Repair   bool   `form:"repair" json:"repair"`
+}
+
+// TrieSnapshotsControlRequest represents the structure on which user input for pausing, resuming or canceling the
+// trie snapshots will validate against. An empty trie applies the action on all tries
+type TrieSnapshotsControlRequest struct {
+	Trie   string `form:"trie" json:"trie"`
+	Action string `form:"action" json:"action"`
+}
+
 type nodeGroup struct {
 	*baseGroup
 	facade    nodeFacadeHandler
@@ -102,6 +137,41 @@ func NewNodeGroup(facade nodeFacadeHandler) (*nodeGroup, error) {
 			Method:  http.MethodGet,
 			Handler: ng.bootstrapMetrics,
 		},
+		{
+			Path:    storageStatsPath,
+			Method:  http.MethodGet,
+			Handler: ng.storageStats,
+		},
+		{
+			Path:    storageCompactPath,
+			Method:  http.MethodPost,
+			Handler: ng.compactStorageUnit,
+		},
+		{
+			Path:    trieIntegrityPath,
+			Method:  http.MethodPost,
+			Handler: ng.verifyTrieIntegrity,
+		},
+		{
+			Path:    trieSnapshotsPath,
+			Method:  http.MethodGet,
+			Handler: ng.trieSnapshotsStatus,
+		},
+		{
+			Path:    snapshotsControlPath,
+			Method:  http.MethodPost,
+			Handler: ng.controlTrieSnapshots,
+		},
+		{
+			Path:    consensusRoundsPath,
+			Method:  http.MethodGet,
+			Handler: ng.consensusRounds,
+		},
+		{
+			Path:    topologyPath,
+			Method:  http.MethodGet,
+			Handler: ng.networkTopology,
+		},
 	}
 	ng.endpoints = endpoints
 
@@ -272,6 +342,132 @@ func (ng *nodeGroup) epochStartDataForEpoch(c *gin.Context) {
 	shared.RespondWithSuccess(c, gin.H{"epochStart": epochStartData})
 }
 
+// storageStats returns the statistics of the active persisters of each storage unit
+func (ng *nodeGroup) storageStats(c *gin.Context) {
+	stats, err := ng.getFacade().GetStorageStats()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetStorageStats, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"units": stats})
+}
+
+// compactStorageUnit triggers a manual compaction of the provided storage unit and returns after it finished
+func (ng *nodeGroup) compactStorageUnit(c *gin.Context) {
+	var request = CompactStorageRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.Unit) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrEmptyStorageUnit)
+		return
+	}
+
+	err = ng.getFacade().CompactStorageUnit(request.Unit)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrCompactStorageUnit, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"unit": request.Unit})
+}
+
+// verifyTrieIntegrity verifies the accounts trie, optionally repairing the damaged nodes from the peers, and returns
+// the report after the whole trie was walked
+func (ng *nodeGroup) verifyTrieIntegrity(c *gin.Context) {
+	var request = TrieIntegrityRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	report, err := ng.getFacade().VerifyTrieIntegrity(request.RootHash, request.Repair)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyTrieIntegrity, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"report": report})
+}
+
+// trieSnapshotsStatus returns the queued and running snapshots and checkpoints of each trie, with their progress
+func (ng *nodeGroup) trieSnapshotsStatus(c *gin.Context) {
+	status, err := ng.getFacade().GetTrieSnapshotsStatus()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetTrieSnapshotsStatus, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"tries": status})
+}
+
+// controlTrieSnapshots pauses, resumes or cancels the snapshots and checkpoints of the provided trie
+func (ng *nodeGroup) controlTrieSnapshots(c *gin.Context) {
+	var request = TrieSnapshotsControlRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	if len(request.Action) == 0 {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrEmptyTrieSnapshotsAction)
+		return
+	}
+
+	err = ng.getFacade().ControlTrieSnapshots(request.Trie, request.Action)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrControlTrieSnapshots, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"trie": request.Trie, "action": request.Action})
+}
+
+// consensusRounds returns the timeline of the last consensus rounds, as recorded by the node
+func (ng *nodeGroup) consensusRounds(c *gin.Context) {
+	rounds, err := ng.getFacade().GetConsensusRounds()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetConsensusRounds, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"rounds": rounds})
+}
+
+// networkTopology returns the network topology snapshot, as seen by the node. The optional format query parameter
+// can request the snapshot as a Graphviz DOT or GraphML document instead of the default JSON response
+func (ng *nodeGroup) networkTopology(c *gin.Context) {
+	format := c.DefaultQuery(formatQueryParam, topology.ExportFormatJSON)
+	contentType, err := topology.GetContentType(format)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrGetNetworkTopology, err)
+		return
+	}
+
+	networkTopology, err := ng.getFacade().GetNetworkTopology()
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetNetworkTopology, err)
+		return
+	}
+
+	if format == topology.ExportFormatJSON {
+		shared.RespondWithSuccess(c, gin.H{"topology": networkTopology})
+		return
+	}
+
+	buff, err := topology.Export(networkTopology, format)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetNetworkTopology, err)
+		return
+	}
+
+	c.Data(http.StatusOK, contentType, buff)
+}
+
 // prometheusMetrics is the endpoint which will return the data in the way that prometheus expects them
 func (ng *nodeGroup) prometheusMetrics(c *gin.Context) {
 	metrics, err := ng.getFacade().StatusMetrics().StatusMetricsWithoutP2PPrometheusString()
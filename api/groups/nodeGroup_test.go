@@ -24,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/debug"
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 	"github.com/multiversx/mx-chain-go/statusHandler"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
@@ -68,6 +69,41 @@ type epochStartResponse struct {
 	generalResponse
 }
 
+type trieSnapshotsStatusResponse struct {
+	Data struct {
+		Tries map[string]*common.TrieSnapshotsStatus `json:"tries"`
+	} `json:"data"`
+	generalResponse
+}
+
+type consensusRoundsResponse struct {
+	Data struct {
+		Rounds []*common.ConsensusRoundTimeline `json:"rounds"`
+	} `json:"data"`
+	generalResponse
+}
+
+type networkTopologyResponse struct {
+	Data struct {
+		Topology *data.NetworkTopology `json:"topology"`
+	} `json:"data"`
+	generalResponse
+}
+
+type storageStatsResponse struct {
+	Data struct {
+		Units []*common.StorageUnitStats `json:"units"`
+	} `json:"data"`
+	generalResponse
+}
+
+type trieIntegrityResponse struct {
+	Data struct {
+		Report *trieIntegrity.Report `json:"report"`
+	} `json:"data"`
+	generalResponse
+}
+
 func init() {
 	gin.SetMode(gin.TestMode)
 }
@@ -393,7 +429,7 @@ func TestPeerInfo_PeerInfoErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		GetPeerInfoCalled: func(pid string) ([]core.QueryP2PPeerInfo, error) {
+		GetPeerInfoCalled: func(pid string) ([]common.PeerInfoAPI, error) {
 			return nil, expectedErr
 		},
 	}
@@ -419,13 +455,19 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	t.Parallel()
 
 	pidProvided := "16Uiu2HAmRCVXdXqt8BXfhrzotczHMXXvgHPd7iwGWvS53JT1xdw6"
-	val := core.QueryP2PPeerInfo{
-		Pid: pidProvided,
+	val := common.PeerInfoAPI{
+		QueryP2PPeerInfo: core.QueryP2PPeerInfo{
+			Pid: pidProvided,
+		},
+		Reputation: &common.PeerReputation{
+			Score:              12.5,
+			NumUsefulResponses: 10,
+		},
 	}
 	facade := mock.FacadeStub{
-		GetPeerInfoCalled: func(pid string) ([]core.QueryP2PPeerInfo, error) {
+		GetPeerInfoCalled: func(pid string) ([]common.PeerInfoAPI, error) {
 			if pid == pidProvided {
-				return []core.QueryP2PPeerInfo{val}, nil
+				return []common.PeerInfoAPI{val}, nil
 			}
 
 			assert.Fail(t, "should have received the pid")
@@ -451,7 +493,14 @@ func TestPeerInfo_PeerInfoShouldWork(t *testing.T) {
 	responseInfo, ok := response.Data.(map[string]interface{})
 	require.True(t, ok)
 
-	assert.NotNil(t, responseInfo["info"])
+	infos, ok := responseInfo["info"].([]interface{})
+	require.True(t, ok)
+	require.Equal(t, 1, len(infos))
+	info := infos[0].(map[string]interface{})
+	assert.Equal(t, pidProvided, info["pid"])
+	reputation := info["reputation"].(map[string]interface{})
+	assert.Equal(t, 12.5, reputation["score"])
+	assert.Equal(t, float64(10), reputation["numUsefulResponses"])
 }
 
 func TestEpochStartData_FacadeErrorsShouldErr(t *testing.T) {
@@ -514,6 +563,608 @@ func TestEpochStartData_ShouldWork(t *testing.T) {
 	require.Equal(t, *expectedEpochStartData, response.Data.EpochStartDataAPI)
 }
 
+func TestStorageStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetStorageStatsCalled: func() ([]*common.StorageUnitStats, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/storage-stats", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStats := []*common.StorageUnitStats{
+			{
+				Unit: "TransactionUnit",
+				Persisters: []*common.PersisterStats{
+					{
+						Path:        "Epoch_3/Shard_0/Transactions",
+						Epoch:       3,
+						NumFiles:    5,
+						SizeInBytes: 2048,
+						Engine:      &common.StorageEngineStats{NumCompactions: 2},
+					},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetStorageStatsCalled: func() ([]*common.StorageUnitStats, error) {
+				return expectedStats, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/storage-stats", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &storageStatsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, expectedStats, response.Data.Units)
+	})
+}
+
+func TestCompactStorageUnit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/storage-compact", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("empty unit should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/storage-compact", bytes.NewBuffer([]byte(`{"unit":""}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyStorageUnit.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			CompactStorageUnitCalled: func(unitName string) error {
+				return expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/storage-compact", bytes.NewBuffer([]byte(`{"unit":"TransactionUnit"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		compactedUnit := ""
+		facade := mock.FacadeStub{
+			CompactStorageUnitCalled: func(unitName string) error {
+				compactedUnit = unitName
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/storage-compact", bytes.NewBuffer([]byte(`{"unit":"TransactionUnit"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "TransactionUnit", compactedUnit)
+	})
+}
+
+func TestVerifyTrieIntegrity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-integrity", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			VerifyTrieIntegrityCalled: func(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-integrity", bytes.NewBuffer([]byte(`{"rootHash":"aabb"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyTrieIntegrity.Error()))
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedReport := &trieIntegrity.Report{
+			RootHash: "aabb",
+			NumNodes: 10,
+			DamagedNodes: []*trieIntegrity.DamagedNode{
+				{Hash: "ccdd", Path: "0a"},
+			},
+			RepairedNodes: []*trieIntegrity.DamagedNode{
+				{Hash: "ccdd", Path: "0a"},
+			},
+		}
+		providedRootHash := ""
+		providedRepair := false
+		facade := mock.FacadeStub{
+			VerifyTrieIntegrityCalled: func(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+				providedRootHash = rootHash
+				providedRepair = repair
+				return expectedReport, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-integrity", bytes.NewBuffer([]byte(`{"rootHash":"aabb","repair":true}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &trieIntegrityResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, "aabb", providedRootHash)
+		assert.True(t, providedRepair)
+		assert.Equal(t, expectedReport, response.Data.Report)
+	})
+}
+
+func TestTrieSnapshotsStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetTrieSnapshotsStatusCalled: func() (map[string]*common.TrieSnapshotsStatus, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-snapshots", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedStatus := map[string]*common.TrieSnapshotsStatus{
+			"0": {
+				NumQueuedCheckpoints:   1,
+				NumRunningSnapshots:    1,
+				NumNodesCopied:         250,
+				NumBytesCopied:         25000,
+				EstimatedNumNodes:      1000,
+				EstimatedCompletionSec: 30,
+				LastError:              "snapshot canceled",
+				RunningOperations: []*common.TrieSnapshotOperation{
+					{
+						Type:           "snapshot",
+						RootHash:       "aabb",
+						Epoch:          4,
+						NumNodesCopied: 250,
+						NumBytesCopied: 25000,
+					},
+				},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetTrieSnapshotsStatusCalled: func() (map[string]*common.TrieSnapshotsStatus, error) {
+				return expectedStatus, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/trie-snapshots", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &trieSnapshotsStatusResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, expectedStatus, response.Data.Tries)
+	})
+}
+
+func TestConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetConsensusRoundsCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRounds := []*common.ConsensusRoundTimeline{
+			{
+				Round:              37,
+				RoundTimestamp:     1000,
+				Leader:             "aa",
+				ConsensusGroupSize: 2,
+				Subrounds: []*common.ConsensusSubroundTimeline{
+					{Name: "(BLOCK)", StartTimestamp: 1010, EndTimestamp: 1020, Finished: true},
+				},
+				Messages: []*common.ConsensusMessageTimeline{
+					{Type: "(SIGNATURE)", PubKey: "bb", PeerID: "pid", Timestamp: 1015},
+				},
+				BlockHash: "cc",
+				Bitmap:    "03",
+				Signers:   []string{"aa", "bb"},
+			},
+		}
+		facade := mock.FacadeStub{
+			GetConsensusRoundsCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+				return expectedRounds, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/consensus/rounds", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &consensusRoundsResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, expectedRounds, response.Data.Rounds)
+	})
+}
+
+func TestNetworkTopology(t *testing.T) {
+	t.Parallel()
+
+	providedTopology := &data.NetworkTopology{
+		Timestamp: time.Unix(1000, 0).UTC(),
+		Observers: []string{"obs"},
+		Peers: []data.TopologyPeer{
+			{Pid: "obs", ShardID: 0, PeerType: "observer", ConnectionStatus: "self", LastSeen: time.Unix(1000, 0).UTC()},
+			{Pid: "pid", PublicKey: "pk", ShardID: 0, PeerType: "eligible", ConnectionStatus: "connected", LastSeen: time.Unix(1000, 0).UTC()},
+		},
+		DirectConnections: []data.DirectConnection{
+			{Source: "obs", Target: "pid", ShardID: 0},
+		},
+	}
+
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology?format=csv", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetNetworkTopology.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			GetNetworkTopologyCalled: func() (*data.NetworkTopology, error) {
+				return nil, expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("json format should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetNetworkTopologyCalled: func() (*data.NetworkTopology, error) {
+				return providedTopology, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &networkTopologyResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "", response.Error)
+		assert.Equal(t, providedTopology, response.Data.Topology)
+	})
+	t.Run("dot format should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetNetworkTopologyCalled: func() (*data.NetworkTopology, error) {
+				return providedTopology, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology?format=dot", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "text/vnd.graphviz", resp.Header().Get("Content-Type"))
+		assert.True(t, strings.Contains(resp.Body.String(), `"obs" -- "pid" [shard="0"];`))
+	})
+	t.Run("graphml format should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			GetNetworkTopologyCalled: func() (*data.NetworkTopology, error) {
+				return providedTopology, nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("GET", "/node/topology?format=graphml", nil)
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "application/graphml+xml", resp.Header().Get("Content-Type"))
+		assert.True(t, strings.Contains(resp.Body.String(), `<edge source="obs" target="pid">`))
+	})
+}
+
+func TestControlTrieSnapshots(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-snapshots/control", bytes.NewBuffer([]byte("invalid")))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("empty action should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeGroup, err := groups.NewNodeGroup(&mock.FacadeStub{})
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-snapshots/control", bytes.NewBuffer([]byte(`{"trie":"0"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrEmptyTrieSnapshotsAction.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		facade := mock.FacadeStub{
+			ControlTrieSnapshotsCalled: func(trieName string, action string) error {
+				return expectedErr
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-snapshots/control", bytes.NewBuffer([]byte(`{"action":"pause"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		response := &shared.GenericAPIResponse{}
+		loadResponse(resp.Body, response)
+
+		assert.Equal(t, http.StatusInternalServerError, resp.Code)
+		assert.True(t, strings.Contains(response.Error, expectedErr.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		providedTrie, providedAction := "", ""
+		facade := mock.FacadeStub{
+			ControlTrieSnapshotsCalled: func(trieName string, action string) error {
+				providedTrie, providedAction = trieName, action
+				return nil
+			},
+		}
+
+		nodeGroup, err := groups.NewNodeGroup(&facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(nodeGroup, "node", getNodeRoutesConfig())
+
+		req, _ := http.NewRequest("POST", "/node/trie-snapshots/control", bytes.NewBuffer([]byte(`{"trie":"0","action":"cancel"}`)))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, "0", providedTrie)
+		assert.Equal(t, "cancel", providedAction)
+	})
+}
+
 func TestPrometheusMetrics_ShouldReturnErrorIfFacadeReturnsError(t *testing.T) {
 	expectedErr := errors.New("i am an error")
 
@@ -595,6 +1246,13 @@ func getNodeRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/peerinfo", Open: true},
 					{Name: "/epoch-start/:epoch", Open: true},
 					{Name: "/bootstrapstatus", Open: true},
+					{Name: "/storage-stats", Open: true},
+					{Name: "/storage-compact", Open: true},
+					{Name: "/trie-integrity", Open: true},
+					{Name: "/trie-snapshots", Open: true},
+					{Name: "/trie-snapshots/control", Open: true},
+					{Name: "/consensus/rounds", Open: true},
+					{Name: "/topology", Open: true},
 				},
 			},
 		},
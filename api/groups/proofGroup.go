@@ -20,10 +20,29 @@ const (
 	getProofEndpoint                = "/proof/root-hash/:roothash/address/:address"
 	getProofDataTrieEndpoint        = "/proof/root-hash/:roothash/address/:address/key/:key"
 	verifyProofEndpoint             = "/proof/verify"
+	getMultiProofEndpoint           = "/proof/root-hash/:roothash/addresses"
+	getMultiProofDataTrieEndpoint   = "/proof/root-hash/:roothash/address/:address/keys"
+	getRangeProofEndpoint           = "/proof/root-hash/:roothash/range"
+	getRangeProofDataTrieEndpoint   = "/proof/root-hash/:roothash/address/:address/range"
+	verifyMultiProofEndpoint        = "/proof/verify-multi"
+	verifyRangeProofEndpoint        = "/proof/verify-range"
 	getProofCurrentRootHashPath     = "/address/:address"
 	getProofPath                    = "/root-hash/:roothash/address/:address"
 	getProofDataTriePath            = "/root-hash/:roothash/address/:address/key/:key"
 	verifyProofPath                 = "/verify"
+	getMultiProofPath               = "/root-hash/:roothash/addresses"
+	getMultiProofDataTriePath       = "/root-hash/:roothash/address/:address/keys"
+	getRangeProofPath               = "/root-hash/:roothash/range"
+	getRangeProofDataTriePath       = "/root-hash/:roothash/address/:address/range"
+	verifyMultiProofPath            = "/verify-multi"
+	verifyRangeProofPath            = "/verify-range"
+
+	urlParamRangeStart      = "start"
+	urlParamRangeEnd        = "end"
+	urlParamRangeLimit      = "limit"
+	maxMultiProofKeys       = 1000
+	defaultRangeProofLeaves = 1000
+	maxRangeProofLeaves     = 10000
 )
 
 // proofFacadeHandler defines the methods to be implemented by a facade for proof requests
@@ -32,6 +51,12 @@ type proofFacadeHandler interface {
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -98,6 +123,72 @@ func NewProofGroup(facade proofFacadeHandler) (*proofGroup, error) {
 				},
 			},
 		},
+		{
+			Path:    getMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getMultiProofDataTriePath,
+			Method:  http.MethodPost,
+			Handler: pg.getMultiProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getMultiProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getRangeProofPath,
+			Method:  http.MethodGet,
+			Handler: pg.getRangeProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getRangeProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    getRangeProofDataTriePath,
+			Method:  http.MethodGet,
+			Handler: pg.getRangeProofDataTrie,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(getRangeProofDataTrieEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyMultiProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyMultiProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyMultiProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
+		{
+			Path:    verifyRangeProofPath,
+			Method:  http.MethodPost,
+			Handler: pg.verifyRangeProof,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(verifyRangeProofEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 	}
 	pg.endpoints = endpoints
 
@@ -111,6 +202,35 @@ type VerifyProofRequest struct {
 	Proof    []string `json:"proof"`
 }
 
+// MultiProofRequest represents the parameters needed to compute a multiproof. The addresses are used for the
+// accounts trie, while the hex encoded keys are used for the data trie of an account
+type MultiProofRequest struct {
+	Addresses []string `json:"addresses"`
+	Keys      []string `json:"keys"`
+}
+
+// VerifyMultiProofRequest represents the parameters needed to verify a multiproof. The keys can be addresses or hex
+// encoded trie keys
+type VerifyMultiProofRequest struct {
+	RootHash string   `json:"roothash"`
+	Keys     []string `json:"keys"`
+	Proof    []string `json:"proof"`
+}
+
+// VerifyRangeProofRequest represents the parameters needed to verify a range proof. An empty end key means that the
+// range has no upper bound
+type VerifyRangeProofRequest struct {
+	RootHash string   `json:"roothash"`
+	StartKey string   `json:"startKey"`
+	EndKey   string   `json:"endKey"`
+	Proof    []string `json:"proof"`
+}
+
+type proofLeaf struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
 // getProof will receive a rootHash and an address from the client, and it will return the Merkle proof
 func (pg *proofGroup) getProof(c *gin.Context) {
 	rootHash := c.Param("roothash")
@@ -353,6 +473,267 @@ func (pg *proofGroup) verifyProof(c *gin.Context) {
 	)
 }
 
+// getMultiProof will receive a rootHash and a list of addresses from the client, and it will return a single Merkle
+// proof for all the addresses
+func (pg *proofGroup) getMultiProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	request, err := getMultiProofRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	err = checkNumProofKeys(len(request.Addresses))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().GetMultiProof(rootHash, request.Addresses)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":    bytesToHex(response.Proof),
+		"leaves":   leavesToHex(response.Leaves),
+		"rootHash": response.RootHash,
+	})
+}
+
+// getMultiProofDataTrie will receive a rootHash, an address and a list of keys from the client, and it will return
+// the Merkle proof for the address and a single Merkle proof for all the keys of the account's data trie
+func (pg *proofGroup) getMultiProofDataTrie(c *gin.Context) {
+	rootHash, address, ok := getRootHashAndAddressParams(c)
+	if !ok {
+		return
+	}
+
+	request, err := getMultiProofRequest(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	err = checkNumProofKeys(len(request.Keys))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetMultiProofDataTrie(rootHash, address, request.Keys)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs": gin.H{
+			"mainProof":     bytesToHex(mainTrieResponse.Proof),
+			"dataTrieProof": bytesToHex(dataTrieResponse.Proof),
+		},
+		"leaves":           leavesToHex(dataTrieResponse.Leaves),
+		"dataTrieRootHash": dataTrieResponse.RootHash,
+	})
+}
+
+// getRangeProof will receive a rootHash and the bounds of a range from the client, and it will return a single Merkle
+// proof for all the accounts in range. If not all the accounts fit in the response, the returned end key is the
+// upper bound proven by the proof, and it can be used as start key for the next request
+func (pg *proofGroup) getRangeProof(c *gin.Context) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return
+	}
+
+	maxLeaves, err := parseRangeProofLimit(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	query := c.Request.URL.Query()
+	response, err := pg.getFacade().GetRangeProof(rootHash, query.Get(urlParamRangeStart), query.Get(urlParamRangeEnd), maxLeaves)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proof":    bytesToHex(response.Proof),
+		"leaves":   leavesToHex(response.Leaves),
+		"endKey":   hex.EncodeToString(response.EndKey),
+		"rootHash": response.RootHash,
+	})
+}
+
+// getRangeProofDataTrie will receive a rootHash, an address and the bounds of a range from the client, and it will
+// return the Merkle proof for the address and a single Merkle proof for all the keys in range of the account's data trie
+func (pg *proofGroup) getRangeProofDataTrie(c *gin.Context) {
+	rootHash, address, ok := getRootHashAndAddressParams(c)
+	if !ok {
+		return
+	}
+
+	maxLeaves, err := parseRangeProofLimit(c)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	query := c.Request.URL.Query()
+	mainTrieResponse, dataTrieResponse, err := pg.getFacade().GetRangeProofDataTrie(rootHash, address, query.Get(urlParamRangeStart), query.Get(urlParamRangeEnd), maxLeaves)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrGetProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{
+		"proofs": gin.H{
+			"mainProof":     bytesToHex(mainTrieResponse.Proof),
+			"dataTrieProof": bytesToHex(dataTrieResponse.Proof),
+		},
+		"leaves":           leavesToHex(dataTrieResponse.Leaves),
+		"endKey":           hex.EncodeToString(dataTrieResponse.EndKey),
+		"dataTrieRootHash": dataTrieResponse.RootHash,
+	})
+}
+
+// verifyMultiProof will receive a rootHash, a list of keys and a multiproof from the client, and it will verify the
+// proof. The response holds the values bound by the proof to the given keys
+func (pg *proofGroup) verifyMultiProof(c *gin.Context) {
+	var request = &VerifyMultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+	err = checkNumProofKeys(len(request.Keys))
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proof, err := hexToBytes(request.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().VerifyMultiProof(request.RootHash, request.Keys, proof)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"ok": response.Ok, "leaves": leavesToHex(response.Leaves)})
+}
+
+// verifyRangeProof will receive a rootHash, the bounds of a range and a range proof from the client, and it will
+// verify the proof. The response holds all the leaves in range
+func (pg *proofGroup) verifyRangeProof(c *gin.Context) {
+	var request = &VerifyRangeProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	proof, err := hexToBytes(request.Proof)
+	if err != nil {
+		shared.RespondWithValidationError(c, errors.ErrValidation, err)
+		return
+	}
+
+	response, err := pg.getFacade().VerifyRangeProof(request.RootHash, request.StartKey, request.EndKey, proof)
+	if err != nil {
+		shared.RespondWithInternalError(c, errors.ErrVerifyProof, err)
+		return
+	}
+
+	shared.RespondWithSuccess(c, gin.H{"ok": response.Ok, "leaves": leavesToHex(response.Leaves)})
+}
+
+func getRootHashAndAddressParams(c *gin.Context) (string, string, bool) {
+	rootHash := c.Param("roothash")
+	if rootHash == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyRootHash)
+		return "", "", false
+	}
+
+	address := c.Param("address")
+	if address == "" {
+		shared.RespondWithValidationError(c, errors.ErrValidation, errors.ErrValidationEmptyAddress)
+		return "", "", false
+	}
+
+	return rootHash, address, true
+}
+
+func getMultiProofRequest(c *gin.Context) (*MultiProofRequest, error) {
+	var request = &MultiProofRequest{}
+	err := c.ShouldBindJSON(&request)
+	if err != nil {
+		return nil, err
+	}
+
+	return request, nil
+}
+
+func checkNumProofKeys(numKeys int) error {
+	if numKeys == 0 || numKeys > maxMultiProofKeys {
+		return fmt.Errorf("%w: the number of keys should be between 1 and %d", errors.ErrValidationInvalidNumKeys, maxMultiProofKeys)
+	}
+
+	return nil
+}
+
+func parseRangeProofLimit(c *gin.Context) (int, error) {
+	limit, err := parseUint32UrlParam(c, urlParamRangeLimit)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", errors.ErrBadUrlParams, err)
+	}
+	if !limit.HasValue {
+		return defaultRangeProofLeaves, nil
+	}
+	if limit.Value == 0 || limit.Value > maxRangeProofLeaves {
+		return 0, fmt.Errorf("%w: %s should be between 1 and %d", errors.ErrBadUrlParams, urlParamRangeLimit, maxRangeProofLeaves)
+	}
+
+	return int(limit.Value), nil
+}
+
+func hexToBytes(hexValues []string) ([][]byte, error) {
+	bytesValues := make([][]byte, 0, len(hexValues))
+	for _, hexValue := range hexValues {
+		bytesValue, err := hex.DecodeString(hexValue)
+		if err != nil {
+			return nil, err
+		}
+
+		bytesValues = append(bytesValues, bytesValue)
+	}
+
+	return bytesValues, nil
+}
+
+func leavesToHex(leaves []core.KeyValueHolder) []proofLeaf {
+	hexLeaves := make([]proofLeaf, 0, len(leaves))
+	for _, leaf := range leaves {
+		hexLeaves = append(hexLeaves, proofLeaf{
+			Key:   hex.EncodeToString(leaf.Key()),
+			Value: hex.EncodeToString(leaf.Value()),
+		})
+	}
+
+	return hexLeaves
+}
+
 func (pg *proofGroup) getFacade() proofFacadeHandler {
 	pg.mutFacade.RLock()
 	defer pg.mutFacade.RUnlock()
@@ -11,6 +11,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
@@ -379,6 +382,323 @@ func TestVerifyProof(t *testing.T) {
 	assert.True(t, isValid)
 }
 
+func TestGetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		response := doProofRequest(ws, "POST", "/proof/root-hash/roothash/addresses", []byte("invalid bytes"))
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("no addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.MultiProofRequest{})
+		response := doProofRequest(ws, "POST", "/proof/root-hash/roothash/addresses", requestBytes)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationInvalidNumKeys.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.MultiProofRequest{Addresses: []string{"addr"}})
+		response := doProofRequest(ws, "POST", "/proof/root-hash/roothash/addresses", requestBytes)
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		addresses := []string{"addr1", "addr2"}
+		facade := &mock.FacadeStub{
+			GetMultiProofCalled: func(rootHash string, providedAddresses []string) (*common.GetMultiProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, addresses, providedAddresses)
+				return &common.GetMultiProofResponse{
+					Proof: [][]byte{[]byte("valid"), []byte("proof")},
+					Leaves: []core.KeyValueHolder{
+						keyValStorage.NewKeyValStorage([]byte("key1"), []byte("value1")),
+						keyValStorage.NewKeyValStorage([]byte("key2"), nil),
+					},
+					RootHash: "roothash",
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.MultiProofRequest{Addresses: addresses})
+		response := doProofRequest(ws, "POST", "/proof/root-hash/roothash/addresses", requestBytes)
+		require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}, responseMap["proof"])
+		assert.Equal(t, "roothash", responseMap["rootHash"])
+		expectedLeaves := []interface{}{
+			map[string]interface{}{"key": hex.EncodeToString([]byte("key1")), "value": hex.EncodeToString([]byte("value1"))},
+			map[string]interface{}{"key": hex.EncodeToString([]byte("key2")), "value": ""},
+		}
+		assert.Equal(t, expectedLeaves, responseMap["leaves"])
+	})
+}
+
+func TestGetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("too many keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.MultiProofRequest{Keys: make([]string, 1001)})
+		response := doProofRequest(ws, "POST", "/proof/root-hash/roothash/address/addr/keys", requestBytes)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidationInvalidNumKeys.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		keys := []string{"aa", "bb"}
+		facade := &mock.FacadeStub{
+			GetMultiProofDataTrieCalled: func(rootHash string, address string, providedKeys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "addr", address)
+				assert.Equal(t, keys, providedKeys)
+				return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+					&common.GetMultiProofResponse{Proof: [][]byte{[]byte("data")}, RootHash: "dataroothash"}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.MultiProofRequest{Keys: keys})
+		response := doProofRequest(ws, "POST", "/proof/root-hash/roothash/address/addr/keys", requestBytes)
+		require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		proofs, _ := responseMap["proofs"].(map[string]interface{})
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("main"))}, proofs["mainProof"])
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("data"))}, proofs["dataTrieProof"])
+		assert.Equal(t, "dataroothash", responseMap["dataTrieRootHash"])
+	})
+}
+
+func TestGetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		response := doProofRequest(ws, "GET", "/proof/root-hash/roothash/range?limit=abc", nil)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+
+		response = doProofRequest(ws, "GET", "/proof/root-hash/roothash/range?limit=10001", nil)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrBadUrlParams.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRangeProofCalled: func(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		response := doProofRequest(ws, "GET", "/proof/root-hash/roothash/range", nil)
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrGetProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			GetRangeProofCalled: func(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "start", startKey)
+				assert.Equal(t, "", endKey)
+				assert.Equal(t, 1000, maxLeaves)
+				return &common.GetRangeProofResponse{
+					Proof:    [][]byte{[]byte("proof")},
+					Leaves:   []core.KeyValueHolder{keyValStorage.NewKeyValStorage([]byte("key"), []byte("value"))},
+					EndKey:   []byte("key"),
+					RootHash: "roothash",
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		response := doProofRequest(ws, "GET", "/proof/root-hash/roothash/range?start=start", nil)
+		require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		assert.Equal(t, []interface{}{hex.EncodeToString([]byte("proof"))}, responseMap["proof"])
+		assert.Equal(t, hex.EncodeToString([]byte("key")), responseMap["endKey"])
+		assert.Equal(t, "roothash", responseMap["rootHash"])
+	})
+}
+
+func TestGetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		GetRangeProofDataTrieCalled: func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+			assert.Equal(t, "roothash", rootHash)
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "aa", startKey)
+			assert.Equal(t, "bb", endKey)
+			assert.Equal(t, 5, maxLeaves)
+			return &common.GetProofResponse{Proof: [][]byte{[]byte("main")}},
+				&common.GetRangeProofResponse{Proof: [][]byte{[]byte("data")}, EndKey: []byte{0xbb}, RootHash: "dataroothash"}, nil
+		},
+	}
+	proofGroup, _ := groups.NewProofGroup(facade)
+	ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+	response := doProofRequest(ws, "GET", "/proof/root-hash/roothash/address/addr/range?start=aa&end=bb&limit=5", nil)
+	require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+	responseMap, _ := response.Data.(map[string]interface{})
+	proofs, _ := responseMap["proofs"].(map[string]interface{})
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("main"))}, proofs["mainProof"])
+	assert.Equal(t, []interface{}{hex.EncodeToString([]byte("data"))}, proofs["dataTrieProof"])
+	assert.Equal(t, "bb", responseMap["endKey"])
+	assert.Equal(t, "dataroothash", responseMap["dataTrieRootHash"])
+}
+
+func TestVerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid proof encoding should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{RootHash: "roothash", Keys: []string{"addr"}, Proof: []string{"invalid"}})
+		response := doProofRequest(ws, "POST", "/proof/verify-multi", requestBytes)
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("facade error should error", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+				return nil, errors.New("expected error")
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{RootHash: "roothash", Keys: []string{"addr"}})
+		response := doProofRequest(ws, "POST", "/proof/verify-multi", requestBytes)
+		assert.Equal(t, shared.ReturnCodeInternalError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrVerifyProof.Error()))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proof := []string{hex.EncodeToString([]byte("valid")), hex.EncodeToString([]byte("proof"))}
+		facade := &mock.FacadeStub{
+			VerifyMultiProofCalled: func(rootHash string, keys []string, providedProof [][]byte) (*common.VerifyProofsResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, []string{"addr"}, keys)
+				assert.Equal(t, [][]byte{[]byte("valid"), []byte("proof")}, providedProof)
+				return &common.VerifyProofsResponse{
+					Ok:     true,
+					Leaves: []core.KeyValueHolder{keyValStorage.NewKeyValStorage([]byte("addr"), []byte("account"))},
+				}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyMultiProofRequest{RootHash: "roothash", Keys: []string{"addr"}, Proof: proof})
+		response := doProofRequest(ws, "POST", "/proof/verify-multi", requestBytes)
+		require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		assert.Equal(t, true, responseMap["ok"])
+		expectedLeaves := []interface{}{
+			map[string]interface{}{"key": hex.EncodeToString([]byte("addr")), "value": hex.EncodeToString([]byte("account"))},
+		}
+		assert.Equal(t, expectedLeaves, responseMap["leaves"])
+	})
+}
+
+func TestVerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid request should error", func(t *testing.T) {
+		t.Parallel()
+
+		proofGroup, _ := groups.NewProofGroup(&mock.FacadeStub{})
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		response := doProofRequest(ws, "POST", "/proof/verify-range", []byte("invalid bytes"))
+		assert.Equal(t, shared.ReturnCodeRequestError, response.Code)
+		assert.True(t, strings.Contains(response.Error, apiErrors.ErrValidation.Error()))
+	})
+	t.Run("invalid proof should return not ok", func(t *testing.T) {
+		t.Parallel()
+
+		facade := &mock.FacadeStub{
+			VerifyRangeProofCalled: func(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+				assert.Equal(t, "roothash", rootHash)
+				assert.Equal(t, "start", startKey)
+				assert.Equal(t, "end", endKey)
+				return &common.VerifyProofsResponse{Ok: false}, nil
+			},
+		}
+		proofGroup, _ := groups.NewProofGroup(facade)
+		ws := startWebServer(proofGroup, "proof", getProofRoutesConfig())
+
+		requestBytes, _ := json.Marshal(groups.VerifyRangeProofRequest{RootHash: "roothash", StartKey: "start", EndKey: "end"})
+		response := doProofRequest(ws, "POST", "/proof/verify-range", requestBytes)
+		require.Equal(t, shared.ReturnCodeSuccess, response.Code)
+
+		responseMap, _ := response.Data.(map[string]interface{})
+		assert.Equal(t, false, responseMap["ok"])
+		assert.Equal(t, []interface{}{}, responseMap["leaves"])
+	})
+}
+
+func doProofRequest(ws *gin.Engine, method string, path string, body []byte) shared.GenericAPIResponse {
+	req, _ := http.NewRequest(method, path, bytes.NewBuffer(body))
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	response := shared.GenericAPIResponse{}
+	loadResponse(resp.Body, &response)
+
+	return response
+}
+
 func getProofRoutesConfig() config.ApiRoutesConfig {
 	return config.ApiRoutesConfig{
 		APIPackages: map[string]config.APIPackageConfig{
@@ -388,6 +708,12 @@ func getProofRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/root-hash/:roothash/address/:address/key/:key", Open: true},
 					{Name: "/address/:address", Open: true},
 					{Name: "/verify", Open: true},
+					{Name: "/root-hash/:roothash/addresses", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/keys", Open: true},
+					{Name: "/root-hash/:roothash/range", Open: true},
+					{Name: "/root-hash/:roothash/address/:address/range", Open: true},
+					{Name: "/verify-multi", Open: true},
+					{Name: "/verify-range", Open: true},
 				},
 			},
 		},
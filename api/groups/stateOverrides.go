@@ -0,0 +1,87 @@
+package groups
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-go/api/errors"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// AccountOverrideRequest represents the structure on which user input for overriding an account while simulating a
+// transaction or executing a VM query will validate against. The code and the storage keys and values are hex encoded
+type AccountOverrideRequest struct {
+	Balance string            `json:"balance,omitempty"`
+	Nonce   *uint64           `json:"nonce,omitempty"`
+	Code    string            `json:"code,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+type addressDecoder func(address string) ([]byte, error)
+
+func createStateOverrides(request map[string]*AccountOverrideRequest, decodeAddress addressDecoder) (common.StateOverrides, error) {
+	if len(request) == 0 {
+		return nil, nil
+	}
+
+	stateOverrides := make(common.StateOverrides, len(request))
+	for address, accountRequest := range request {
+		decodedAddress, err := decodeAddress(address)
+		if err != nil {
+			return nil, fmt.Errorf("%w: '%s' is not a valid address: %s", errors.ErrInvalidStateOverrides, address, err.Error())
+		}
+
+		accountOverride, err := createAccountOverride(accountRequest)
+		if err != nil {
+			return nil, fmt.Errorf("%w for address '%s': %s", errors.ErrInvalidStateOverrides, address, err.Error())
+		}
+
+		stateOverrides[string(decodedAddress)] = accountOverride
+	}
+
+	return stateOverrides, nil
+}
+
+func createAccountOverride(request *AccountOverrideRequest) (*common.AccountOverride, error) {
+	accountOverride := &common.AccountOverride{}
+	if request == nil {
+		return accountOverride, nil
+	}
+
+	if len(request.Balance) > 0 {
+		balance, ok := big.NewInt(0).SetString(request.Balance, 10)
+		if !ok || balance.Sign() < 0 {
+			return nil, fmt.Errorf("invalid balance %s", request.Balance)
+		}
+		accountOverride.Balance = balance
+	}
+
+	accountOverride.Nonce = request.Nonce
+
+	if len(request.Code) > 0 {
+		code, err := hex.DecodeString(request.Code)
+		if err != nil {
+			return nil, fmt.Errorf("invalid code: %s", err.Error())
+		}
+		accountOverride.Code = code
+	}
+
+	if len(request.Storage) > 0 {
+		accountOverride.Storage = make(map[string][]byte, len(request.Storage))
+	}
+	for key, value := range request.Storage {
+		decodedKey, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid storage key %s: %s", key, err.Error())
+		}
+		decodedValue, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid storage value for key %s: %s", key, err.Error())
+		}
+
+		accountOverride.Storage[string(decodedKey)] = decodedValue
+	}
+
+	return accountOverride, nil
+}
@@ -25,10 +25,12 @@ import (
 const (
 	sendTransactionEndpoint          = "/transaction/send"
 	simulateTransactionEndpoint      = "/transaction/simulate"
+	simulateBundleEndpoint           = "/transaction/simulate-bundle"
 	sendMultipleTransactionsEndpoint = "/transaction/send-multiple"
 	getTransactionEndpoint           = "/transaction/:hash"
 	sendTransactionPath              = "/send"
 	simulateTransactionPath          = "/simulate"
+	simulateBundlePath               = "/simulate-bundle"
 	costPath                         = "/cost"
 	sendMultiplePath                 = "/send-multiple"
 	getTransactionPath               = "/:txhash"
@@ -36,6 +38,7 @@ const (
 
 	queryParamWithResults    = "withResults"
 	queryParamCheckSignature = "checkSignature"
+	queryParamWithTrace      = "withTrace"
 	queryParamSender         = "by-sender"
 	queryParamFields         = "fields"
 	queryParamLastNonce      = "last-nonce"
@@ -48,14 +51,16 @@ type transactionFacadeHandler interface {
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
 	GetTransactionsPool(fields string) (*common.TransactionsPoolAPIResponse, error)
 	GetTransactionsPoolForSender(sender, fields string) (*common.TransactionsPoolForSenderApiResponse, error)
 	GetLastPoolNonceForSender(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSender(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
+	DecodeAddressPubkey(pk string) ([]byte, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	IsInterfaceNil() bool
 }
@@ -100,6 +105,17 @@ func NewTransactionGroup(facade transactionFacadeHandler) (*transactionGroup, er
 				},
 			},
 		},
+		{
+			Path:    simulateBundlePath,
+			Method:  http.MethodPost,
+			Handler: tg.simulateTransactionsBundle,
+			AdditionalMiddlewares: []shared.AdditionalMiddleware{
+				{
+					Middleware: middleware.CreateEndpointThrottlerFromFacade(simulateBundleEndpoint, facade),
+					Position:   shared.Before,
+				},
+			},
+		},
 		{
 			Path:    costPath,
 			Method:  http.MethodPost,
@@ -178,6 +194,13 @@ type SendTxRequest struct {
 	GuardianSignature string `json:"guardianSignature,omitempty"`
 }
 
+// SimulateTxRequest represents the structure that maps and validates user input for simulating a transaction or for
+// computing its cost, with optional state overrides keyed by the bech32 address of the overridden accounts
+type SimulateTxRequest struct {
+	SendTxRequest
+	StateOverrides map[string]*AccountOverrideRequest `json:"stateOverrides,omitempty"`
+}
+
 // TxResponse represents the structure on which the response will be validated against
 type TxResponse struct {
 	SendTxRequest
@@ -190,7 +213,7 @@ type TxResponse struct {
 
 // simulateTransaction will receive a transaction from the client and will simulate its execution and return the results
 func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
-	var gtx = SendTxRequest{}
+	var gtx = SimulateTxRequest{}
 	err := c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
@@ -217,6 +240,19 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	txArgs := &external.ArgsCreateTransaction{
 		Nonce:            gtx.Nonce,
 		Value:            gtx.Value,
@@ -265,8 +301,21 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 		return
 	}
 
+	stateOverrides, err := createStateOverrides(gtx.StateOverrides, tg.getFacade().DecodeAddressPubkey)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start = time.Now()
-	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx)
+	executionResults, err := tg.getFacade().SimulateTransactionExecution(tx, stateOverrides, withTrace)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionExecution")
 	if err != nil {
 		c.JSON(
@@ -291,6 +340,146 @@ func (tg *transactionGroup) simulateTransaction(c *gin.Context) {
 	)
 }
 
+// simulateTransactionsBundle will receive an ordered list of transactions from the client and will simulate their
+// execution one after another, each transaction seeing the state left by the previous ones
+func (tg *transactionGroup) simulateTransactionsBundle(c *gin.Context) {
+	var gtxs []SendTxRequest
+	err := c.ShouldBindJSON(&gtxs)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+	if len(gtxs) == 0 {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), errors.ErrEmptyTransactionsBundle.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	checkSignature, err := getQueryParameterCheckSignature(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: errors.ErrValidation.Error(),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	withTrace, err := getQueryParamWithTrace(c)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
+	var start time.Time
+	txs := make([]*transaction.Transaction, 0, len(gtxs))
+	txsHashes := make([]string, 0, len(gtxs))
+	for idx, gtx := range gtxs {
+		txArgs := &external.ArgsCreateTransaction{
+			Nonce:            gtx.Nonce,
+			Value:            gtx.Value,
+			Receiver:         gtx.Receiver,
+			ReceiverUsername: gtx.ReceiverUsername,
+			Sender:           gtx.Sender,
+			SenderUsername:   gtx.SenderUsername,
+			GasPrice:         gtx.GasPrice,
+			GasLimit:         gtx.GasLimit,
+			DataField:        gtx.Data,
+			SignatureHex:     gtx.Signature,
+			ChainID:          gtx.ChainID,
+			Version:          gtx.Version,
+			Options:          gtx.Options,
+			Guardian:         gtx.GuardianAddr,
+			GuardianSigHex:   gtx.GuardianSignature,
+		}
+		start = time.Now()
+		tx, txHash, errCreate := tg.getFacade().CreateTransaction(txArgs)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: CreateTransaction")
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s at index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		start = time.Now()
+		errCreate = tg.getFacade().ValidateTransactionForSimulation(tx, checkSignature)
+		logging.LogAPIActionDurationIfNeeded(start, "API call: ValidateTransactionForSimulation")
+		if errCreate != nil {
+			c.JSON(
+				http.StatusBadRequest,
+				shared.GenericAPIResponse{
+					Data:  nil,
+					Error: fmt.Sprintf("%s at index %d: %s", errors.ErrTxGenerationFailed.Error(), idx, errCreate.Error()),
+					Code:  shared.ReturnCodeRequestError,
+				},
+			)
+			return
+		}
+
+		txs = append(txs, tx)
+		txsHashes = append(txsHashes, hex.EncodeToString(txHash))
+	}
+
+	start = time.Now()
+	bundleResults, err := tg.getFacade().SimulateTransactionsBundleExecution(txs, withTrace)
+	logging.LogAPIActionDurationIfNeeded(start, "API call: SimulateTransactionsBundleExecution")
+	if err != nil {
+		c.JSON(
+			http.StatusInternalServerError,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: err.Error(),
+				Code:  shared.ReturnCodeInternalError,
+			},
+		)
+		return
+	}
+
+	for idx, results := range bundleResults.Results {
+		if idx < len(txsHashes) {
+			results.Hash = txsHashes[idx]
+		}
+	}
+
+	c.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"result": bundleResults},
+			Error: "",
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
+}
+
 // sendTransaction will receive a transaction from the client and propagate it for processing
 func (tg *transactionGroup) sendTransaction(c *gin.Context) {
 	var gtx = SendTxRequest{}
@@ -520,7 +709,7 @@ func (tg *transactionGroup) getTransaction(c *gin.Context) {
 
 // computeTransactionGasLimit returns how many gas units a transaction wil consume
 func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
-	var gtx SendTxRequest
+	var gtx SimulateTxRequest
 	err := c.ShouldBindJSON(&gtx)
 	if err != nil {
 		c.JSON(
@@ -566,8 +755,21 @@ func (tg *transactionGroup) computeTransactionGasLimit(c *gin.Context) {
 		return
 	}
 
+	stateOverrides, err := createStateOverrides(gtx.StateOverrides, tg.getFacade().DecodeAddressPubkey)
+	if err != nil {
+		c.JSON(
+			http.StatusBadRequest,
+			shared.GenericAPIResponse{
+				Data:  nil,
+				Error: fmt.Sprintf("%s: %s", errors.ErrValidation.Error(), err.Error()),
+				Code:  shared.ReturnCodeRequestError,
+			},
+		)
+		return
+	}
+
 	start = time.Now()
-	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx)
+	cost, err := tg.getFacade().ComputeTransactionGasLimit(tx, stateOverrides)
 	logging.LogAPIActionDurationIfNeeded(start, "API call: ComputeTransactionGasLimit")
 	if err != nil {
 		c.JSON(
@@ -812,6 +1014,15 @@ func getQueryParamWithResults(c *gin.Context) (bool, error) {
 	return strconv.ParseBool(withResultsStr)
 }
 
+func getQueryParamWithTrace(c *gin.Context) (bool, error) {
+	withTraceStr := c.Request.URL.Query().Get(queryParamWithTrace)
+	if withTraceStr == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(withTraceStr)
+}
+
 func getQueryParameterCheckSignature(c *gin.Context) (bool, error) {
 	bypassSignatureStr := c.Request.URL.Query().Get(queryParamCheckSignature)
 	if bypassSignatureStr == "" {
@@ -522,7 +522,7 @@ func TestComputeTransactionGasLimit(t *testing.T) {
 		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 			return &dataTx.Transaction{}, nil, nil
 		},
-		ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction) (*dataTx.CostResponse, error) {
+		ComputeTransactionGasLimitHandler: func(tx *dataTx.Transaction, _ common.StateOverrides) (*dataTx.CostResponse, error) {
 			return &dataTx.CostResponse{
 				GasUnits:      expectedGasLimit,
 				ReturnMessage: "",
@@ -588,7 +588,7 @@ func TestSimulateTransaction_CreateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -643,7 +643,7 @@ func TestSimulateTransaction_ValidateErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -736,7 +736,7 @@ func TestSimulateTransaction_UseQueryParameterShouldWork(t *testing.T) {
 		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
 			return &dataTx.Transaction{}, []byte("hash"), nil
 		},
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			return &txSimData.SimulationResults{}, nil
 		},
 	}
@@ -774,7 +774,7 @@ func TestSimulateTransaction_ProcessErrorsShouldErr(t *testing.T) {
 
 	expectedErr := errors.New("expected error")
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			return nil, expectedErr
 		},
 		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
@@ -820,7 +820,7 @@ func TestSimulateTransaction(t *testing.T) {
 	processTxWasCalled := false
 
 	facade := mock.FacadeStub{
-		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction) (*txSimData.SimulationResults, error) {
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			processTxWasCalled = true
 			return &txSimData.SimulationResults{
 				Status:     "ok",
@@ -868,6 +868,307 @@ func TestSimulateTransaction(t *testing.T) {
 	assert.Equal(t, string(shared.ReturnCodeSuccess), simulateResponse.Code)
 }
 
+func TestSimulateTransaction_WithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	createFacade := func(providedOverrides *common.StateOverrides) *mock.FacadeStub {
+		return &mock.FacadeStub{
+			SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, stateOverrides common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
+				*providedOverrides = stateOverrides
+				return &txSimData.SimulationResults{Status: "ok"}, nil
+			},
+			CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+				return &dataTx.Transaction{}, []byte("hash"), nil
+			},
+			ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+				return nil
+			},
+		}
+	}
+	sendRequest := func(facade *mock.FacadeStub, stateOverrides map[string]*groups.AccountOverrideRequest) (*httptest.ResponseRecorder, simulateTxResponse) {
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		request := groups.SimulateTxRequest{
+			SendTxRequest: groups.SendTxRequest{
+				Sender:   "sender1",
+				Receiver: "receiver1",
+				Value:    "100",
+			},
+			StateOverrides: stateOverrides,
+		}
+		jsonBytes, _ := json.Marshal(request)
+
+		req, _ := http.NewRequest("POST", "/transaction/simulate", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		simulateResponse := simulateTxResponse{}
+		loadResponse(resp.Body, &simulateResponse)
+
+		return resp, simulateResponse
+	}
+
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOverrides common.StateOverrides
+		resp, simulateResponse := sendRequest(createFacade(&providedOverrides), map[string]*groups.AccountOverrideRequest{
+			"invalid": {Balance: "10"},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, simulateResponse.Error, apiErrors.ErrInvalidStateOverrides.Error())
+		assert.Nil(t, providedOverrides)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOverrides common.StateOverrides
+		resp, simulateResponse := sendRequest(createFacade(&providedOverrides), map[string]*groups.AccountOverrideRequest{
+			"0102": {Balance: "-10"},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, simulateResponse.Error, apiErrors.ErrInvalidStateOverrides.Error())
+		assert.Nil(t, providedOverrides)
+	})
+	t.Run("invalid storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		var providedOverrides common.StateOverrides
+		resp, simulateResponse := sendRequest(createFacade(&providedOverrides), map[string]*groups.AccountOverrideRequest{
+			"0102": {Storage: map[string]string{"0a": "not hex"}},
+		})
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Contains(t, simulateResponse.Error, apiErrors.ErrInvalidStateOverrides.Error())
+		assert.Nil(t, providedOverrides)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := uint64(7)
+		var providedOverrides common.StateOverrides
+		resp, _ := sendRequest(createFacade(&providedOverrides), map[string]*groups.AccountOverrideRequest{
+			"0102": {
+				Balance: "10",
+				Nonce:   &nonce,
+				Code:    "abcd",
+				Storage: map[string]string{"0a": "0b"},
+			},
+		})
+
+		expectedOverrides := common.StateOverrides{
+			string([]byte{0x01, 0x02}): &common.AccountOverride{
+				Balance: big.NewInt(10),
+				Nonce:   &nonce,
+				Code:    []byte{0xab, 0xcd},
+				Storage: map[string][]byte{"\x0a": {0x0b}},
+			},
+		}
+		assert.Equal(t, http.StatusOK, resp.Code)
+		assert.Equal(t, expectedOverrides, providedOverrides)
+	})
+}
+
+func TestSimulateTransaction_WithTrace(t *testing.T) {
+	t.Parallel()
+
+	facade := &mock.FacadeStub{
+		SimulateTransactionExecutionHandler: func(tx *dataTx.Transaction, _ common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error) {
+			results := &txSimData.SimulationResults{
+				Status: "ok",
+			}
+			if withTrace {
+				results.Trace = &common.CallTrace{GasUsed: 10}
+			}
+
+			return results, nil
+		},
+		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+	}
+	sendRequest := func(url string) (int, *txSimData.SimulationResults) {
+		transactionGroup, err := groups.NewTransactionGroup(facade)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+		req, _ := http.NewRequest("POST", url, bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		simulateResponse := struct {
+			Data struct {
+				Result *txSimData.SimulationResults `json:"result"`
+			} `json:"data"`
+		}{}
+		loadResponse(resp.Body, &simulateResponse)
+
+		return resp.Code, simulateResponse.Data.Result
+	}
+
+	t.Run("invalid parameter should error", func(t *testing.T) {
+		t.Parallel()
+
+		facadeWithoutSimulation := &mock.FacadeStub{
+			SimulateTransactionExecutionHandler: func(_ *dataTx.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
+				require.Fail(t, "should have not simulated the transaction")
+				return nil, nil
+			},
+			CreateTransactionHandler: facade.CreateTransactionHandler,
+		}
+		transactionGroup, err := groups.NewTransactionGroup(facadeWithoutSimulation)
+		require.NoError(t, err)
+
+		ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+		jsonBytes, _ := json.Marshal(groups.SendTxRequest{Sender: "sender1", Receiver: "receiver1", Value: "100"})
+		req, _ := http.NewRequest("POST", "/transaction/simulate?withTrace=not-a-bool", bytes.NewBuffer(jsonBytes))
+		resp := httptest.NewRecorder()
+		ws.ServeHTTP(resp, req)
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+	})
+	t.Run("trace not requested should be omitted", func(t *testing.T) {
+		t.Parallel()
+
+		code, results := sendRequest("/transaction/simulate")
+		assert.Equal(t, http.StatusOK, code)
+		require.NotNil(t, results)
+		assert.Nil(t, results.Trace)
+	})
+	t.Run("trace requested should be returned", func(t *testing.T) {
+		t.Parallel()
+
+		code, results := sendRequest("/transaction/simulate?withTrace=true")
+		assert.Equal(t, http.StatusOK, code)
+		require.NotNil(t, results.Trace)
+		assert.Equal(t, uint64(10), results.Trace.GasUsed)
+	})
+}
+
+func TestSimulateTransactionsBundle_EmptyBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	transactionGroup, err := groups.NewTransactionGroup(&mock.FacadeStub{})
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, apiErrors.ErrEmptyTransactionsBundle.Error())
+}
+
+func TestSimulateTransactionsBundle_ValidationErrorShouldErr(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte("hash"), nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			if tx.Nonce == 1 {
+				return expectedErr
+			}
+			return nil
+		},
+		SimulateTransactionsBundleExecutionHandler: func(txs []*dataTx.Transaction, _ bool) (*txSimData.BundleSimulationResults, error) {
+			require.Fail(t, "should have not been called")
+			return nil, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 0}, {Nonce: 1}})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	simulateResponse := simulateTxResponse{}
+	loadResponse(resp.Body, &simulateResponse)
+
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+	assert.Contains(t, simulateResponse.Error, "at index 1")
+	assert.Contains(t, simulateResponse.Error, expectedErr.Error())
+}
+
+func TestSimulateTransactionsBundle(t *testing.T) {
+	t.Parallel()
+
+	var simulatedNonces []uint64
+	facade := mock.FacadeStub{
+		CreateTransactionHandler: func(txArgs *external.ArgsCreateTransaction) (*dataTx.Transaction, []byte, error) {
+			return &dataTx.Transaction{Nonce: txArgs.Nonce}, []byte{byte(txArgs.Nonce)}, nil
+		},
+		ValidateTransactionForSimulationHandler: func(tx *dataTx.Transaction, bypassSignature bool) error {
+			return nil
+		},
+		SimulateTransactionsBundleExecutionHandler: func(txs []*dataTx.Transaction, _ bool) (*txSimData.BundleSimulationResults, error) {
+			results := &txSimData.BundleSimulationResults{}
+			for _, tx := range txs {
+				simulatedNonces = append(simulatedNonces, tx.Nonce)
+				results.Results = append(results.Results, &txSimData.SimulationResults{Status: "success"})
+			}
+			results.AccountChanges = []*txSimData.AccountChange{{Address: "address", Nonce: 2}}
+
+			return results, nil
+		},
+	}
+
+	transactionGroup, err := groups.NewTransactionGroup(&facade)
+	require.NoError(t, err)
+
+	ws := startWebServer(transactionGroup, "transaction", getTransactionRoutesConfig())
+
+	jsonBytes, _ := json.Marshal([]groups.SendTxRequest{{Nonce: 0}, {Nonce: 1}})
+	req, _ := http.NewRequest("POST", "/transaction/simulate-bundle", bytes.NewBuffer(jsonBytes))
+
+	resp := httptest.NewRecorder()
+	ws.ServeHTTP(resp, req)
+
+	type bundleResponse struct {
+		Data struct {
+			Result txSimData.BundleSimulationResults `json:"result"`
+		} `json:"data"`
+		Error string `json:"error"`
+		Code  string `json:"code"`
+	}
+	response := bundleResponse{}
+	loadResponse(resp.Body, &response)
+
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, []uint64{0, 1}, simulatedNonces)
+	require.Len(t, response.Data.Result.Results, 2)
+	assert.Equal(t, "00", response.Data.Result.Results[0].Hash)
+	assert.Equal(t, "01", response.Data.Result.Results[1].Hash)
+	require.Len(t, response.Data.Result.AccountChanges, 1)
+	assert.Equal(t, uint64(2), response.Data.Result.AccountChanges[0].Nonce)
+}
+
 func TestGetTransactionsPoolShouldError(t *testing.T) {
 	t.Parallel()
 
@@ -1182,6 +1483,7 @@ func getTransactionRoutesConfig() config.ApiRoutesConfig {
 					{Name: "/:txhash", Open: true},
 					{Name: "/:txhash/status", Open: true},
 					{Name: "/simulate", Open: true},
+					{Name: "/simulate-bundle", Open: true},
 				},
 			},
 		},
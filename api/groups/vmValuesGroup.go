@@ -8,10 +8,12 @@ import (
 	"sync"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -25,7 +27,7 @@ const (
 
 // vmValuesFacadeHandler defines the methods to be implemented by a facade for vm-values requests
 type vmValuesFacadeHandler interface {
-	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	IsInterfaceNil() bool
 }
@@ -76,13 +78,18 @@ func NewVmValuesGroup(facade vmValuesFacadeHandler) (*vmValuesGroup, error) {
 
 // VMValueRequest represents the structure on which user input for generating a new transaction will validate against
 type VMValueRequest struct {
-	ScAddress      string   `json:"scAddress"`
-	FuncName       string   `json:"funcName"`
-	CallerAddr     string   `json:"caller"`
-	CallValue      string   `json:"value"`
-	Args           []string `json:"args"`
-	SameScState    bool     `json:"sameScState"`
-	ShouldBeSynced bool     `json:"shouldBeSynced"`
+	ScAddress      string                             `json:"scAddress"`
+	FuncName       string                             `json:"funcName"`
+	CallerAddr     string                             `json:"caller"`
+	CallValue      string                             `json:"value"`
+	Args           []string                           `json:"args"`
+	SameScState    bool                               `json:"sameScState"`
+	ShouldBeSynced bool                               `json:"shouldBeSynced"`
+	StateOverrides map[string]*AccountOverrideRequest `json:"stateOverrides,omitempty"`
+	WithTrace      bool                               `json:"withTrace"`
+	BlockNonce     *uint64                            `json:"blockNonce,omitempty"`
+	BlockHash      string                             `json:"blockHash"`
+	RootHash       string                             `json:"rootHash"`
 }
 
 // getHex returns the data as bytes, hex-encoded
@@ -101,7 +108,7 @@ func (vvg *vmValuesGroup) getInt(context *gin.Context) {
 }
 
 func (vvg *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnDataKind) {
-	vmOutput, execErrMsg, err := vvg.doExecuteQuery(context)
+	vmOutput, _, execErrMsg, err := vvg.doExecuteQuery(context)
 
 	if err != nil {
 		vvg.returnBadRequest(context, "doGetVMValue", err)
@@ -116,32 +123,44 @@ func (vvg *vmValuesGroup) doGetVMValue(context *gin.Context, asType vm.ReturnDat
 	vvg.returnOkResponse(context, returnData, execErrMsg)
 }
 
-// executeQuery returns the data as string
+// executeQuery returns the data as string, together with the call trace if it was requested
 func (vvg *vmValuesGroup) executeQuery(context *gin.Context) {
-	vmOutput, execErrMsg, err := vvg.doExecuteQuery(context)
+	vmOutput, callTrace, execErrMsg, err := vvg.doExecuteQuery(context)
 	if err != nil {
 		vvg.returnBadRequest(context, "executeQuery", err)
 		return
 	}
 
-	vvg.returnOkResponse(context, vmOutput, execErrMsg)
+	if callTrace == nil {
+		vvg.returnOkResponse(context, vmOutput, execErrMsg)
+		return
+	}
+
+	context.JSON(
+		http.StatusOK,
+		shared.GenericAPIResponse{
+			Data:  gin.H{"data": vmOutput, "trace": callTrace},
+			Error: execErrMsg,
+			Code:  shared.ReturnCodeSuccess,
+		},
+	)
 }
 
-func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, string, error) {
+func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi, *common.CallTrace, string, error) {
 	request := VMValueRequest{}
 	err := context.ShouldBindJSON(&request)
 	if err != nil {
-		return nil, "", errors.ErrInvalidJSONRequest
+		return nil, nil, "", errors.ErrInvalidJSONRequest
 	}
 
 	command, err := vvg.createSCQuery(&request)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
-	vmOutputApi, err := vvg.getFacade().ExecuteSCQuery(command)
+	vmOutputApi, callTrace, err := vvg.getFacade().ExecuteSCQuery(command)
 	if err != nil {
-		return nil, "", err
+		return nil, nil, "", err
 	}
 
 	vmExecErrMsg := ""
@@ -149,7 +168,7 @@ func (vvg *vmValuesGroup) doExecuteQuery(context *gin.Context) (*vm.VMOutputApi,
 		vmExecErrMsg = vmOutputApi.ReturnCode + ":" + vmOutputApi.ReturnMessage
 	}
 
-	return vmOutputApi, vmExecErrMsg, nil
+	return vmOutputApi, callTrace, vmExecErrMsg, nil
 }
 
 func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQuery, error) {
@@ -175,6 +194,7 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		Arguments:      arguments,
 		SameScState:    request.SameScState,
 		ShouldBeSynced: request.ShouldBeSynced,
+		WithTrace:      request.WithTrace,
 	}
 
 	if len(request.CallerAddr) > 0 {
@@ -194,9 +214,49 @@ func (vvg *vmValuesGroup) createSCQuery(request *VMValueRequest) (*process.SCQue
 		scQuery.CallValue = callValue
 	}
 
+	scQuery.StateOverrides, err = createStateOverrides(request.StateOverrides, vvg.getFacade().DecodeAddressPubkey)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addBlockCoordinatesToSCQuery(scQuery, request)
+	if err != nil {
+		return nil, err
+	}
+
 	return scQuery, nil
 }
 
+func addBlockCoordinatesToSCQuery(scQuery *process.SCQuery, request *VMValueRequest) error {
+	numBlockCoordinates := 0
+	if request.BlockNonce != nil {
+		numBlockCoordinates++
+		scQuery.BlockNonce = core.OptionalUint64{Value: *request.BlockNonce, HasValue: true}
+	}
+
+	var err error
+	if len(request.BlockHash) > 0 {
+		numBlockCoordinates++
+		scQuery.BlockHash, err = hex.DecodeString(request.BlockHash)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid block hash: %s", request.BlockHash, err.Error())
+		}
+	}
+	if len(request.RootHash) > 0 {
+		numBlockCoordinates++
+		scQuery.BlockRootHash, err = hex.DecodeString(request.RootHash)
+		if err != nil {
+			return fmt.Errorf("'%s' is not a valid root hash: %s", request.RootHash, err.Error())
+		}
+	}
+
+	if numBlockCoordinates > 1 {
+		return errors.ErrTooManyBlockCoordinates
+	}
+
+	return nil
+}
+
 func (vvg *vmValuesGroup) returnBadRequest(context *gin.Context, errScope string, err error) {
 	message := fmt.Sprintf("%s: %s", errScope, err)
 	context.JSON(
@@ -11,11 +11,13 @@ import (
 	"testing"
 
 	"github.com/gin-gonic/gin"
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/vm"
 	apiErrors "github.com/multiversx/mx-chain-go/api/errors"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/mock"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -60,10 +62,10 @@ func TestGetHex_ShouldWork(t *testing.T) {
 	valueBuff, _ := hex.DecodeString("DEADBEEF")
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{valueBuff},
-			}, nil
+			}, nil, nil
 		},
 	}
 
@@ -87,10 +89,10 @@ func TestGetString_ShouldWork(t *testing.T) {
 	valueBuff := "DEADBEEF"
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{[]byte(valueBuff)},
-			}, nil
+			}, nil, nil
 		},
 	}
 
@@ -114,12 +116,12 @@ func TestGetInt_ShouldWork(t *testing.T) {
 	value := "1234567"
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
 			returnData := big.NewInt(0)
 			returnData.SetString(value, 10)
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{returnData.Bytes()},
-			}, nil
+			}, nil, nil
 		},
 	}
 
@@ -141,11 +143,11 @@ func TestQuery_ShouldWork(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
 
 			return &vm.VMOutputApi{
 				ReturnData: [][]byte{big.NewInt(42).Bytes()},
-			}, nil
+			}, nil, nil
 		},
 	}
 
@@ -163,6 +165,153 @@ func TestQuery_ShouldWork(t *testing.T) {
 	require.Equal(t, int64(42), big.NewInt(0).SetBytes(response.Data.ReturnData[0]).Int64())
 }
 
+func TestQuery_WithTraceShouldWork(t *testing.T) {
+	t.Parallel()
+
+	expectedTrace := &common.CallTrace{
+		ReturnCode: "ok",
+		GasUsed:    10,
+		Call: &common.CallFrame{
+			Function: "function",
+		},
+	}
+	facade := mock.FacadeStub{
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+			require.True(t, query.WithTrace)
+			return &vm.VMOutputApi{}, expectedTrace, nil
+		},
+	}
+
+	request := groups.VMValueRequest{
+		ScAddress: dummyScAddress,
+		FuncName:  "function",
+		WithTrace: true,
+	}
+
+	response := struct {
+		Data  *vm.VMOutputApi   `json:"data"`
+		Trace *common.CallTrace `json:"trace"`
+	}{}
+	statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+
+	require.Equal(t, http.StatusOK, statusCode)
+	require.NotNil(t, response.Data)
+	require.Equal(t, expectedTrace, response.Trace)
+}
+
+func TestQuery_OnPastBlocksShouldWork(t *testing.T) {
+	t.Parallel()
+
+	t.Run("on block nonce", func(t *testing.T) {
+		t.Parallel()
+
+		blockNonce := uint64(37)
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+				require.Equal(t, core.OptionalUint64{Value: 37, HasValue: true}, query.BlockNonce)
+				require.Empty(t, query.BlockHash)
+				require.Empty(t, query.BlockRootHash)
+				return &vm.VMOutputApi{}, nil, nil
+			},
+		}
+
+		request := groups.VMValueRequest{
+			ScAddress:  dummyScAddress,
+			FuncName:   "function",
+			BlockNonce: &blockNonce,
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+	})
+	t.Run("on block hash", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+				require.False(t, query.BlockNonce.HasValue)
+				require.Equal(t, []byte("block hash"), query.BlockHash)
+				return &vm.VMOutputApi{}, nil, nil
+			},
+		}
+
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			BlockHash: hex.EncodeToString([]byte("block hash")),
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+	})
+	t.Run("on root hash", func(t *testing.T) {
+		t.Parallel()
+
+		facade := mock.FacadeStub{
+			ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+				require.Equal(t, []byte("root hash"), query.BlockRootHash)
+				return &vm.VMOutputApi{}, nil, nil
+			},
+		}
+
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			RootHash:  hex.EncodeToString([]byte("root hash")),
+		}
+
+		response := simpleResponse{}
+		statusCode := doPost(t, &facade, "/vm-values/query", request, &response)
+		require.Equal(t, http.StatusOK, statusCode)
+	})
+}
+
+func TestCreateSCQuery_BlockCoordinates(t *testing.T) {
+	t.Parallel()
+
+	group, _ := groups.NewVmValuesGroup(&mock.FacadeStub{})
+
+	t.Run("invalid block hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			BlockHash: "bad hash",
+		}
+		_, err := group.CreateSCQuery(&request)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "'bad hash' is not a valid block hash")
+	})
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := groups.VMValueRequest{
+			ScAddress: dummyScAddress,
+			FuncName:  "function",
+			RootHash:  "bad hash",
+		}
+		_, err := group.CreateSCQuery(&request)
+		require.NotNil(t, err)
+		require.Contains(t, err.Error(), "'bad hash' is not a valid root hash")
+	})
+	t.Run("more block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		blockNonce := uint64(37)
+		request := groups.VMValueRequest{
+			ScAddress:  dummyScAddress,
+			FuncName:   "function",
+			BlockNonce: &blockNonce,
+			RootHash:   "aabb",
+		}
+		_, err := group.CreateSCQuery(&request)
+		require.Equal(t, apiErrors.ErrTooManyBlockCoordinates, err)
+	})
+}
+
 func TestCreateSCQuery_ArgumentIsNotHexShouldErr(t *testing.T) {
 	request := groups.VMValueRequest{
 		ScAddress: dummyScAddress,
@@ -181,8 +330,8 @@ func TestAllRoutes_FacadeErrorsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("some random error")
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return nil, errExpected
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+			return nil, nil, errExpected
 		},
 	}
 
@@ -200,8 +349,8 @@ func TestAllRoutes_WhenBadAddressShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid address")
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+			return &vm.VMOutputApi{}, nil, nil
 		},
 	}
 
@@ -219,8 +368,8 @@ func TestAllRoutes_WhenBadArgumentsShouldErr(t *testing.T) {
 
 	errExpected := errors.New("not a valid hex string")
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+			return &vm.VMOutputApi{}, nil, nil
 		},
 	}
 
@@ -238,8 +387,8 @@ func TestAllRoutes_WhenNoVMReturnDataShouldErr(t *testing.T) {
 
 	errExpected := errors.New("no return data")
 	facade := &mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+			return &vm.VMOutputApi{}, nil, nil
 		},
 	}
 
@@ -268,8 +417,8 @@ func TestAllRoutes_WhenBadJsonShouldErr(t *testing.T) {
 	t.Parallel()
 
 	facade := mock.FacadeStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (vmOutput *vm.VMOutputApi, e error) {
-			return &vm.VMOutputApi{}, nil
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+			return &vm.VMOutputApi{}, nil, nil
 		},
 	}
 
@@ -17,6 +17,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 )
 
 // FacadeStub is the mock implementation of a node router handler
@@ -33,21 +34,24 @@ type FacadeStub struct {
 	ValidateTransactionHandler                  func(tx *transaction.Transaction) error
 	ValidateTransactionForSimulationHandler     func(tx *transaction.Transaction, bypassSignature bool) error
 	SendBulkTransactionsHandler                 func(txs []*transaction.Transaction) (uint64, error)
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
 	ValidatorStatisticsHandler                  func() (map[string]*state.ValidatorApiResponse, error)
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	NodeConfigCalled                            func() map[string]interface{}
 	GetQueryHandlerCalled                       func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                        func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetGuardianDataCalled                       func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
-	GetPeerInfoCalled                           func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeerInfoCalled                           func(pid string) ([]common.PeerInfoAPI, error)
 	GetEpochStartDataAPICalled                  func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetThrottlerForEndpointCalled               func(endpoint string) (core.Throttler, bool)
 	GetUsernameCalled                           func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                           func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
 	GetKeyValuePairsCalled                      func(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
-	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	GetKeyValuePairsPageCalled                  func(address string, options api.AccountQueryOptions, fromKey string, limit int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error)
+	GetAccountStoragePageCalled                 func(address string, options api.AccountQueryOptions, continuationToken string, pageSize int) (*common.AccountStoragePage, api.BlockInfo, error)
+	SimulateTransactionExecutionHandler         func(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundleExecutionHandler  func(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 	GetESDTDataCalled                           func(address string, key string, nonce uint64, options api.AccountQueryOptions) (*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetAllESDTTokensCalled                      func(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetESDTsWithRoleCalled                      func(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
@@ -56,6 +60,7 @@ type FacadeStub struct {
 	GetBlockByHashCalled                        func(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonceCalled                       func(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetAlteredAccountsForBlockCalled            func(options api.GetAlteredAccountsForBlockOptions) ([]*outportcore.AlteredAccount, error)
+	GetStateDiffCalled                          func(fromRootHash string, toRootHash string, fromAddress string, limit int) (*common.StateDiffAPI, error)
 	GetBlockByRoundCalled                       func(round uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetInternalShardBlockByNonceCalled          func(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHashCalled           func(format common.ApiOutputFormat, hash string) (interface{}, error)
@@ -74,6 +79,12 @@ type FacadeStub struct {
 	GetProofCurrentRootHashCalled               func(string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                      func(string, string, string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                           func(string, string, [][]byte) (bool, error)
+	GetMultiProofCalled                         func(string, []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrieCalled                 func(string, string, []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofCalled                         func(string, string, string, int) (*common.GetRangeProofResponse, error)
+	GetRangeProofDataTrieCalled                 func(string, string, string, string, int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyMultiProofCalled                      func(string, []string, [][]byte) (*common.VerifyProofsResponse, error)
+	VerifyRangeProofCalled                      func(string, string, string, [][]byte) (*common.VerifyProofsResponse, error)
 	GetTokenSupplyCalled                        func(token string) (*api.ESDTSupply, error)
 	GetGenesisNodesPubKeysCalled                func() (map[uint32][]string, map[uint32][]string, error)
 	GetGenesisBalancesCalled                    func() ([]*common.InitialAccountAPI, error)
@@ -82,6 +93,13 @@ type FacadeStub struct {
 	GetLastPoolNonceForSenderCalled             func(sender string) (uint64, error)
 	GetTransactionsPoolNonceGapsForSenderCalled func(sender string) (*common.TransactionsPoolNonceGapsForSenderApiResponse, error)
 	GetGasConfigsCalled                         func() (map[string]map[string]uint64, error)
+	GetStorageStatsCalled                       func() ([]*common.StorageUnitStats, error)
+	CompactStorageUnitCalled                    func(unitName string) error
+	VerifyTrieIntegrityCalled                   func(rootHash string, repair bool) (*trieIntegrity.Report, error)
+	GetTrieSnapshotsStatusCalled                func() (map[string]*common.TrieSnapshotsStatus, error)
+	ControlTrieSnapshotsCalled                  func(trieName string, action string) error
+	GetConsensusRoundsCalled                    func() ([]*common.ConsensusRoundTimeline, error)
+	GetNetworkTopologyCalled                    func() (*data.NetworkTopology, error)
 }
 
 // GetTokenSupply -
@@ -129,6 +147,123 @@ func (f *FacadeStub) VerifyProof(rootHash string, address string, proof [][]byte
 	return false, nil
 }
 
+// GetMultiProof -
+func (f *FacadeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if f.GetMultiProofCalled != nil {
+		return f.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetMultiProofDataTrie -
+func (f *FacadeStub) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if f.GetMultiProofDataTrieCalled != nil {
+		return f.GetMultiProofDataTrieCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (f *FacadeStub) GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	if f.GetRangeProofCalled != nil {
+		return f.GetRangeProofCalled(rootHash, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil
+}
+
+// GetRangeProofDataTrie -
+func (f *FacadeStub) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if f.GetRangeProofDataTrieCalled != nil {
+		return f.GetRangeProofDataTrieCalled(rootHash, address, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (f *FacadeStub) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	if f.VerifyMultiProofCalled != nil {
+		return f.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return nil, nil
+}
+
+// VerifyRangeProof -
+func (f *FacadeStub) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	if f.VerifyRangeProofCalled != nil {
+		return f.VerifyRangeProofCalled(rootHash, startKey, endKey, proof)
+	}
+
+	return nil, nil
+}
+
+// GetStorageStats -
+func (f *FacadeStub) GetStorageStats() ([]*common.StorageUnitStats, error) {
+	if f.GetStorageStatsCalled != nil {
+		return f.GetStorageStatsCalled()
+	}
+
+	return nil, nil
+}
+
+// CompactStorageUnit -
+func (f *FacadeStub) CompactStorageUnit(unitName string) error {
+	if f.CompactStorageUnitCalled != nil {
+		return f.CompactStorageUnitCalled(unitName)
+	}
+
+	return nil
+}
+
+// VerifyTrieIntegrity -
+func (f *FacadeStub) VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+	if f.VerifyTrieIntegrityCalled != nil {
+		return f.VerifyTrieIntegrityCalled(rootHash, repair)
+	}
+
+	return &trieIntegrity.Report{}, nil
+}
+
+// GetTrieSnapshotsStatus -
+func (f *FacadeStub) GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error) {
+	if f.GetTrieSnapshotsStatusCalled != nil {
+		return f.GetTrieSnapshotsStatusCalled()
+	}
+
+	return nil, nil
+}
+
+// ControlTrieSnapshots -
+func (f *FacadeStub) ControlTrieSnapshots(trieName string, action string) error {
+	if f.ControlTrieSnapshotsCalled != nil {
+		return f.ControlTrieSnapshotsCalled(trieName, action)
+	}
+
+	return nil
+}
+
+// GetConsensusRounds -
+func (f *FacadeStub) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	if f.GetConsensusRoundsCalled != nil {
+		return f.GetConsensusRoundsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetNetworkTopology -
+func (f *FacadeStub) GetNetworkTopology() (*data.NetworkTopology, error) {
+	if f.GetNetworkTopologyCalled != nil {
+		return f.GetNetworkTopologyCalled()
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (f *FacadeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if f.GetUsernameCalled != nil {
@@ -190,6 +325,24 @@ func (f *FacadeStub) GetValueForKey(address string, key string, options api.Acco
 	return "", api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (f *FacadeStub) GetKeyValuePairsPage(address string, options api.AccountQueryOptions, fromKey string, limit int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+	if f.GetKeyValuePairsPageCalled != nil {
+		return f.GetKeyValuePairsPageCalled(address, options, fromKey, limit)
+	}
+
+	return nil, "", api.BlockInfo{}, nil
+}
+
+// GetAccountStoragePage -
+func (f *FacadeStub) GetAccountStoragePage(address string, options api.AccountQueryOptions, continuationToken string, pageSize int) (*common.AccountStoragePage, api.BlockInfo, error) {
+	if f.GetAccountStoragePageCalled != nil {
+		return f.GetAccountStoragePageCalled(address, options, continuationToken, pageSize)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
 // GetKeyValuePairs -
 func (f *FacadeStub) GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
 	if f.GetKeyValuePairsCalled != nil {
@@ -286,8 +439,17 @@ func (f *FacadeStub) GetTransaction(hash string, withResults bool) (*transaction
 }
 
 // SimulateTransactionExecution is the mock implementation of a handler's SimulateTransactionExecution method
-func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return f.SimulateTransactionExecutionHandler(tx)
+func (f *FacadeStub) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error) {
+	return f.SimulateTransactionExecutionHandler(tx, stateOverrides, withTrace)
+}
+
+// SimulateTransactionsBundleExecution is the mock implementation of a handler's SimulateTransactionsBundleExecution method
+func (f *FacadeStub) SimulateTransactionsBundleExecution(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error) {
+	if f.SimulateTransactionsBundleExecutionHandler != nil {
+		return f.SimulateTransactionsBundleExecutionHandler(txs, withTrace)
+	}
+
+	return nil, nil
 }
 
 // SendBulkTransactions is the mock implementation of a handler's SendBulkTransactions method
@@ -311,7 +473,7 @@ func (f *FacadeStub) ValidatorStatisticsApi() (map[string]*state.ValidatorApiRes
 }
 
 // ExecuteSCQuery is a mock implementation.
-func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
+func (f *FacadeStub) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
 	return f.ExecuteSCQueryHandler(query)
 }
 
@@ -336,8 +498,8 @@ func (f *FacadeStub) GetDelegatorsList() ([]*api.Delegator, error) {
 }
 
 // ComputeTransactionGasLimit -
-func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return f.ComputeTransactionGasLimitHandler(tx)
+func (f *FacadeStub) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
+	return f.ComputeTransactionGasLimitHandler(tx, stateOverrides)
 }
 
 // NodeConfig -
@@ -361,7 +523,7 @@ func (f *FacadeStub) GetQueryHandler(name string) (debug.QueryHandler, error) {
 }
 
 // GetPeerInfo -
-func (f *FacadeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
+func (f *FacadeStub) GetPeerInfo(pid string) ([]common.PeerInfoAPI, error) {
 	return f.GetPeerInfoCalled(pid)
 }
 
@@ -396,6 +558,14 @@ func (f *FacadeStub) GetAlteredAccountsForBlock(options api.GetAlteredAccountsFo
 	return nil, nil
 }
 
+// GetStateDiff -
+func (f *FacadeStub) GetStateDiff(fromRootHash string, toRootHash string, fromAddress string, limit int) (*common.StateDiffAPI, error) {
+	if f.GetStateDiffCalled != nil {
+		return f.GetStateDiffCalled(fromRootHash, toRootHash, fromAddress, limit)
+	}
+	return nil, nil
+}
+
 // GetInternalMetaBlockByNonce -
 func (f *FacadeStub) GetInternalMetaBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error) {
 	if f.GetInternalMetaBlockByNonceCalled != nil {
@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/api/shared"
+
+// SubscriptionsHandlerStub -
+type SubscriptionsHandlerStub struct {
+	ServeSubscriberBlockingCalled func(conn shared.WsConn) error
+}
+
+// ServeSubscriberBlocking -
+func (stub *SubscriptionsHandlerStub) ServeSubscriberBlocking(conn shared.WsConn) error {
+	if stub.ServeSubscriberBlockingCalled != nil {
+		return stub.ServeSubscriberBlockingCalled(conn)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SubscriptionsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -1,6 +1,7 @@
 package shared
 
 import (
+	"io"
 	"math/big"
 
 	"github.com/gin-gonic/gin"
@@ -18,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 )
 
 // HttpServerCloser defines the basic actions of starting and closing that a web server should be able to do
@@ -49,6 +51,19 @@ type UpgradeableHttpServerHandler interface {
 	IsInterfaceNil() bool
 }
 
+// WsConn defines the actions of a web socket connection
+type WsConn interface {
+	io.Closer
+	ReadMessage() (messageType int, p []byte, err error)
+	WriteMessage(messageType int, data []byte) error
+}
+
+// SubscriptionsHandler defines the component that serves the web socket subscribers
+type SubscriptionsHandler interface {
+	ServeSubscriberBlocking(conn WsConn) error
+	IsInterfaceNil() bool
+}
+
 // GroupHandler defines the actions needed to be performed by a gin API group
 type GroupHandler interface {
 	UpdateFacade(newFacade interface{}) error
@@ -73,11 +88,14 @@ type FacadeHandler interface {
 	GetESDTsWithRole(address string, role string, options api.AccountQueryOptions) ([]string, api.BlockInfo, error)
 	GetAllESDTTokens(address string, options api.AccountQueryOptions) (map[string]*esdt.ESDigitalToken, api.BlockInfo, error)
 	GetKeyValuePairs(address string, options api.AccountQueryOptions) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, fromKey string, limit int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error)
+	GetAccountStoragePage(address string, options api.AccountQueryOptions, continuationToken string, pageSize int) (*common.AccountStoragePage, api.BlockInfo, error)
 	GetGuardianData(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
 	GetBlockByHash(hash string, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByNonce(nonce uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetBlockByRound(round uint64, options api.BlockQueryOptions) (*api.Block, error)
 	GetAlteredAccountsForBlock(options api.GetAlteredAccountsForBlockOptions) ([]*outportcore.AlteredAccount, error)
+	GetStateDiff(fromRootHash string, toRootHash string, fromAddress string, limit int) (*common.StateDiffAPI, error)
 	GetInternalShardBlockByNonce(format common.ApiOutputFormat, nonce uint64) (interface{}, error)
 	GetInternalShardBlockByHash(format common.ApiOutputFormat, hash string) (interface{}, error)
 	GetInternalShardBlockByRound(format common.ApiOutputFormat, round uint64) (interface{}, error)
@@ -98,22 +116,36 @@ type FacadeHandler interface {
 	GetHeartbeats() ([]data.PubKeyHeartbeat, error)
 	GetQueryHandler(name string) (debug.QueryHandler, error)
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
-	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeerInfo(pid string) ([]common.PeerInfoAPI, error)
 	GetProof(rootHash string, address string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	GetProofCurrentRootHash(address string) (*common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	GetStorageStats() ([]*common.StorageUnitStats, error)
+	CompactStorageUnit(unitName string) error
+	VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error)
+	GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error)
+	ControlTrieSnapshots(trieName string, action string) error
+	GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error)
+	GetNetworkTopology() (*data.NetworkTopology, error)
 	GetThrottlerForEndpoint(endpoint string) (core.Throttler, bool)
 	CreateTransaction(txArgs *external.ArgsCreateTransaction) (*transaction.Transaction, []byte, error)
 	ValidateTransaction(tx *transaction.Transaction) error
 	ValidateTransactionForSimulation(tx *transaction.Transaction, checkSignature bool) error
 	SendBulkTransactions([]*transaction.Transaction) (uint64, error)
-	SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	SimulateTransactionsBundleExecution(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 	GetTransaction(hash string, withResults bool) (*transaction.ApiTransactionResult, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	EncodeAddressPubkey(pk []byte) (string, error)
 	ValidatorStatisticsApi() (map[string]*state.ValidatorApiResponse, error)
-	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, error)
+	ExecuteSCQuery(*process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error)
 	DecodeAddressPubkey(pk string) ([]byte, error)
 	RestApiInterface() string
 	RestAPIServerDebugMode() bool
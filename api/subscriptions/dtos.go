@@ -0,0 +1,78 @@
+package subscriptions
+
+const (
+	// ActionSubscribe is the action used for creating a new subscription
+	ActionSubscribe = "subscribe"
+	// ActionUnsubscribe is the action used for removing an existing subscription
+	ActionUnsubscribe = "unsubscribe"
+)
+
+const (
+	// TopicBlocks is the topic of the blocks committed by the node
+	TopicBlocks = "blocks"
+	// TopicFinalizedBlocks is the topic of the blocks finalized by the node
+	TopicFinalizedBlocks = "finalizedBlocks"
+	// TopicTransactions is the topic of the transactions sent from, or to, an address
+	TopicTransactions = "transactions"
+	// TopicEvents is the topic of the smart contract events, filtered by address and identifier
+	TopicEvents = "events"
+)
+
+// SubscriptionRequest is the message sent by a subscriber in order to create or to remove a subscription
+type SubscriptionRequest struct {
+	Action     string `json:"action"`
+	ID         string `json:"id"`
+	Topic      string `json:"topic"`
+	Address    string `json:"address,omitempty"`
+	Identifier string `json:"identifier,omitempty"`
+}
+
+// SubscriptionResponse is the message sent to a subscriber as the result of a subscription request
+type SubscriptionResponse struct {
+	ID    string `json:"id"`
+	Error string `json:"error,omitempty"`
+}
+
+// Notification is the message sent to a subscriber for each item that matches one of its subscriptions
+type Notification struct {
+	SubscriptionID string      `json:"subscriptionId"`
+	Topic          string      `json:"topic"`
+	Data           interface{} `json:"data"`
+}
+
+// BlockNotification holds the data of a committed block
+type BlockNotification struct {
+	Hash      string `json:"hash"`
+	Nonce     uint64 `json:"nonce"`
+	Round     uint64 `json:"round"`
+	Epoch     uint32 `json:"epoch"`
+	ShardID   uint32 `json:"shardID"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// FinalizedBlockNotification holds the data of a finalized block
+type FinalizedBlockNotification struct {
+	Hash string `json:"hash"`
+}
+
+// TransactionNotification holds the data of a transaction included in a committed block
+type TransactionNotification struct {
+	Hash      string `json:"hash"`
+	Type      string `json:"type"`
+	Nonce     uint64 `json:"nonce"`
+	Value     string `json:"value"`
+	Sender    string `json:"sender"`
+	Receiver  string `json:"receiver"`
+	Data      []byte `json:"data,omitempty"`
+	BlockHash string `json:"blockHash"`
+}
+
+// EventNotification holds the data of a smart contract event included in a committed block
+type EventNotification struct {
+	TxHash     string   `json:"txHash"`
+	Address    string   `json:"address"`
+	Identifier string   `json:"identifier"`
+	Topics     [][]byte `json:"topics"`
+	Data       []byte   `json:"data,omitempty"`
+	BlockHash  string   `json:"blockHash"`
+}
@@ -0,0 +1,51 @@
+package subscriptions
+
+import "errors"
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilPubKeyConverter signals that a nil public key converter has been provided
+var ErrNilPubKeyConverter = errors.New("nil public key converter")
+
+// ErrNilWsConn signals that a nil web socket connection has been provided
+var ErrNilWsConn = errors.New("nil web socket connection")
+
+// ErrInvalidMaxSubscribers signals that an invalid maximum number of subscribers has been provided
+var ErrInvalidMaxSubscribers = errors.New("invalid maximum number of subscribers")
+
+// ErrInvalidSubscriberQueueSize signals that an invalid subscriber queue size has been provided
+var ErrInvalidSubscriberQueueSize = errors.New("invalid subscriber queue size")
+
+// ErrInvalidMaxSubscriptionsPerSubscriber signals that an invalid maximum number of subscriptions per subscriber has been provided
+var ErrInvalidMaxSubscriptionsPerSubscriber = errors.New("invalid maximum number of subscriptions per subscriber")
+
+// ErrTooManySubscribers signals that the maximum number of subscribers has been reached
+var ErrTooManySubscribers = errors.New("too many subscribers")
+
+// ErrTooManySubscriptions signals that the subscriber reached the maximum number of subscriptions
+var ErrTooManySubscriptions = errors.New("too many subscriptions")
+
+// ErrInvalidAction signals that an invalid subscription action has been provided
+var ErrInvalidAction = errors.New("invalid action")
+
+// ErrInvalidTopic signals that an invalid subscription topic has been provided
+var ErrInvalidTopic = errors.New("invalid topic")
+
+// ErrEmptySubscriptionID signals that an empty subscription ID has been provided
+var ErrEmptySubscriptionID = errors.New("empty subscription ID")
+
+// ErrDuplicatedSubscriptionID signals that the subscription ID is already used by the subscriber
+var ErrDuplicatedSubscriptionID = errors.New("duplicated subscription ID")
+
+// ErrUnknownSubscriptionID signals that the subscriber has no subscription with the provided ID
+var ErrUnknownSubscriptionID = errors.New("unknown subscription ID")
+
+// ErrMissingAddress signals that the subscription requires an address filter
+var ErrMissingAddress = errors.New("missing address")
+
+// ErrInvalidAddress signals that an invalid address filter has been provided
+var ErrInvalidAddress = errors.New("invalid address")
+
+// ErrSubscriberClosed signals that the subscriber was closed
+var ErrSubscriberClosed = errors.New("subscriber closed")
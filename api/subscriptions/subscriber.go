@@ -0,0 +1,255 @@
+package subscriptions
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/api/shared"
+)
+
+type subscription struct {
+	id         string
+	topic      string
+	address    []byte
+	identifier []byte
+}
+
+type subscriber struct {
+	conn             shared.WsConn
+	marshaller       marshal.Marshalizer
+	pubKeyConverter  core.PubkeyConverter
+	maxSubscriptions int
+
+	mutSubscriptions sync.RWMutex
+	subscriptions    map[string]*subscription
+
+	queue     chan []byte
+	chanClose chan struct{}
+	closeOnce sync.Once
+}
+
+func newSubscriber(
+	conn shared.WsConn,
+	marshaller marshal.Marshalizer,
+	pubKeyConverter core.PubkeyConverter,
+	maxSubscriptions int,
+	queueSize int,
+) *subscriber {
+	return &subscriber{
+		conn:             conn,
+		marshaller:       marshaller,
+		pubKeyConverter:  pubKeyConverter,
+		maxSubscriptions: maxSubscriptions,
+		subscriptions:    make(map[string]*subscription),
+		queue:            make(chan []byte, queueSize),
+		chanClose:        make(chan struct{}),
+	}
+}
+
+// processRequestsBlocking reads the subscription requests until the connection is closed
+func (s *subscriber) processRequestsBlocking() {
+	for {
+		messageType, message, err := s.conn.ReadMessage()
+		if err != nil || messageType == websocket.CloseMessage {
+			log.Debug("subscriber connection closed", "error", err)
+			return
+		}
+
+		request := &SubscriptionRequest{}
+		err = json.Unmarshal(message, request)
+		if err != nil {
+			s.send(&SubscriptionResponse{Error: err.Error()})
+			continue
+		}
+
+		err = s.processRequest(request)
+		response := &SubscriptionResponse{ID: request.ID}
+		if err != nil {
+			response.Error = err.Error()
+		}
+		s.send(response)
+	}
+}
+
+func (s *subscriber) processRequest(request *SubscriptionRequest) error {
+	if len(request.ID) == 0 {
+		return ErrEmptySubscriptionID
+	}
+
+	switch request.Action {
+	case ActionSubscribe:
+		return s.subscribe(request)
+	case ActionUnsubscribe:
+		return s.unsubscribe(request.ID)
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidAction, request.Action)
+	}
+}
+
+func (s *subscriber) subscribe(request *SubscriptionRequest) error {
+	sub, err := s.createSubscription(request)
+	if err != nil {
+		return err
+	}
+
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	_, exists := s.subscriptions[sub.id]
+	if exists {
+		return ErrDuplicatedSubscriptionID
+	}
+	if len(s.subscriptions) >= s.maxSubscriptions {
+		return ErrTooManySubscriptions
+	}
+
+	s.subscriptions[sub.id] = sub
+
+	return nil
+}
+
+func (s *subscriber) createSubscription(request *SubscriptionRequest) (*subscription, error) {
+	sub := &subscription{
+		id:         request.ID,
+		topic:      request.Topic,
+		identifier: []byte(request.Identifier),
+	}
+
+	switch request.Topic {
+	case TopicBlocks, TopicFinalizedBlocks:
+		return sub, nil
+	case TopicTransactions:
+		if len(request.Address) == 0 {
+			return nil, ErrMissingAddress
+		}
+	case TopicEvents:
+		if len(request.Address) == 0 {
+			return sub, nil
+		}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrInvalidTopic, request.Topic)
+	}
+
+	address, err := s.pubKeyConverter.Decode(request.Address)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidAddress, err.Error())
+	}
+	sub.address = address
+
+	return sub, nil
+}
+
+func (s *subscriber) unsubscribe(id string) error {
+	s.mutSubscriptions.Lock()
+	defer s.mutSubscriptions.Unlock()
+
+	_, exists := s.subscriptions[id]
+	if !exists {
+		return ErrUnknownSubscriptionID
+	}
+
+	delete(s.subscriptions, id)
+
+	return nil
+}
+
+func (s *subscriber) notifyBlock(block *blockNotifications) {
+	s.mutSubscriptions.RLock()
+	defer s.mutSubscriptions.RUnlock()
+
+	for _, sub := range s.subscriptions {
+		switch sub.topic {
+		case TopicBlocks:
+			s.notify(sub, block.block)
+		case TopicTransactions:
+			for _, tx := range block.transactions {
+				if bytes.Equal(sub.address, tx.sender) || bytes.Equal(sub.address, tx.receiver) {
+					s.notify(sub, tx.notification)
+				}
+			}
+		case TopicEvents:
+			for _, event := range block.events {
+				if sub.matchesEvent(event) {
+					s.notify(sub, event.notification)
+				}
+			}
+		}
+	}
+}
+
+func (s *subscriber) notifyFinalizedBlock(finalizedBlock *FinalizedBlockNotification) {
+	s.mutSubscriptions.RLock()
+	defer s.mutSubscriptions.RUnlock()
+
+	for _, sub := range s.subscriptions {
+		if sub.topic == TopicFinalizedBlocks {
+			s.notify(sub, finalizedBlock)
+		}
+	}
+}
+
+func (sub *subscription) matchesEvent(event *eventEntry) bool {
+	if len(sub.address) > 0 && !bytes.Equal(sub.address, event.address) {
+		return false
+	}
+	if len(sub.identifier) > 0 && !bytes.Equal(sub.identifier, event.identifier) {
+		return false
+	}
+
+	return true
+}
+
+func (s *subscriber) notify(sub *subscription, data interface{}) {
+	s.send(&Notification{
+		SubscriptionID: sub.id,
+		Topic:          sub.topic,
+		Data:           data,
+	})
+}
+
+// send adds the message in the subscriber's queue. A subscriber that does not keep up with the messages is closed,
+// so that it will not delay the other subscribers, nor will it accumulate messages in memory
+func (s *subscriber) send(message interface{}) {
+	buff, err := s.marshaller.Marshal(message)
+	if err != nil {
+		log.Warn("cannot marshal subscription message", "error", err)
+		return
+	}
+
+	select {
+	case s.queue <- buff:
+	case <-s.chanClose:
+	default:
+		log.Debug("subscriber queue is full, closing the subscriber")
+		s.close()
+	}
+}
+
+// sendContinuously writes the queued messages on the connection until the subscriber is closed
+func (s *subscriber) sendContinuously() {
+	for {
+		select {
+		case <-s.chanClose:
+			return
+		case buff := <-s.queue:
+			err := s.conn.WriteMessage(websocket.TextMessage, buff)
+			if err != nil {
+				log.Debug("cannot write to subscriber, closing it", "error", err)
+				s.close()
+				return
+			}
+		}
+	}
+}
+
+func (s *subscriber) close() {
+	s.closeOnce.Do(func() {
+		close(s.chanClose)
+		_ = s.conn.Close()
+	})
+}
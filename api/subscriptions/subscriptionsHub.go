@@ -0,0 +1,348 @@
+package subscriptions
+
+import (
+	"encoding/hex"
+	"sort"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("api/subscriptions")
+
+const (
+	normalTxType   = "normal"
+	unsignedTxType = "unsigned"
+	rewardTxType   = "reward"
+	invalidTxType  = "invalid"
+)
+
+// ArgsSubscriptionsHub defines the arguments needed for creating a new subscriptions hub
+type ArgsSubscriptionsHub struct {
+	Marshaller                    marshal.Marshalizer
+	PubKeyConverter               core.PubkeyConverter
+	MaxSubscribers                uint32
+	MaxSubscriptionsPerSubscriber uint32
+	SubscriberQueueSize           uint32
+}
+
+type transactionEntry struct {
+	notification *TransactionNotification
+	sender       []byte
+	receiver     []byte
+}
+
+type eventEntry struct {
+	notification *EventNotification
+	address      []byte
+	identifier   []byte
+}
+
+type blockNotifications struct {
+	block        *BlockNotification
+	transactions []*transactionEntry
+	events       []*eventEntry
+}
+
+type subscriptionsHub struct {
+	marshaller                    marshal.Marshalizer
+	pubKeyConverter               core.PubkeyConverter
+	maxSubscribers                int
+	maxSubscriptionsPerSubscriber int
+	subscriberQueueSize           int
+
+	mutSubscribers sync.RWMutex
+	subscribers    map[*subscriber]struct{}
+}
+
+// NewSubscriptionsHub creates a new subscriptions hub. The hub is an outport driver that pushes the committed blocks,
+// the finalized blocks, the transactions and the smart contract events to the web socket subscribers
+func NewSubscriptionsHub(args ArgsSubscriptionsHub) (*subscriptionsHub, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &subscriptionsHub{
+		marshaller:                    args.Marshaller,
+		pubKeyConverter:               args.PubKeyConverter,
+		maxSubscribers:                int(args.MaxSubscribers),
+		maxSubscriptionsPerSubscriber: int(args.MaxSubscriptionsPerSubscriber),
+		subscriberQueueSize:           int(args.SubscriberQueueSize),
+		subscribers:                   make(map[*subscriber]struct{}),
+	}, nil
+}
+
+func checkArgs(args ArgsSubscriptionsHub) error {
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.PubKeyConverter) {
+		return ErrNilPubKeyConverter
+	}
+	if args.MaxSubscribers == 0 {
+		return ErrInvalidMaxSubscribers
+	}
+	if args.MaxSubscriptionsPerSubscriber == 0 {
+		return ErrInvalidMaxSubscriptionsPerSubscriber
+	}
+	if args.SubscriberQueueSize == 0 {
+		return ErrInvalidSubscriberQueueSize
+	}
+
+	return nil
+}
+
+// ServeSubscriberBlocking handles the subscription requests received on the provided connection and pushes the
+// matching notifications, until the connection is closed
+func (hub *subscriptionsHub) ServeSubscriberBlocking(conn shared.WsConn) error {
+	if conn == nil {
+		return ErrNilWsConn
+	}
+
+	sub := newSubscriber(conn, hub.marshaller, hub.pubKeyConverter, hub.maxSubscriptionsPerSubscriber, hub.subscriberQueueSize)
+	defer sub.close()
+
+	err := hub.addSubscriber(sub)
+	if err != nil {
+		hub.rejectConnection(conn, err)
+		return err
+	}
+	defer hub.removeSubscriber(sub)
+
+	go sub.sendContinuously()
+	sub.processRequestsBlocking()
+
+	return nil
+}
+
+func (hub *subscriptionsHub) addSubscriber(sub *subscriber) error {
+	hub.mutSubscribers.Lock()
+	defer hub.mutSubscribers.Unlock()
+
+	if len(hub.subscribers) >= hub.maxSubscribers {
+		return ErrTooManySubscribers
+	}
+
+	hub.subscribers[sub] = struct{}{}
+
+	return nil
+}
+
+func (hub *subscriptionsHub) rejectConnection(conn shared.WsConn, reason error) {
+	buff, err := hub.marshaller.Marshal(&SubscriptionResponse{Error: reason.Error()})
+	if err != nil {
+		return
+	}
+
+	_ = conn.WriteMessage(websocket.TextMessage, buff)
+}
+
+func (hub *subscriptionsHub) removeSubscriber(sub *subscriber) {
+	hub.mutSubscribers.Lock()
+	delete(hub.subscribers, sub)
+	hub.mutSubscribers.Unlock()
+}
+
+// NumSubscribers returns the number of the connected subscribers
+func (hub *subscriptionsHub) NumSubscribers() int {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	return len(hub.subscribers)
+}
+
+// SaveBlock pushes the block, its transactions and its events to the subscribers
+func (hub *subscriptionsHub) SaveBlock(args *outport.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return nil
+	}
+
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	if len(hub.subscribers) == 0 {
+		return nil
+	}
+
+	notifications := hub.createBlockNotifications(args)
+	for sub := range hub.subscribers {
+		sub.notifyBlock(notifications)
+	}
+
+	return nil
+}
+
+func (hub *subscriptionsHub) createBlockNotifications(args *outport.ArgsSaveBlockData) *blockNotifications {
+	blockHash := hex.EncodeToString(args.HeaderHash)
+	notifications := &blockNotifications{
+		block: &BlockNotification{
+			Hash:      blockHash,
+			Nonce:     args.Header.GetNonce(),
+			Round:     args.Header.GetRound(),
+			Epoch:     args.Header.GetEpoch(),
+			ShardID:   args.Header.GetShardID(),
+			Timestamp: args.Header.GetTimeStamp(),
+		},
+		transactions: make([]*transactionEntry, 0),
+		events:       make([]*eventEntry, 0),
+	}
+
+	pool := args.TransactionsPool
+	if pool == nil {
+		return notifications
+	}
+
+	notifications.transactions = append(notifications.transactions, hub.createTransactionEntries(pool.Txs, normalTxType, blockHash)...)
+	notifications.transactions = append(notifications.transactions, hub.createTransactionEntries(pool.Scrs, unsignedTxType, blockHash)...)
+	notifications.transactions = append(notifications.transactions, hub.createTransactionEntries(pool.Rewards, rewardTxType, blockHash)...)
+	notifications.transactions = append(notifications.transactions, hub.createTransactionEntries(pool.Invalid, invalidTxType, blockHash)...)
+	notifications.events = hub.createEventEntries(pool.Logs, blockHash)
+
+	return notifications
+}
+
+func (hub *subscriptionsHub) createTransactionEntries(
+	txs map[string]data.TransactionHandlerWithGasUsedAndFee,
+	txType string,
+	blockHash string,
+) []*transactionEntry {
+	hashes := make([]string, 0, len(txs))
+	for hash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i, j int) bool {
+		return txs[hashes[i]].GetExecutionOrder() < txs[hashes[j]].GetExecutionOrder()
+	})
+
+	entries := make([]*transactionEntry, 0, len(hashes))
+	for _, hash := range hashes {
+		tx := txs[hash]
+		value := "0"
+		if tx.GetValue() != nil {
+			value = tx.GetValue().String()
+		}
+
+		entries = append(entries, &transactionEntry{
+			notification: &TransactionNotification{
+				Hash:      hex.EncodeToString([]byte(hash)),
+				Type:      txType,
+				Nonce:     tx.GetNonce(),
+				Value:     value,
+				Sender:    hub.encodeAddress(tx.GetSndAddr()),
+				Receiver:  hub.encodeAddress(tx.GetRcvAddr()),
+				Data:      tx.GetData(),
+				BlockHash: blockHash,
+			},
+			sender:   tx.GetSndAddr(),
+			receiver: tx.GetRcvAddr(),
+		})
+	}
+
+	return entries
+}
+
+func (hub *subscriptionsHub) createEventEntries(logs []*data.LogData, blockHash string) []*eventEntry {
+	entries := make([]*eventEntry, 0)
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		for _, event := range logData.GetLogEvents() {
+			if check.IfNil(event) {
+				continue
+			}
+
+			entries = append(entries, &eventEntry{
+				notification: &EventNotification{
+					TxHash:     hex.EncodeToString([]byte(logData.TxHash)),
+					Address:    hub.encodeAddress(event.GetAddress()),
+					Identifier: string(event.GetIdentifier()),
+					Topics:     event.GetTopics(),
+					Data:       event.GetData(),
+					BlockHash:  blockHash,
+				},
+				address:    event.GetAddress(),
+				identifier: event.GetIdentifier(),
+			})
+		}
+	}
+
+	return entries
+}
+
+func (hub *subscriptionsHub) encodeAddress(address []byte) string {
+	if len(address) != hub.pubKeyConverter.Len() {
+		return hex.EncodeToString(address)
+	}
+
+	return hub.pubKeyConverter.Encode(address)
+}
+
+// FinalizedBlock pushes the finalized block hash to the subscribers
+func (hub *subscriptionsHub) FinalizedBlock(headerHash []byte) error {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	finalizedBlock := &FinalizedBlockNotification{
+		Hash: hex.EncodeToString(headerHash),
+	}
+	for sub := range hub.subscribers {
+		sub.notifyFinalizedBlock(finalizedBlock)
+	}
+
+	return nil
+}
+
+// RevertIndexedBlock does nothing
+func (hub *subscriptionsHub) RevertIndexedBlock(_ data.HeaderHandler, _ data.BodyHandler) error {
+	return nil
+}
+
+// SaveRoundsInfo does nothing
+func (hub *subscriptionsHub) SaveRoundsInfo(_ []*outport.RoundInfo) error {
+	return nil
+}
+
+// SaveValidatorsPubKeys does nothing
+func (hub *subscriptionsHub) SaveValidatorsPubKeys(_ map[uint32][][]byte, _ uint32) error {
+	return nil
+}
+
+// SaveValidatorsRating does nothing
+func (hub *subscriptionsHub) SaveValidatorsRating(_ string, _ []*outport.ValidatorRatingInfo) error {
+	return nil
+}
+
+// SaveAccounts does nothing
+func (hub *subscriptionsHub) SaveAccounts(_ uint64, _ map[string]*outport.AlteredAccount, _ uint32) error {
+	return nil
+}
+
+// Close closes all the subscribers
+func (hub *subscriptionsHub) Close() error {
+	hub.mutSubscribers.RLock()
+	defer hub.mutSubscribers.RUnlock()
+
+	for sub := range hub.subscribers {
+		sub.close()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (hub *subscriptionsHub) IsInterfaceNil() bool {
+	return hub == nil
+}
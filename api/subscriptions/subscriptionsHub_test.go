@@ -0,0 +1,465 @@
+package subscriptions_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/subscriptions"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const timeout = time.Second * 2
+
+var (
+	alice = []byte("alice-address-of-32-bytes-length")
+	bob   = []byte("bob-address-of-32-bytes-length!!")
+)
+
+// testConn is a web socket connection that reads the requests from a channel and writes the messages on another one
+type testConn struct {
+	requests  chan []byte
+	messages  chan []byte
+	closeOnce sync.Once
+	chanClose chan struct{}
+}
+
+func newTestConn() *testConn {
+	return &testConn{
+		requests:  make(chan []byte, 10),
+		messages:  make(chan []byte, 100),
+		chanClose: make(chan struct{}),
+	}
+}
+
+func (conn *testConn) ReadMessage() (int, []byte, error) {
+	select {
+	case request := <-conn.requests:
+		return websocket.TextMessage, request, nil
+	case <-conn.chanClose:
+		return 0, nil, errors.New("connection closed")
+	}
+}
+
+func (conn *testConn) WriteMessage(_ int, buff []byte) error {
+	select {
+	case conn.messages <- buff:
+		return nil
+	case <-conn.chanClose:
+		return errors.New("connection closed")
+	}
+}
+
+func (conn *testConn) Close() error {
+	conn.closeOnce.Do(func() {
+		close(conn.chanClose)
+	})
+
+	return nil
+}
+
+func (conn *testConn) sendRequest(t *testing.T, request *subscriptions.SubscriptionRequest) *subscriptions.SubscriptionResponse {
+	buff, _ := json.Marshal(request)
+	conn.requests <- buff
+
+	response := &subscriptions.SubscriptionResponse{}
+	conn.receive(t, response)
+
+	return response
+}
+
+func (conn *testConn) receive(t *testing.T, message interface{}) {
+	select {
+	case buff := <-conn.messages:
+		require.Nil(t, json.Unmarshal(buff, message))
+	case <-time.After(timeout):
+		require.Fail(t, "timeout waiting for message")
+	}
+}
+
+func (conn *testConn) requireNoMessage(t *testing.T) {
+	select {
+	case buff := <-conn.messages:
+		require.Fail(t, "unexpected message", string(buff))
+	case <-time.After(time.Millisecond * 100):
+	}
+}
+
+func createMockArgsSubscriptionsHub() subscriptions.ArgsSubscriptionsHub {
+	return subscriptions.ArgsSubscriptionsHub{
+		Marshaller:                    &marshal.JsonMarshalizer{},
+		PubKeyConverter:               testscommon.NewPubkeyConverterMock(32),
+		MaxSubscribers:                2,
+		MaxSubscriptionsPerSubscriber: 2,
+		SubscriberQueueSize:           100,
+	}
+}
+
+type subscriptionsHubHandler interface {
+	ServeSubscriberBlocking(conn shared.WsConn) error
+	NumSubscribers() int
+}
+
+func connectSubscriber(t *testing.T, hub subscriptionsHubHandler) *testConn {
+	conn := newTestConn()
+	numSubscribers := hub.NumSubscribers()
+	go func() {
+		_ = hub.ServeSubscriberBlocking(conn)
+	}()
+
+	require.Eventually(t, func() bool {
+		return hub.NumSubscribers() == numSubscribers+1
+	}, timeout, time.Millisecond*10)
+
+	return conn
+}
+
+func createArgsSaveBlock() *outport.ArgsSaveBlockData {
+	tx := &transaction.Transaction{Nonce: 3, Value: big.NewInt(10), SndAddr: alice, RcvAddr: bob, Data: []byte("data")}
+	reward := &rewardTx.RewardTx{Value: big.NewInt(5), RcvAddr: alice}
+
+	return &outport.ArgsSaveBlockData{
+		HeaderHash: []byte("header hash"),
+		Header:     &block.Header{Nonce: 7, Round: 8, Epoch: 1},
+		TransactionsPool: &outport.Pool{
+			Txs: map[string]data.TransactionHandlerWithGasUsedAndFee{
+				"tx hash": outport.NewTransactionHandlerWithGasAndFee(tx, 0, big.NewInt(0)),
+			},
+			Rewards: map[string]data.TransactionHandlerWithGasUsedAndFee{
+				"reward hash": outport.NewTransactionHandlerWithGasAndFee(reward, 0, big.NewInt(0)),
+			},
+			Logs: []*data.LogData{
+				{
+					TxHash: "tx hash",
+					LogHandler: &transaction.Log{
+						Address: bob,
+						Events: []*transaction.Event{
+							{Address: bob, Identifier: []byte("transfer"), Topics: [][]byte{[]byte("topic")}},
+							{Address: bob, Identifier: []byte("writeLog")},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestNewSubscriptionsHub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.Marshaller = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.Equal(t, subscriptions.ErrNilMarshaller, err)
+	})
+	t.Run("nil pub key converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.PubKeyConverter = nil
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.Equal(t, subscriptions.ErrNilPubKeyConverter, err)
+	})
+	t.Run("invalid max subscribers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscribers = 0
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.Equal(t, subscriptions.ErrInvalidMaxSubscribers, err)
+	})
+	t.Run("invalid max subscriptions per subscriber should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.MaxSubscriptionsPerSubscriber = 0
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.Equal(t, subscriptions.ErrInvalidMaxSubscriptionsPerSubscriber, err)
+	})
+	t.Run("invalid subscriber queue size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSubscriptionsHub()
+		args.SubscriberQueueSize = 0
+		hub, err := subscriptions.NewSubscriptionsHub(args)
+		assert.True(t, check.IfNil(hub))
+		assert.Equal(t, subscriptions.ErrInvalidSubscriberQueueSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, err := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		assert.False(t, check.IfNil(hub))
+		assert.Nil(t, err)
+	})
+}
+
+func TestSubscriptionsHub_ServeSubscriberBlocking(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil connection should error", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		err := hub.ServeSubscriberBlocking(nil)
+		assert.Equal(t, subscriptions.ErrNilWsConn, err)
+	})
+	t.Run("too many subscribers should reject the connection", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		_ = connectSubscriber(t, hub)
+		_ = connectSubscriber(t, hub)
+
+		conn := newTestConn()
+		err := hub.ServeSubscriberBlocking(conn)
+		assert.Equal(t, subscriptions.ErrTooManySubscribers, err)
+
+		response := &subscriptions.SubscriptionResponse{}
+		conn.receive(t, response)
+		assert.Equal(t, subscriptions.ErrTooManySubscribers.Error(), response.Error)
+		assert.Equal(t, 2, hub.NumSubscribers())
+	})
+	t.Run("closed connection should remove the subscriber", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		_ = conn.Close()
+
+		assert.Eventually(t, func() bool {
+			return hub.NumSubscribers() == 0
+		}, timeout, time.Millisecond*10)
+	})
+	t.Run("invalid requests should respond with errors", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		response := conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, Topic: subscriptions.TopicBlocks})
+		assert.Equal(t, subscriptions.ErrEmptySubscriptionID.Error(), response.Error)
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: "action", ID: "1", Topic: subscriptions.TopicBlocks})
+		assert.Contains(t, response.Error, subscriptions.ErrInvalidAction.Error())
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: "topic"})
+		assert.Contains(t, response.Error, subscriptions.ErrInvalidTopic.Error())
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicTransactions})
+		assert.Equal(t, subscriptions.ErrMissingAddress.Error(), response.Error)
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicTransactions, Address: "not hex"})
+		assert.Contains(t, response.Error, subscriptions.ErrInvalidAddress.Error())
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionUnsubscribe, ID: "1"})
+		assert.Equal(t, subscriptions.ErrUnknownSubscriptionID.Error(), response.Error)
+	})
+	t.Run("subscribe and unsubscribe should work", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		defer func() {
+			_ = conn.Close()
+		}()
+
+		response := conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicBlocks})
+		assert.Equal(t, &subscriptions.SubscriptionResponse{ID: "1"}, response)
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicBlocks})
+		assert.Equal(t, subscriptions.ErrDuplicatedSubscriptionID.Error(), response.Error)
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "2", Topic: subscriptions.TopicFinalizedBlocks})
+		assert.Empty(t, response.Error)
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "3", Topic: subscriptions.TopicEvents})
+		assert.Equal(t, subscriptions.ErrTooManySubscriptions.Error(), response.Error)
+
+		response = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionUnsubscribe, ID: "1"})
+		assert.Equal(t, &subscriptions.SubscriptionResponse{ID: "1"}, response)
+
+		err := hub.SaveBlock(createArgsSaveBlock())
+		assert.Nil(t, err)
+		conn.requireNoMessage(t)
+	})
+}
+
+func TestSubscriptionsHub_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("blocks subscription should receive the block", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		defer func() {
+			_ = conn.Close()
+		}()
+		_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicBlocks})
+
+		err := hub.SaveBlock(createArgsSaveBlock())
+		assert.Nil(t, err)
+
+		blockNotification := &subscriptions.BlockNotification{}
+		notification := &subscriptions.Notification{Data: blockNotification}
+		conn.receive(t, notification)
+		assert.Equal(t, "1", notification.SubscriptionID)
+		assert.Equal(t, subscriptions.TopicBlocks, notification.Topic)
+		assert.Equal(t, &subscriptions.BlockNotification{
+			Hash:  hex.EncodeToString([]byte("header hash")),
+			Nonce: 7,
+			Round: 8,
+			Epoch: 1,
+		}, blockNotification)
+		conn.requireNoMessage(t)
+	})
+	t.Run("transactions subscription should receive the transactions of the address", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		defer func() {
+			_ = conn.Close()
+		}()
+		_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{
+			Action:  subscriptions.ActionSubscribe,
+			ID:      "1",
+			Topic:   subscriptions.TopicTransactions,
+			Address: hex.EncodeToString(bob),
+		})
+
+		err := hub.SaveBlock(createArgsSaveBlock())
+		assert.Nil(t, err)
+
+		txNotification := &subscriptions.TransactionNotification{}
+		conn.receive(t, &subscriptions.Notification{Data: txNotification})
+		assert.Equal(t, &subscriptions.TransactionNotification{
+			Hash:      hex.EncodeToString([]byte("tx hash")),
+			Type:      "normal",
+			Nonce:     3,
+			Value:     "10",
+			Sender:    hex.EncodeToString(alice),
+			Receiver:  hex.EncodeToString(bob),
+			Data:      []byte("data"),
+			BlockHash: hex.EncodeToString([]byte("header hash")),
+		}, txNotification)
+		// the reward transaction is sent to alice only
+		conn.requireNoMessage(t)
+	})
+	t.Run("events subscription should filter by address and identifier", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		defer func() {
+			_ = conn.Close()
+		}()
+		_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{
+			Action:     subscriptions.ActionSubscribe,
+			ID:         "1",
+			Topic:      subscriptions.TopicEvents,
+			Address:    hex.EncodeToString(bob),
+			Identifier: "transfer",
+		})
+		_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{
+			Action:  subscriptions.ActionSubscribe,
+			ID:      "2",
+			Topic:   subscriptions.TopicEvents,
+			Address: hex.EncodeToString(alice),
+		})
+
+		err := hub.SaveBlock(createArgsSaveBlock())
+		assert.Nil(t, err)
+
+		eventNotification := &subscriptions.EventNotification{}
+		notification := &subscriptions.Notification{Data: eventNotification}
+		conn.receive(t, notification)
+		assert.Equal(t, "1", notification.SubscriptionID)
+		assert.Equal(t, &subscriptions.EventNotification{
+			TxHash:     hex.EncodeToString([]byte("tx hash")),
+			Address:    hex.EncodeToString(bob),
+			Identifier: "transfer",
+			Topics:     [][]byte{[]byte("topic")},
+			BlockHash:  hex.EncodeToString([]byte("header hash")),
+		}, eventNotification)
+		conn.requireNoMessage(t)
+	})
+	t.Run("nil arguments should not notify", func(t *testing.T) {
+		t.Parallel()
+
+		hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+		conn := connectSubscriber(t, hub)
+		defer func() {
+			_ = conn.Close()
+		}()
+		_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicBlocks})
+
+		err := hub.SaveBlock(nil)
+		assert.Nil(t, err)
+		err = hub.SaveBlock(&outport.ArgsSaveBlockData{})
+		assert.Nil(t, err)
+		conn.requireNoMessage(t)
+	})
+}
+
+func TestSubscriptionsHub_FinalizedBlock(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	conn := connectSubscriber(t, hub)
+	defer func() {
+		_ = conn.Close()
+	}()
+	_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "1", Topic: subscriptions.TopicBlocks})
+	_ = conn.sendRequest(t, &subscriptions.SubscriptionRequest{Action: subscriptions.ActionSubscribe, ID: "2", Topic: subscriptions.TopicFinalizedBlocks})
+
+	err := hub.FinalizedBlock([]byte("header hash"))
+	assert.Nil(t, err)
+
+	finalizedBlock := &subscriptions.FinalizedBlockNotification{}
+	notification := &subscriptions.Notification{Data: finalizedBlock}
+	conn.receive(t, notification)
+	assert.Equal(t, "2", notification.SubscriptionID)
+	assert.Equal(t, hex.EncodeToString([]byte("header hash")), finalizedBlock.Hash)
+	conn.requireNoMessage(t)
+}
+
+func TestSubscriptionsHub_Close(t *testing.T) {
+	t.Parallel()
+
+	hub, _ := subscriptions.NewSubscriptionsHub(createMockArgsSubscriptionsHub())
+	_ = connectSubscriber(t, hub)
+	_ = connectSubscriber(t, hub)
+
+	err := hub.Close()
+	assert.Nil(t, err)
+	assert.Eventually(t, func() bool {
+		return hub.NumSubscribers() == 0
+	}, timeout, time.Millisecond*10)
+}
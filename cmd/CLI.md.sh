@@ -2,11 +2,16 @@
 
 generate() {
     generateForAssessmentTool
+    generateForDbMigrator
     generateForKeyGenerator
     generateForLogViewer
     generateForNode
     generateForSeedNode
+    generateForSlashingProtection
+    generateForStateExporter
+    generateForStorageInspector
     generateForTermUi
+    generateForTopologyExporter
 }
 
 generateForAssessmentTool() {
@@ -23,6 +28,20 @@ $(code)
     echo "$HELP" > ./assessment/CLI.md
 }
 
+generateForDbMigrator() {
+    HELP="
+# Database migrator CLI
+
+The **Database migrator tool** exposes the following Command Line Interface:
+$(code)
+\$ dbmigrator --help
+
+$(./dbmigrator/dbmigrator --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./dbmigrator/CLI.md
+}
+
 generateForKeyGenerator() {
     HELP="
 # Keygenerator CLI
@@ -79,6 +98,48 @@ $(code)
     echo "$HELP" > ./seednode/CLI.md
 }
 
+generateForSlashingProtection() {
+    HELP="
+# Slashing protection CLI
+
+The **Slashing protection tool** exposes the following Command Line Interface:
+$(code)
+\$ slashingprotection --help
+
+$(./slashingprotection/slashingprotection --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./slashingprotection/CLI.md
+}
+
+generateForStateExporter() {
+    HELP="
+# State exporter CLI
+
+The **State exporter tool** exposes the following Command Line Interface:
+$(code)
+\$ stateexporter --help
+
+$(./stateexporter/stateexporter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./stateexporter/CLI.md
+}
+
+generateForStorageInspector() {
+    HELP="
+# Storage inspector CLI
+
+The **Storage inspector tool** exposes the following Command Line Interface:
+$(code)
+\$ storageinspector --help
+
+$(./storageinspector/storageinspector --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./storageinspector/CLI.md
+}
+
 generateForTermUi() {
     HELP="
 # MultiversX TermUI CLI
@@ -93,6 +154,20 @@ $(code)
     echo "$HELP" > ./termui/CLI.md
 }
 
+generateForTopologyExporter() {
+    HELP="
+# Topology exporter CLI
+
+The **Topology exporter tool** exposes the following Command Line Interface:
+$(code)
+\$ topologyexporter --help
+
+$(./topologyexporter/topologyexporter --help | head -n -3)
+$(code)
+"
+    echo "$HELP" > ./topologyexporter/CLI.md
+}
+
 code() {
     printf "\n\`\`\`\n"
 }
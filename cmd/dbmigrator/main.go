@@ -0,0 +1,132 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var errInvalidTargetType = errors.New("invalid target database type")
+var errInvalidBatchSize = errors.New("invalid batch size")
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the databases that will be migrated
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `path` of the node databases. All the databases found under it are migrated. " +
+			"Example: ./db",
+	}
+	// targetType defines a flag for the database type the databases are converted to
+	targetType = cli.StringFlag{
+		Name: "target-type",
+		Usage: "The database `type` the databases are converted to, one of PebbleDB, LvlDBSerial or LvlDB. The " +
+			"databases that already have the target format are skipped",
+		Value: string(storageunit.PebbleDB),
+	}
+	// batchSize defines a flag for the number of keys written at once in the converted databases
+	batchSize = cli.IntFlag{
+		Name:  "batch-size",
+		Usage: "The `number` of keys written at once in the converted databases",
+		Value: 10000,
+	}
+	// keepSource defines a flag that keeps the original databases
+	keepSource = cli.BoolFlag{
+		Name: "keep-source",
+		Usage: "Boolean option for keeping the original databases, renamed with the " + backupSuffix + " suffix. " +
+			"If not set, they are removed after being converted",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	log = logger.GetOrCreate("dbmigrator")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Database migrator tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This tool converts, in place, the databases of a stopped node to another database type. After the " +
+		"migration, the Type of the storage units DB in config.toml should be set to the target type"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		targetType,
+		batchSize,
+		keepSource,
+		logLevel,
+	}
+
+	app.Action = migrateDatabases
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error migrating the databases", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func migrateDatabases(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.GlobalString(dbPath.Name)) == 0 {
+		return fmt.Errorf("the --%s flag is mandatory", dbPath.Name)
+	}
+
+	migrator, err := newDBMigrator(
+		storageunit.DBType(ctx.GlobalString(targetType.Name)),
+		ctx.GlobalInt(batchSize.Name),
+		ctx.GlobalBool(keepSource.Name),
+	)
+	if err != nil {
+		return err
+	}
+
+	summary, err := migrator.migrateAll(ctx.GlobalString(dbPath.Name))
+	if err != nil {
+		return err
+	}
+
+	log.Info("databases migrated",
+		"num migrated", summary.numMigrated,
+		"num skipped", summary.numSkipped,
+		"num keys", summary.numKeys,
+	)
+
+	return nil
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+const (
+	// currentFileName is the file written by both level db and pebble in each database directory
+	currentFileName = "CURRENT"
+	// pebbleOptionsFilePrefix is the prefix of the options file written only by pebble
+	pebbleOptionsFilePrefix = "OPTIONS-"
+	migratingSuffix         = ".migrating"
+	backupSuffix            = ".backup"
+	batchDelaySeconds       = 2
+	maxOpenFiles            = 10
+)
+
+// migrationSummary holds the number of databases processed by a migration
+type migrationSummary struct {
+	numMigrated int
+	numSkipped  int
+	numKeys     int
+}
+
+// dbMigrator converts, in place, all the databases found under a directory to the target database type. Each
+// database is copied in a sibling directory which replaces the original one only after all the keys were copied,
+// so an interrupted migration can be safely started again.
+type dbMigrator struct {
+	targetType storageunit.DBType
+	batchSize  int
+	keepSource bool
+}
+
+func newDBMigrator(targetType storageunit.DBType, batchSize int, keepSource bool) (*dbMigrator, error) {
+	switch targetType {
+	case storageunit.LvlDB, storageunit.LvlDBSerial, storageunit.PebbleDB:
+	default:
+		return nil, fmt.Errorf("%w: %s", errInvalidTargetType, targetType)
+	}
+	if batchSize < 1 {
+		return nil, errInvalidBatchSize
+	}
+
+	return &dbMigrator{
+		targetType: targetType,
+		batchSize:  batchSize,
+		keepSource: keepSource,
+	}, nil
+}
+
+func (dm *dbMigrator) migrateAll(rootPath string) (*migrationSummary, error) {
+	databases, interrupted, err := findDatabases(rootPath)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, path := range interrupted {
+		databases, err = recoverInterruptedMigration(path, databases)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	summary := &migrationSummary{}
+	for _, path := range databases {
+		sourceType, errDetect := detectDBType(path)
+		if errDetect != nil {
+			return nil, errDetect
+		}
+		if isSameFormat(sourceType, dm.targetType) {
+			log.Debug("database already has the target format", "path", path)
+			summary.numSkipped++
+			continue
+		}
+
+		numKeys, errMigrate := dm.migrateDatabase(path, sourceType)
+		if errMigrate != nil {
+			return nil, fmt.Errorf("%w while migrating %s", errMigrate, path)
+		}
+		summary.numMigrated++
+		summary.numKeys += numKeys
+	}
+
+	return summary, nil
+}
+
+// findDatabases returns the database directories and the directories of the interrupted migrations
+func findDatabases(rootPath string) ([]string, []string, error) {
+	databases := make([]string, 0)
+	interrupted := make([]string, 0)
+	err := filepath.Walk(rootPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if strings.HasSuffix(path, migratingSuffix) {
+			interrupted = append(interrupted, path)
+			return filepath.SkipDir
+		}
+		if strings.HasSuffix(path, backupSuffix) {
+			return filepath.SkipDir
+		}
+
+		_, errStat := os.Stat(filepath.Join(path, currentFileName))
+		if errStat != nil {
+			return nil
+		}
+
+		databases = append(databases, path)
+		return filepath.SkipDir
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return databases, interrupted, nil
+}
+
+// recoverInterruptedMigration removes the partial copy of a database. If the original database was already removed,
+// the copy was complete and it is only moved in place.
+func recoverInterruptedMigration(migratingPath string, databases []string) ([]string, error) {
+	path := strings.TrimSuffix(migratingPath, migratingSuffix)
+	_, err := os.Stat(path)
+	if err == nil {
+		log.Warn("removing the partial copy of an interrupted migration", "path", migratingPath)
+		return databases, os.RemoveAll(migratingPath)
+	}
+
+	log.Warn("completing an interrupted migration", "path", path)
+	err = os.Rename(migratingPath, path)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(databases, path), nil
+}
+
+func detectDBType(path string) (storageunit.DBType, error) {
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return "", err
+	}
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), pebbleOptionsFilePrefix) {
+			return storageunit.PebbleDB, nil
+		}
+	}
+
+	return storageunit.LvlDBSerial, nil
+}
+
+func isSameFormat(sourceType storageunit.DBType, targetType storageunit.DBType) bool {
+	return (sourceType == storageunit.PebbleDB) == (targetType == storageunit.PebbleDB)
+}
+
+func (dm *dbMigrator) migrateDatabase(path string, sourceType storageunit.DBType) (int, error) {
+	log.Info("migrating database", "path", path, "source type", sourceType, "target type", dm.targetType)
+	startTime := time.Now()
+
+	migratingPath := path + migratingSuffix
+	err := os.RemoveAll(migratingPath)
+	if err != nil {
+		return 0, err
+	}
+
+	numKeys, err := dm.copyDatabase(path, sourceType, migratingPath)
+	if err != nil {
+		_ = os.RemoveAll(migratingPath)
+		return 0, err
+	}
+
+	if dm.keepSource {
+		err = os.Rename(path, path+backupSuffix)
+	} else {
+		err = os.RemoveAll(path)
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	err = os.Rename(migratingPath, path)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info("database migrated", "path", path, "num keys", numKeys, "duration", time.Since(startTime))
+
+	return numKeys, nil
+}
+
+func (dm *dbMigrator) copyDatabase(sourcePath string, sourceType storageunit.DBType, targetPath string) (int, error) {
+	source, err := dm.openDatabase(sourcePath, sourceType)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = source.Close()
+	}()
+
+	target, err := dm.openDatabase(targetPath, dm.targetType)
+	if err != nil {
+		return 0, err
+	}
+
+	numKeys := 0
+	var errPut error
+	source.RangeKeys(func(key []byte, val []byte) bool {
+		errPut = target.Put(key, val)
+		if errPut != nil {
+			return false
+		}
+
+		numKeys++
+		return true
+	})
+	if errPut != nil {
+		_ = target.Close()
+		return 0, errPut
+	}
+
+	// closing the target commits the last batch
+	err = target.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return numKeys, nil
+}
+
+func (dm *dbMigrator) openDatabase(path string, dbType storageunit.DBType) (storage.Persister, error) {
+	dbConfig := config.DBConfig{
+		Type:              string(dbType),
+		BatchDelaySeconds: batchDelaySeconds,
+		MaxBatchSize:      dm.batchSize,
+		MaxOpenFiles:      maxOpenFiles,
+	}
+
+	return factory.NewPersisterFactory(dbConfig).Create(path)
+}
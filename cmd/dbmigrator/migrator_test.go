@@ -0,0 +1,135 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createDatabase(t *testing.T, dm *dbMigrator, path string, dbType storageunit.DBType, numKeys int) {
+	db, err := dm.openDatabase(path, dbType)
+	require.Nil(t, err)
+
+	for i := 0; i < numKeys; i++ {
+		require.Nil(t, db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	require.Nil(t, db.Close())
+}
+
+func readDatabase(t *testing.T, dm *dbMigrator, path string, dbType storageunit.DBType) map[string]string {
+	detectedType, err := detectDBType(path)
+	require.Nil(t, err)
+	require.True(t, isSameFormat(detectedType, dbType))
+
+	db, err := dm.openDatabase(path, dbType)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	values := make(map[string]string)
+	db.RangeKeys(func(key []byte, val []byte) bool {
+		values[string(key)] = string(val)
+		return true
+	})
+
+	return values
+}
+
+func TestNewDBMigrator(t *testing.T) {
+	t.Parallel()
+
+	dm, err := newDBMigrator(storageunit.MemoryDB, 10, false)
+	assert.Nil(t, dm)
+	assert.True(t, errors.Is(err, errInvalidTargetType))
+
+	dm, err = newDBMigrator(storageunit.PebbleDB, 0, false)
+	assert.Nil(t, dm)
+	assert.Equal(t, errInvalidBatchSize, err)
+
+	dm, err = newDBMigrator(storageunit.PebbleDB, 10, false)
+	assert.Nil(t, err)
+	assert.NotNil(t, dm)
+}
+
+func TestDBMigrator_MigrateAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should convert the level db databases to pebble", func(t *testing.T) {
+		t.Parallel()
+
+		rootPath := t.TempDir()
+		dm, _ := newDBMigrator(storageunit.PebbleDB, 7, false)
+		transactionsPath := filepath.Join(rootPath, "1", "Epoch_0", "Shard_0", "Transactions")
+		trieEpochPath := filepath.Join(rootPath, "1", "Static", "Shard_0", "TrieEpochRootHash")
+		createDatabase(t, dm, transactionsPath, storageunit.LvlDBSerial, 20)
+		createDatabase(t, dm, trieEpochPath, storageunit.LvlDB, 3)
+
+		summary, err := dm.migrateAll(rootPath)
+		require.Nil(t, err)
+		assert.Equal(t, &migrationSummary{numMigrated: 2, numKeys: 23}, summary)
+
+		values := readDatabase(t, dm, transactionsPath, storageunit.PebbleDB)
+		assert.Equal(t, 20, len(values))
+		assert.Equal(t, "value13", values["key13"])
+		assert.Equal(t, 3, len(readDatabase(t, dm, trieEpochPath, storageunit.PebbleDB)))
+
+		_, err = os.Stat(transactionsPath + backupSuffix)
+		assert.True(t, os.IsNotExist(err))
+
+		// a second run finds all the databases in the target format
+		summary, err = dm.migrateAll(rootPath)
+		require.Nil(t, err)
+		assert.Equal(t, &migrationSummary{numSkipped: 2}, summary)
+	})
+	t.Run("should convert back to level db and keep the source", func(t *testing.T) {
+		t.Parallel()
+
+		rootPath := t.TempDir()
+		dm, _ := newDBMigrator(storageunit.LvlDBSerial, 100, true)
+		path := filepath.Join(rootPath, "MiniBlocks")
+		createDatabase(t, dm, path, storageunit.PebbleDB, 10)
+
+		summary, err := dm.migrateAll(rootPath)
+		require.Nil(t, err)
+		assert.Equal(t, &migrationSummary{numMigrated: 1, numKeys: 10}, summary)
+
+		assert.Equal(t, 10, len(readDatabase(t, dm, path, storageunit.LvlDBSerial)))
+		assert.Equal(t, 10, len(readDatabase(t, dm, path+backupSuffix, storageunit.PebbleDB)))
+	})
+	t.Run("should discard the partial copy of an interrupted migration", func(t *testing.T) {
+		t.Parallel()
+
+		rootPath := t.TempDir()
+		dm, _ := newDBMigrator(storageunit.PebbleDB, 100, false)
+		path := filepath.Join(rootPath, "MiniBlocks")
+		createDatabase(t, dm, path, storageunit.LvlDBSerial, 10)
+		createDatabase(t, dm, path+migratingSuffix, storageunit.PebbleDB, 2)
+
+		summary, err := dm.migrateAll(rootPath)
+		require.Nil(t, err)
+		assert.Equal(t, &migrationSummary{numMigrated: 1, numKeys: 10}, summary)
+		assert.Equal(t, 10, len(readDatabase(t, dm, path, storageunit.PebbleDB)))
+	})
+	t.Run("should complete an interrupted migration after the source was removed", func(t *testing.T) {
+		t.Parallel()
+
+		rootPath := t.TempDir()
+		dm, _ := newDBMigrator(storageunit.PebbleDB, 100, false)
+		path := filepath.Join(rootPath, "MiniBlocks")
+		createDatabase(t, dm, path+migratingSuffix, storageunit.PebbleDB, 10)
+
+		summary, err := dm.migrateAll(rootPath)
+		require.Nil(t, err)
+		assert.Equal(t, &migrationSummary{numSkipped: 1}, summary)
+		assert.Equal(t, 10, len(readDatabase(t, dm, path, storageunit.PebbleDB)))
+		_, err = os.Stat(path + migratingSuffix)
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -286,6 +286,14 @@ var (
 			"and re-process everything",
 		Value: "",
 	}
+	// importStateSnapshot defines a flag for the optional directory holding a state snapshot to bootstrap from
+	importStateSnapshot = cli.StringFlag{
+		Name: "import-state-snapshot",
+		Usage: "This flag, if set, will make the node load the accounts state from the state snapshot placed in the " +
+			"provided directory, instead of syncing it from the network, when bootstrapping in an epoch. The snapshot " +
+			"is verified against the epoch start root hash. Snapshots are created with the stateexporter tool",
+		Value: "",
+	}
 	// importDbNoSigCheck defines a flag for the optional import DB no signature check option
 	importDbNoSigCheck = cli.BoolFlag{
 		Name:  "import-db-no-sig-check",
@@ -302,6 +310,30 @@ var (
 		Value: 0,
 		Usage: "This flag will specify the start in epoch value in import-db process",
 	}
+	// outportReplayFromNonce defines a flag for the first nonce replayed into the outport drivers
+	outportReplayFromNonce = cli.Uint64Flag{
+		Name: "outport-replay-from-nonce",
+		Usage: "This flag, if set, will make the node push the blocks already committed in its storage, starting with the provided nonce, " +
+			"into the configured outport drivers and then exit. Should be used together with the outport-replay-to-nonce flag. " +
+			"Requires a full archive node, as the accounts state of each replayed block is recreated",
+		Value: 0,
+	}
+	// outportReplayToNonce defines a flag for the last nonce replayed into the outport drivers
+	outportReplayToNonce = cli.Uint64Flag{
+		Name: "outport-replay-to-nonce",
+		Usage: "This flag, if set, will make the node push the blocks already committed in its storage, up to and including the provided nonce, " +
+			"into the configured outport drivers and then exit. Should be used together with the outport-replay-from-nonce flag. " +
+			"Requires a full archive node, as the accounts state of each replayed block is recreated",
+		Value: 0,
+	}
+	// outportReplayRecordsDir defines a flag for the directory of the records replayed into the outport drivers
+	outportReplayRecordsDir = cli.StringFlag{
+		Name: "outport-replay-records-dir",
+		Usage: "This flag, if set together with the outport-replay-from-nonce and outport-replay-to-nonce flags, will make the " +
+			"node push the records written by the file outport driver in the provided `directory`, instead of the blocks " +
+			"committed in its storage, into the other configured outport drivers. Does not require a full archive node",
+		Value: "",
+	}
 	// redundancyLevel defines a flag that specifies the level of redundancy used by the current instance for the node (-1 = disabled, 0 = main instance (default), 1 = first backup, 2 = second backup, etc.)
 	redundancyLevel = cli.Int64Flag{
 		Name:  "redundancy-level",
@@ -404,6 +436,10 @@ func getFlags() []cli.Flag {
 		importDbNoSigCheck,
 		importDbSaveEpochRootHash,
 		importDbStartInEpoch,
+		importStateSnapshot,
+		outportReplayFromNonce,
+		outportReplayToNonce,
+		outportReplayRecordsDir,
 		redundancyLevel,
 		fullArchive,
 		memBallast,
@@ -441,6 +477,7 @@ func getFlagsConfig(ctx *cli.Context, log logger.Logger) *config.ContextFlagsCon
 	flagsConfig.SerializeSnapshots = ctx.GlobalBool(serializeSnapshots.Name)
 	flagsConfig.NoKeyProvided = ctx.GlobalBool(noKey.Name)
 	flagsConfig.OperationMode = ctx.GlobalString(operationMode.Name)
+	flagsConfig.StateSnapshotDirectory = ctx.GlobalString(importStateSnapshot.Name)
 
 	return flagsConfig
 }
@@ -485,8 +522,15 @@ func applyFlags(ctx *cli.Context, cfgs *config.Configs, flagsConfig *config.Cont
 		ImportDbSaveTrieEpochRootHash: ctx.GlobalBool(importDbSaveEpochRootHash.Name),
 		ImportDBStartInEpoch:          uint32(ctx.GlobalUint64(importDbStartInEpoch.Name)),
 	}
+	outportReplayConfig := &config.OutportReplayConfig{
+		IsOutportReplayMode: ctx.IsSet(outportReplayFromNonce.Name) || ctx.IsSet(outportReplayToNonce.Name),
+		FromNonce:           ctx.GlobalUint64(outportReplayFromNonce.Name),
+		ToNonce:             ctx.GlobalUint64(outportReplayToNonce.Name),
+		RecordsDirectory:    ctx.GlobalString(outportReplayRecordsDir.Name),
+	}
 	cfgs.FlagsConfig = flagsConfig
 	cfgs.ImportDbConfig = importDBConfigs
+	cfgs.OutportReplayConfig = outportReplayConfig
 	err := applyCompatibleConfigs(log, cfgs)
 	if err != nil {
 		return err
@@ -534,6 +578,13 @@ func applyCompatibleConfigs(log logger.Logger, configs *config.Configs) error {
 		return fmt.Errorf("import-db-no-sig-check can only be used with the import-db flag")
 	}
 
+	if configs.OutportReplayConfig.IsOutportReplayMode {
+		err := processConfigOutportReplayMode(log, configs)
+		if err != nil {
+			return err
+		}
+	}
+
 	operationModes, err := operationmodes.ParseOperationModes(configs.FlagsConfig.OperationMode)
 	if err != nil {
 		return err
@@ -652,6 +703,40 @@ func processConfigImportDBMode(log logger.Logger, configs *config.Configs) error
 	return nil
 }
 
+func processConfigOutportReplayMode(log logger.Logger, configs *config.Configs) error {
+	replayConfig := configs.OutportReplayConfig
+	if configs.ImportDbConfig.IsImportDBMode {
+		return fmt.Errorf("the outport replay can not be used together with the import-db flag")
+	}
+	if replayConfig.FromNonce > replayConfig.ToNonce {
+		return fmt.Errorf("invalid outport replay interval: from nonce %d is greater than to nonce %d",
+			replayConfig.FromNonce, replayConfig.ToNonce)
+	}
+
+	if len(replayConfig.RecordsDirectory) > 0 {
+		// the records are pushed into the other drivers, the file driver would only append them to its own files
+		configs.ExternalConfig.FileDriverConnector.Enabled = false
+		log.Warn("the node will replay the outport records from files, the file outport driver was disabled",
+			"records directory", replayConfig.RecordsDirectory,
+		)
+	}
+
+	// the blocks are read from the local storage, so the node should neither bootstrap from the network nor
+	// wait for peers before the replay starts
+	configs.GeneralConfig.GeneralSettings.StartInEpochEnabled = false
+	configs.P2pConfig.Node.ThresholdMinConnectedPeers = 0
+	configs.P2pConfig.KadDhtPeerDiscovery.Enabled = false
+
+	log.Warn("the node is in outport replay mode! Will auto-set some config values",
+		"GeneralSettings.StartInEpochEnabled", configs.GeneralConfig.GeneralSettings.StartInEpochEnabled,
+		"p2p.ThresholdMinConnectedPeers", configs.P2pConfig.Node.ThresholdMinConnectedPeers,
+		"kad dht discoverer", "off",
+		"from nonce", replayConfig.FromNonce,
+		"to nonce", replayConfig.ToNonce,
+	)
+	return nil
+}
+
 func processConfigFullArchiveMode(log logger.Logger, configs *config.Configs) {
 	generalConfigs := configs.GeneralConfig
 
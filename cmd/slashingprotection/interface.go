@@ -0,0 +1,13 @@
+package main
+
+import (
+	"io"
+
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+)
+
+type slashingProtectionDB interface {
+	Export(w io.Writer) error
+	Import(r io.Reader) (*slashingProtection.ImportSummary, error)
+	Close() error
+}
@@ -0,0 +1,201 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var errExportOrImportFileNeeded = errors.New("exactly one of the export-file and import-file flags should be set")
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the slashing protection database
+	dbPath = cli.StringFlag{
+		Name: "db-path",
+		Usage: "The `path` of the slashing protection database, as set in the SlashingProtection section of " +
+			"config.toml, relative to the node working directory. Example: ./slashing-protection",
+	}
+	// dbType defines a flag for the type of the slashing protection database
+	dbType = cli.StringFlag{
+		Name:  "db-type",
+		Usage: "The database `type` of the slashing protection database, one of LvlDBSerial, LvlDB or PebbleDB",
+		Value: string(storageunit.LvlDBSerial),
+	}
+	// exportFile defines a flag for the file the records are exported to
+	exportFile = cli.StringFlag{
+		Name:  "export-file",
+		Usage: "The `file` where all the records are exported, in the interchange format",
+	}
+	// importFile defines a flag for the file the records are imported from
+	importFile = cli.StringFlag{
+		Name: "import-file",
+		Usage: "The `file` with the records to be imported, in the interchange format. The records conflicting " +
+			"with the ones already in the database are reported and skipped",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	log = logger.GetOrCreate("slashingprotection")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Slashing protection tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This tool exports or imports the blocks proposed and signed by the validator keys of a stopped node. " +
+		"It should be used when moving a validator key on another machine, so that the new machine does not sign " +
+		"a block conflicting with the ones already signed"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		dbType,
+		exportFile,
+		importFile,
+		logLevel,
+	}
+
+	app.Action = exportOrImport
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the slashing protection tool", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func exportOrImport(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.GlobalString(dbPath.Name)) == 0 {
+		return fmt.Errorf("the --%s flag is mandatory", dbPath.Name)
+	}
+	exportPath := ctx.GlobalString(exportFile.Name)
+	importPath := ctx.GlobalString(importFile.Name)
+	if (len(exportPath) == 0) == (len(importPath) == 0) {
+		return errExportOrImportFileNeeded
+	}
+
+	db, err := openSlashingProtectionDB(ctx.GlobalString(dbPath.Name), storageunit.DBType(ctx.GlobalString(dbType.Name)))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		errClose := db.Close()
+		if errClose != nil {
+			log.Warn("error closing the slashing protection database", "error", errClose)
+		}
+	}()
+
+	if len(exportPath) > 0 {
+		return exportRecords(db, exportPath)
+	}
+
+	return importRecords(db, importPath)
+}
+
+func openSlashingProtectionDB(path string, dbType storageunit.DBType) (slashingProtectionDB, error) {
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageunit.CacheConfig{
+			Name:     "SlashingProtection",
+			Type:     storageunit.LRUCache,
+			Capacity: 1000,
+		},
+		storageunit.DBConfig{
+			FilePath:          path,
+			Type:              dbType,
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashingProtection.NewSlashingProtectionDB(slashingProtection.ArgsSlashingProtectionDB{
+		Storer: storer,
+	})
+}
+
+func exportRecords(db slashingProtectionDB, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = db.Export(file)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	log.Info("slashing protection records exported", "file", path)
+
+	return nil
+}
+
+func importRecords(db slashingProtectionDB, path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	summary, err := db.Import(file)
+	if err != nil {
+		return err
+	}
+
+	log.Info("slashing protection records imported",
+		"file", path,
+		"num imported", summary.NumImported,
+		"num existing", summary.NumExisting,
+		"num conflicts", summary.NumConflicts,
+	)
+
+	return nil
+}
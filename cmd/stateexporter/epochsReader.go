@@ -0,0 +1,96 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+)
+
+var errReadOnlyStorage = errors.New("the storage is opened read only")
+var errNoEpochDirectory = errors.New("no epoch directory found")
+
+const epochDirectoryFormat = "Epoch_%d"
+const shardDirectoryFormat = "Shard_%s"
+
+// epochsReader reads the trie nodes from all the epoch directories of a storage unit, newest epoch first, the same
+// way the pruning storer of the node does
+type epochsReader struct {
+	persisters []storage.Persister
+}
+
+func newEpochsReader(dbPath string, shardID uint32, epoch uint32, dbConfig config.DBConfig) (*epochsReader, error) {
+	persisterFactory := factory.NewPersisterFactory(dbConfig)
+	shardDirectory := fmt.Sprintf(shardDirectoryFormat, core.GetShardIDString(shardID))
+	reader := &epochsReader{
+		persisters: make([]storage.Persister, 0),
+	}
+
+	for e := int64(epoch); e >= 0; e-- {
+		path := filepath.Join(dbPath, fmt.Sprintf(epochDirectoryFormat, e), shardDirectory, dbConfig.FilePath)
+		_, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		persister, err := persisterFactory.Create(path)
+		if err != nil {
+			_ = reader.Close()
+			return nil, fmt.Errorf("%w while opening %s", err, path)
+		}
+
+		log.Debug("opened storage", "path", path)
+		reader.persisters = append(reader.persisters, persister)
+	}
+
+	if len(reader.persisters) == 0 {
+		return nil, fmt.Errorf("%w in %s for shard %s, epoch %d", errNoEpochDirectory, dbPath, core.GetShardIDString(shardID), epoch)
+	}
+
+	return reader, nil
+}
+
+// Get returns the value from the newest epoch that holds the key
+func (er *epochsReader) Get(key []byte) ([]byte, error) {
+	for _, persister := range er.persisters {
+		val, err := persister.Get(key)
+		if err == nil {
+			return val, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// Put returns error as the storage is read only
+func (er *epochsReader) Put(_, _ []byte) error {
+	return errReadOnlyStorage
+}
+
+// Remove returns error as the storage is read only
+func (er *epochsReader) Remove(_ []byte) error {
+	return errReadOnlyStorage
+}
+
+// Close closes all the opened persisters
+func (er *epochsReader) Close() error {
+	var lastErr error
+	for _, persister := range er.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (er *epochsReader) IsInterfaceNil() bool {
+	return er == nil
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const megabyte = 1024 * 1024
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the node database, including the chain ID directory
+	dbPath = cli.StringFlag{
+		Name:  "db-path",
+		Usage: "The `path` of the node database, including the chain ID directory. Example: ./db/1",
+	}
+	// shard defines a flag for the shard of the exported state
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `shard` of the exported state, as a shard number or metachain",
+		Value: "0",
+	}
+	// epoch defines a flag for the epoch of the exported state
+	epoch = cli.UintFlag{
+		Name:  "epoch",
+		Usage: "The `epoch` of the exported state. The trie nodes are searched in this epoch and all the previous ones",
+	}
+	// rootHash defines a flag for the root hash of the exported state
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded `root hash` of the accounts trie that will be exported",
+	}
+	// outputDirectory defines a flag for the directory that will hold the snapshot
+	outputDirectory = cli.StringFlag{
+		Name:  "output",
+		Usage: "The `directory` in which the snapshot chunks and manifest will be written",
+		Value: "./state-snapshot",
+	}
+	// chunkSize defines a flag for the maximum size of a snapshot chunk
+	chunkSize = cli.Uint64Flag{
+		Name:  "chunk-size",
+		Usage: "The maximum `size` in MB of a snapshot chunk",
+		Value: 256,
+	}
+	// configurationFile defines a flag for the path to the main toml configuration file of the node
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `filepath` for the main configuration file of the node. The accounts trie storage, hasher and " +
+			"marshalizer configurations are read from it",
+		Value: "./config/config.toml",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	log = logger.GetOrCreate("stateexporter")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "State exporter tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This tool exports the accounts trie and the data tries of a stopped node into a portable state " +
+		"snapshot, which can be loaded by another node with the --import-state-snapshot flag"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		shard,
+		epoch,
+		rootHash,
+		outputDirectory,
+		chunkSize,
+		configurationFile,
+		logLevel,
+	}
+
+	app.Action = exportState
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error exporting the state", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func exportState(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.GlobalString(dbPath.Name)) == 0 {
+		return fmt.Errorf("the --%s flag is mandatory", dbPath.Name)
+	}
+	shardID, err := core.ConvertShardIDToUint32(ctx.GlobalString(shard.Name))
+	if err != nil {
+		return err
+	}
+	rootHashBytes, err := hex.DecodeString(ctx.GlobalString(rootHash.Name))
+	if err != nil {
+		return err
+	}
+	if len(rootHashBytes) == 0 {
+		return fmt.Errorf("the --%s flag is mandatory", rootHash.Name)
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return err
+	}
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return err
+	}
+
+	epochValue := uint32(ctx.GlobalUint(epoch.Name))
+	db, err := newEpochsReader(ctx.GlobalString(dbPath.Name), shardID, epochValue, generalConfig.AccountsTrieStorage.DB)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	output := ctx.GlobalString(outputDirectory.Name)
+	exporter, err := stateSnapshot.NewSnapshotExporter(stateSnapshot.ArgsSnapshotExporter{
+		DB:              db,
+		Marshalizer:     marshalizer,
+		Hasher:          hasher,
+		OutputDirectory: output,
+		MaxChunkSize:    ctx.GlobalUint64(chunkSize.Name) * megabyte,
+	})
+	if err != nil {
+		return err
+	}
+
+	manifest, err := exporter.Export(rootHashBytes, shardID, epochValue)
+	if err != nil {
+		return err
+	}
+
+	log.Info("state snapshot written",
+		"manifest", filepath.Join(output, stateSnapshot.ManifestFileName),
+		"num nodes", manifest.NumNodes,
+		"num data tries", manifest.NumDataTries,
+		"num chunks", len(manifest.Chunks),
+	)
+	log.Info("start the node with the --import-state-snapshot flag pointing to the snapshot directory to use it")
+
+	return nil
+}
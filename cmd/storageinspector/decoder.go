@@ -0,0 +1,142 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const (
+	headerType      = "header"
+	metaBlockType   = "metablock"
+	miniBlockType   = "miniblock"
+	transactionType = "transaction"
+	scrType         = "scr"
+	rewardTxType    = "reward"
+	trieNodeType    = "trienode"
+	rawType         = "raw"
+)
+
+var objectTypes = []string{headerType, metaBlockType, miniBlockType, transactionType, scrType, rewardTxType, trieNodeType, rawType}
+
+var bigIntType = reflect.TypeOf(&big.Int{})
+
+type objectDecoder struct {
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+// decode unmarshals the value read from the storage as the given object type and returns it in a form that can be
+// printed as JSON, with all the byte slices hex encoded
+func (od *objectDecoder) decode(objectType string, value []byte) (interface{}, error) {
+	var object interface{}
+	var err error
+
+	switch objectType {
+	case headerType:
+		object, err = process.UnmarshalShardHeader(od.marshalizer, value)
+	case metaBlockType:
+		object, err = od.unmarshal(&block.MetaBlock{}, value)
+	case miniBlockType:
+		object, err = od.unmarshal(&block.MiniBlock{}, value)
+	case transactionType:
+		object, err = od.unmarshal(&transaction.Transaction{}, value)
+	case scrType:
+		object, err = od.unmarshal(&smartContractResult.SmartContractResult{}, value)
+	case rewardTxType:
+		object, err = od.unmarshal(&rewardTx.RewardTx{}, value)
+	case trieNodeType:
+		object, err = trie.DecodeNodeData(value, od.marshalizer, od.hasher)
+	case rawType:
+		object = value
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnknownObjectType, objectType)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w as %s", err, objectType)
+	}
+
+	return toDisplayable(reflect.ValueOf(object)), nil
+}
+
+func (od *objectDecoder) unmarshal(object interface{}, value []byte) (interface{}, error) {
+	err := od.marshalizer.Unmarshal(object, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// toDisplayable converts the structs into maps so that the byte slices are hex encoded and the big integers printed
+// in base 10, instead of the default JSON encoding
+func toDisplayable(value reflect.Value) interface{} {
+	if !value.IsValid() {
+		return nil
+	}
+	if value.Type() == bigIntType {
+		if value.IsNil() {
+			return nil
+		}
+		return value.Interface().(*big.Int).String()
+	}
+
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+		return toDisplayable(value.Elem())
+	case reflect.Struct:
+		fields := make(map[string]interface{})
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if len(field.PkgPath) > 0 {
+				continue
+			}
+
+			fields[field.Name] = toDisplayable(value.Field(i))
+		}
+		return fields
+	case reflect.Slice, reflect.Array:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			return hex.EncodeToString(toBytes(value))
+		}
+
+		items := make([]interface{}, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items[i] = toDisplayable(value.Index(i))
+		}
+		return items
+	case reflect.Map:
+		items := make(map[string]interface{})
+		iterator := value.MapRange()
+		for iterator.Next() {
+			items[fmt.Sprintf("%v", toDisplayable(iterator.Key()))] = toDisplayable(iterator.Value())
+		}
+		return items
+	default:
+		return value.Interface()
+	}
+}
+
+func toBytes(value reflect.Value) []byte {
+	if value.Kind() == reflect.Slice {
+		return value.Bytes()
+	}
+
+	buff := make([]byte, value.Len())
+	reflect.Copy(reflect.ValueOf(buff), value)
+
+	return buff
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pathmanager"
+)
+
+var errNoActiveEpoch = errors.New("no active epoch")
+
+// epochsTrieStorage reads the trie nodes from the persisters of the active epochs, newest first, and writes them in the
+// newest one, the same way the trie pruning storer of the node does. The older epochs are only read through
+// GetFromEpoch, so the missing nodes can be searched in them.
+type epochsTrieStorage struct {
+	pathManager  *pathmanager.PathManager
+	shard        string
+	dbConfig     config.DBConfig
+	activeEpochs []uint32
+	persisters   map[uint32]storage.Persister
+}
+
+// newEpochsTrieStorage creates the trie storage for the given epochs, sorted in ascending order. The last
+// numActiveEpochs epochs are the active ones
+func newEpochsTrieStorage(
+	pathManager *pathmanager.PathManager,
+	shard string,
+	dbConfig config.DBConfig,
+	epochs []uint32,
+	numActiveEpochs int,
+) (*epochsTrieStorage, error) {
+	if len(epochs) == 0 || numActiveEpochs <= 0 {
+		return nil, errNoActiveEpoch
+	}
+
+	activeEpochs := make([]uint32, 0, numActiveEpochs)
+	for i := len(epochs) - 1; i >= 0 && len(activeEpochs) < numActiveEpochs; i-- {
+		activeEpochs = append(activeEpochs, epochs[i])
+	}
+
+	return &epochsTrieStorage{
+		pathManager:  pathManager,
+		shard:        shard,
+		dbConfig:     dbConfig,
+		activeEpochs: activeEpochs,
+		persisters:   make(map[uint32]storage.Persister),
+	}, nil
+}
+
+// Get returns the value from the newest active epoch that holds the key
+func (ets *epochsTrieStorage) Get(key []byte) ([]byte, error) {
+	for _, epoch := range ets.activeEpochs {
+		val, err := ets.GetFromEpoch(key, epoch)
+		if err == nil {
+			return val, nil
+		}
+	}
+
+	return nil, storage.ErrKeyNotFound
+}
+
+// GetFromEpoch returns the value from the persister of the given epoch
+func (ets *epochsTrieStorage) GetFromEpoch(key []byte, epoch uint32) ([]byte, error) {
+	persister, err := ets.getPersister(epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	return persister.Get(key)
+}
+
+// Put saves the value in the newest epoch
+func (ets *epochsTrieStorage) Put(key, val []byte) error {
+	persister, err := ets.getPersister(ets.activeEpochs[0])
+	if err != nil {
+		return err
+	}
+
+	return persister.Put(key, val)
+}
+
+// Remove removes the key from the newest epoch
+func (ets *epochsTrieStorage) Remove(key []byte) error {
+	persister, err := ets.getPersister(ets.activeEpochs[0])
+	if err != nil {
+		return err
+	}
+
+	return persister.Remove(key)
+}
+
+func (ets *epochsTrieStorage) getPersister(epoch uint32) (storage.Persister, error) {
+	persister, found := ets.persisters[epoch]
+	if found {
+		return persister, nil
+	}
+
+	path := ets.pathManager.PathForEpoch(ets.shard, epoch, ets.dbConfig.FilePath)
+	persister, err := openDatabase(path, ets.dbConfig)
+	if err != nil {
+		return nil, fmt.Errorf("%w while opening %s", err, path)
+	}
+
+	log.Debug("opened trie storage", "path", path)
+	ets.persisters[epoch] = persister
+
+	return persister, nil
+}
+
+// Close closes all the opened persisters
+func (ets *epochsTrieStorage) Close() error {
+	var lastErr error
+	for _, persister := range ets.persisters {
+		err := persister.Close()
+		if err != nil {
+			lastErr = err
+		}
+	}
+
+	return lastErr
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ets *epochsTrieStorage) IsInterfaceNil() bool {
+	return ets == nil
+}
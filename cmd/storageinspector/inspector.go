@@ -0,0 +1,422 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/pathmanager"
+)
+
+// unitStats holds the details of a storage unit database found on the disk
+type unitStats struct {
+	Epoch    *uint32 `json:"epoch,omitempty"`
+	Shard    string  `json:"shard"`
+	Unit     string  `json:"unit"`
+	Path     string  `json:"path"`
+	Size     int64   `json:"size"`
+	NumKeys  *int    `json:"numKeys,omitempty"`
+	ErrorMsg string  `json:"error,omitempty"`
+}
+
+// foundValue holds a value read from the storage, with the location of the database that holds it
+type foundValue struct {
+	Epoch  *uint32     `json:"epoch,omitempty"`
+	Shard  string      `json:"shard"`
+	Unit   string      `json:"unit"`
+	Path   string      `json:"path"`
+	Type   string      `json:"type"`
+	Object interface{} `json:"object"`
+}
+
+type storageInspector struct {
+	dbPath              string
+	units               []*unitInfo
+	shardHdrDB          config.DBConfig
+	numActivePersisters int
+	pathManager         *pathmanager.PathManager
+	decoder             *objectDecoder
+}
+
+func newStorageInspector(dbPath string, cfg *config.Config, decoder *objectDecoder) (*storageInspector, error) {
+	_, err := os.Stat(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pathManager, err := factory.CreatePathManagerFromSinglePathString(dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &storageInspector{
+		dbPath:              dbPath,
+		units:               createUnitsInfo(cfg),
+		shardHdrDB:          cfg.ShardHdrNonceHashStorage.DB,
+		numActivePersisters: int(cfg.StoragePruning.NumActivePersisters),
+		pathManager:         pathManager,
+		decoder:             decoder,
+	}, nil
+}
+
+// listUnits returns all the storage unit databases found, for each epoch and shard
+func (si *storageInspector) listUnits(countKeys bool) ([]*unitStats, error) {
+	epochs, err := si.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	allStats := make([]*unitStats, 0)
+	for _, epoch := range epochs {
+		epochDirectory := filepath.Join(si.dbPath, fmt.Sprintf("%s_%d", storage.DefaultEpochString, epoch))
+		for _, shard := range getShards(epochDirectory) {
+			for _, unit := range si.units {
+				if unit.static {
+					continue
+				}
+
+				e := epoch
+				path := si.pathManager.PathForEpoch(shard, epoch, unit.dbConfig.FilePath)
+				allStats = appendUnitStats(allStats, &unitStats{Epoch: &e, Shard: shard, Unit: unit.unitType.String(), Path: path}, unit.dbConfig, countKeys)
+			}
+		}
+	}
+
+	staticDirectory := filepath.Join(si.dbPath, storage.DefaultStaticDbString)
+	for _, shard := range getShards(staticDirectory) {
+		for _, unit := range si.units {
+			if !unit.static {
+				continue
+			}
+
+			path := si.pathManager.PathForStatic(shard, unit.dbConfig.FilePath)
+			allStats = appendUnitStats(allStats, &unitStats{Shard: shard, Unit: unit.unitType.String(), Path: path}, unit.dbConfig, countKeys)
+		}
+
+		// the shard header nonce to hash units are suffixed with the shard they refer to
+		prefix := si.pathManager.PathForStatic(shard, si.shardHdrDB.FilePath)
+		for _, path := range getPathsWithNumericSuffix(prefix) {
+			shardID, _ := strconv.Atoi(strings.TrimPrefix(path, prefix))
+			unitType := dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID)
+			allStats = appendUnitStats(allStats, &unitStats{Shard: shard, Unit: unitType.String(), Path: path}, si.shardHdrDB, countKeys)
+		}
+	}
+
+	return allStats, nil
+}
+
+func appendUnitStats(allStats []*unitStats, stats *unitStats, dbConfig config.DBConfig, countKeys bool) []*unitStats {
+	size, err := getDirectorySize(stats.Path)
+	if err != nil {
+		return allStats
+	}
+
+	stats.Size = size
+	if countKeys {
+		numKeys, errCount := countDatabaseKeys(stats.Path, dbConfig)
+		if errCount != nil {
+			stats.ErrorMsg = errCount.Error()
+		} else {
+			stats.NumKeys = &numKeys
+		}
+	}
+
+	return append(allStats, stats)
+}
+
+// get searches the value of the given key in the unit, in all the shards and epochs found, newest epoch first. If the
+// epoch is provided, only that epoch is searched
+func (si *storageInspector) get(unitName string, shard string, epoch *uint32, key []byte, objectType string) (*foundValue, error) {
+	unit, err := si.getUnit(unitName)
+	if err != nil {
+		return nil, err
+	}
+	if len(objectType) == 0 {
+		objectType = unit.objectType
+	}
+
+	locations, err := si.getLocations(unit, shard, epoch)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, location := range locations {
+		value, errGet := getFromDatabase(location.Path, unit.dbConfig, key)
+		if errGet != nil {
+			log.Debug("key not found", "path", location.Path, "error", errGet)
+			continue
+		}
+
+		location.Type = objectType
+		location.Object, err = si.decoder.decode(objectType, value)
+		if err != nil {
+			return nil, err
+		}
+
+		return location, nil
+	}
+
+	return nil, fmt.Errorf("%w in unit %s", storage.ErrKeyNotFound, unit.unitType.String())
+}
+
+// verifyTrie checks that the active epochs of the accounts trie storage hold all the nodes of the accounts trie with the
+// given root hash and of its data tries. If repair is set, the damaged nodes are searched in all the older epochs and
+// saved in the newest one.
+func (si *storageInspector) verifyTrie(shard string, rootHash []byte, repair bool) (*trieIntegrity.Report, error) {
+	unit, err := getUnitInfo(si.units, dataRetriever.UserAccountsUnit.String())
+	if err != nil {
+		return nil, err
+	}
+
+	epochs, err := si.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+	epochsWithTrie := make([]uint32, 0, len(epochs))
+	for _, epoch := range epochs {
+		_, errStat := os.Stat(si.pathManager.PathForEpoch(shard, epoch, unit.dbConfig.FilePath))
+		if errStat == nil {
+			epochsWithTrie = append(epochsWithTrie, epoch)
+		}
+	}
+
+	trieStorage, err := newEpochsTrieStorage(si.pathManager, shard, unit.dbConfig, epochsWithTrie, si.numActivePersisters)
+	if err != nil {
+		return nil, fmt.Errorf("%w for shard %s", err, shard)
+	}
+	defer func() {
+		_ = trieStorage.Close()
+	}()
+
+	verifier, err := trieIntegrity.NewTrieIntegrityVerifier(trieIntegrity.ArgsTrieIntegrityVerifier{
+		TrieStorage: trieStorage,
+		Marshalizer: si.decoder.marshalizer,
+		Hasher:      si.decoder.hasher,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !repair {
+		return verifier.Verify(rootHash)
+	}
+
+	lastEpoch := epochsWithTrie[len(epochsWithTrie)-1]
+	fetcher, err := trieIntegrity.NewOlderEpochsNodesFetcher(trieIntegrity.ArgsOlderEpochsNodesFetcher{
+		Storer:     trieStorage,
+		StartEpoch: lastEpoch,
+		NumEpochs:  lastEpoch + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return verifier.VerifyAndRepair(rootHash, fetcher)
+}
+
+func (si *storageInspector) getUnit(unitName string) (*unitInfo, error) {
+	unit, err := getUnitInfo(si.units, unitName)
+	if err == nil {
+		return unit, nil
+	}
+
+	// the shard header nonce to hash units are not in the units list as they depend on the number of shards
+	if strings.HasPrefix(unitName, dataRetriever.ShardHdrNonceHashDataUnit.String()) {
+		shardID, errConvert := strconv.Atoi(strings.TrimPrefix(unitName, dataRetriever.ShardHdrNonceHashDataUnit.String()))
+		if errConvert == nil {
+			dbConfig := si.shardHdrDB
+			dbConfig.FilePath += strconv.Itoa(shardID)
+			return &unitInfo{
+				unitType:   dataRetriever.ShardHdrNonceHashDataUnit + dataRetriever.UnitType(shardID),
+				dbConfig:   dbConfig,
+				static:     true,
+				objectType: rawType,
+			}, nil
+		}
+	}
+
+	return nil, err
+}
+
+func (si *storageInspector) getLocations(unit *unitInfo, shard string, epoch *uint32) ([]*foundValue, error) {
+	locations := make([]*foundValue, 0)
+	if unit.static {
+		for _, s := range si.filterShards(getShards(filepath.Join(si.dbPath, storage.DefaultStaticDbString)), shard) {
+			path := si.pathManager.PathForStatic(s, unit.dbConfig.FilePath)
+			locations = append(locations, &foundValue{Shard: s, Unit: unit.unitType.String(), Path: path})
+		}
+
+		return locations, nil
+	}
+
+	epochs, err := si.getEpochs()
+	if err != nil {
+		return nil, err
+	}
+
+	for i := len(epochs) - 1; i >= 0; i-- {
+		e := epochs[i]
+		if epoch != nil && *epoch != e {
+			continue
+		}
+
+		epochDirectory := filepath.Join(si.dbPath, fmt.Sprintf("%s_%d", storage.DefaultEpochString, e))
+		for _, s := range si.filterShards(getShards(epochDirectory), shard) {
+			path := si.pathManager.PathForEpoch(s, e, unit.dbConfig.FilePath)
+			locations = append(locations, &foundValue{Epoch: &e, Shard: s, Unit: unit.unitType.String(), Path: path})
+		}
+	}
+
+	return locations, nil
+}
+
+func (si *storageInspector) filterShards(shards []string, shard string) []string {
+	if len(shard) == 0 {
+		return shards
+	}
+
+	for _, s := range shards {
+		if s == shard {
+			return []string{s}
+		}
+	}
+
+	return make([]string, 0)
+}
+
+// getEpochs returns the epochs that have a directory in the database path, in ascending order
+func (si *storageInspector) getEpochs() ([]uint32, error) {
+	entries, err := os.ReadDir(si.dbPath)
+	if err != nil {
+		return nil, err
+	}
+
+	epochPrefix := storage.DefaultEpochString + "_"
+	epochs := make([]uint32, 0)
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), epochPrefix) {
+			continue
+		}
+
+		epoch, errParse := strconv.ParseUint(strings.TrimPrefix(entry.Name(), epochPrefix), 10, 32)
+		if errParse != nil {
+			continue
+		}
+
+		epochs = append(epochs, uint32(epoch))
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+// getShards returns the shard directory names found in the given directory, such as 0 or metachain
+func getShards(directory string) []string {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return make([]string, 0)
+	}
+
+	shardPrefix := storage.DefaultShardString + "_"
+	shards := make([]string, 0)
+	for _, entry := range entries {
+		if entry.IsDir() && strings.HasPrefix(entry.Name(), shardPrefix) {
+			shards = append(shards, strings.TrimPrefix(entry.Name(), shardPrefix))
+		}
+	}
+
+	return shards
+}
+
+func getPathsWithNumericSuffix(prefix string) []string {
+	entries, err := os.ReadDir(filepath.Dir(prefix))
+	if err != nil {
+		return make([]string, 0)
+	}
+
+	paths := make([]string, 0)
+	for _, entry := range entries {
+		path := filepath.Join(filepath.Dir(prefix), entry.Name())
+		if !entry.IsDir() || !strings.HasPrefix(path, prefix) {
+			continue
+		}
+
+		_, errConvert := strconv.Atoi(strings.TrimPrefix(path, prefix))
+		if errConvert == nil {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+func getDirectorySize(path string) (int64, error) {
+	_, err := os.Stat(path)
+	if err != nil {
+		return 0, err
+	}
+
+	size := int64(0)
+	err = filepath.Walk(path, func(_ string, info os.FileInfo, errWalk error) error {
+		if errWalk != nil {
+			return errWalk
+		}
+		if !info.IsDir() {
+			size += info.Size()
+		}
+
+		return nil
+	})
+
+	return size, err
+}
+
+func openDatabase(path string, dbConfig config.DBConfig) (storage.Persister, error) {
+	// the persister factory creates the database if it does not exist, so the path is checked beforehand
+	_, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return factory.NewPersisterFactory(dbConfig).Create(path)
+}
+
+func countDatabaseKeys(path string, dbConfig config.DBConfig) (int, error) {
+	persister, err := openDatabase(path, dbConfig)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	numKeys := 0
+	persister.RangeKeys(func(_ []byte, _ []byte) bool {
+		numKeys++
+		return true
+	})
+
+	return numKeys, nil
+}
+
+func getFromDatabase(path string, dbConfig config.DBConfig, key []byte) ([]byte, error) {
+	persister, err := openDatabase(path, dbConfig)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = persister.Close()
+	}()
+
+	return persister.Get(key)
+}
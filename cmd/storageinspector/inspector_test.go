@@ -0,0 +1,225 @@
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	testStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestDBConfig(filePath string) config.DBConfig {
+	return config.DBConfig{
+		FilePath:          filePath,
+		Type:              "LvlDBSerial",
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	}
+}
+
+func createTestConfig() *config.Config {
+	cfg := &config.Config{}
+	cfg.TxStorage.DB = createTestDBConfig("Transactions")
+	cfg.MiniBlocksStorage.DB = createTestDBConfig("MiniBlocks")
+	cfg.MetaHdrNonceHashStorage.DB = createTestDBConfig("MetaHdrHashNonce")
+	cfg.ShardHdrNonceHashStorage.DB = createTestDBConfig("ShardHdrHashNonce")
+	cfg.AccountsTrieStorage.DB = createTestDBConfig("AccountsTrie")
+	cfg.StoragePruning.NumActivePersisters = 1
+
+	return cfg
+}
+
+func putInDatabase(t *testing.T, path string, dbConfig config.DBConfig, key []byte, value []byte) {
+	persister, err := factory.NewPersisterFactory(dbConfig).Create(path)
+	require.Nil(t, err)
+
+	err = persister.Put(key, value)
+	require.Nil(t, err)
+	err = persister.Close()
+	require.Nil(t, err)
+}
+
+func createTestInspector(t *testing.T) (*storageInspector, string) {
+	dbPath := t.TempDir()
+	decoder := &objectDecoder{
+		marshalizer: &marshal.GogoProtoMarshalizer{},
+		hasher:      &testscommon.KeccakMock{},
+	}
+
+	inspector, err := newStorageInspector(dbPath, createTestConfig(), decoder)
+	require.Nil(t, err)
+
+	return inspector, dbPath
+}
+
+func TestStorageInspector_ListUnits(t *testing.T) {
+	t.Parallel()
+
+	inspector, dbPath := createTestInspector(t)
+	cfg := createTestConfig()
+	putInDatabase(t, filepath.Join(dbPath, "Epoch_0", "Shard_0", "Transactions"), cfg.TxStorage.DB, []byte("tx1"), []byte("value"))
+	putInDatabase(t, filepath.Join(dbPath, "Epoch_1", "Shard_0", "Transactions"), cfg.TxStorage.DB, []byte("tx2"), []byte("value"))
+	putInDatabase(t, filepath.Join(dbPath, "Epoch_1", "Shard_0", "Transactions"), cfg.TxStorage.DB, []byte("tx3"), []byte("value"))
+	putInDatabase(t, filepath.Join(dbPath, "Static", "Shard_metachain", "MetaHdrHashNonce"), cfg.MetaHdrNonceHashStorage.DB, []byte("nonce"), []byte("hash"))
+	putInDatabase(t, filepath.Join(dbPath, "Static", "Shard_metachain", "ShardHdrHashNonce1"), cfg.ShardHdrNonceHashStorage.DB, []byte("nonce"), []byte("hash"))
+
+	allStats, err := inspector.listUnits(true)
+	require.Nil(t, err)
+	require.Equal(t, 4, len(allStats))
+
+	assert.Equal(t, uint32(0), *allStats[0].Epoch)
+	assert.Equal(t, "0", allStats[0].Shard)
+	assert.Equal(t, "TransactionUnit", allStats[0].Unit)
+	assert.Equal(t, 1, *allStats[0].NumKeys)
+	assert.True(t, allStats[0].Size > 0)
+
+	assert.Equal(t, uint32(1), *allStats[1].Epoch)
+	assert.Equal(t, 2, *allStats[1].NumKeys)
+
+	assert.Nil(t, allStats[2].Epoch)
+	assert.Equal(t, "metachain", allStats[2].Shard)
+	assert.Equal(t, "MetaHdrNonceHashDataUnit", allStats[2].Unit)
+
+	assert.Equal(t, "ShardHdrNonceHashDataUnit1", allStats[3].Unit)
+	assert.Equal(t, 1, *allStats[3].NumKeys)
+}
+
+func TestStorageInspector_Get(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	tx := &transaction.Transaction{
+		Nonce:   7,
+		Value:   big.NewInt(1000),
+		RcvAddr: []byte{0xaa, 0xbb},
+		SndAddr: []byte{0xcc},
+	}
+	txBytes, _ := marshalizer.Marshal(tx)
+	miniBlock := &block.MiniBlock{TxHashes: [][]byte{[]byte("tx1")}, SenderShardID: 1}
+	miniBlockBytes, _ := marshalizer.Marshal(miniBlock)
+
+	inspector, dbPath := createTestInspector(t)
+	cfg := createTestConfig()
+	putInDatabase(t, filepath.Join(dbPath, "Epoch_2", "Shard_1", "Transactions"), cfg.TxStorage.DB, []byte("tx1"), txBytes)
+	putInDatabase(t, filepath.Join(dbPath, "Epoch_3", "Shard_1", "MiniBlocks"), cfg.MiniBlocksStorage.DB, []byte("mb"), miniBlockBytes)
+
+	t.Run("unknown unit should error", func(t *testing.T) {
+		value, err := inspector.get("InvalidUnit", "", nil, []byte("tx1"), "")
+		assert.Nil(t, value)
+		assert.True(t, errors.Is(err, errUnknownUnit))
+	})
+	t.Run("missing key should error", func(t *testing.T) {
+		value, err := inspector.get("TransactionUnit", "", nil, []byte("missing"), "")
+		assert.Nil(t, value)
+		assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	})
+	t.Run("key in another epoch should error", func(t *testing.T) {
+		epoch := uint32(3)
+		value, err := inspector.get("TransactionUnit", "", &epoch, []byte("tx1"), "")
+		assert.Nil(t, value)
+		assert.True(t, errors.Is(err, storage.ErrKeyNotFound))
+	})
+	t.Run("unknown object type should error", func(t *testing.T) {
+		value, err := inspector.get("TransactionUnit", "", nil, []byte("tx1"), "invalid")
+		assert.Nil(t, value)
+		assert.True(t, errors.Is(err, errUnknownObjectType))
+	})
+	t.Run("should decode a transaction", func(t *testing.T) {
+		value, err := inspector.get("TransactionUnit", "1", nil, []byte("tx1"), "")
+		require.Nil(t, err)
+
+		assert.Equal(t, uint32(2), *value.Epoch)
+		assert.Equal(t, "1", value.Shard)
+		assert.Equal(t, transactionType, value.Type)
+		fields := value.Object.(map[string]interface{})
+		assert.Equal(t, uint64(7), fields["Nonce"])
+		assert.Equal(t, "1000", fields["Value"])
+		assert.Equal(t, "aabb", fields["RcvAddr"])
+		assert.Equal(t, "cc", fields["SndAddr"])
+	})
+	t.Run("should decode a miniblock searched by directory name", func(t *testing.T) {
+		value, err := inspector.get("MiniBlocks", "", nil, []byte("mb"), "")
+		require.Nil(t, err)
+
+		fields := value.Object.(map[string]interface{})
+		assert.Equal(t, []interface{}{"747831"}, fields["TxHashes"])
+		assert.Equal(t, uint32(1), fields["SenderShardID"])
+	})
+	t.Run("should return the raw value", func(t *testing.T) {
+		value, err := inspector.get("TransactionUnit", "", nil, []byte("tx1"), rawType)
+		require.Nil(t, err)
+
+		assert.Equal(t, rawType, value.Type)
+		assert.Len(t, value.Object, 2*len(txBytes))
+	})
+}
+
+func TestStorageInspector_VerifyTrie(t *testing.T) {
+	t.Parallel()
+
+	marshalizer := &marshal.GogoProtoMarshalizer{}
+	hasher := &testscommon.KeccakMock{}
+	args, options := testStorage.GetStorageManagerArgsAndOptions()
+	args.Marshalizer = marshalizer
+	args.Hasher = hasher
+	tsm, err := trie.CreateTrieStorageManager(args, options)
+	require.Nil(t, err)
+
+	accountsTrie, _ := trie.NewTrie(tsm, marshalizer, hasher, 5)
+	for i := 0; i < 50; i++ {
+		_ = accountsTrie.Update([]byte(fmt.Sprintf("address%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	require.Nil(t, accountsTrie.Commit())
+	rootHash, _ := accountsTrie.RootHash()
+
+	// the older epoch holds all the nodes while the active one misses a leaf
+	inspector, dbPath := createTestInspector(t)
+	dbConfig := createTestConfig().AccountsTrieStorage.DB
+	olderEpoch, err := factory.NewPersisterFactory(dbConfig).Create(filepath.Join(dbPath, "Epoch_0", "Shard_0", "AccountsTrie"))
+	require.Nil(t, err)
+	activeEpoch, err := factory.NewPersisterFactory(dbConfig).Create(filepath.Join(dbPath, "Epoch_1", "Shard_0", "AccountsTrie"))
+	require.Nil(t, err)
+
+	var missingHash []byte
+	err = trie.WalkTrieNodes(rootHash, tsm, marshalizer, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+		_ = olderEpoch.Put(hash, encodedNode)
+		if len(leafValue) > 0 && len(missingHash) == 0 {
+			missingHash = hash
+			return nil
+		}
+
+		return activeEpoch.Put(hash, encodedNode)
+	})
+	require.Nil(t, err)
+	require.Nil(t, olderEpoch.Close())
+	require.Nil(t, activeEpoch.Close())
+
+	report, err := inspector.verifyTrie("0", rootHash, false)
+	require.Nil(t, err)
+	require.Equal(t, 1, len(report.DamagedNodes))
+	assert.Equal(t, hex.EncodeToString(missingHash), report.DamagedNodes[0].Hash)
+
+	report, err = inspector.verifyTrie("0", rootHash, true)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.DamagedNodes))
+	require.Equal(t, 1, len(report.RepairedNodes))
+	assert.Equal(t, hex.EncodeToString(missingHash), report.RepairedNodes[0].Hash)
+
+	report, err = inspector.verifyTrie("0", rootHash, false)
+	require.Nil(t, err)
+	assert.Equal(t, 0, len(report.DamagedNodes))
+}
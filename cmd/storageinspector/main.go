@@ -0,0 +1,285 @@
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	hasherFactory "github.com/multiversx/mx-chain-core-go/hashing/factory"
+	marshalFactory "github.com/multiversx/mx-chain-core-go/marshal/factory"
+	"github.com/multiversx/mx-chain-go/config"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+var errUnknownUnit = errors.New("unknown storage unit")
+var errUnknownObjectType = errors.New("unknown object type")
+var errMissingFlag = errors.New("missing mandatory flag")
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}{{if .Commands}} command [command options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+COMMANDS:
+   {{range .Commands}}{{join .Names ", "}}{{ "\t" }}{{.Usage}}
+   {{end}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// dbPath defines a flag for the path of the node database, including the chain ID directory
+	dbPath = cli.StringFlag{
+		Name:  "db-path",
+		Usage: "The `path` of the node database, including the chain ID directory. Example: ./db/1",
+	}
+	// configurationFile defines a flag for the path to the main toml configuration file of the node
+	configurationFile = cli.StringFlag{
+		Name: "config",
+		Usage: "The `filepath` for the main configuration file of the node. The storage units, hasher and " +
+			"marshalizer configurations are read from it",
+		Value: "./config/config.toml",
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+	// countKeys defines a flag that enables counting the keys of each storage unit
+	countKeys = cli.BoolFlag{
+		Name:  "count-keys",
+		Usage: "Boolean option for counting the keys of each storage unit. It iterates over all the databases, so it can take a while",
+	}
+	// unit defines a flag for the storage unit from which the value is read
+	unit = cli.StringFlag{
+		Name:  "unit",
+		Usage: "The storage `unit` name, such as TransactionUnit, or its database directory name, such as Transactions",
+	}
+	// hash defines a flag for the hex encoded key of the value
+	hash = cli.StringFlag{
+		Name:  "hash",
+		Usage: "The hex encoded `hash` of the value",
+	}
+	// shard defines a flag for the shard directory in which the value is searched
+	shard = cli.StringFlag{
+		Name:  "shard",
+		Usage: "The `shard` in which the value is searched, as a shard number or metachain. If not set, all the shards are searched",
+	}
+	// epoch defines a flag for the epoch in which the value is searched
+	epoch = cli.StringFlag{
+		Name:  "epoch",
+		Usage: "The `epoch` in which the value is searched. If not set, all the epochs are searched, newest first",
+	}
+	// rootHash defines a flag for the root hash of the verified accounts trie
+	rootHash = cli.StringFlag{
+		Name:  "root-hash",
+		Usage: "The hex encoded `root hash` of the accounts trie that will be verified",
+	}
+	// repair defines a flag that enables the repair of the damaged trie nodes
+	repair = cli.BoolFlag{
+		Name: "repair",
+		Usage: "Boolean option for repairing the damaged trie nodes. The nodes are searched in the older epochs and " +
+			"saved in the newest one",
+	}
+	// objectType defines a flag for the type used to decode the value
+	objectType = cli.StringFlag{
+		Name: "type",
+		Usage: "The `type` used to decode the value, one of " + strings.Join(objectTypes, ", ") + ". If not set, " +
+			"the type stored by the unit is used",
+	}
+
+	log = logger.GetOrCreate("storageinspector")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Storage inspector tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This tool reads the databases of a stopped node, listing the storage units, decoding the stored objects as JSON and verifying the accounts trie"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		dbPath,
+		configurationFile,
+		logLevel,
+	}
+	app.Commands = []cli.Command{
+		{
+			Name:   "list",
+			Usage:  "lists the epochs, shards and storage units found, with their size on the disk",
+			Flags:  []cli.Flag{countKeys},
+			Action: listUnits,
+		},
+		{
+			Name:   "get",
+			Usage:  "reads a value by its hash and prints it decoded",
+			Flags:  []cli.Flag{unit, hash, shard, epoch, objectType},
+			Action: getValue,
+		},
+		{
+			Name:   "verify-trie",
+			Usage:  "verifies that the accounts trie and all the data tries can be read from the active epochs",
+			Flags:  []cli.Flag{rootHash, shard, repair},
+			Action: verifyTrie,
+		},
+	}
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error inspecting the storage", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func listUnits(ctx *cli.Context) error {
+	inspector, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	allStats, err := inspector.listUnits(ctx.Bool(countKeys.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(allStats)
+}
+
+func getValue(ctx *cli.Context) error {
+	inspector, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(ctx.String(unit.Name)) == 0 {
+		return fmt.Errorf("%w: --%s", errMissingFlag, unit.Name)
+	}
+	key, err := hex.DecodeString(ctx.String(hash.Name))
+	if err != nil {
+		return err
+	}
+	if len(key) == 0 {
+		return fmt.Errorf("%w: --%s", errMissingFlag, hash.Name)
+	}
+
+	shardDirectory := ""
+	if len(ctx.String(shard.Name)) > 0 {
+		shardID, errConvert := core.ConvertShardIDToUint32(ctx.String(shard.Name))
+		if errConvert != nil {
+			return errConvert
+		}
+		shardDirectory = core.GetShardIDString(shardID)
+	}
+
+	var epochValue *uint32
+	if len(ctx.String(epoch.Name)) > 0 {
+		parsedEpoch, errParse := strconv.ParseUint(ctx.String(epoch.Name), 10, 32)
+		if errParse != nil {
+			return fmt.Errorf("%w for --%s", errParse, epoch.Name)
+		}
+		e := uint32(parsedEpoch)
+		epochValue = &e
+	}
+
+	value, err := inspector.get(ctx.String(unit.Name), shardDirectory, epochValue, key, ctx.String(objectType.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(value)
+}
+
+func verifyTrie(ctx *cli.Context) error {
+	inspector, err := createInspector(ctx)
+	if err != nil {
+		return err
+	}
+
+	rootHashBytes, err := hex.DecodeString(ctx.String(rootHash.Name))
+	if err != nil {
+		return err
+	}
+	if len(rootHashBytes) == 0 {
+		return fmt.Errorf("%w: --%s", errMissingFlag, rootHash.Name)
+	}
+	if len(ctx.String(shard.Name)) == 0 {
+		return fmt.Errorf("%w: --%s", errMissingFlag, shard.Name)
+	}
+	shardID, err := core.ConvertShardIDToUint32(ctx.String(shard.Name))
+	if err != nil {
+		return err
+	}
+
+	report, err := inspector.verifyTrie(core.GetShardIDString(shardID), rootHashBytes, ctx.Bool(repair.Name))
+	if err != nil {
+		return err
+	}
+
+	return printJSON(report)
+}
+
+func createInspector(ctx *cli.Context) (*storageInspector, error) {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ctx.GlobalString(dbPath.Name)) == 0 {
+		return nil, fmt.Errorf("%w: --%s", errMissingFlag, dbPath.Name)
+	}
+
+	generalConfig := &config.Config{}
+	err = core.LoadTomlFile(generalConfig, ctx.GlobalString(configurationFile.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	hasher, err := hasherFactory.NewHasher(generalConfig.Hasher.Type)
+	if err != nil {
+		return nil, err
+	}
+	marshalizer, err := marshalFactory.NewMarshalizer(generalConfig.Marshalizer.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := &objectDecoder{
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}
+
+	return newStorageInspector(ctx.GlobalString(dbPath.Name), generalConfig, decoder)
+}
+
+func printJSON(object interface{}) error {
+	jsonBytes, err := json.MarshalIndent(object, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(string(jsonBytes))
+
+	return nil
+}
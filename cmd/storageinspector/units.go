@@ -0,0 +1,71 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+// unitInfo describes where a storage unit is saved and how its values are decoded. The pruning units have one
+// database for each epoch while the static ones have a single database for each shard
+type unitInfo struct {
+	unitType   dataRetriever.UnitType
+	dbConfig   config.DBConfig
+	static     bool
+	objectType string
+}
+
+func createUnitsInfo(cfg *config.Config) []*unitInfo {
+	lookupExtensions := cfg.DbLookupExtensions
+
+	allUnits := []*unitInfo{
+		{unitType: dataRetriever.TransactionUnit, dbConfig: cfg.TxStorage.DB, objectType: transactionType},
+		{unitType: dataRetriever.MiniBlockUnit, dbConfig: cfg.MiniBlocksStorage.DB, objectType: miniBlockType},
+		{unitType: dataRetriever.PeerChangesUnit, dbConfig: cfg.PeerBlockBodyStorage.DB, objectType: rawType},
+		{unitType: dataRetriever.BlockHeaderUnit, dbConfig: cfg.BlockHeaderStorage.DB, objectType: headerType},
+		{unitType: dataRetriever.MetaBlockUnit, dbConfig: cfg.MetaBlockStorage.DB, objectType: metaBlockType},
+		{unitType: dataRetriever.UnsignedTransactionUnit, dbConfig: cfg.UnsignedTransactionStorage.DB, objectType: scrType},
+		{unitType: dataRetriever.RewardTransactionUnit, dbConfig: cfg.RewardTxStorage.DB, objectType: rewardTxType},
+		{unitType: dataRetriever.MetaHdrNonceHashDataUnit, dbConfig: cfg.MetaHdrNonceHashStorage.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.BootstrapUnit, dbConfig: cfg.BootstrapStorage.DB, objectType: rawType},
+		{unitType: dataRetriever.StatusMetricsUnit, dbConfig: cfg.StatusMetricsStorage.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.TxLogsUnit, dbConfig: cfg.LogsAndEvents.TxLogsStorage.DB, objectType: rawType},
+		{unitType: dataRetriever.MiniblocksMetadataUnit, dbConfig: lookupExtensions.MiniblocksMetadataStorageConfig.DB, objectType: rawType},
+		{unitType: dataRetriever.EpochByHashUnit, dbConfig: lookupExtensions.EpochByHashStorageConfig.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.MiniblockHashByTxHashUnit, dbConfig: lookupExtensions.MiniblockHashByTxHashStorageConfig.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.ReceiptsUnit, dbConfig: cfg.ReceiptsStorage.DB, objectType: rawType},
+		{unitType: dataRetriever.ResultsHashesByTxHashUnit, dbConfig: lookupExtensions.ResultsHashesByTxHashStorageConfig.DB, objectType: rawType},
+		{unitType: dataRetriever.TrieEpochRootHashUnit, dbConfig: cfg.TrieEpochRootHashStorage.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.ESDTSuppliesUnit, dbConfig: lookupExtensions.ESDTSuppliesStorageConfig.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.RoundHdrHashDataUnit, dbConfig: lookupExtensions.RoundHashStorageConfig.DB, static: true, objectType: rawType},
+		{unitType: dataRetriever.UserAccountsUnit, dbConfig: cfg.AccountsTrieStorage.DB, objectType: trieNodeType},
+		{unitType: dataRetriever.UserAccountsCheckpointsUnit, dbConfig: cfg.AccountsTrieCheckpointsStorage.DB, objectType: trieNodeType},
+		{unitType: dataRetriever.PeerAccountsUnit, dbConfig: cfg.PeerAccountsTrieStorage.DB, objectType: trieNodeType},
+		{unitType: dataRetriever.PeerAccountsCheckpointsUnit, dbConfig: cfg.PeerAccountsTrieCheckpointsStorage.DB, objectType: trieNodeType},
+		{unitType: dataRetriever.ScheduledSCRsUnit, dbConfig: cfg.ScheduledSCRsStorage.DB, objectType: rawType},
+	}
+
+	// the units without a configured database, such as the disabled db lookup extensions, are skipped
+	units := make([]*unitInfo, 0, len(allUnits))
+	for _, unit := range allUnits {
+		if len(unit.dbConfig.FilePath) > 0 {
+			units = append(units, unit)
+		}
+	}
+
+	return units
+}
+
+// getUnitInfo returns the unit with the given name. The name can be the unit type name, such as TransactionUnit, or
+// the database directory name, such as Transactions
+func getUnitInfo(units []*unitInfo, name string) (*unitInfo, error) {
+	for _, unit := range units {
+		if strings.EqualFold(unit.unitType.String(), name) || strings.EqualFold(unit.dbConfig.FilePath, name) {
+			return unit, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %s", errUnknownUnit, name)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/heartbeat/topology"
+)
+
+const topologyUrlSuffix = "/node/topology"
+
+type topologyResponseData struct {
+	Topology *data.NetworkTopology `json:"topology"`
+}
+
+type responseFromApi struct {
+	Data  topologyResponseData `json:"data"`
+	Error string               `json:"error"`
+	Code  string               `json:"code"`
+}
+
+type crawler struct {
+	client        http.Client
+	nodeAddresses []string
+}
+
+func newCrawler(nodeAddresses []string, requestTimeout time.Duration) (*crawler, error) {
+	if len(nodeAddresses) == 0 {
+		return nil, errNoNodeAddress
+	}
+
+	formattedAddresses := make([]string, 0, len(nodeAddresses))
+	for _, address := range nodeAddresses {
+		formattedAddresses = append(formattedAddresses, formatUrlAddress(address))
+	}
+
+	return &crawler{
+		client: http.Client{
+			Timeout: requestTimeout,
+		},
+		nodeAddresses: formattedAddresses,
+	}, nil
+}
+
+// crawl fetches the network topology as seen by each of the nodes and merges them. The nodes which can not be
+// reached are skipped, an error being returned only if none of them responded
+func (c *crawler) crawl() (*data.NetworkTopology, error) {
+	topologies := make([]*data.NetworkTopology, 0, len(c.nodeAddresses))
+	for _, address := range c.nodeAddresses {
+		networkTopology, err := c.fetchTopology(address)
+		if err != nil {
+			log.Warn("could not fetch the network topology", "node", address, "error", err)
+			continue
+		}
+
+		log.Info("fetched the network topology", "node", address,
+			"num peers", len(networkTopology.Peers),
+			"num direct connections", len(networkTopology.DirectConnections))
+		topologies = append(topologies, networkTopology)
+	}
+
+	if len(topologies) == 0 {
+		return nil, errNoTopologyFetched
+	}
+
+	return topology.MergeTopologies(topologies), nil
+}
+
+func (c *crawler) fetchTopology(address string) (*data.NetworkTopology, error) {
+	resp, err := c.client.Get(address + topologyUrlSuffix)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		errClose := resp.Body.Close()
+		if errClose != nil {
+			log.Warn("close response body", "error", errClose)
+		}
+	}()
+
+	responseBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var topologyResponse responseFromApi
+	err = json.Unmarshal(responseBytes, &topologyResponse)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%w, status code: %d, error: %s", errTopologyRequestFailed, resp.StatusCode, topologyResponse.Error)
+	}
+	if topologyResponse.Data.Topology == nil {
+		return nil, errNilTopologyInResponse
+	}
+
+	return topologyResponse.Data.Topology, nil
+}
+
+func formatUrlAddress(address string) string {
+	address = strings.TrimSuffix(strings.TrimSpace(address), "/")
+	if !strings.HasPrefix(address, "http") {
+		address = "http://" + address
+	}
+
+	return address
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTopologyServer(t *testing.T, networkTopology *data.NetworkTopology) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, topologyUrlSuffix, r.URL.Path)
+
+		response := responseFromApi{
+			Data: topologyResponseData{
+				Topology: networkTopology,
+			},
+			Code: "successful",
+		}
+		buff, err := json.Marshal(response)
+		require.Nil(t, err)
+
+		_, _ = w.Write(buff)
+	}))
+}
+
+func TestNewCrawler(t *testing.T) {
+	t.Parallel()
+
+	c, err := newCrawler(nil, time.Second)
+	assert.Equal(t, errNoNodeAddress, err)
+	assert.Nil(t, c)
+
+	c, err = newCrawler([]string{"127.0.0.1:8080", "https://10.0.0.1:8080/"}, time.Second)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"http://127.0.0.1:8080", "https://10.0.0.1:8080"}, c.nodeAddresses)
+}
+
+func TestCrawler_Crawl(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no node responding should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = w.Write([]byte(`{"data":null,"error":"internal error","code":"internal_issue"}`))
+		}))
+		defer server.Close()
+
+		c, _ := newCrawler([]string{server.URL}, time.Second)
+		networkTopology, err := c.crawl()
+		assert.True(t, errors.Is(err, errNoTopologyFetched))
+		assert.Nil(t, networkTopology)
+	})
+	t.Run("should merge the topologies of the responding nodes", func(t *testing.T) {
+		t.Parallel()
+
+		server1 := createTopologyServer(t, &data.NetworkTopology{
+			Timestamp:         time.Unix(100, 0),
+			Observers:         []string{"obs1"},
+			Peers:             []data.TopologyPeer{{Pid: "obs1", ConnectionStatus: "self"}},
+			DirectConnections: []data.DirectConnection{{Source: "obs1", Target: "obs2"}},
+		})
+		defer server1.Close()
+		server2 := createTopologyServer(t, &data.NetworkTopology{
+			Timestamp: time.Unix(200, 0),
+			Observers: []string{"obs2"},
+			Peers:     []data.TopologyPeer{{Pid: "obs2", ConnectionStatus: "self"}},
+		})
+		defer server2.Close()
+
+		c, _ := newCrawler([]string{server1.URL, "127.0.0.1:1", server2.URL}, time.Second)
+		networkTopology, err := c.crawl()
+		require.Nil(t, err)
+		assert.Equal(t, []string{"obs1", "obs2"}, networkTopology.Observers)
+		assert.Equal(t, 2, len(networkTopology.Peers))
+		assert.Equal(t, 1, len(networkTopology.DirectConnections))
+		assert.Equal(t, int64(200), networkTopology.Timestamp.Unix())
+	})
+}
@@ -0,0 +1,11 @@
+package main
+
+import "errors"
+
+var errNoNodeAddress = errors.New("no node address provided")
+
+var errNoTopologyFetched = errors.New("the network topology could not be fetched from any of the nodes")
+
+var errTopologyRequestFailed = errors.New("network topology request failed")
+
+var errNilTopologyInResponse = errors.New("nil network topology in response")
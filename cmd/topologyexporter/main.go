@@ -0,0 +1,172 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/heartbeat/topology"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/urfave/cli"
+)
+
+const filePrefix = "topology"
+
+var (
+	helpTemplate = `NAME:
+   {{.Name}} - {{.Usage}}
+USAGE:
+   {{.HelpName}} {{if .VisibleFlags}}[global options]{{end}}
+   {{if len .Authors}}
+AUTHOR:
+   {{range .Authors}}{{ . }}{{end}}
+   {{end}}{{if .Commands}}
+GLOBAL OPTIONS:
+   {{range .VisibleFlags}}{{.}}
+   {{end}}
+VERSION:
+   {{.Version}}
+   {{end}}
+`
+	// nodeAddresses defines a flag for the REST API addresses of the crawled nodes
+	nodeAddresses = cli.StringFlag{
+		Name: "node-addresses",
+		Usage: "The comma-separated `addresses` of the REST API of the crawled nodes. The /node/topology route " +
+			"should be enabled on each of them. Example: 127.0.0.1:8080,http://10.0.0.2:8080",
+		Value: "127.0.0.1:8080",
+	}
+	// formats defines a flag for the export formats of the network topology
+	formats = cli.StringFlag{
+		Name: "formats",
+		Usage: "The comma-separated export `formats` of the network topology, out of " +
+			strings.Join([]string{topology.ExportFormatJSON, topology.ExportFormatDOT, topology.ExportFormatGraphML}, ", "),
+		Value: strings.Join([]string{topology.ExportFormatJSON, topology.ExportFormatDOT, topology.ExportFormatGraphML}, ","),
+	}
+	// outputDir defines a flag for the directory where the network topology files are written
+	outputDir = cli.StringFlag{
+		Name:  "output-dir",
+		Usage: "The `directory` where the network topology files are written",
+		Value: ".",
+	}
+	// requestTimeout defines a flag for the timeout of a topology request
+	requestTimeout = cli.IntFlag{
+		Name:  "request-timeout",
+		Usage: "The timeout, in `seconds`, of the network topology request sent to each node",
+		Value: 10,
+	}
+	// logLevel defines the logger level
+	logLevel = cli.StringFlag{
+		Name: "log-level",
+		Usage: "This flag specifies the logger `level(s)`. It can contain multiple comma-separated value. For example" +
+			", if set to *:INFO the logs for all packages will have the INFO level. However, if set to *:INFO,api:DEBUG" +
+			" the logs for all packages will have the INFO level, excepting the api package which will receive a DEBUG" +
+			" log level.",
+		Value: "*:" + logger.LogInfo.String(),
+	}
+
+	log = logger.GetOrCreate("topologyexporter")
+)
+
+func main() {
+	app := cli.NewApp()
+	cli.AppHelpTemplate = helpTemplate
+	app.Name = "Topology exporter tool"
+	app.Version = "v1.0.0"
+	app.Usage = "This tool crawls the network topology seen by one or more nodes, built from the received heartbeats " +
+		"and the nodes direct connections, and exports the merged snapshot as JSON, Graphviz DOT or GraphML files"
+	app.Authors = []cli.Author{
+		{
+			Name:  "The MultiversX Team",
+			Email: "contact@multiversx.com",
+		},
+	}
+	app.Flags = []cli.Flag{
+		nodeAddresses,
+		formats,
+		outputDir,
+		requestTimeout,
+		logLevel,
+	}
+
+	app.Action = crawlAndExport
+
+	err := app.Run(os.Args)
+	if err != nil {
+		log.Error("error running the topology exporter tool", "error", err)
+
+		os.Exit(1)
+	}
+}
+
+func crawlAndExport(ctx *cli.Context) error {
+	err := logger.SetLogLevel(ctx.GlobalString(logLevel.Name))
+	if err != nil {
+		return err
+	}
+
+	exportFormats := splitList(ctx.GlobalString(formats.Name))
+	for _, format := range exportFormats {
+		_, err = topology.GetContentType(format)
+		if err != nil {
+			return err
+		}
+	}
+
+	c, err := newCrawler(splitList(ctx.GlobalString(nodeAddresses.Name)), time.Second*time.Duration(ctx.GlobalInt(requestTimeout.Name)))
+	if err != nil {
+		return err
+	}
+
+	networkTopology, err := c.crawl()
+	if err != nil {
+		return err
+	}
+
+	log.Info("network topology crawled",
+		"num observers", len(networkTopology.Observers),
+		"num peers", len(networkTopology.Peers),
+		"num direct connections", len(networkTopology.DirectConnections))
+
+	return exportTopology(networkTopology, exportFormats, ctx.GlobalString(outputDir.Name))
+}
+
+func exportTopology(networkTopology *data.NetworkTopology, exportFormats []string, dir string) error {
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+
+	for _, format := range exportFormats {
+		buff, errExport := topology.Export(networkTopology, format)
+		if errExport != nil {
+			return errExport
+		}
+
+		fileName := fmt.Sprintf("%s_%d.%s", filePrefix, networkTopology.Timestamp.Unix(), format)
+		filePath := filepath.Join(dir, fileName)
+		errWrite := ioutil.WriteFile(filePath, buff, 0644)
+		if errWrite != nil {
+			return errWrite
+		}
+
+		log.Info("network topology exported", "format", format, "file", filePath)
+	}
+
+	return nil
+}
+
+func splitList(value string) []string {
+	items := make([]string, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) > 0 {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
@@ -174,6 +174,30 @@ const MetricNetworkSentBps = "erd_network_sent_bps"
 // MetricNetworkSentBpsPeak is the metric for monitoring network sent peak bytes per second
 const MetricNetworkSentBpsPeak = "erd_network_sent_bps_peak"
 
+// MetricStorageSizeInBytesPrefix is the prefix of the metrics for the size on disk of the active persisters of a
+// storage unit. The storage unit name is appended to the prefix
+const MetricStorageSizeInBytesPrefix = "erd_storage_size_in_bytes_"
+
+// MetricStorageNumFilesPrefix is the prefix of the metrics for the number of files of the active persisters of a
+// storage unit
+const MetricStorageNumFilesPrefix = "erd_storage_num_files_"
+
+// MetricStorageNumCompactionsPrefix is the prefix of the metrics for the number of compactions done by the active
+// persisters of a storage unit. It is set only for the persisters reporting their engine statistics
+const MetricStorageNumCompactionsPrefix = "erd_storage_num_compactions_"
+
+// MetricStorageCompactionDebtPrefix is the prefix of the metrics for the estimated number of bytes to be compacted by
+// the active persisters of a storage unit
+const MetricStorageCompactionDebtPrefix = "erd_storage_compaction_debt_in_bytes_"
+
+// MetricStorageBlockCacheHitsPrefix is the prefix of the metrics for the block cache hits of the active persisters of a
+// storage unit
+const MetricStorageBlockCacheHitsPrefix = "erd_storage_block_cache_hits_"
+
+// MetricStorageBlockCacheMissesPrefix is the prefix of the metrics for the block cache misses of the active persisters
+// of a storage unit
+const MetricStorageBlockCacheMissesPrefix = "erd_storage_block_cache_misses_"
+
 // MetricRoundTime is the metric for round time in seconds
 const MetricRoundTime = "erd_round_time"
 
@@ -846,3 +870,12 @@ const MetricTrieSyncNumReceivedBytes = "erd_trie_sync_num_bytes_received"
 
 // MetricTrieSyncNumProcessedNodes is the metric that outputs the number of trie nodes processed for accounts during trie sync
 const MetricTrieSyncNumProcessedNodes = "erd_trie_sync_num_nodes_processed"
+
+// TrieSnapshotsPauseAction is the action that pauses the trie snapshots and checkpoints
+const TrieSnapshotsPauseAction = "pause"
+
+// TrieSnapshotsResumeAction is the action that resumes the paused trie snapshots and checkpoints
+const TrieSnapshotsResumeAction = "resume"
+
+// TrieSnapshotsCancelAction is the action that cancels the running and queued trie snapshots and checkpoints
+const TrieSnapshotsCancelAction = "cancel"
@@ -1,6 +1,11 @@
 package common
 
-import "github.com/multiversx/mx-chain-core-go/data/outport"
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+)
 
 // GetProofResponse is a struct that stores the response of a GetProof API request
 type GetProofResponse struct {
@@ -9,6 +14,30 @@ type GetProofResponse struct {
 	RootHash string
 }
 
+// GetMultiProofResponse is a struct that stores the response of a multiproof API request. The leaves are in the order
+// of the requested keys, and the keys missing from the trie have empty values
+type GetMultiProofResponse struct {
+	Proof    [][]byte
+	Leaves   []core.KeyValueHolder
+	RootHash string
+}
+
+// GetRangeProofResponse is a struct that stores the response of a range proof API request. The end key is the upper
+// bound proven by the proof, which is lower than the requested one if not all the leaves fitted in the response
+type GetRangeProofResponse struct {
+	Proof    [][]byte
+	Leaves   []core.KeyValueHolder
+	EndKey   []byte
+	RootHash string
+}
+
+// VerifyProofsResponse is a struct that stores the result of a multiproof or a range proof verification, together
+// with the leaves bound by the proof
+type VerifyProofsResponse struct {
+	Ok     bool
+	Leaves []core.KeyValueHolder
+}
+
 // TransactionsPoolAPIResponse is a struct that holds the data to be returned when getting the transaction pool from an API call
 type TransactionsPoolAPIResponse struct {
 	RegularTransactions  []Transaction `json:"regularTransactions"`
@@ -73,3 +102,213 @@ type EpochStartDataAPI struct {
 type AlteredAccountsForBlockAPIResponse struct {
 	Accounts []*outport.AlteredAccount `json:"accounts"`
 }
+
+// AccountOverride holds the values that replace the ones of an account while simulating a transaction or executing
+// a VM query. Nil or empty fields leave the original values untouched
+type AccountOverride struct {
+	Balance *big.Int
+	Nonce   *uint64
+	Code    []byte
+	Storage map[string][]byte
+}
+
+// StateOverrides maps the address bytes of the accounts to be overridden to their overrides
+type StateOverrides map[string]*AccountOverride
+
+// CallTrace holds the structured trace of a smart contract execution
+type CallTrace struct {
+	ReturnCode    string            `json:"returnCode"`
+	ReturnMessage string            `json:"returnMessage,omitempty"`
+	GasProvided   uint64            `json:"gasProvided"`
+	GasUsed       uint64            `json:"gasUsed"`
+	Counters      map[string]uint64 `json:"counters,omitempty"`
+	Call          *CallFrame        `json:"call"`
+}
+
+// CallFrame holds the details of a single call performed while executing a smart contract, together with the
+// calls it triggered
+type CallFrame struct {
+	Caller            string                `json:"caller"`
+	Callee            string                `json:"callee"`
+	Function          string                `json:"function,omitempty"`
+	CallType          string                `json:"callType"`
+	Value             string                `json:"value,omitempty"`
+	GasLimit          uint64                `json:"gasLimit"`
+	GasLocked         uint64                `json:"gasLocked,omitempty"`
+	GasUsed           uint64                `json:"gasUsed"`
+	IsBuiltInFunction bool                  `json:"isBuiltInFunction,omitempty"`
+	StorageReads      []*StorageAccessTrace `json:"storageReads,omitempty"`
+	StorageWrites     []*StorageAccessTrace `json:"storageWrites,omitempty"`
+	Logs              []*LogEntryTrace      `json:"logs,omitempty"`
+	Calls             []*CallFrame          `json:"calls,omitempty"`
+}
+
+// StorageAccessTrace holds a hex encoded storage key together with its hex encoded value
+type StorageAccessTrace struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// LogEntryTrace holds a log emitted while executing a smart contract, with the topics and data hex encoded
+type LogEntryTrace struct {
+	Identifier string   `json:"identifier"`
+	Address    string   `json:"address"`
+	Topics     []string `json:"topics,omitempty"`
+	Data       string   `json:"data,omitempty"`
+}
+
+// AccountStoragePage holds a page of an account's hex encoded key-value pairs, in the data trie iteration order,
+// together with the token needed for fetching the next page. An empty continuation token marks the last page
+type AccountStoragePage struct {
+	Pairs             []*KeyValuePairAPI `json:"pairs"`
+	ContinuationToken string             `json:"continuationToken,omitempty"`
+}
+
+// KeyValuePairAPI holds a hex encoded key together with its hex encoded value
+type KeyValuePairAPI struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// StorageUnitStats holds the statistics of the active persisters of a storage unit
+type StorageUnitStats struct {
+	Unit       string            `json:"unit"`
+	Persisters []*PersisterStats `json:"persisters"`
+}
+
+// PersisterStats holds the statistics of a persister directory. The engine statistics are set only for the persisters
+// that report them
+type PersisterStats struct {
+	Path        string              `json:"path"`
+	Epoch       uint32              `json:"epoch"`
+	NumFiles    uint64              `json:"numFiles"`
+	SizeInBytes uint64              `json:"sizeInBytes"`
+	Engine      *StorageEngineStats `json:"engine,omitempty"`
+}
+
+// StorageEngineStats holds the internal statistics of a storage engine
+type StorageEngineStats struct {
+	NumCompactions           uint64 `json:"numCompactions"`
+	NumCompactionsInProgress uint64 `json:"numCompactionsInProgress"`
+	CompactionDebtInBytes    uint64 `json:"compactionDebtInBytes"`
+	NumFlushes               uint64 `json:"numFlushes"`
+	NumTables                uint64 `json:"numTables"`
+	MemTableSizeInBytes      uint64 `json:"memTableSizeInBytes"`
+	BlockCacheHits           uint64 `json:"blockCacheHits"`
+	BlockCacheMisses         uint64 `json:"blockCacheMisses"`
+}
+
+// TrieSnapshotsStatus holds the progress of the snapshots and checkpoints of a trie storage manager. The copied nodes
+// and bytes are counted since the storage manager became busy, over all the tries of the operations queued meanwhile.
+// The estimations are based on the number of nodes copied by the previous successful run and are 0 if unknown
+type TrieSnapshotsStatus struct {
+	Paused                 bool                     `json:"paused"`
+	NumQueuedSnapshots     uint64                   `json:"numQueuedSnapshots"`
+	NumQueuedCheckpoints   uint64                   `json:"numQueuedCheckpoints"`
+	NumRunningSnapshots    uint64                   `json:"numRunningSnapshots"`
+	NumRunningCheckpoints  uint64                   `json:"numRunningCheckpoints"`
+	StartTimestamp         int64                    `json:"startTimestamp"`
+	NumNodesCopied         uint64                   `json:"numNodesCopied"`
+	NumBytesCopied         uint64                   `json:"numBytesCopied"`
+	EstimatedNumNodes      uint64                   `json:"estimatedNumNodes"`
+	EstimatedCompletionSec int64                    `json:"estimatedCompletionSec"`
+	LastDurationSec        int64                    `json:"lastDurationSec"`
+	LastError              string                   `json:"lastError"`
+	LastErrorTimestamp     int64                    `json:"lastErrorTimestamp"`
+	RunningOperations      []*TrieSnapshotOperation `json:"runningOperations"`
+}
+
+// TrieSnapshotOperation holds the progress of a running trie snapshot or checkpoint
+type TrieSnapshotOperation struct {
+	Type             string `json:"type"`
+	Address          string `json:"address"`
+	RootHash         string `json:"rootHash"`
+	MainTrieRootHash string `json:"mainTrieRootHash"`
+	Epoch            uint32 `json:"epoch"`
+	StartTimestamp   int64  `json:"startTimestamp"`
+	NumNodesCopied   uint64 `json:"numNodesCopied"`
+	NumBytesCopied   uint64 `json:"numBytesCopied"`
+}
+
+// StateDiffAPI holds a page of the accounts changed between two root hashes of the accounts trie, in the trie's
+// traversal order. The next address is the cursor the next page starts with, and it is empty for the last page
+type StateDiffAPI struct {
+	Accounts    []*AccountDiffAPI `json:"accounts"`
+	NextAddress string            `json:"nextAddress,omitempty"`
+}
+
+// AccountDiffAPI holds the old and the new state of a changed account, with the hex encoded code hashes. The old
+// fields are empty for a created account, while the new fields are empty for a removed account
+type AccountDiffAPI struct {
+	Address         string             `json:"address"`
+	Created         bool               `json:"created,omitempty"`
+	Removed         bool               `json:"removed,omitempty"`
+	OldBalance      string             `json:"oldBalance"`
+	NewBalance      string             `json:"newBalance"`
+	OldNonce        uint64             `json:"oldNonce"`
+	NewNonce        uint64             `json:"newNonce"`
+	OldCodeHash     string             `json:"oldCodeHash,omitempty"`
+	NewCodeHash     string             `json:"newCodeHash,omitempty"`
+	DataTrieChanges []*KeyValueDiffAPI `json:"dataTrieChanges,omitempty"`
+}
+
+// KeyValueDiffAPI holds a changed key of a data trie with its old and new values, all hex encoded. The old value is
+// empty for an added key, while the new value is empty for a removed key
+type KeyValueDiffAPI struct {
+	Key      string `json:"key"`
+	OldValue string `json:"oldValue"`
+	NewValue string `json:"newValue"`
+}
+
+// ConsensusRoundTimeline holds what happened in a consensus round, as seen by the node. The timestamps are unix
+// timestamps in milliseconds, while the public keys, the block hash and the bitmap are hex encoded. The leader and the
+// consensus group size are not set if the node did not start the round, and the block fields are set only if the
+// block of the round was committed
+type ConsensusRoundTimeline struct {
+	Round              int64                        `json:"round"`
+	RoundTimestamp     int64                        `json:"roundTimestamp"`
+	Leader             string                       `json:"leader"`
+	ConsensusGroupSize int                          `json:"consensusGroupSize"`
+	Subrounds          []*ConsensusSubroundTimeline `json:"subrounds"`
+	Messages           []*ConsensusMessageTimeline  `json:"messages"`
+	BlockHash          string                       `json:"blockHash,omitempty"`
+	Bitmap             string                       `json:"bitmap,omitempty"`
+	Signers            []string                     `json:"signers,omitempty"`
+}
+
+// ConsensusSubroundTimeline holds the start and the end of a subround. A subround which did not finish in time was
+// extended, while a subround still in progress has no end timestamp
+type ConsensusSubroundTimeline struct {
+	Name           string `json:"name"`
+	StartTimestamp int64  `json:"startTimestamp"`
+	EndTimestamp   int64  `json:"endTimestamp"`
+	Finished       bool   `json:"finished"`
+}
+
+// ConsensusMessageTimeline holds a consensus message received for a round
+type ConsensusMessageTimeline struct {
+	Type      string `json:"type"`
+	PubKey    string `json:"pubKey"`
+	PeerID    string `json:"peerID"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// PeerReputation holds the unified reputation of a peer. The score combines the antiflood violations, the invalid
+// intercepted data and the responses to the node's requests with the weighted honesty score, which is the consensus
+// honesty of the peer's public key, if known
+type PeerReputation struct {
+	Score                  float64 `json:"score"`
+	HonestyScore           float64 `json:"honestyScore"`
+	IsBadPeer              bool    `json:"isBadPeer"`
+	NumAntifloodViolations uint32  `json:"numAntifloodViolations"`
+	NumInvalidData         uint32  `json:"numInvalidData"`
+	NumUsefulResponses     uint32  `json:"numUsefulResponses"`
+	NumUselessResponses    uint32  `json:"numUselessResponses"`
+	NumLateResponses       uint32  `json:"numLateResponses"`
+}
+
+// PeerInfoAPI holds the p2p information of a peer, as returned by the /node/peerinfo endpoint, together with its reputation
+type PeerInfoAPI struct {
+	core.QueryP2PPeerInfo
+	Reputation *PeerReputation `json:"reputation,omitempty"`
+}
@@ -40,14 +40,22 @@ type Trie interface {
 	GetSerializedNodes([]byte, uint64) ([][]byte, uint64, error)
 	GetSerializedNode([]byte) ([]byte, error)
 	GetAllLeavesOnChannel(allLeavesChan *TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder KeyBuilder) error
+	GetLeavesPage(rootHash []byte, startKey []byte, maxLeaves int, ctx context.Context) ([]core.KeyValueHolder, []byte, error)
+	GetDiff(oldRootHash []byte, newRootHash []byte, startKey []byte, handler TrieDiffHandlerFunc, ctx context.Context) error
 	GetAllHashes() ([][]byte, error)
 	GetProof(key []byte) ([][]byte, []byte, error)
+	GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, []byte, error)
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManager() StorageManager
 	Close() error
 	IsInterfaceNil() bool
 }
 
+// TrieDiffHandlerFunc is called for each key with different values in two versions of a trie. The old value is nil
+// for an added key and the new value is nil for a removed key
+type TrieDiffHandlerFunc func(key []byte, oldValue []byte, newValue []byte) error
+
 // TrieStats is used to collect the trie statistics for the given rootHash
 type TrieStats interface {
 	GetTrieStats(address string, rootHash []byte) (*statistics.TrieStatsDTO, error)
@@ -64,6 +72,7 @@ type KeyBuilder interface {
 type DataTrieHandler interface {
 	RootHash() ([]byte, error)
 	GetAllLeavesOnChannel(leavesChannels *TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder KeyBuilder) error
+	GetLeavesPage(rootHash []byte, startKey []byte, maxLeaves int, ctx context.Context) ([]core.KeyValueHolder, []byte, error)
 	IsInterfaceNil() bool
 }
 
@@ -91,6 +100,15 @@ type StorageManager interface {
 	IsInterfaceNil() bool
 }
 
+// TrieSnapshotsController defines what a trie storage manager able to report and control the progress of its
+// snapshots and checkpoints should do
+type TrieSnapshotsController interface {
+	GetSnapshotsStatus() *TrieSnapshotsStatus
+	PauseSnapshots()
+	ResumeSnapshots()
+	CancelSnapshots()
+}
+
 // DBWriteCacher is used to cache changes made to the trie, and only write to the database when it's needed
 type DBWriteCacher interface {
 	Put(key, val []byte) error
@@ -132,6 +150,8 @@ type Locker interface {
 // MerkleProofVerifier is used to verify merkle proofs
 type MerkleProofVerifier interface {
 	VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error)
+	VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) ([]core.KeyValueHolder, error)
+	VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]core.KeyValueHolder, error)
 }
 
 // SizeSyncStatisticsHandler extends the SyncStatisticsHandler interface by allowing setting up the trie node size
@@ -67,6 +67,12 @@ type ConsensusConfig struct {
 	Type string
 }
 
+// SlashingProtectionConfig will hold the configuration for the local database of proposed and signed blocks
+type SlashingProtectionConfig struct {
+	Enabled       bool
+	StorageConfig StorageConfig
+}
+
 // NTPConfig will hold the configuration for NTP queries
 type NTPConfig struct {
 	Hosts               []string
@@ -193,6 +199,7 @@ type Config struct {
 	ValidatorStatistics ValidatorStatisticsConfig
 	GeneralSettings     GeneralSettingsConfig
 	Consensus           ConsensusConfig
+	SlashingProtection  SlashingProtectionConfig
 	StoragePruning      StoragePruningConfig
 	LogsAndEvents       LogsAndEventsConfig
 
@@ -215,6 +222,7 @@ type Config struct {
 	VMOutputCacher        CacheConfig
 
 	PeersRatingConfig   PeersRatingConfig
+	PeerReputation      PeerReputationConfig
 	PoolsCleanersConfig PoolsCleanersConfig
 }
 
@@ -224,6 +232,32 @@ type PeersRatingConfig struct {
 	BadRatedCacheCapacity int
 }
 
+// PeerReputationConfig will hold settings related to the unified peer reputation score
+type PeerReputationConfig struct {
+	Enabled                       bool
+	CacheCapacity                 int
+	PendingRequestsCacheCapacity  int
+	DecayCoefficient              float64
+	DecayUpdateIntervalInSeconds  uint32
+	MaxScore                      float64
+	MinScore                      float64
+	BadPeerThreshold              float64
+	BlacklistThreshold            float64
+	BlacklistDurationInSeconds    uint32
+	ResponseTimeoutInMilliseconds uint32
+	HonestyWeight                 float64
+	Scores                        PeerReputationScoresConfig
+}
+
+// PeerReputationScoresConfig will hold the score changes applied on a peer reputation for each recorded event
+type PeerReputationScoresConfig struct {
+	AntifloodViolation float64
+	InvalidData        float64
+	UsefulResponse     float64
+	UselessResponse    float64
+	LateResponse       float64
+}
+
 // LogsConfig will hold settings related to the logging sub-system
 type LogsConfig struct {
 	LogFileLifeSpanInSec int
@@ -240,6 +274,23 @@ type StoragePruningConfig struct {
 	NumEpochsToKeep                      uint64
 	NumActivePersisters                  uint64
 	FullArchiveNumActivePersisters       uint32
+	UnitsRetention                       []StorageUnitRetentionConfig
+	ColdStorage                          ColdStorageConfig
+}
+
+// ColdStorageConfig holds the settings for moving the persisters of the epochs out of the active window to a
+// secondary, usually slower, storage
+type ColdStorageConfig struct {
+	Enabled bool
+	Path    string
+}
+
+// StorageUnitRetentionConfig holds the retention policy of a storage unit, which overrides the StoragePruning settings
+// for that unit. Unit is the FilePath of the unit's DB
+type StorageUnitRetentionConfig struct {
+	Unit            string
+	KeepForever     bool
+	NumEpochsToKeep uint64
 }
 
 // ResourceStatsConfig will hold all resource stats settings
@@ -269,6 +320,7 @@ type MultiSignerConfig struct {
 // GeneralSettingsConfig will hold the general settings for a node
 type GeneralSettingsConfig struct {
 	StatusPollingIntervalSec             int
+	StorageStatsPollingIntervalSec       int
 	MaxComputableRounds                  uint64
 	MaxConsecutiveRoundsOfRatingDecrease uint64
 	StartInEpochEnabled                  bool
@@ -467,6 +519,7 @@ type DebugConfig struct {
 	ShuffleOut          ShuffleOutDebugConfig
 	EpochStart          EpochStartDebugConfig
 	Process             ProcessDebugConfig
+	ConsensusRounds     ConsensusRoundsDebugConfig
 }
 
 // HealthServiceConfig will hold health service (monitoring) configuration
@@ -519,10 +572,27 @@ type ProcessDebugConfig struct {
 	RevertLogLevelTimeInSeconds int
 }
 
+// ConsensusRoundsDebugConfig will hold the consensus rounds timeline debug configuration
+type ConsensusRoundsDebugConfig struct {
+	Enabled         bool
+	NumRoundsToKeep int
+	PersistToFile   bool
+	FolderPath      string
+}
+
 // ApiRoutesConfig holds the configuration related to Rest API routes
 type ApiRoutesConfig struct {
-	Logging     ApiLoggingConfig
-	APIPackages map[string]APIPackageConfig
+	Logging       ApiLoggingConfig
+	Subscriptions ApiSubscriptionsConfig
+	APIPackages   map[string]APIPackageConfig
+}
+
+// ApiSubscriptionsConfig holds the configuration related to the web socket subscriptions
+type ApiSubscriptionsConfig struct {
+	MaxSubscribers                uint32
+	MaxSubscriptionsPerSubscriber uint32
+	SubscriberQueueSize           uint32
+	AllowedOrigins                []string
 }
 
 // ApiLoggingConfig holds the configuration related to API requests logging
@@ -567,6 +637,7 @@ type Configs struct {
 	P2pConfig                *p2pConfig.P2PConfig
 	FlagsConfig              *ContextFlagsConfig
 	ImportDbConfig           *ImportDbConfig
+	OutportReplayConfig      *OutportReplayConfig
 	ConfigurationPathsHolder *ConfigurationPathsHolder
 	EpochConfig              *EpochConfig
 	RoundConfig              *RoundConfig
@@ -605,6 +676,17 @@ type ResolverConfig struct {
 	NumCrossShardPeers  uint32
 	NumTotalPeers       uint32
 	NumFullHistoryPeers uint32
+	AdaptiveRouting     AdaptiveRoutingConfig
+}
+
+// AdaptiveRoutingConfig represents the config options used when preferring the peers that answered faster
+// to the previous requests
+type AdaptiveRoutingConfig struct {
+	Enabled                       bool
+	CacheCapacity                 int
+	ResponseTimeoutInMilliseconds uint32
+	LatencySmoothingFactor        float64
+	ExplorationPercent            uint32
 }
 
 // PoolsCleanersConfig represents the config options to be used by the pools cleaners
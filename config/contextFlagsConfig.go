@@ -24,6 +24,7 @@ type ContextFlagsConfig struct {
 	SerializeSnapshots           bool
 	NoKeyProvided                bool
 	OperationMode                string
+	StateSnapshotDirectory       string
 }
 
 // ImportDbConfig will hold the import-db parameters
@@ -35,3 +36,11 @@ type ImportDbConfig struct {
 	ImportDbNoSigCheckFlag        bool
 	ImportDbSaveTrieEpochRootHash bool
 }
+
+// OutportReplayConfig will hold the outport replay parameters
+type OutportReplayConfig struct {
+	IsOutportReplayMode bool
+	FromNonce           uint64
+	ToNonce             uint64
+	RecordsDirectory    string
+}
@@ -5,6 +5,7 @@ type ExternalConfig struct {
 	ElasticSearchConnector ElasticSearchConfig
 	EventNotifierConnector EventNotifierConfig
 	WebSocketConnector     WebSocketDriverConfig
+	FileDriverConnector    FileDriverConfig
 }
 
 // ElasticSearchConfig will hold the configuration for the elastic search
@@ -21,12 +22,15 @@ type ElasticSearchConfig struct {
 
 // EventNotifierConfig will hold the configuration for the events notifier driver
 type EventNotifierConfig struct {
-	Enabled           bool
-	UseAuthorization  bool
-	ProxyUrl          string
-	Username          string
-	Password          string
-	RequestTimeoutSec int
+	Enabled                        bool
+	UseAuthorization               bool
+	ProxyUrl                       string
+	Username                       string
+	Password                       string
+	RequestTimeoutSec              int
+	QueueDirectory                 string
+	MinRetryIntervalInMilliseconds uint32
+	MaxRetryIntervalInMilliseconds uint32
 }
 
 // CovalentConfig will hold the configurations for covalent indexer
@@ -44,3 +48,11 @@ type WebSocketDriverConfig struct {
 	URL             string
 	MarshallerType  string
 }
+
+// FileDriverConfig will hold the configuration for the file based outport driver
+type FileDriverConfig struct {
+	Enabled         bool
+	Directory       string
+	Format          string
+	MaxFileSizeInMB uint64
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/p2p"
 )
 
@@ -140,6 +141,27 @@ type NodeRedundancyHandler interface {
 	IsInterfaceNil() bool
 }
 
+// SlashingProtector records the blocks proposed and signed by the node's keys and refuses to propose or to sign
+// a different block in an already used round
+type SlashingProtector interface {
+	CheckAndRecordProposal(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignature(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// RoundsTimelineRecorder records the timeline of the last consensus rounds, used for debugging missed blocks
+type RoundsTimelineRecorder interface {
+	RoundStarted(round int64, roundTimeStamp time.Time, leader string, consensusGroup []string)
+	SubroundStarted(round int64, subroundName string)
+	SubroundEnded(round int64, subroundName string, finished bool)
+	MessageReceived(round int64, messageType string, pubKey []byte, pid core.PeerID)
+	BlockCommitted(round int64, headerHash []byte, bitmap []byte)
+	GetRounds() []*common.ConsensusRoundTimeline
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // ScheduledProcessor encapsulates the scheduled processor functionality required by consensus module
 type ScheduledProcessor interface {
 	StartScheduledProcessing(header data.HeaderHandler, body data.BodyHandler, startTime time.Time)
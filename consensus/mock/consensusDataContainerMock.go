@@ -39,6 +39,8 @@ type ConsensusCoreMock struct {
 	nodeRedundancyHandler   consensus.NodeRedundancyHandler
 	scheduledProcessor      consensus.ScheduledProcessor
 	signatureHandler        consensus.SignatureHandler
+	slashingProtector       consensus.SlashingProtector
+	roundsTimelineRecorder  consensus.RoundsTimelineRecorder
 }
 
 // GetAntiFloodHandler -
@@ -241,6 +243,26 @@ func (ccm *ConsensusCoreMock) SetSignatureHandler(signatureHandler consensus.Sig
 	ccm.signatureHandler = signatureHandler
 }
 
+// SlashingProtector -
+func (ccm *ConsensusCoreMock) SlashingProtector() consensus.SlashingProtector {
+	return ccm.slashingProtector
+}
+
+// SetSlashingProtector -
+func (ccm *ConsensusCoreMock) SetSlashingProtector(slashingProtector consensus.SlashingProtector) {
+	ccm.slashingProtector = slashingProtector
+}
+
+// RoundsTimelineRecorder -
+func (ccm *ConsensusCoreMock) RoundsTimelineRecorder() consensus.RoundsTimelineRecorder {
+	return ccm.roundsTimelineRecorder
+}
+
+// SetRoundsTimelineRecorder -
+func (ccm *ConsensusCoreMock) SetRoundsTimelineRecorder(roundsTimelineRecorder consensus.RoundsTimelineRecorder) {
+	ccm.roundsTimelineRecorder = roundsTimelineRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ccm *ConsensusCoreMock) IsInterfaceNil() bool {
 	return ccm == nil
@@ -210,6 +210,8 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 	scheduledProcessor := &consensusMocks.ScheduledProcessorStub{}
 	multiSignerContainer := cryptoMocks.NewMultiSignerContainerMock(multiSigner)
 	signatureHandler := &SignatureHandlerStub{}
+	slashingProtector := &SlashingProtectorStub{}
+	roundsTimelineRecorder := &RoundsTimelineRecorderStub{}
 
 	container := &ConsensusCoreMock{
 		blockChain:              blockChain,
@@ -235,6 +237,8 @@ func InitConsensusCoreWithMultiSigner(multiSigner crypto.MultiSigner) *Consensus
 		nodeRedundancyHandler:   nodeRedundancyHandler,
 		scheduledProcessor:      scheduledProcessor,
 		signatureHandler:        signatureHandler,
+		slashingProtector:       slashingProtector,
+		roundsTimelineRecorder:  roundsTimelineRecorder,
 	}
 
 	return container
@@ -0,0 +1,77 @@
+package mock
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// RoundsTimelineRecorderStub -
+type RoundsTimelineRecorderStub struct {
+	RoundStartedCalled    func(round int64, roundTimeStamp time.Time, leader string, consensusGroup []string)
+	SubroundStartedCalled func(round int64, subroundName string)
+	SubroundEndedCalled   func(round int64, subroundName string, finished bool)
+	MessageReceivedCalled func(round int64, messageType string, pubKey []byte, pid core.PeerID)
+	BlockCommittedCalled  func(round int64, headerHash []byte, bitmap []byte)
+	GetRoundsCalled       func() []*common.ConsensusRoundTimeline
+	CloseCalled           func() error
+}
+
+// RoundStarted -
+func (stub *RoundsTimelineRecorderStub) RoundStarted(round int64, roundTimeStamp time.Time, leader string, consensusGroup []string) {
+	if stub.RoundStartedCalled != nil {
+		stub.RoundStartedCalled(round, roundTimeStamp, leader, consensusGroup)
+	}
+}
+
+// SubroundStarted -
+func (stub *RoundsTimelineRecorderStub) SubroundStarted(round int64, subroundName string) {
+	if stub.SubroundStartedCalled != nil {
+		stub.SubroundStartedCalled(round, subroundName)
+	}
+}
+
+// SubroundEnded -
+func (stub *RoundsTimelineRecorderStub) SubroundEnded(round int64, subroundName string, finished bool) {
+	if stub.SubroundEndedCalled != nil {
+		stub.SubroundEndedCalled(round, subroundName, finished)
+	}
+}
+
+// MessageReceived -
+func (stub *RoundsTimelineRecorderStub) MessageReceived(round int64, messageType string, pubKey []byte, pid core.PeerID) {
+	if stub.MessageReceivedCalled != nil {
+		stub.MessageReceivedCalled(round, messageType, pubKey, pid)
+	}
+}
+
+// BlockCommitted -
+func (stub *RoundsTimelineRecorderStub) BlockCommitted(round int64, headerHash []byte, bitmap []byte) {
+	if stub.BlockCommittedCalled != nil {
+		stub.BlockCommittedCalled(round, headerHash, bitmap)
+	}
+}
+
+// GetRounds -
+func (stub *RoundsTimelineRecorderStub) GetRounds() []*common.ConsensusRoundTimeline {
+	if stub.GetRoundsCalled != nil {
+		return stub.GetRoundsCalled()
+	}
+
+	return make([]*common.ConsensusRoundTimeline, 0)
+}
+
+// Close -
+func (stub *RoundsTimelineRecorderStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *RoundsTimelineRecorderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
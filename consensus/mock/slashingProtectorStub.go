@@ -0,0 +1,40 @@
+package mock
+
+// SlashingProtectorStub -
+type SlashingProtectorStub struct {
+	CheckAndRecordProposalCalled  func(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error
+	CheckAndRecordSignatureCalled func(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error
+	CloseCalled                   func() error
+}
+
+// CheckAndRecordProposal -
+func (stub *SlashingProtectorStub) CheckAndRecordProposal(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+	if stub.CheckAndRecordProposalCalled != nil {
+		return stub.CheckAndRecordProposalCalled(pubKey, epoch, round, headerHash)
+	}
+
+	return nil
+}
+
+// CheckAndRecordSignature -
+func (stub *SlashingProtectorStub) CheckAndRecordSignature(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+	if stub.CheckAndRecordSignatureCalled != nil {
+		return stub.CheckAndRecordSignatureCalled(pubKey, epoch, round, headerHash)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *SlashingProtectorStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *SlashingProtectorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
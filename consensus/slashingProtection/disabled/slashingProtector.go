@@ -0,0 +1,28 @@
+package disabled
+
+type slashingProtector struct{}
+
+// NewSlashingProtector returns a new instance of this disabled slashing protector
+func NewSlashingProtector() *slashingProtector {
+	return &slashingProtector{}
+}
+
+// CheckAndRecordProposal returns nil
+func (sp *slashingProtector) CheckAndRecordProposal(_ []byte, _ uint32, _ uint64, _ []byte) error {
+	return nil
+}
+
+// CheckAndRecordSignature returns nil
+func (sp *slashingProtector) CheckAndRecordSignature(_ []byte, _ uint32, _ uint64, _ []byte) error {
+	return nil
+}
+
+// Close returns nil
+func (sp *slashingProtector) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (sp *slashingProtector) IsInterfaceNil() bool {
+	return sp == nil
+}
@@ -0,0 +1,25 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlashingProtector_MethodsDoNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+		}
+	}()
+
+	sp := NewSlashingProtector()
+	assert.False(t, sp.IsInterfaceNil())
+	assert.Nil(t, sp.CheckAndRecordProposal([]byte("pk"), 1, 2, []byte("hash")))
+	assert.Nil(t, sp.CheckAndRecordSignature([]byte("pk"), 1, 2, []byte("hash")))
+	assert.Nil(t, sp.Close())
+}
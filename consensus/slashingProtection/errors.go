@@ -0,0 +1,21 @@
+package slashingProtection
+
+import "errors"
+
+// ErrNilStorer signals that a nil storer has been provided
+var ErrNilStorer = errors.New("nil storer")
+
+// ErrEmptyPublicKey signals that an empty public key has been provided
+var ErrEmptyPublicKey = errors.New("empty public key")
+
+// ErrEmptyHeaderHash signals that an empty header hash has been provided
+var ErrEmptyHeaderHash = errors.New("empty header hash")
+
+// ErrConflictingBlock signals that a different block was already proposed or signed in the same round
+var ErrConflictingBlock = errors.New("a different block was already proposed or signed in this round")
+
+// ErrInvalidRecord signals that an invalid slashing protection record was found
+var ErrInvalidRecord = errors.New("invalid slashing protection record")
+
+// ErrUnsupportedInterchangeVersion signals that the interchange data has an unsupported format version
+var ErrUnsupportedInterchangeVersion = errors.New("unsupported interchange format version")
@@ -0,0 +1,214 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// InterchangeFormatVersion is the version of the interchange format written by Export
+const InterchangeFormatVersion = "1"
+
+// Interchange is the JSON document used for moving the slashing protection records between machines, for example
+// when a validator key is moved from a main machine to a backup one:
+//
+//	{
+//	  "metadata": {"interchangeFormatVersion": "1"},
+//	  "data": [
+//	    {
+//	      "pubKey": "<hex encoded BLS public key>",
+//	      "proposedBlocks": [{"epoch": 1, "round": 1000, "headerHash": "<hex encoded header hash>"}],
+//	      "signedBlocks": [{"epoch": 1, "round": 1001, "headerHash": "<hex encoded header hash>"}]
+//	    }
+//	  ]
+//	}
+//
+// The keys are sorted by their public key and the blocks are sorted by round
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []*InterchangeKey   `json:"data"`
+}
+
+// InterchangeMetadata holds the version of the interchange format
+type InterchangeMetadata struct {
+	InterchangeFormatVersion string `json:"interchangeFormatVersion"`
+}
+
+// InterchangeKey holds the blocks proposed and signed by a public key
+type InterchangeKey struct {
+	PubKey         string              `json:"pubKey"`
+	ProposedBlocks []*InterchangeBlock `json:"proposedBlocks"`
+	SignedBlocks   []*InterchangeBlock `json:"signedBlocks"`
+}
+
+// InterchangeBlock holds a proposed or signed block
+type InterchangeBlock struct {
+	Epoch      uint32 `json:"epoch"`
+	Round      uint64 `json:"round"`
+	HeaderHash string `json:"headerHash"`
+}
+
+// ImportSummary holds the outcome of an import. The conflicting records are blocks different from the ones already
+// recorded for the same round, and they are not imported
+type ImportSummary struct {
+	NumImported  int
+	NumExisting  int
+	NumConflicts int
+}
+
+// Export writes all the records in the interchange format
+func (db *slashingProtectionDB) Export(w io.Writer) error {
+	db.mut.Lock()
+	records, err := db.getAllRecords()
+	db.mut.Unlock()
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(records, func(i, j int) bool {
+		comparison := bytes.Compare(records[i].pubKey, records[j].pubKey)
+		if comparison != 0 {
+			return comparison < 0
+		}
+
+		return records[i].round < records[j].round
+	})
+
+	interchange := &Interchange{
+		Metadata: InterchangeMetadata{
+			InterchangeFormatVersion: InterchangeFormatVersion,
+		},
+		Data: make([]*InterchangeKey, 0),
+	}
+	var currentKey *InterchangeKey
+	for _, rec := range records {
+		pubKey := hex.EncodeToString(rec.pubKey)
+		if currentKey == nil || currentKey.PubKey != pubKey {
+			currentKey = &InterchangeKey{
+				PubKey:         pubKey,
+				ProposedBlocks: make([]*InterchangeBlock, 0),
+				SignedBlocks:   make([]*InterchangeBlock, 0),
+			}
+			interchange.Data = append(interchange.Data, currentKey)
+		}
+
+		block := &InterchangeBlock{
+			Epoch:      rec.epoch,
+			Round:      rec.round,
+			HeaderHash: hex.EncodeToString(rec.headerHash),
+		}
+		if rec.recordType == proposalRecordType {
+			currentKey.ProposedBlocks = append(currentKey.ProposedBlocks, block)
+		} else {
+			currentKey.SignedBlocks = append(currentKey.SignedBlocks, block)
+		}
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(interchange)
+}
+
+// Import reads the records from the interchange format and stores the ones not already recorded. The whole document
+// is validated before storing any record
+func (db *slashingProtectionDB) Import(r io.Reader) (*ImportSummary, error) {
+	interchange := &Interchange{}
+	err := json.NewDecoder(r).Decode(interchange)
+	if err != nil {
+		return nil, err
+	}
+	if interchange.Metadata.InterchangeFormatVersion != InterchangeFormatVersion {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedInterchangeVersion, interchange.Metadata.InterchangeFormatVersion)
+	}
+
+	records, err := interchangeToRecords(interchange)
+	if err != nil {
+		return nil, err
+	}
+
+	db.mut.Lock()
+	defer db.mut.Unlock()
+
+	summary := &ImportSummary{}
+	for _, rec := range records {
+		existing, errGet := db.getRecord(rec.recordType, rec.pubKey, rec.round)
+		if errGet != nil {
+			return summary, errGet
+		}
+		if existing != nil {
+			if bytes.Equal(existing.headerHash, rec.headerHash) {
+				summary.NumExisting++
+				continue
+			}
+
+			log.Warn("conflicting slashing protection record not imported",
+				"public key", hex.EncodeToString(rec.pubKey),
+				"round", rec.round,
+				"recorded header hash", existing.headerHash,
+				"imported header hash", rec.headerHash)
+			summary.NumConflicts++
+			continue
+		}
+
+		err = db.storer.Put(recordKey(rec.recordType, rec.pubKey, rec.round), recordValue(rec))
+		if err != nil {
+			return summary, err
+		}
+		summary.NumImported++
+	}
+
+	return summary, nil
+}
+
+func interchangeToRecords(interchange *Interchange) ([]*record, error) {
+	records := make([]*record, 0)
+	for _, key := range interchange.Data {
+		pubKey, err := hex.DecodeString(key.PubKey)
+		if err != nil || len(pubKey) == 0 {
+			return nil, fmt.Errorf("%w: invalid public key %s", ErrInvalidRecord, key.PubKey)
+		}
+
+		proposals, err := interchangeBlocksToRecords(proposalRecordType, pubKey, key.ProposedBlocks)
+		if err != nil {
+			return nil, err
+		}
+		signatures, err := interchangeBlocksToRecords(signatureRecordType, pubKey, key.SignedBlocks)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, proposals...)
+		records = append(records, signatures...)
+	}
+
+	return records, nil
+}
+
+func interchangeBlocksToRecords(recordType byte, pubKey []byte, blocks []*InterchangeBlock) ([]*record, error) {
+	records := make([]*record, 0, len(blocks))
+	for _, block := range blocks {
+		if block == nil {
+			return nil, fmt.Errorf("%w: nil block for public key %s", ErrInvalidRecord, hex.EncodeToString(pubKey))
+		}
+
+		headerHash, err := hex.DecodeString(block.HeaderHash)
+		if err != nil || len(headerHash) == 0 {
+			return nil, fmt.Errorf("%w: invalid header hash %s for public key %s in round %d",
+				ErrInvalidRecord, block.HeaderHash, hex.EncodeToString(pubKey), block.Round)
+		}
+
+		records = append(records, &record{
+			recordType: recordType,
+			pubKey:     pubKey,
+			round:      block.Round,
+			epoch:      block.Epoch,
+			headerHash: headerHash,
+		})
+	}
+
+	return records, nil
+}
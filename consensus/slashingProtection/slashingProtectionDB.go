@@ -0,0 +1,186 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("consensus/slashingprotection")
+
+const (
+	proposalRecordType  = byte(1)
+	signatureRecordType = byte(2)
+	roundSize           = 8
+	epochSize           = 4
+)
+
+// ArgsSlashingProtectionDB holds the arguments needed for creating a new slashing protection database
+type ArgsSlashingProtectionDB struct {
+	Storer storage.Storer
+}
+
+// record is a block proposed or signed by a public key. The storage key is made of the record type, the public key
+// and the big endian round, while the value holds the big endian epoch followed by the header hash
+type record struct {
+	recordType byte
+	pubKey     []byte
+	round      uint64
+	epoch      uint32
+	headerHash []byte
+}
+
+type slashingProtectionDB struct {
+	mut    sync.Mutex
+	storer storage.Storer
+}
+
+// NewSlashingProtectionDB creates a new slashing protection database, which records every block proposed or signed
+// by the node's keys and refuses to propose or to sign a different block in the same round
+func NewSlashingProtectionDB(args ArgsSlashingProtectionDB) (*slashingProtectionDB, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilStorer
+	}
+
+	return &slashingProtectionDB{
+		storer: args.Storer,
+	}, nil
+}
+
+// CheckAndRecordProposal records the block proposed by the public key in the given round. It returns
+// ErrConflictingBlock if a different block was already proposed by the key in the same round
+func (db *slashingProtectionDB) CheckAndRecordProposal(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+	return db.checkAndRecord(&record{
+		recordType: proposalRecordType,
+		pubKey:     pubKey,
+		round:      round,
+		epoch:      epoch,
+		headerHash: headerHash,
+	})
+}
+
+// CheckAndRecordSignature records the block signed by the public key in the given round. It returns
+// ErrConflictingBlock if a different block was already signed by the key in the same round
+func (db *slashingProtectionDB) CheckAndRecordSignature(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+	return db.checkAndRecord(&record{
+		recordType: signatureRecordType,
+		pubKey:     pubKey,
+		round:      round,
+		epoch:      epoch,
+		headerHash: headerHash,
+	})
+}
+
+func (db *slashingProtectionDB) checkAndRecord(rec *record) error {
+	if len(rec.pubKey) == 0 {
+		return ErrEmptyPublicKey
+	}
+	if len(rec.headerHash) == 0 {
+		return ErrEmptyHeaderHash
+	}
+
+	db.mut.Lock()
+	defer db.mut.Unlock()
+
+	existing, err := db.getRecord(rec.recordType, rec.pubKey, rec.round)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		if bytes.Equal(existing.headerHash, rec.headerHash) {
+			return nil
+		}
+
+		return fmt.Errorf("%w: round %d, recorded header hash %s, requested header hash %s",
+			ErrConflictingBlock, rec.round, hex.EncodeToString(existing.headerHash), hex.EncodeToString(rec.headerHash))
+	}
+
+	return db.storer.Put(recordKey(rec.recordType, rec.pubKey, rec.round), recordValue(rec))
+}
+
+// getRecord returns the stored record, or nil if there is none. Should be called under mutex protection
+func (db *slashingProtectionDB) getRecord(recordType byte, pubKey []byte, round uint64) (*record, error) {
+	key := recordKey(recordType, pubKey, round)
+	if db.storer.Has(key) != nil {
+		return nil, nil
+	}
+
+	value, err := db.storer.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeRecord(key, value)
+}
+
+// getAllRecords returns all the stored records, in the storage iteration order
+func (db *slashingProtectionDB) getAllRecords() ([]*record, error) {
+	records := make([]*record, 0)
+	var err error
+	db.storer.RangeKeys(func(key []byte, val []byte) bool {
+		var rec *record
+		rec, err = decodeRecord(key, val)
+		if err != nil {
+			return false
+		}
+
+		records = append(records, rec)
+		return true
+	})
+
+	return records, err
+}
+
+func recordKey(recordType byte, pubKey []byte, round uint64) []byte {
+	key := make([]byte, 1+len(pubKey)+roundSize)
+	key[0] = recordType
+	copy(key[1:], pubKey)
+	binary.BigEndian.PutUint64(key[1+len(pubKey):], round)
+
+	return key
+}
+
+func recordValue(rec *record) []byte {
+	value := make([]byte, epochSize+len(rec.headerHash))
+	binary.BigEndian.PutUint32(value, rec.epoch)
+	copy(value[epochSize:], rec.headerHash)
+
+	return value
+}
+
+func decodeRecord(key []byte, value []byte) (*record, error) {
+	if len(key) <= 1+roundSize || len(value) <= epochSize {
+		return nil, fmt.Errorf("%w for key %s", ErrInvalidRecord, hex.EncodeToString(key))
+	}
+
+	recordType := key[0]
+	if recordType != proposalRecordType && recordType != signatureRecordType {
+		return nil, fmt.Errorf("%w for key %s", ErrInvalidRecord, hex.EncodeToString(key))
+	}
+
+	roundIndex := len(key) - roundSize
+
+	return &record{
+		recordType: recordType,
+		pubKey:     key[1:roundIndex],
+		round:      binary.BigEndian.Uint64(key[roundIndex:]),
+		epoch:      binary.BigEndian.Uint32(value[:epochSize]),
+		headerHash: value[epochSize:],
+	}, nil
+}
+
+// Close closes the underlying storer
+func (db *slashingProtectionDB) Close() error {
+	return db.storer.Close()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (db *slashingProtectionDB) IsInterfaceNil() bool {
+	return db == nil
+}
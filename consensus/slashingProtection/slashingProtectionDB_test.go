@@ -0,0 +1,154 @@
+package slashingProtection
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSlashingProtectionDB(t *testing.T) *slashingProtectionDB {
+	db, err := NewSlashingProtectionDB(ArgsSlashingProtectionDB{
+		Storer: testscommon.CreateMemUnit(),
+	})
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewSlashingProtectionDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewSlashingProtectionDB(ArgsSlashingProtectionDB{})
+		assert.Nil(t, db)
+		assert.Equal(t, ErrNilStorer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := NewSlashingProtectionDB(ArgsSlashingProtectionDB{Storer: testscommon.CreateMemUnit()})
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+	})
+}
+
+func TestSlashingProtectionDB_CheckAndRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := createSlashingProtectionDB(t)
+		assert.Equal(t, ErrEmptyPublicKey, db.CheckAndRecordProposal(nil, 1, 10, []byte("hash")))
+		assert.Equal(t, ErrEmptyHeaderHash, db.CheckAndRecordSignature([]byte("pk"), 1, 10, nil))
+	})
+	t.Run("conflicting block in the same round should error", func(t *testing.T) {
+		t.Parallel()
+
+		db := createSlashingProtectionDB(t)
+		pk := []byte("pk")
+		assert.Nil(t, db.CheckAndRecordProposal(pk, 1, 10, []byte("hash A")))
+		// the same block can be proposed again, as it does not conflict with the recorded one
+		assert.Nil(t, db.CheckAndRecordProposal(pk, 1, 10, []byte("hash A")))
+		err := db.CheckAndRecordProposal(pk, 1, 10, []byte("hash B"))
+		assert.True(t, errors.Is(err, ErrConflictingBlock))
+
+		// signatures are recorded separately from proposals
+		assert.Nil(t, db.CheckAndRecordSignature(pk, 1, 10, []byte("hash A")))
+		err = db.CheckAndRecordSignature(pk, 1, 10, []byte("hash B"))
+		assert.True(t, errors.Is(err, ErrConflictingBlock))
+
+		// other rounds and other keys are not affected
+		assert.Nil(t, db.CheckAndRecordSignature(pk, 1, 11, []byte("hash B")))
+		assert.Nil(t, db.CheckAndRecordSignature([]byte("other pk"), 1, 10, []byte("hash B")))
+	})
+}
+
+func TestSlashingProtectionDB_ExportImport(t *testing.T) {
+	t.Parallel()
+
+	pkA := []byte("public key A")
+	pkB := []byte("public key B")
+	source := createSlashingProtectionDB(t)
+	require.Nil(t, source.CheckAndRecordSignature(pkB, 2, 21, []byte("hash 21")))
+	require.Nil(t, source.CheckAndRecordProposal(pkA, 1, 11, []byte("hash 11")))
+	require.Nil(t, source.CheckAndRecordSignature(pkA, 1, 11, []byte("hash 11")))
+	require.Nil(t, source.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 10")))
+
+	buff := &bytes.Buffer{}
+	require.Nil(t, source.Export(buff))
+
+	expected := &Interchange{
+		Metadata: InterchangeMetadata{InterchangeFormatVersion: InterchangeFormatVersion},
+		Data: []*InterchangeKey{
+			{
+				PubKey:         hex.EncodeToString(pkA),
+				ProposedBlocks: []*InterchangeBlock{{Epoch: 1, Round: 11, HeaderHash: hex.EncodeToString([]byte("hash 11"))}},
+				SignedBlocks: []*InterchangeBlock{
+					{Epoch: 1, Round: 10, HeaderHash: hex.EncodeToString([]byte("hash 10"))},
+					{Epoch: 1, Round: 11, HeaderHash: hex.EncodeToString([]byte("hash 11"))},
+				},
+			},
+			{
+				PubKey:         hex.EncodeToString(pkB),
+				ProposedBlocks: []*InterchangeBlock{},
+				SignedBlocks:   []*InterchangeBlock{{Epoch: 2, Round: 21, HeaderHash: hex.EncodeToString([]byte("hash 21"))}},
+			},
+		},
+	}
+	exported := buff.String()
+	decoded := &Interchange{}
+	require.Nil(t, json.Unmarshal(buff.Bytes(), decoded))
+	assert.Equal(t, expected, decoded)
+
+	destination := createSlashingProtectionDB(t)
+	require.Nil(t, destination.CheckAndRecordSignature(pkA, 1, 10, []byte("hash 10")))
+	require.Nil(t, destination.CheckAndRecordSignature(pkA, 1, 11, []byte("other hash")))
+
+	summary, err := destination.Import(strings.NewReader(exported))
+	require.Nil(t, err)
+	assert.Equal(t, &ImportSummary{NumImported: 2, NumExisting: 1, NumConflicts: 1}, summary)
+
+	// the imported records protect the destination, while the conflicting local record is kept
+	assert.True(t, errors.Is(destination.CheckAndRecordProposal(pkA, 1, 11, []byte("hash B")), ErrConflictingBlock))
+	assert.True(t, errors.Is(destination.CheckAndRecordSignature(pkB, 2, 21, []byte("hash B")), ErrConflictingBlock))
+	assert.Nil(t, destination.CheckAndRecordSignature(pkA, 1, 11, []byte("other hash")))
+
+	roundTrip := createSlashingProtectionDB(t)
+	_, err = roundTrip.Import(strings.NewReader(exported))
+	require.Nil(t, err)
+	roundTripBuff := &bytes.Buffer{}
+	require.Nil(t, roundTrip.Export(roundTripBuff))
+	assert.Equal(t, exported, roundTripBuff.String())
+}
+
+func TestSlashingProtectionDB_ImportInvalidData(t *testing.T) {
+	t.Parallel()
+
+	db := createSlashingProtectionDB(t)
+
+	_, err := db.Import(strings.NewReader("not json"))
+	assert.NotNil(t, err)
+
+	_, err = db.Import(strings.NewReader(`{"metadata": {"interchangeFormatVersion": "2"}, "data": []}`))
+	assert.True(t, errors.Is(err, ErrUnsupportedInterchangeVersion))
+
+	_, err = db.Import(strings.NewReader(`{"metadata": {"interchangeFormatVersion": "1"}, "data": [{"pubKey": "zz"}]}`))
+	assert.True(t, errors.Is(err, ErrInvalidRecord))
+
+	// nothing is stored when a block is invalid, even if it is not the first one
+	data := `{"metadata": {"interchangeFormatVersion": "1"}, "data": [{"pubKey": "aa", ` +
+		`"signedBlocks": [{"epoch": 1, "round": 1, "headerHash": "bb"}, {"epoch": 1, "round": 2, "headerHash": ""}]}]}`
+	_, err = db.Import(strings.NewReader(data))
+	assert.True(t, errors.Is(err, ErrInvalidRecord))
+	assert.Nil(t, db.CheckAndRecordSignature([]byte{0xaa}, 1, 1, []byte("other hash")))
+}
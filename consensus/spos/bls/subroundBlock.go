@@ -134,6 +134,13 @@ func (sr *subroundBlock) sendBlock(header data.HeaderHandler, body data.BodyHand
 		return false
 	}
 
+	headerHash := sr.Hasher().Compute(string(marshalizedHeader))
+	err = sr.SlashingProtector().CheckAndRecordProposal([]byte(sr.SelfPubKey()), header.GetEpoch(), header.GetRound(), headerHash)
+	if err != nil {
+		log.Warn("sendBlock.CheckAndRecordProposal: block not proposed", "error", err.Error())
+		return false
+	}
+
 	if sr.couldBeSentTogether(marshalizedBody, marshalizedHeader) {
 		return sr.sendHeaderAndBlockBody(header, body, marshalizedBody, marshalizedHeader)
 	}
@@ -342,6 +342,15 @@ func TestSubroundBlock_DoBlockJob(t *testing.T) {
 	container.SetRoundHandler(&mock.RoundHandlerMock{
 		RoundIndex: 1,
 	})
+	container.SetSlashingProtector(&mock.SlashingProtectorStub{
+		CheckAndRecordProposalCalled: func(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+			return errors.New("conflicting block")
+		},
+	})
+	r = sr.DoBlockJob()
+	assert.False(t, r)
+
+	container.SetSlashingProtector(&mock.SlashingProtectorStub{})
 	r = sr.DoBlockJob()
 	assert.True(t, r)
 	assert.Equal(t, uint64(1), sr.Header.GetNonce())
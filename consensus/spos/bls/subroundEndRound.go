@@ -263,6 +263,7 @@ func (sr *subroundEndRound) doEndRoundJobByLeader() bool {
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.RoundsTimelineRecorder().BlockCommitted(sr.RoundHandler().Index(), sr.GetData(), sr.Header.GetPubKeysBitmap())
 
 	sr.displayStatistics()
 
@@ -374,6 +375,7 @@ func (sr *subroundEndRound) doEndRoundJobByParticipant(cnsDta *consensus.Message
 	}
 
 	sr.SetStatus(sr.Current(), spos.SsFinished)
+	sr.RoundsTimelineRecorder().BlockCommitted(sr.RoundHandler().Index(), sr.GetData(), header.GetPubKeysBitmap())
 
 	if sr.IsNodeInConsensusGroup(sr.SelfPubKey()) {
 		err = sr.setHeaderForValidator(header)
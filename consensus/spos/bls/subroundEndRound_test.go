@@ -397,6 +397,14 @@ func TestSubroundEndRound_DoEndRoundJobErrBroadcastBlockOK(t *testing.T) {
 		},
 	}
 	container.SetBroadcastMessenger(bm)
+	wasBlockCommittedRecorded := false
+	container.SetRoundsTimelineRecorder(&mock.RoundsTimelineRecorderStub{
+		BlockCommittedCalled: func(round int64, headerHash []byte, bitmap []byte) {
+			assert.Equal(t, []byte("X"), headerHash)
+			assert.NotEmpty(t, bitmap)
+			wasBlockCommittedRecorded = true
+		},
+	})
 	sr := *initSubroundEndRoundWithContainer(container, &statusHandler.AppStatusHandlerStub{})
 	sr.SetSelfPubKey("A")
 
@@ -404,6 +412,7 @@ func TestSubroundEndRound_DoEndRoundJobErrBroadcastBlockOK(t *testing.T) {
 
 	r := sr.DoEndRoundJob()
 	assert.True(t, r)
+	assert.True(t, wasBlockCommittedRecorded)
 }
 
 func TestSubroundEndRound_DoEndRoundJobErrMarshalizedDataToBroadcastOK(t *testing.T) {
@@ -79,6 +79,12 @@ func (sr *subroundSignature) doSignatureJob(_ context.Context) bool {
 		return false
 	}
 
+	err = sr.SlashingProtector().CheckAndRecordSignature([]byte(sr.SelfPubKey()), sr.Header.GetEpoch(), sr.Header.GetRound(), sr.GetData())
+	if err != nil {
+		log.Warn("doSignatureJob.CheckAndRecordSignature: block not signed", "error", err.Error())
+		return false
+	}
+
 	signatureShare, err := sr.SignatureHandler().CreateSignatureShare(sr.GetData(), uint16(selfIndex), sr.Header.GetEpoch())
 	if err != nil {
 		log.Debug("doSignatureJob.CreateSignatureShare", "error", err.Error())
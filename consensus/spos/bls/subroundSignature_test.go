@@ -294,6 +294,15 @@ func TestSubroundSignature_DoSignatureJob(t *testing.T) {
 	}
 	container.SetSignatureHandler(signatureHandler)
 
+	container.SetSlashingProtector(&mock.SlashingProtectorStub{
+		CheckAndRecordSignatureCalled: func(pubKey []byte, epoch uint32, round uint64, headerHash []byte) error {
+			return errors.New("conflicting block")
+		},
+	})
+	r = sr.DoSignatureJob()
+	assert.False(t, r)
+
+	container.SetSlashingProtector(&mock.SlashingProtectorStub{})
 	r = sr.DoSignatureJob()
 	assert.True(t, r)
 
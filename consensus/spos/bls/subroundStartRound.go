@@ -168,6 +168,7 @@ func (sr *subroundStartRound) initCurrentRound() bool {
 
 	pubKeys := sr.ConsensusGroup()
 
+	sr.RoundsTimelineRecorder().RoundStarted(sr.RoundHandler().Index(), sr.RoundTimeStamp, leader, pubKeys)
 	sr.indexRoundIfNeeded(pubKeys)
 
 	_, err = sr.SelfConsensusGroupIndex()
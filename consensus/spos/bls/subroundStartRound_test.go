@@ -414,11 +414,20 @@ func TestSubroundStartRound_InitCurrentRoundShouldReturnTrue(t *testing.T) {
 
 	container := mock.InitConsensusCore()
 	container.SetBootStrapper(bootstrapperMock)
+	wasRoundStartedRecorded := false
+	container.SetRoundsTimelineRecorder(&mock.RoundsTimelineRecorderStub{
+		RoundStartedCalled: func(round int64, roundTimeStamp time.Time, leader string, consensusGroup []string) {
+			assert.NotEmpty(t, leader)
+			assert.Contains(t, consensusGroup, leader)
+			wasRoundStartedRecorded = true
+		},
+	})
 
 	srStartRound := *initSubroundStartRoundWithContainer(container)
 
 	r := srStartRound.InitCurrentRound()
 	assert.True(t, r)
+	assert.True(t, wasRoundStartedRecorded)
 }
 
 func TestSubroundStartRound_GenerateNextConsensusGroupShouldReturnErr(t *testing.T) {
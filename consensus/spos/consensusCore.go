@@ -39,6 +39,8 @@ type ConsensusCore struct {
 	nodeRedundancyHandler         consensus.NodeRedundancyHandler
 	scheduledProcessor            consensus.ScheduledProcessor
 	signatureHandler              consensus.SignatureHandler
+	slashingProtector             consensus.SlashingProtector
+	roundsTimelineRecorder        consensus.RoundsTimelineRecorder
 }
 
 // ConsensusCoreArgs store all arguments that are needed to create a ConsensusCore object
@@ -65,6 +67,8 @@ type ConsensusCoreArgs struct {
 	NodeRedundancyHandler         consensus.NodeRedundancyHandler
 	ScheduledProcessor            consensus.ScheduledProcessor
 	SignatureHandler              consensus.SignatureHandler
+	SlashingProtector             consensus.SlashingProtector
+	RoundsTimelineRecorder        consensus.RoundsTimelineRecorder
 }
 
 // NewConsensusCore creates a new ConsensusCore instance
@@ -94,6 +98,8 @@ func NewConsensusCore(
 		nodeRedundancyHandler:         args.NodeRedundancyHandler,
 		scheduledProcessor:            args.ScheduledProcessor,
 		signatureHandler:              args.SignatureHandler,
+		slashingProtector:             args.SlashingProtector,
+		roundsTimelineRecorder:        args.RoundsTimelineRecorder,
 	}
 
 	err := ValidateConsensusCore(consensusCore)
@@ -214,6 +220,16 @@ func (cc *ConsensusCore) SignatureHandler() consensus.SignatureHandler {
 	return cc.signatureHandler
 }
 
+// SlashingProtector will return the slashing protector component
+func (cc *ConsensusCore) SlashingProtector() consensus.SlashingProtector {
+	return cc.slashingProtector
+}
+
+// RoundsTimelineRecorder will return the rounds timeline recorder component
+func (cc *ConsensusCore) RoundsTimelineRecorder() consensus.RoundsTimelineRecorder {
+	return cc.roundsTimelineRecorder
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (cc *ConsensusCore) IsInterfaceNil() bool {
 	return cc == nil
@@ -71,6 +71,12 @@ func ValidateConsensusCore(container ConsensusCoreHandler) error {
 	if check.IfNil(container.SignatureHandler()) {
 		return ErrNilSignatureHandler
 	}
+	if check.IfNil(container.SlashingProtector()) {
+		return ErrNilSlashingProtector
+	}
+	if check.IfNil(container.RoundsTimelineRecorder()) {
+		return ErrNilRoundsTimelineRecorder
+	}
 
 	return nil
 }
@@ -33,6 +33,8 @@ func initConsensusDataContainer() *ConsensusCore {
 	nodeRedundancyHandler := &mock.NodeRedundancyHandlerStub{}
 	multiSignerContainer := cryptoMocks.NewMultiSignerContainerMock(multiSignerMock)
 	signatureHandler := &mock.SignatureHandlerStub{}
+	slashingProtector := &mock.SlashingProtectorStub{}
+	roundsTimelineRecorder := &mock.RoundsTimelineRecorderStub{}
 
 	return &ConsensusCore{
 		blockChain:              blockChain,
@@ -55,6 +57,8 @@ func initConsensusDataContainer() *ConsensusCore {
 		fallbackHeaderValidator: fallbackHeaderValidator,
 		nodeRedundancyHandler:   nodeRedundancyHandler,
 		signatureHandler:        signatureHandler,
+		slashingProtector:       slashingProtector,
+		roundsTimelineRecorder:  roundsTimelineRecorder,
 	}
 }
 
@@ -256,6 +260,28 @@ func TestConsensusContainerValidator_ValidateNilSignatureHandlerShouldFail(t *te
 	assert.Equal(t, ErrNilSignatureHandler, err)
 }
 
+func TestConsensusContainerValidator_ValidateNilSlashingProtectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.slashingProtector = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilSlashingProtector, err)
+}
+
+func TestConsensusContainerValidator_ValidateNilRoundsTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	container := initConsensusDataContainer()
+	container.roundsTimelineRecorder = nil
+
+	err := ValidateConsensusCore(container)
+
+	assert.Equal(t, ErrNilRoundsTimelineRecorder, err)
+}
+
 func TestConsensusContainerValidator_ShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -38,6 +38,8 @@ func createDefaultConsensusCoreArgs() *spos.ConsensusCoreArgs {
 		NodeRedundancyHandler:         consensusCoreMock.NodeRedundancyHandler(),
 		ScheduledProcessor:            scheduledProcessor,
 		SignatureHandler:              consensusCoreMock.SignatureHandler(),
+		SlashingProtector:             consensusCoreMock.SlashingProtector(),
+		RoundsTimelineRecorder:        consensusCoreMock.RoundsTimelineRecorder(),
 	}
 	return args
 }
@@ -320,6 +322,34 @@ func TestConsensusCore_WithNilNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestConsensusCore_WithNilSlashingProtectorShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.SlashingProtector = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilSlashingProtector, err)
+}
+
+func TestConsensusCore_WithNilRoundsTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	args := createDefaultConsensusCoreArgs()
+	args.RoundsTimelineRecorder = nil
+
+	consensusCore, err := spos.NewConsensusCore(
+		args,
+	)
+
+	assert.Nil(t, consensusCore)
+	assert.Equal(t, spos.ErrNilRoundsTimelineRecorder, err)
+}
+
 func TestConsensusCore_CreateConsensusCoreShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -225,3 +225,9 @@ var ErrNilScheduledProcessor = errors.New("nil scheduled processor")
 
 // ErrNilSignatureHandler signals that provided signature handler is nil
 var ErrNilSignatureHandler = errors.New("nil signature handler")
+
+// ErrNilSlashingProtector signals that provided slashing protector is nil
+var ErrNilSlashingProtector = errors.New("nil slashing protector")
+
+// ErrNilRoundsTimelineRecorder signals that provided rounds timeline recorder is nil
+var ErrNilRoundsTimelineRecorder = errors.New("nil rounds timeline recorder")
@@ -65,6 +65,10 @@ type ConsensusCoreHandler interface {
 	ScheduledProcessor() consensus.ScheduledProcessor
 	// SignatureHandler returns the signature handler component
 	SignatureHandler() consensus.SignatureHandler
+	// SlashingProtector returns the slashing protector component
+	SlashingProtector() consensus.SlashingProtector
+	// RoundsTimelineRecorder returns the rounds timeline recorder component
+	RoundsTimelineRecorder() consensus.RoundsTimelineRecorder
 	// IsInterfaceNil returns true if there is no value under the interface
 	IsInterfaceNil() bool
 }
@@ -132,8 +132,12 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 	startTime := roundHandler.TimeStamp()
 	maxTime := roundHandler.TimeDuration() * MaxThresholdPercent / 100
 
+	round := roundHandler.Index()
+	sr.RoundsTimelineRecorder().SubroundStarted(round, sr.name)
+
 	sr.Job(ctx)
 	if sr.Check() {
+		sr.RoundsTimelineRecorder().SubroundEnded(round, sr.name, true)
 		return true
 	}
 
@@ -141,6 +145,7 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 		select {
 		case <-sr.consensusStateChangedChannel:
 			if sr.Check() {
+				sr.RoundsTimelineRecorder().SubroundEnded(round, sr.name, true)
 				return true
 			}
 		case <-time.After(roundHandler.RemainingTime(startTime, maxTime)):
@@ -149,6 +154,7 @@ func (sr *Subround) DoWork(ctx context.Context, roundHandler consensus.RoundHand
 				sr.Extend(sr.current)
 			}
 
+			sr.RoundsTimelineRecorder().SubroundEnded(round, sr.name, false)
 			return false
 		}
 	}
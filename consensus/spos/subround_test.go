@@ -649,6 +649,19 @@ func testDoWork(t *testing.T, checkDone bool, shouldWork bool) {
 	consensusState := initConsensusState()
 	ch := make(chan bool, 1)
 	container := mock.InitConsensusCore()
+	subroundStarted := false
+	subroundEnded := false
+	container.SetRoundsTimelineRecorder(&mock.RoundsTimelineRecorderStub{
+		SubroundStartedCalled: func(round int64, subroundName string) {
+			assert.Equal(t, "(START_ROUND)", subroundName)
+			subroundStarted = true
+		},
+		SubroundEndedCalled: func(round int64, subroundName string, finished bool) {
+			assert.Equal(t, "(START_ROUND)", subroundName)
+			assert.Equal(t, shouldWork, finished)
+			subroundEnded = true
+		},
+	})
 
 	sr, _ := spos.NewSubround(
 		-1,
@@ -680,6 +693,8 @@ func testDoWork(t *testing.T, checkDone bool, shouldWork bool) {
 
 	r := sr.DoWork(context.Background(), roundHandlerMock)
 	assert.Equal(t, shouldWork, r)
+	assert.True(t, subroundStarted)
+	assert.True(t, subroundEnded)
 }
 
 func TestSubround_DoWorkShouldReturnTrueWhenJobIsDoneAndConsensusIsDoneAfterAWhile(t *testing.T) {
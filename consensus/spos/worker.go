@@ -75,6 +75,7 @@ type Worker struct {
 	cancelFunc                func()
 	consensusMessageValidator *consensusMessageValidator
 	nodeRedundancyHandler     consensus.NodeRedundancyHandler
+	roundsTimelineRecorder    consensus.RoundsTimelineRecorder
 	closer                    core.SafeCloser
 }
 
@@ -104,6 +105,7 @@ type WorkerArgs struct {
 	PublicKeySize            int
 	AppStatusHandler         core.AppStatusHandler
 	NodeRedundancyHandler    consensus.NodeRedundancyHandler
+	RoundsTimelineRecorder   consensus.RoundsTimelineRecorder
 }
 
 // NewWorker creates a new Worker object
@@ -150,6 +152,7 @@ func NewWorker(args *WorkerArgs) (*Worker, error) {
 		antifloodHandler:         args.AntifloodHandler,
 		poolAdder:                args.PoolAdder,
 		nodeRedundancyHandler:    args.NodeRedundancyHandler,
+		roundsTimelineRecorder:   args.RoundsTimelineRecorder,
 		closer:                   closing.NewSafeChanCloser(),
 	}
 
@@ -248,6 +251,9 @@ func checkNewWorkerParams(args *WorkerArgs) error {
 	if check.IfNil(args.NodeRedundancyHandler) {
 		return ErrNilNodeRedundancyHandler
 	}
+	if check.IfNil(args.RoundsTimelineRecorder) {
+		return ErrNilRoundsTimelineRecorder
+	}
 
 	return nil
 }
@@ -383,6 +389,7 @@ func (wrk *Worker) ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedP
 	}
 
 	wrk.networkShardingCollector.UpdatePeerIDInfo(message.Peer(), cnsMsg.PubKey, wrk.shardCoordinator.SelfId())
+	wrk.roundsTimelineRecorder.MessageReceived(cnsMsg.RoundIndex, wrk.consensusService.GetStringValue(msgType), cnsMsg.PubKey, message.Peer())
 
 	isMessageWithBlockBody := wrk.consensusService.IsMessageWithBlockBody(msgType)
 	isMessageWithBlockHeader := wrk.consensusService.IsMessageWithBlockHeader(msgType)
@@ -111,6 +111,7 @@ func createDefaultWorkerArgs(appStatusHandler core.AppStatusHandler) *spos.Worke
 		PublicKeySize:            PublicKeySize,
 		AppStatusHandler:         appStatusHandler,
 		NodeRedundancyHandler:    &mock.NodeRedundancyHandlerStub{},
+		RoundsTimelineRecorder:   &mock.RoundsTimelineRecorderStub{},
 	}
 
 	return workerArgs
@@ -365,6 +366,17 @@ func TestWorker_NewWorkerNodeRedundancyHandlerShouldFail(t *testing.T) {
 	assert.Equal(t, spos.ErrNilNodeRedundancyHandler, err)
 }
 
+func TestWorker_NewWorkerRoundsTimelineRecorderShouldFail(t *testing.T) {
+	t.Parallel()
+
+	workerArgs := createDefaultWorkerArgs(statusHandlerMock.NewAppStatusHandlerMock())
+	workerArgs.RoundsTimelineRecorder = nil
+	wrk, err := spos.NewWorker(workerArgs)
+
+	assert.Nil(t, wrk)
+	assert.Equal(t, spos.ErrNilRoundsTimelineRecorder, err)
+}
+
 func TestWorker_NewWorkerShouldWork(t *testing.T) {
 	t.Parallel()
 
@@ -1072,6 +1084,16 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 			wasUpdatePeerIDInfoCalled = true
 		},
 	}
+	wasMessageRecorded := false
+	workerArgs.RoundsTimelineRecorder = &mock.RoundsTimelineRecorderStub{
+		MessageReceivedCalled: func(round int64, messageType string, pubKey []byte, pid core.PeerID) {
+			assert.Equal(t, int64(0), round)
+			assert.Equal(t, bls.BlockHeaderStringValue, messageType)
+			assert.Equal(t, expectedPK, pubKey)
+			assert.Equal(t, currentPid, pid)
+			wasMessageRecorded = true
+		},
+	}
 	wrk, _ := spos.NewWorker(workerArgs)
 
 	wrk.SetBlockProcessor(
@@ -1123,6 +1145,7 @@ func TestWorker_ProcessReceivedMessageOkValsShouldWork(t *testing.T) {
 	assert.Equal(t, 1, len(wrk.ReceivedMessages()[bls.MtBlockHeader]))
 	assert.Nil(t, err)
 	assert.True(t, wasUpdatePeerIDInfoCalled)
+	assert.True(t, wasMessageRecorded)
 }
 
 func TestWorker_CheckSelfStateShouldErrMessageFromItself(t *testing.T) {
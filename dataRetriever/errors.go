@@ -146,6 +146,12 @@ var ErrNilPeerListCreator = errors.New("nil peer list creator provided")
 // ErrNilPeersRatingHandler signals that a nil peers rating handler implementation has been provided
 var ErrNilPeersRatingHandler = errors.New("nil peers rating handler")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler implementation has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNilPeersResponseTracker signals that a nil peers response tracker implementation has been provided
+var ErrNilPeersResponseTracker = errors.New("nil peers response tracker")
+
 // ErrNilTrieDataGetter signals that a nil trie data getter has been provided
 var ErrNilTrieDataGetter = errors.New("nil trie data getter provided")
 
@@ -27,6 +27,8 @@ type FactoryArgs struct {
 	CurrentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler
 	PreferredPeersHolder        p2p.PreferredPeersHolderHandler
 	PeersRatingHandler          dataRetriever.PeersRatingHandler
+	PeerReputationHandler       dataRetriever.PeerReputationHandler
+	PeersResponseTracker        dataRetriever.PeersResponseTracker
 	SizeCheckDelta              uint32
 	IsFullHistoryNode           bool
 	PayloadValidator            dataRetriever.PeerAuthenticationPayloadValidator
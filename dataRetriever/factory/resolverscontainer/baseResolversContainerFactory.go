@@ -40,6 +40,8 @@ type baseResolversContainerFactory struct {
 	currentNetworkEpochProvider dataRetriever.CurrentNetworkEpochProviderHandler
 	preferredPeersHolder        dataRetriever.PreferredPeersHolderHandler
 	peersRatingHandler          dataRetriever.PeersRatingHandler
+	peerReputationHandler       dataRetriever.PeerReputationHandler
+	peersResponseTracker        dataRetriever.PeersResponseTracker
 	numCrossShardPeers          int
 	numIntraShardPeers          int
 	numTotalPeers               int
@@ -90,6 +92,12 @@ func (brcf *baseResolversContainerFactory) checkParams() error {
 	if check.IfNil(brcf.peersRatingHandler) {
 		return dataRetriever.ErrNilPeersRatingHandler
 	}
+	if check.IfNil(brcf.peerReputationHandler) {
+		return dataRetriever.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(brcf.peersResponseTracker) {
+		return dataRetriever.ErrNilPeersResponseTracker
+	}
 	if brcf.numCrossShardPeers <= 0 {
 		return fmt.Errorf("%w for numCrossShardPeers", dataRetriever.ErrInvalidValue)
 	}
@@ -335,6 +343,8 @@ func (brcf *baseResolversContainerFactory) createOneResolverSenderWithSpecifiedN
 		PreferredPeersHolder:        brcf.preferredPeersHolder,
 		SelfShardIdProvider:         brcf.shardCoordinator,
 		PeersRatingHandler:          brcf.peersRatingHandler,
+		PeerReputationHandler:       brcf.peerReputationHandler,
+		PeersResponseTracker:        brcf.peersResponseTracker,
 	}
 	// TODO instantiate topic sender resolver with the shard IDs for which this resolver is supposed to serve the data
 	// this will improve the serving of transactions as the searching will be done only on 2 sharded data units
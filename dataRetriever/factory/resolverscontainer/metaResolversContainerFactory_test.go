@@ -180,6 +180,28 @@ func TestNewMetaResolversContainerFactory_NilPeersRatingHandlerShouldErr(t *test
 	assert.Equal(t, dataRetriever.ErrNilPeersRatingHandler, err)
 }
 
+func TestNewMetaResolversContainerFactory_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.PeerReputationHandler = nil
+	rcf, err := resolverscontainer.NewMetaResolversContainerFactory(args)
+
+	assert.Nil(t, rcf)
+	assert.Equal(t, dataRetriever.ErrNilPeerReputationHandler, err)
+}
+
+func TestNewMetaResolversContainerFactory_NilPeersResponseTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsMeta()
+	args.PeersResponseTracker = nil
+	rcf, err := resolverscontainer.NewMetaResolversContainerFactory(args)
+
+	assert.Nil(t, rcf)
+	assert.Equal(t, dataRetriever.ErrNilPeersResponseTracker, err)
+}
+
 func TestNewMetaResolversContainerFactory_NilUint64SliceConverterShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -382,7 +404,9 @@ func getArgumentsMeta() resolverscontainer.FactoryArgs {
 			NumTotalPeers:       3,
 			NumFullHistoryPeers: 3,
 		},
-		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
-		PayloadValidator:   &testscommon.PeerAuthenticationPayloadValidatorStub{},
+		PeersRatingHandler:    &p2pmocks.PeersRatingHandlerStub{},
+		PeerReputationHandler: &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTracker:  &testscommon.PeersResponseTrackerStub{},
+		PayloadValidator:      &testscommon.PeerAuthenticationPayloadValidatorStub{},
 	}
 }
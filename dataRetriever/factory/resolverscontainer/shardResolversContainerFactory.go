@@ -52,6 +52,8 @@ func NewShardResolversContainerFactory(
 		currentNetworkEpochProvider: args.CurrentNetworkEpochProvider,
 		preferredPeersHolder:        args.PreferredPeersHolder,
 		peersRatingHandler:          args.PeersRatingHandler,
+		peerReputationHandler:       args.PeerReputationHandler,
+		peersResponseTracker:        args.PeersResponseTracker,
 		numCrossShardPeers:          int(args.ResolverConfig.NumCrossShardPeers),
 		numIntraShardPeers:          int(numIntraShardPeers),
 		numTotalPeers:               int(args.ResolverConfig.NumTotalPeers),
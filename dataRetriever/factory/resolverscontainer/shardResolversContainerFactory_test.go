@@ -208,6 +208,28 @@ func TestNewShardResolversContainerFactory_NilPeersRatingHandlerShouldErr(t *tes
 	assert.Equal(t, dataRetriever.ErrNilPeersRatingHandler, err)
 }
 
+func TestNewShardResolversContainerFactory_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.PeerReputationHandler = nil
+	rcf, err := resolverscontainer.NewShardResolversContainerFactory(args)
+
+	assert.Nil(t, rcf)
+	assert.Equal(t, dataRetriever.ErrNilPeerReputationHandler, err)
+}
+
+func TestNewShardResolversContainerFactory_NilPeersResponseTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getArgumentsShard()
+	args.PeersResponseTracker = nil
+	rcf, err := resolverscontainer.NewShardResolversContainerFactory(args)
+
+	assert.Nil(t, rcf)
+	assert.Equal(t, dataRetriever.ErrNilPeersResponseTracker, err)
+}
+
 func TestNewShardResolversContainerFactory_NilTriesContainerShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -443,7 +465,9 @@ func getArgumentsShard() resolverscontainer.FactoryArgs {
 			NumTotalPeers:       3,
 			NumFullHistoryPeers: 3,
 		},
-		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
-		PayloadValidator:   &testscommon.PeerAuthenticationPayloadValidatorStub{},
+		PeersRatingHandler:    &p2pmocks.PeersRatingHandlerStub{},
+		PeerReputationHandler: &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTracker:  &testscommon.PeersResponseTrackerStub{},
+		PayloadValidator:      &testscommon.PeerAuthenticationPayloadValidatorStub{},
 	}
 }
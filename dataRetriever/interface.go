@@ -342,6 +342,25 @@ type PeersRatingHandler interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationHandler represents an entity able to order peers by their reputation and to track the requests
+// sent to them
+type PeerReputationHandler interface {
+	RecordRequestSent(pid core.PeerID, topic string)
+	GetPeersByReputation(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID
+	IsInterfaceNil() bool
+}
+
+// PeersResponseTracker tracks the latency and the success of the requests sent to each peer, on each topic, and is
+// able to order the peers so the fastest responders are requested first
+type PeersResponseTracker interface {
+	RequestSent(pid core.PeerID, topic string)
+	ResponseReceived(pid core.PeerID, topic string, isRequested bool)
+	SortPeers(peers []core.PeerID, topic string) []core.PeerID
+	Query(search string) []string
+	Close() error
+	IsInterfaceNil() bool
+}
+
 // SelfShardIDProvider defines the behavior of a component able to provide the self shard ID
 type SelfShardIDProvider interface {
 	SelfId() uint32
@@ -0,0 +1,45 @@
+package peersResponseTracker
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+var _ dataRetriever.PeersResponseTracker = (*disabledPeersResponseTracker)(nil)
+
+type disabledPeersResponseTracker struct {
+}
+
+// NewDisabledPeersResponseTracker returns a new instance of a disabled peers response tracker, which does not
+// track anything and keeps the provided order of the peers
+func NewDisabledPeersResponseTracker() *disabledPeersResponseTracker {
+	return &disabledPeersResponseTracker{}
+}
+
+// RequestSent does nothing
+func (dprt *disabledPeersResponseTracker) RequestSent(_ core.PeerID, _ string) {
+}
+
+// ResponseReceived does nothing
+func (dprt *disabledPeersResponseTracker) ResponseReceived(_ core.PeerID, _ string, _ bool) {
+}
+
+// SortPeers returns the provided peers
+func (dprt *disabledPeersResponseTracker) SortPeers(peers []core.PeerID, _ string) []core.PeerID {
+	return peers
+}
+
+// Query returns an empty slice
+func (dprt *disabledPeersResponseTracker) Query(_ string) []string {
+	return make([]string, 0)
+}
+
+// Close returns nil
+func (dprt *disabledPeersResponseTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (dprt *disabledPeersResponseTracker) IsInterfaceNil() bool {
+	return dprt == nil
+}
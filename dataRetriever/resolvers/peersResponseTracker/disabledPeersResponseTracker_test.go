@@ -0,0 +1,37 @@
+package peersResponseTracker
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledPeersResponseTracker(t *testing.T) {
+	t.Parallel()
+
+	dprt := NewDisabledPeersResponseTracker()
+	assert.False(t, check.IfNil(dprt))
+}
+
+func TestDisabledPeersResponseTracker_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not failed %v", r))
+		}
+	}()
+
+	dprt := NewDisabledPeersResponseTracker()
+	dprt.RequestSent("pid", "topic")
+	dprt.ResponseReceived("pid", "topic", true)
+
+	peers := []core.PeerID{"b", "a"}
+	assert.Equal(t, peers, dprt.SortPeers(peers, "topic"))
+	assert.Equal(t, 0, len(dprt.Query("*")))
+	assert.Nil(t, dprt.Close())
+}
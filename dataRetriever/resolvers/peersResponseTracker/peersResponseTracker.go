@@ -0,0 +1,296 @@
+package peersResponseTracker
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+)
+
+const (
+	minResponseTimeoutInMilliseconds = 1
+	maxLatencySmoothingFactor        = 1.0
+	maxExplorationPercent            = 100
+	wildcardSearch                   = "*"
+)
+
+var _ dataRetriever.PeersResponseTracker = (*peersResponseTracker)(nil)
+
+// ArgPeersResponseTracker is the argument structure used to create a new peers response tracker instance
+type ArgPeersResponseTracker struct {
+	Config     config.AdaptiveRoutingConfig
+	Randomizer dataRetriever.IntRandomizer
+}
+
+type responseStats struct {
+	pid            core.PeerID
+	topic          string
+	numRequests    uint32
+	numResponses   uint32
+	numTimeouts    uint32
+	averageLatency time.Duration
+	lastLatency    time.Duration
+	pendingRequest time.Time
+}
+
+type peersResponseTracker struct {
+	mut                    sync.RWMutex
+	stats                  storage.Cacher
+	randomizer             dataRetriever.IntRandomizer
+	responseTimeout        time.Duration
+	latencySmoothingFactor float64
+	explorationPercent     int
+	getTimeHandler         func() time.Time
+}
+
+// NewPeersResponseTracker creates a new peers response tracker, able to keep, for each peer and each topic, the
+// latency and the success rate of the requests sent
+func NewPeersResponseTracker(args ArgPeersResponseTracker) (*peersResponseTracker, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	statsCache, err := cache.NewLRUCache(args.Config.CacheCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for CacheCapacity while creating an instance of peersResponseTracker", err)
+	}
+
+	return &peersResponseTracker{
+		stats:                  statsCache,
+		randomizer:             args.Randomizer,
+		responseTimeout:        time.Duration(args.Config.ResponseTimeoutInMilliseconds) * time.Millisecond,
+		latencySmoothingFactor: args.Config.LatencySmoothingFactor,
+		explorationPercent:     int(args.Config.ExplorationPercent),
+		getTimeHandler:         time.Now,
+	}, nil
+}
+
+func checkArgs(args ArgPeersResponseTracker) error {
+	if check.IfNil(args.Randomizer) {
+		return dataRetriever.ErrNilRandomizer
+	}
+	if args.Config.ResponseTimeoutInMilliseconds < minResponseTimeoutInMilliseconds {
+		return fmt.Errorf("%w for ResponseTimeoutInMilliseconds, minimum %d, got %d",
+			dataRetriever.ErrInvalidValue, minResponseTimeoutInMilliseconds, args.Config.ResponseTimeoutInMilliseconds)
+	}
+	isSmoothingFactorValid := args.Config.LatencySmoothingFactor > 0 &&
+		args.Config.LatencySmoothingFactor <= maxLatencySmoothingFactor
+	if !isSmoothingFactorValid {
+		return fmt.Errorf("%w for LatencySmoothingFactor, should be in interval (0, %.1f], got %f",
+			dataRetriever.ErrInvalidValue, maxLatencySmoothingFactor, args.Config.LatencySmoothingFactor)
+	}
+	if args.Config.ExplorationPercent > maxExplorationPercent {
+		return fmt.Errorf("%w for ExplorationPercent, maximum %d, got %d",
+			dataRetriever.ErrInvalidValue, maxExplorationPercent, args.Config.ExplorationPercent)
+	}
+
+	return nil
+}
+
+// RequestSent records a request sent to the peer on the provided topic. Only the oldest pending request is kept,
+// a pending request older than the response timeout being counted as a timeout
+func (prt *peersResponseTracker) RequestSent(pid core.PeerID, topic string) {
+	prt.mut.Lock()
+	defer prt.mut.Unlock()
+
+	now := prt.getTimeHandler()
+	stats := prt.getOrCreateStats(pid, topic)
+	stats.numRequests++
+
+	hasPendingRequest := !stats.pendingRequest.IsZero()
+	if hasPendingRequest && now.Sub(stats.pendingRequest) <= prt.responseTimeout {
+		return
+	}
+	if hasPendingRequest {
+		stats.numTimeouts++
+	}
+
+	stats.pendingRequest = now
+}
+
+// ResponseReceived records the response of the peer on the provided topic. The responses not containing requested
+// items, or received without a pending request, are ignored
+func (prt *peersResponseTracker) ResponseReceived(pid core.PeerID, topic string, isRequested bool) {
+	if !isRequested {
+		return
+	}
+
+	prt.mut.Lock()
+	defer prt.mut.Unlock()
+
+	stats, found := prt.getStats(pid, topic)
+	if !found || stats.pendingRequest.IsZero() {
+		return
+	}
+
+	latency := prt.getTimeHandler().Sub(stats.pendingRequest)
+	stats.pendingRequest = time.Time{}
+	if latency > prt.responseTimeout {
+		stats.numTimeouts++
+		return
+	}
+
+	stats.lastLatency = latency
+	stats.numResponses++
+	if stats.numResponses == 1 {
+		stats.averageLatency = latency
+		return
+	}
+
+	stats.averageLatency = time.Duration(prt.latencySmoothingFactor*float64(latency) +
+		(1-prt.latencySmoothingFactor)*float64(stats.averageLatency))
+}
+
+// SortPeers returns a copy of the provided peers, sorted ascending by their expected response time on the topic.
+// Each position has an ExplorationPercent chance of being swapped with a randomly chosen peer from the remaining ones.
+// The sort is stable, so the peers without a history keep their provided order
+func (prt *peersResponseTracker) SortPeers(peers []core.PeerID, topic string) []core.PeerID {
+	sortedPeers := make([]core.PeerID, len(peers))
+	copy(sortedPeers, peers)
+	if len(sortedPeers) < 2 {
+		return sortedPeers
+	}
+
+	costs := make(map[core.PeerID]time.Duration, len(sortedPeers))
+	prt.mut.RLock()
+	now := prt.getTimeHandler()
+	for _, pid := range sortedPeers {
+		costs[pid] = prt.computeExpectedResponseTime(pid, topic, now)
+	}
+	prt.mut.RUnlock()
+
+	sort.SliceStable(sortedPeers, func(i, j int) bool {
+		return costs[sortedPeers[i]] < costs[sortedPeers[j]]
+	})
+
+	for i := 0; i < len(sortedPeers)-1; i++ {
+		if prt.randomizer.Intn(maxExplorationPercent) >= prt.explorationPercent {
+			continue
+		}
+
+		j := i + prt.randomizer.Intn(len(sortedPeers)-i)
+		sortedPeers[i], sortedPeers[j] = sortedPeers[j], sortedPeers[i]
+	}
+
+	return sortedPeers
+}
+
+// computeExpectedResponseTime returns the average time spent waiting for the peer on the topic, each timeout
+// weighting as the whole response timeout. A peer without a history gets half of the response timeout, so
+// the peers known to answer faster are preferred and the slow ones are avoided. Should be called under mutex protection
+func (prt *peersResponseTracker) computeExpectedResponseTime(pid core.PeerID, topic string, now time.Time) time.Duration {
+	stats, found := prt.getStats(pid, topic)
+	if !found {
+		return prt.responseTimeout / 2
+	}
+
+	numTimeouts := stats.numTimeouts
+	hasExpiredRequest := !stats.pendingRequest.IsZero() && now.Sub(stats.pendingRequest) > prt.responseTimeout
+	if hasExpiredRequest {
+		numTimeouts++
+	}
+
+	numSamples := stats.numResponses + numTimeouts
+	if numSamples == 0 {
+		return prt.responseTimeout / 2
+	}
+
+	totalWaitTime := float64(stats.numResponses)*float64(stats.averageLatency) +
+		float64(numTimeouts)*float64(prt.responseTimeout)
+
+	return time.Duration(totalWaitTime / float64(numSamples))
+}
+
+// getStats returns the stats of the peer on the topic. Should be called under mutex protection
+func (prt *peersResponseTracker) getStats(pid core.PeerID, topic string) (*responseStats, bool) {
+	obj, found := prt.stats.Get(createKey(pid, topic))
+	if !found {
+		return nil, false
+	}
+
+	stats, ok := obj.(*responseStats)
+	if !ok {
+		return nil, false
+	}
+
+	return stats, true
+}
+
+// getOrCreateStats returns the stats of the peer on the topic, creating them if needed. Should be called under
+// mutex protection
+func (prt *peersResponseTracker) getOrCreateStats(pid core.PeerID, topic string) *responseStats {
+	stats, found := prt.getStats(pid, topic)
+	if found {
+		return stats
+	}
+
+	stats = &responseStats{
+		pid:   pid,
+		topic: topic,
+	}
+	_ = prt.stats.Put(createKey(pid, topic), stats, 0)
+
+	return stats
+}
+
+func createKey(pid core.PeerID, topic string) []byte {
+	return []byte(topic + string(pid))
+}
+
+// Query returns the stats of the peers matching the search, which can be a topic, a peer ID or * for all
+func (prt *peersResponseTracker) Query(search string) []string {
+	prt.mut.RLock()
+	defer prt.mut.RUnlock()
+
+	lines := make([]string, 0)
+	for _, key := range prt.stats.Keys() {
+		stats, found := prt.peekStats(key)
+		if !found {
+			continue
+		}
+
+		isMatching := search == wildcardSearch || search == stats.topic || search == stats.pid.Pretty()
+		if !isMatching {
+			continue
+		}
+
+		lines = append(lines, fmt.Sprintf("topic: %s, peer: %s, requests: %d, responses: %d, timeouts: %d, "+
+			"average latency: %v, last latency: %v",
+			stats.topic, stats.pid.Pretty(), stats.numRequests, stats.numResponses, stats.numTimeouts,
+			stats.averageLatency, stats.lastLatency))
+	}
+	sort.Strings(lines)
+
+	return lines
+}
+
+// peekStats returns the stats stored under the key, without changing the cache eviction order. Should be called
+// under mutex protection
+func (prt *peersResponseTracker) peekStats(key []byte) (*responseStats, bool) {
+	obj, found := prt.stats.Peek(key)
+	if !found {
+		return nil, false
+	}
+
+	stats, ok := obj.(*responseStats)
+
+	return stats, ok
+}
+
+// Close returns nil
+func (prt *peersResponseTracker) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prt *peersResponseTracker) IsInterfaceNil() bool {
+	return prt == nil
+}
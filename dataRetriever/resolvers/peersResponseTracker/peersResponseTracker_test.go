@@ -0,0 +1,327 @@
+package peersResponseTracker
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "topic"
+
+func createMockArgPeersResponseTracker() ArgPeersResponseTracker {
+	return ArgPeersResponseTracker{
+		Config: config.AdaptiveRoutingConfig{
+			Enabled:                       true,
+			CacheCapacity:                 100,
+			ResponseTimeoutInMilliseconds: 1000,
+			LatencySmoothingFactor:        0.5,
+			ExplorationPercent:            0,
+		},
+		Randomizer: &mock.IntRandomizerStub{
+			IntnCalled: func(n int) int {
+				return n - 1
+			},
+		},
+	}
+}
+
+func createTrackerWithTime(t *testing.T, args ArgPeersResponseTracker, currentTime *time.Time) *peersResponseTracker {
+	prt, err := NewPeersResponseTracker(args)
+	require.Nil(t, err)
+	prt.getTimeHandler = func() time.Time {
+		return *currentTime
+	}
+
+	return prt
+}
+
+func TestNewPeersResponseTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil randomizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersResponseTracker()
+		args.Randomizer = nil
+		prt, err := NewPeersResponseTracker(args)
+		assert.Equal(t, dataRetriever.ErrNilRandomizer, err)
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("invalid response timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersResponseTracker()
+		args.Config.ResponseTimeoutInMilliseconds = 0
+		prt, err := NewPeersResponseTracker(args)
+		assert.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "ResponseTimeoutInMilliseconds"))
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("invalid latency smoothing factor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersResponseTracker()
+		args.Config.LatencySmoothingFactor = 0
+		prt, err := NewPeersResponseTracker(args)
+		assert.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "LatencySmoothingFactor"))
+		assert.True(t, check.IfNil(prt))
+
+		args.Config.LatencySmoothingFactor = 1.1
+		prt, err = NewPeersResponseTracker(args)
+		assert.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("invalid exploration percent should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersResponseTracker()
+		args.Config.ExplorationPercent = 101
+		prt, err := NewPeersResponseTracker(args)
+		assert.True(t, errors.Is(err, dataRetriever.ErrInvalidValue))
+		assert.True(t, strings.Contains(err.Error(), "ExplorationPercent"))
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("invalid cache capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersResponseTracker()
+		args.Config.CacheCapacity = 0
+		prt, err := NewPeersResponseTracker(args)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "CacheCapacity"))
+		assert.True(t, check.IfNil(prt))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		prt, err := NewPeersResponseTracker(createMockArgPeersResponseTracker())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(prt))
+		assert.Nil(t, prt.Close())
+	})
+}
+
+func TestPeersResponseTracker_ResponseReceived(t *testing.T) {
+	t.Parallel()
+
+	t.Run("response without request should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+		prt.ResponseReceived("pid", testTopic, true)
+
+		_, found := prt.getStats("pid", testTopic)
+		assert.False(t, found)
+	})
+	t.Run("not requested response should be ignored", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+		prt.RequestSent("pid", testTopic)
+		currentTime = currentTime.Add(time.Millisecond * 100)
+		prt.ResponseReceived("pid", testTopic, false)
+
+		stats, found := prt.getStats("pid", testTopic)
+		require.True(t, found)
+		assert.Equal(t, uint32(1), stats.numRequests)
+		assert.Equal(t, uint32(0), stats.numResponses)
+		assert.False(t, stats.pendingRequest.IsZero())
+	})
+	t.Run("response on time should update the latency", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+		prt.RequestSent("pid", testTopic)
+		currentTime = currentTime.Add(time.Millisecond * 100)
+		prt.ResponseReceived("pid", testTopic, true)
+
+		stats, found := prt.getStats("pid", testTopic)
+		require.True(t, found)
+		assert.Equal(t, uint32(1), stats.numResponses)
+		assert.Equal(t, time.Millisecond*100, stats.averageLatency)
+		assert.Equal(t, time.Millisecond*100, stats.lastLatency)
+		assert.True(t, stats.pendingRequest.IsZero())
+
+		prt.RequestSent("pid", testTopic)
+		currentTime = currentTime.Add(time.Millisecond * 300)
+		prt.ResponseReceived("pid", testTopic, true)
+
+		assert.Equal(t, uint32(2), stats.numRequests)
+		assert.Equal(t, uint32(2), stats.numResponses)
+		assert.Equal(t, time.Millisecond*200, stats.averageLatency)
+		assert.Equal(t, time.Millisecond*300, stats.lastLatency)
+		assert.Equal(t, uint32(0), stats.numTimeouts)
+	})
+	t.Run("late response should count as timeout", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+		prt.RequestSent("pid", testTopic)
+		currentTime = currentTime.Add(time.Second * 2)
+		prt.ResponseReceived("pid", testTopic, true)
+
+		stats, found := prt.getStats("pid", testTopic)
+		require.True(t, found)
+		assert.Equal(t, uint32(0), stats.numResponses)
+		assert.Equal(t, uint32(1), stats.numTimeouts)
+		assert.True(t, stats.pendingRequest.IsZero())
+	})
+}
+
+func TestPeersResponseTracker_RequestSent(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+	prt.RequestSent("pid", testTopic)
+	firstRequest := currentTime
+
+	currentTime = currentTime.Add(time.Millisecond * 500)
+	prt.RequestSent("pid", testTopic)
+
+	stats, found := prt.getStats("pid", testTopic)
+	require.True(t, found)
+	assert.Equal(t, uint32(2), stats.numRequests)
+	assert.Equal(t, firstRequest, stats.pendingRequest)
+	assert.Equal(t, uint32(0), stats.numTimeouts)
+
+	currentTime = currentTime.Add(time.Second)
+	prt.RequestSent("pid", testTopic)
+	assert.Equal(t, uint32(3), stats.numRequests)
+	assert.Equal(t, currentTime, stats.pendingRequest)
+	assert.Equal(t, uint32(1), stats.numTimeouts)
+}
+
+func TestPeersResponseTracker_SortPeers(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should prefer the fast responders", func(t *testing.T) {
+		t.Parallel()
+
+		currentTime := time.Unix(1000, 0)
+		prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+
+		latencies := map[core.PeerID]time.Duration{
+			"slow":   time.Millisecond * 900,
+			"fast":   time.Millisecond * 10,
+			"medium": time.Millisecond * 300,
+		}
+		for pid, latency := range latencies {
+			prt.RequestSent(pid, testTopic)
+			currentTime = currentTime.Add(latency)
+			prt.ResponseReceived(pid, testTopic, true)
+		}
+		prt.RequestSent("timeout", testTopic)
+		currentTime = currentTime.Add(time.Second * 2)
+
+		peers := []core.PeerID{"timeout", "slow", "unknown", "medium", "fast"}
+		sortedPeers := prt.SortPeers(peers, testTopic)
+		assert.Equal(t, []core.PeerID{"fast", "medium", "unknown", "slow", "timeout"}, sortedPeers)
+		assert.Equal(t, []core.PeerID{"timeout", "slow", "unknown", "medium", "fast"}, peers)
+
+		sortedPeers = prt.SortPeers(peers, "other topic")
+		assert.Equal(t, peers, sortedPeers)
+	})
+	t.Run("exploration should swap peers", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeersResponseTracker()
+		args.Config.ExplorationPercent = 100
+		args.Randomizer = &mock.IntRandomizerStub{
+			IntnCalled: func(n int) int {
+				return n - 1
+			},
+		}
+		currentTime := time.Unix(1000, 0)
+		prt := createTrackerWithTime(t, args, &currentTime)
+
+		peers := []core.PeerID{"a", "b", "c"}
+		sortedPeers := prt.SortPeers(peers, testTopic)
+		assert.Equal(t, []core.PeerID{"c", "a", "b"}, sortedPeers)
+	})
+	t.Run("less than 2 peers should not sort", func(t *testing.T) {
+		t.Parallel()
+
+		prt, _ := NewPeersResponseTracker(createMockArgPeersResponseTracker())
+		assert.Equal(t, []core.PeerID{}, prt.SortPeers(nil, testTopic))
+		assert.Equal(t, []core.PeerID{"a"}, prt.SortPeers([]core.PeerID{"a"}, testTopic))
+	})
+}
+
+func TestPeersResponseTracker_Query(t *testing.T) {
+	t.Parallel()
+
+	currentTime := time.Unix(1000, 0)
+	prt := createTrackerWithTime(t, createMockArgPeersResponseTracker(), &currentTime)
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	prt.RequestSent(pid1, "topic1")
+	prt.RequestSent(pid2, "topic1")
+	prt.RequestSent(pid2, "topic2")
+	currentTime = currentTime.Add(time.Millisecond * 100)
+	prt.ResponseReceived(pid1, "topic1", true)
+
+	assert.Equal(t, 3, len(prt.Query(wildcardSearch)))
+	assert.Equal(t, 2, len(prt.Query("topic1")))
+	assert.Equal(t, 2, len(prt.Query(pid2.Pretty())))
+	assert.Equal(t, 0, len(prt.Query("missing")))
+
+	expectedLine := fmt.Sprintf("topic: topic1, peer: %s, requests: 1, responses: 1, timeouts: 0, "+
+		"average latency: 100ms, last latency: 100ms", pid1.Pretty())
+	assert.Equal(t, []string{expectedLine}, prt.Query(pid1.Pretty()))
+}
+
+func TestPeersResponseTracker_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked %v", r))
+		}
+	}()
+
+	args := createMockArgPeersResponseTracker()
+	args.Config.ExplorationPercent = 20
+	prt, _ := NewPeersResponseTracker(args)
+	peers := []core.PeerID{"a", "b", "c", "d"}
+
+	numCalls := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numCalls)
+	for i := 0; i < numCalls; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			pid := peers[idx%len(peers)]
+			switch idx % 4 {
+			case 0:
+				prt.RequestSent(pid, testTopic)
+			case 1:
+				prt.ResponseReceived(pid, testTopic, true)
+			case 2:
+				_ = prt.SortPeers(peers, testTopic)
+			case 3:
+				_ = prt.Query(wildcardSearch)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
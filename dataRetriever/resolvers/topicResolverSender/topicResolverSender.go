@@ -40,6 +40,8 @@ type ArgTopicResolverSender struct {
 	PreferredPeersHolder        dataRetriever.PreferredPeersHolderHandler
 	SelfShardIdProvider         dataRetriever.SelfShardIDProvider
 	PeersRatingHandler          dataRetriever.PeersRatingHandler
+	PeerReputationHandler       dataRetriever.PeerReputationHandler
+	PeersResponseTracker        dataRetriever.PeersResponseTracker
 	TargetShardId               uint32
 }
 
@@ -59,6 +61,8 @@ type topicResolverSender struct {
 	currentNetworkEpochProviderHandler dataRetriever.CurrentNetworkEpochProviderHandler
 	preferredPeersHolderHandler        dataRetriever.PreferredPeersHolderHandler
 	peersRatingHandler                 dataRetriever.PeersRatingHandler
+	peerReputationHandler              dataRetriever.PeerReputationHandler
+	peersResponseTracker               dataRetriever.PeersResponseTracker
 	selfShardId                        uint32
 	targetShardId                      uint32
 }
@@ -75,6 +79,8 @@ func NewTopicResolverSender(arg ArgTopicResolverSender) (*topicResolverSender, e
 		topicName:                          arg.TopicName,
 		peerListCreator:                    arg.PeerListCreator,
 		peersRatingHandler:                 arg.PeersRatingHandler,
+		peerReputationHandler:              arg.PeerReputationHandler,
+		peersResponseTracker:               arg.PeersResponseTracker,
 		marshalizer:                        arg.Marshalizer,
 		randomizer:                         arg.Randomizer,
 		targetShardId:                      arg.TargetShardId,
@@ -116,6 +122,12 @@ func checkArgs(args ArgTopicResolverSender) error {
 	if check.IfNil(args.PeersRatingHandler) {
 		return dataRetriever.ErrNilPeersRatingHandler
 	}
+	if check.IfNil(args.PeerReputationHandler) {
+		return dataRetriever.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(args.PeersResponseTracker) {
+		return dataRetriever.ErrNilPeersResponseTracker
+	}
 	if check.IfNil(args.SelfShardIdProvider) {
 		return dataRetriever.ErrNilSelfShardIDProvider
 	}
@@ -210,23 +222,33 @@ func (trs *topicResolverSender) sendOnTopic(
 	histogramMap := make(map[string]int)
 
 	topRatedPeersList := trs.peersRatingHandler.GetTopRatedPeersFromList(peerList, maxToSend)
+	topRatedPeersList = trs.peerReputationHandler.GetPeersByReputation(topRatedPeersList, maxToSend)
 
 	indexes := createIndexList(len(topRatedPeersList))
 	shuffledIndexes := random.FisherYatesShuffle(indexes, trs.randomizer)
+	shuffledPeers := make([]core.PeerID, 0, len(shuffledIndexes))
+	for _, index := range shuffledIndexes {
+		shuffledPeers = append(shuffledPeers, topRatedPeersList[index])
+	}
+	sortedPeers := trs.peersResponseTracker.SortPeers(shuffledPeers, trs.topicName)
+
+	peersIndexes := createIndexList(len(sortedPeers))
 	logData := make([]interface{}, 0)
 	msgSentCounter := 0
 	shouldSendToPreferredPeer := preferredPeer != "" && maxToSend > 1
 	if shouldSendToPreferredPeer {
-		shuffledIndexes = append([]int{preferredPeerIndex}, shuffledIndexes...)
+		peersIndexes = append([]int{preferredPeerIndex}, peersIndexes...)
 	}
 
-	for idx := 0; idx < len(shuffledIndexes); idx++ {
-		peer := getPeerID(shuffledIndexes[idx], topRatedPeersList, preferredPeer, peerType, topicToSendRequest, histogramMap)
+	for idx := 0; idx < len(peersIndexes); idx++ {
+		peer := getPeerID(peersIndexes[idx], sortedPeers, preferredPeer, peerType, topicToSendRequest, histogramMap)
 
 		err := trs.sendToConnectedPeer(topicToSendRequest, buff, peer)
 		if err != nil {
 			continue
 		}
+		trs.peerReputationHandler.RecordRequestSent(peer, trs.topicName)
+		trs.peersResponseTracker.RequestSent(peer, trs.topicName)
 
 		logData = append(logData, peerType)
 		logData = append(logData, peer.Pretty())
@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever/mock"
 	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/topicResolverSender"
 	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,7 +40,9 @@ func createMockArgTopicResolverSender() topicResolverSender.ArgTopicResolverSend
 				return map[uint32][]core.PeerID{}
 			},
 		},
-		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
+		PeersRatingHandler:    &p2pmocks.PeersRatingHandlerStub{},
+		PeerReputationHandler: &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTracker:  &testscommon.PeersResponseTrackerStub{},
 	}
 }
 
@@ -133,6 +136,28 @@ func TestNewTopicResolverSender_NilPeersRatingHandlerShouldErr(t *testing.T) {
 	assert.Equal(t, dataRetriever.ErrNilPeersRatingHandler, err)
 }
 
+func TestNewTopicResolverSender_NilPeerReputationHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTopicResolverSender()
+	arg.PeerReputationHandler = nil
+	trs, err := topicResolverSender.NewTopicResolverSender(arg)
+
+	assert.True(t, check.IfNil(trs))
+	assert.Equal(t, dataRetriever.ErrNilPeerReputationHandler, err)
+}
+
+func TestNewTopicResolverSender_NilPeersResponseTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgTopicResolverSender()
+	arg.PeersResponseTracker = nil
+	trs, err := topicResolverSender.NewTopicResolverSender(arg)
+
+	assert.True(t, check.IfNil(trs))
+	assert.Equal(t, dataRetriever.ErrNilPeersResponseTracker, err)
+}
+
 func TestNewTopicResolverSender_NilSelfShardIDProviderShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -620,6 +645,95 @@ func TestTopicResolverSender_SendOnRequestShouldStopAfterSendingToRequiredNum(t
 	assert.Equal(t, arg.NumCrossShardPeers+arg.NumIntraShardPeers, numSent)
 }
 
+func TestTopicResolverSender_SendOnRequestShouldUsePeerReputation(t *testing.T) {
+	t.Parallel()
+
+	badPeer := core.PeerID("bad peer")
+	goodPeer := core.PeerID("good peer")
+
+	sentToPeers := make(map[core.PeerID]int)
+	arg := createMockArgTopicResolverSender()
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sentToPeers[peerID]++
+
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		CrossShardPeerListCalled: func() []core.PeerID {
+			return []core.PeerID{badPeer, goodPeer}
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return []core.PeerID{badPeer, goodPeer}
+		},
+	}
+	recordedRequests := make(map[core.PeerID]int)
+	arg.PeerReputationHandler = &p2pmocks.PeerReputationHandlerStub{
+		GetPeersByReputationCalled: func(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID {
+			return []core.PeerID{goodPeer}
+		},
+		RecordRequestSentCalled: func(pid core.PeerID, topic string) {
+			assert.Equal(t, arg.TopicName, topic)
+			recordedRequests[pid]++
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[core.PeerID]int{goodPeer: 2}, sentToPeers)
+	assert.Equal(t, map[core.PeerID]int{goodPeer: 2}, recordedRequests)
+}
+
+func TestTopicResolverSender_SendOnRequestShouldUsePeersResponseTracker(t *testing.T) {
+	t.Parallel()
+
+	slowPeer := core.PeerID("slow peer")
+	fastPeer := core.PeerID("fast peer")
+
+	sentToPeers := make([]core.PeerID, 0)
+	arg := createMockArgTopicResolverSender()
+	arg.NumCrossShardPeers = 1
+	arg.NumIntraShardPeers = 1
+	arg.Messenger = &mock.MessageHandlerStub{
+		SendToConnectedPeerCalled: func(topic string, buff []byte, peerID core.PeerID) error {
+			sentToPeers = append(sentToPeers, peerID)
+
+			return nil
+		},
+	}
+	arg.PeerListCreator = &mock.PeerListCreatorStub{
+		CrossShardPeerListCalled: func() []core.PeerID {
+			return []core.PeerID{slowPeer, fastPeer}
+		},
+		IntraShardPeerListCalled: func() []core.PeerID {
+			return []core.PeerID{slowPeer, fastPeer}
+		},
+	}
+	recordedRequests := make(map[core.PeerID]int)
+	arg.PeersResponseTracker = &testscommon.PeersResponseTrackerStub{
+		SortPeersCalled: func(peers []core.PeerID, topic string) []core.PeerID {
+			assert.Equal(t, arg.TopicName, topic)
+			assert.Equal(t, 2, len(peers))
+
+			return []core.PeerID{fastPeer, slowPeer}
+		},
+		RequestSentCalled: func(pid core.PeerID, topic string) {
+			assert.Equal(t, arg.TopicName, topic)
+			recordedRequests[pid]++
+		},
+	}
+	trs, _ := topicResolverSender.NewTopicResolverSender(arg)
+
+	err := trs.SendOnRequestTopic(&dataRetriever.RequestData{}, defaultHashes)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []core.PeerID{fastPeer, fastPeer}, sentToPeers)
+	assert.Equal(t, map[core.PeerID]int{fastPeer: 2}, recordedRequests)
+}
+
 func TestTopicResolverSender_SendOnRequestNoIntraShardShouldNotCallIntraShard(t *testing.T) {
 	t.Parallel()
 
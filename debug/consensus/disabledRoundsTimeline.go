@@ -0,0 +1,51 @@
+package consensus
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+type disabledRoundsTimeline struct {
+}
+
+// NewDisabledRoundsTimeline creates a disabled rounds timeline instance
+func NewDisabledRoundsTimeline() *disabledRoundsTimeline {
+	return &disabledRoundsTimeline{}
+}
+
+// RoundStarted does nothing
+func (rt *disabledRoundsTimeline) RoundStarted(_ int64, _ time.Time, _ string, _ []string) {
+}
+
+// SubroundStarted does nothing
+func (rt *disabledRoundsTimeline) SubroundStarted(_ int64, _ string) {
+}
+
+// SubroundEnded does nothing
+func (rt *disabledRoundsTimeline) SubroundEnded(_ int64, _ string, _ bool) {
+}
+
+// MessageReceived does nothing
+func (rt *disabledRoundsTimeline) MessageReceived(_ int64, _ string, _ []byte, _ core.PeerID) {
+}
+
+// BlockCommitted does nothing
+func (rt *disabledRoundsTimeline) BlockCommitted(_ int64, _ []byte, _ []byte) {
+}
+
+// GetRounds returns an empty slice
+func (rt *disabledRoundsTimeline) GetRounds() []*common.ConsensusRoundTimeline {
+	return make([]*common.ConsensusRoundTimeline, 0)
+}
+
+// Close does nothing and returns nil
+func (rt *disabledRoundsTimeline) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *disabledRoundsTimeline) IsInterfaceNil() bool {
+	return rt == nil
+}
@@ -0,0 +1,37 @@
+package consensus
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDisabledRoundsTimeline(t *testing.T) {
+	t.Parallel()
+
+	rt := NewDisabledRoundsTimeline()
+	assert.False(t, check.IfNil(rt))
+}
+
+func TestDisabledRoundsTimeline_MethodsShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not failed %v", r))
+		}
+	}()
+
+	rt := NewDisabledRoundsTimeline()
+	rt.RoundStarted(1, time.Now(), "leader", []string{"leader"})
+	rt.SubroundStarted(1, "subround")
+	rt.SubroundEnded(1, "subround", true)
+	rt.MessageReceived(1, "message", []byte("pk"), "pid")
+	rt.BlockCommitted(1, []byte("hash"), []byte{1})
+	assert.Empty(t, rt.GetRounds())
+	assert.Nil(t, rt.Close())
+}
@@ -0,0 +1,7 @@
+package consensus
+
+import "errors"
+
+var errInvalidValue = errors.New("invalid value")
+
+var errNilSyncTimer = errors.New("nil sync timer")
@@ -0,0 +1,315 @@
+package consensus
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/ntp"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+const (
+	minNumRoundsToKeep = 1
+	filePrefix         = "consensus-rounds"
+	fileExtension      = "json"
+)
+
+var log = logger.GetOrCreate("debug/consensus")
+
+// ArgsRoundsTimeline holds the arguments needed for creating a new rounds timeline
+type ArgsRoundsTimeline struct {
+	Config     config.ConsensusRoundsDebugConfig
+	WorkingDir string
+	SyncTimer  ntp.SyncTimer
+}
+
+type roundData struct {
+	timeline       *common.ConsensusRoundTimeline
+	consensusGroup []string
+	persisted      bool
+}
+
+type roundsTimeline struct {
+	mut             sync.RWMutex
+	rounds          map[int64]*roundData
+	sortedRounds    []int64
+	numRoundsToKeep int
+	syncTimer       ntp.SyncTimer
+	file            *os.File
+}
+
+// NewRoundsTimeline creates a new rounds timeline, which keeps what happened in the last consensus rounds and,
+// optionally, appends each round to a file once the next round has started
+func NewRoundsTimeline(args ArgsRoundsTimeline) (*roundsTimeline, error) {
+	if args.Config.NumRoundsToKeep < minNumRoundsToKeep {
+		return nil, fmt.Errorf("%w for NumRoundsToKeep, minimum %d, got %d",
+			errInvalidValue, minNumRoundsToKeep, args.Config.NumRoundsToKeep)
+	}
+	if check.IfNil(args.SyncTimer) {
+		return nil, errNilSyncTimer
+	}
+
+	rt := &roundsTimeline{
+		rounds:          make(map[int64]*roundData),
+		sortedRounds:    make([]int64, 0, args.Config.NumRoundsToKeep+1),
+		numRoundsToKeep: args.Config.NumRoundsToKeep,
+		syncTimer:       args.SyncTimer,
+	}
+
+	if args.Config.PersistToFile {
+		var err error
+		rt.file, err = core.CreateFile(core.ArgCreateFileArgument{
+			Directory:     filepath.Join(args.WorkingDir, args.Config.FolderPath),
+			Prefix:        filePrefix,
+			FileExtension: fileExtension,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return rt, nil
+}
+
+// RoundStarted records the leader and the consensus group of the round. The previous rounds are persisted, if
+// the persistence is enabled
+func (rt *roundsTimeline) RoundStarted(round int64, roundTimeStamp time.Time, leader string, consensusGroup []string) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	rd := rt.getOrCreateRound(round)
+	if rd == nil {
+		return
+	}
+
+	rd.timeline.RoundTimestamp = roundTimeStamp.UnixMilli()
+	rd.timeline.Leader = hex.EncodeToString([]byte(leader))
+	rd.timeline.ConsensusGroupSize = len(consensusGroup)
+	rd.consensusGroup = make([]string, len(consensusGroup))
+	copy(rd.consensusGroup, consensusGroup)
+
+	rt.persistRoundsBefore(round)
+}
+
+// SubroundStarted records the start of a subround
+func (rt *roundsTimeline) SubroundStarted(round int64, subroundName string) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	rd := rt.getOrCreateRound(round)
+	if rd == nil {
+		return
+	}
+
+	rd.timeline.Subrounds = append(rd.timeline.Subrounds, &common.ConsensusSubroundTimeline{
+		Name:           subroundName,
+		StartTimestamp: rt.now(),
+	})
+}
+
+// SubroundEnded records the end of the last started subround with the provided name. The subround is not finished
+// if it was extended because its time was out
+func (rt *roundsTimeline) SubroundEnded(round int64, subroundName string, finished bool) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	rd, ok := rt.rounds[round]
+	if !ok {
+		return
+	}
+
+	for i := len(rd.timeline.Subrounds) - 1; i >= 0; i-- {
+		subround := rd.timeline.Subrounds[i]
+		if subround.Name == subroundName && subround.EndTimestamp == 0 {
+			subround.EndTimestamp = rt.now()
+			subround.Finished = finished
+			return
+		}
+	}
+}
+
+// MessageReceived records a consensus message received for the round
+func (rt *roundsTimeline) MessageReceived(round int64, messageType string, pubKey []byte, pid core.PeerID) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	rd := rt.getOrCreateRound(round)
+	if rd == nil {
+		return
+	}
+
+	rd.timeline.Messages = append(rd.timeline.Messages, &common.ConsensusMessageTimeline{
+		Type:      messageType,
+		PubKey:    hex.EncodeToString(pubKey),
+		PeerID:    pid.Pretty(),
+		Timestamp: rt.now(),
+	})
+}
+
+// BlockCommitted records the hash and the signers bitmap of the block committed in the round. The signers are
+// decoded from the bitmap using the consensus group recorded when the round started
+func (rt *roundsTimeline) BlockCommitted(round int64, headerHash []byte, bitmap []byte) {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	rd := rt.getOrCreateRound(round)
+	if rd == nil {
+		return
+	}
+
+	rd.timeline.BlockHash = hex.EncodeToString(headerHash)
+	rd.timeline.Bitmap = hex.EncodeToString(bitmap)
+	rd.timeline.Signers = make([]string, 0, len(rd.consensusGroup))
+	for i, pubKey := range rd.consensusGroup {
+		isSigner := i/8 < len(bitmap) && bitmap[i/8]&(1<<uint(i%8)) != 0
+		if isSigner {
+			rd.timeline.Signers = append(rd.timeline.Signers, hex.EncodeToString([]byte(pubKey)))
+		}
+	}
+}
+
+// GetRounds returns a copy of the recorded rounds, sorted ascending by round
+func (rt *roundsTimeline) GetRounds() []*common.ConsensusRoundTimeline {
+	rt.mut.RLock()
+	defer rt.mut.RUnlock()
+
+	rounds := make([]*common.ConsensusRoundTimeline, 0, len(rt.sortedRounds))
+	for _, round := range rt.sortedRounds {
+		rounds = append(rounds, copyTimeline(rt.rounds[round].timeline))
+	}
+
+	return rounds
+}
+
+// getOrCreateRound returns the data of the round, creating it if needed. It returns nil for a round older than all
+// the kept rounds, when there is no room left. Should be called under mutex protection
+func (rt *roundsTimeline) getOrCreateRound(round int64) *roundData {
+	rd, ok := rt.rounds[round]
+	if ok {
+		return rd
+	}
+
+	isFull := len(rt.sortedRounds) >= rt.numRoundsToKeep
+	if isFull && round < rt.sortedRounds[0] {
+		return nil
+	}
+
+	rd = &roundData{
+		timeline: &common.ConsensusRoundTimeline{
+			Round:     round,
+			Subrounds: make([]*common.ConsensusSubroundTimeline, 0),
+			Messages:  make([]*common.ConsensusMessageTimeline, 0),
+		},
+	}
+	rt.rounds[round] = rd
+
+	index := sort.Search(len(rt.sortedRounds), func(i int) bool {
+		return rt.sortedRounds[i] > round
+	})
+	rt.sortedRounds = append(rt.sortedRounds, 0)
+	copy(rt.sortedRounds[index+1:], rt.sortedRounds[index:])
+	rt.sortedRounds[index] = round
+
+	for len(rt.sortedRounds) > rt.numRoundsToKeep {
+		rt.evictOldestRound()
+	}
+
+	return rd
+}
+
+// evictOldestRound removes the oldest kept round, persisting it first if needed. Should be called under mutex protection
+func (rt *roundsTimeline) evictOldestRound() {
+	oldest := rt.sortedRounds[0]
+	rt.persistRound(rt.rounds[oldest])
+	delete(rt.rounds, oldest)
+	rt.sortedRounds = rt.sortedRounds[1:]
+}
+
+// persistRoundsBefore persists the rounds older than the provided one. Should be called under mutex protection
+func (rt *roundsTimeline) persistRoundsBefore(round int64) {
+	for _, r := range rt.sortedRounds {
+		if r >= round {
+			return
+		}
+
+		rt.persistRound(rt.rounds[r])
+	}
+}
+
+// persistRound appends the round to the file, if the persistence is enabled and the round was not already
+// persisted. Should be called under mutex protection
+func (rt *roundsTimeline) persistRound(rd *roundData) {
+	if rt.file == nil || rd.persisted {
+		return
+	}
+	rd.persisted = true
+
+	buff, err := json.Marshal(rd.timeline)
+	if err != nil {
+		log.Warn("roundsTimeline.persistRound: marshal", "round", rd.timeline.Round, "error", err)
+		return
+	}
+
+	_, err = rt.file.Write(append(buff, '\n'))
+	if err != nil {
+		log.Warn("roundsTimeline.persistRound: write", "round", rd.timeline.Round, "error", err)
+	}
+}
+
+func (rt *roundsTimeline) now() int64 {
+	return rt.syncTimer.CurrentTime().UnixMilli()
+}
+
+func copyTimeline(timeline *common.ConsensusRoundTimeline) *common.ConsensusRoundTimeline {
+	timelineCopy := *timeline
+	timelineCopy.Subrounds = make([]*common.ConsensusSubroundTimeline, 0, len(timeline.Subrounds))
+	for _, subround := range timeline.Subrounds {
+		subroundCopy := *subround
+		timelineCopy.Subrounds = append(timelineCopy.Subrounds, &subroundCopy)
+	}
+	timelineCopy.Messages = make([]*common.ConsensusMessageTimeline, 0, len(timeline.Messages))
+	for _, message := range timeline.Messages {
+		messageCopy := *message
+		timelineCopy.Messages = append(timelineCopy.Messages, &messageCopy)
+	}
+	if timeline.Signers != nil {
+		timelineCopy.Signers = make([]string, len(timeline.Signers))
+		copy(timelineCopy.Signers, timeline.Signers)
+	}
+
+	return &timelineCopy
+}
+
+// Close persists the rounds not already persisted and closes the file, if the persistence is enabled
+func (rt *roundsTimeline) Close() error {
+	rt.mut.Lock()
+	defer rt.mut.Unlock()
+
+	if rt.file == nil {
+		return nil
+	}
+
+	for _, round := range rt.sortedRounds {
+		rt.persistRound(rt.rounds[round])
+	}
+
+	err := rt.file.Close()
+	rt.file = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rt *roundsTimeline) IsInterfaceNil() bool {
+	return rt == nil
+}
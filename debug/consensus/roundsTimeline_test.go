@@ -0,0 +1,186 @@
+package consensus
+
+import (
+	"bufio"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsRoundsTimeline() ArgsRoundsTimeline {
+	currentTime := int64(1000)
+	return ArgsRoundsTimeline{
+		Config: config.ConsensusRoundsDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 3,
+		},
+		SyncTimer: &testscommon.SyncTimerStub{
+			CurrentTimeCalled: func() time.Time {
+				currentTime += 10
+				return time.UnixMilli(currentTime)
+			},
+		},
+	}
+}
+
+func getRounds(rt *roundsTimeline) []int64 {
+	rounds := make([]int64, 0)
+	for _, timeline := range rt.GetRounds() {
+		rounds = append(rounds, timeline.Round)
+	}
+
+	return rounds
+}
+
+func TestNewRoundsTimeline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of rounds to keep should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundsTimeline()
+		args.Config.NumRoundsToKeep = 0
+		rt, err := NewRoundsTimeline(args)
+		assert.True(t, check.IfNil(rt))
+		assert.True(t, errors.Is(err, errInvalidValue))
+	})
+	t.Run("nil sync timer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsRoundsTimeline()
+		args.SyncTimer = nil
+		rt, err := NewRoundsTimeline(args)
+		assert.True(t, check.IfNil(rt))
+		assert.Equal(t, errNilSyncTimer, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		rt, err := NewRoundsTimeline(createMockArgsRoundsTimeline())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rt))
+		assert.Empty(t, rt.GetRounds())
+		assert.Nil(t, rt.Close())
+	})
+}
+
+func TestRoundsTimeline_RecordRound(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := NewRoundsTimeline(createMockArgsRoundsTimeline())
+	consensusGroup := []string{"pk0", "pk1", "pk2", "pk3", "pk4", "pk5", "pk6", "pk7", "pk8"}
+
+	rt.SubroundStarted(5, "(START_ROUND)")
+	rt.RoundStarted(5, time.UnixMilli(1000), "pk0", consensusGroup)
+	rt.SubroundEnded(5, "(START_ROUND)", true)
+	rt.SubroundStarted(5, "(BLOCK)")
+	rt.MessageReceived(5, "(BLOCK_BODY_AND_HEADER)", []byte("pk0"), core.PeerID("pid0"))
+	rt.SubroundEnded(5, "(BLOCK)", true)
+	rt.SubroundStarted(5, "(SIGNATURE)")
+	rt.MessageReceived(5, "(SIGNATURE)", []byte("pk8"), core.PeerID("pid8"))
+	rt.SubroundEnded(5, "(SIGNATURE)", false)
+	rt.SubroundEnded(5, "(END_ROUND)", true) // not started, ignored
+	rt.BlockCommitted(5, []byte("hash"), []byte{0b00000011, 0b00000001})
+
+	expected := &common.ConsensusRoundTimeline{
+		Round:              5,
+		RoundTimestamp:     1000,
+		Leader:             hex.EncodeToString([]byte("pk0")),
+		ConsensusGroupSize: len(consensusGroup),
+		Subrounds: []*common.ConsensusSubroundTimeline{
+			{Name: "(START_ROUND)", StartTimestamp: 1010, EndTimestamp: 1020, Finished: true},
+			{Name: "(BLOCK)", StartTimestamp: 1030, EndTimestamp: 1050, Finished: true},
+			{Name: "(SIGNATURE)", StartTimestamp: 1060, EndTimestamp: 1080, Finished: false},
+		},
+		Messages: []*common.ConsensusMessageTimeline{
+			{Type: "(BLOCK_BODY_AND_HEADER)", PubKey: hex.EncodeToString([]byte("pk0")), PeerID: core.PeerID("pid0").Pretty(), Timestamp: 1040},
+			{Type: "(SIGNATURE)", PubKey: hex.EncodeToString([]byte("pk8")), PeerID: core.PeerID("pid8").Pretty(), Timestamp: 1070},
+		},
+		BlockHash: hex.EncodeToString([]byte("hash")),
+		Bitmap:    "0301",
+		Signers: []string{
+			hex.EncodeToString([]byte("pk0")),
+			hex.EncodeToString([]byte("pk1")),
+			hex.EncodeToString([]byte("pk8")),
+		},
+	}
+	rounds := rt.GetRounds()
+	require.Equal(t, 1, len(rounds))
+	assert.Equal(t, expected, rounds[0])
+
+	// the returned rounds are copies
+	rounds[0].Subrounds[0].Name = "changed"
+	rounds[0].Signers[0] = "changed"
+	assert.Equal(t, expected, rt.GetRounds()[0])
+}
+
+func TestRoundsTimeline_KeepsTheLastRounds(t *testing.T) {
+	t.Parallel()
+
+	rt, _ := NewRoundsTimeline(createMockArgsRoundsTimeline())
+	rt.SubroundStarted(2, "(START_ROUND)")
+	rt.SubroundStarted(1, "(START_ROUND)")
+	rt.MessageReceived(4, "(SIGNATURE)", []byte("pk"), "pid") // messages can arrive before the node starts the round
+	assert.Equal(t, []int64{1, 2, 4}, getRounds(rt))
+
+	rt.SubroundStarted(3, "(START_ROUND)")
+	assert.Equal(t, []int64{2, 3, 4}, getRounds(rt))
+
+	rt.MessageReceived(1, "(SIGNATURE)", []byte("pk"), "pid")
+	assert.Equal(t, []int64{2, 3, 4}, getRounds(rt))
+
+	rt.SubroundStarted(5, "(START_ROUND)")
+	assert.Equal(t, []int64{3, 4, 5}, getRounds(rt))
+}
+
+func TestRoundsTimeline_PersistToFile(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgsRoundsTimeline()
+	args.Config.PersistToFile = true
+	args.Config.FolderPath = "consensus-rounds"
+	args.WorkingDir = t.TempDir()
+	rt, err := NewRoundsTimeline(args)
+	require.Nil(t, err)
+
+	rt.RoundStarted(1, time.UnixMilli(1000), "pk0", []string{"pk0"})
+	rt.RoundStarted(2, time.UnixMilli(2000), "pk0", []string{"pk0"})
+	rt.MessageReceived(1, "(SIGNATURE)", []byte("pk0"), "pid") // late message, after round 1 was persisted
+	rt.RoundStarted(3, time.UnixMilli(3000), "pk0", []string{"pk0"})
+	rt.RoundStarted(4, time.UnixMilli(4000), "pk0", []string{"pk0"}) // evicts round 1, already persisted
+	require.Nil(t, rt.Close())
+
+	files, err := os.ReadDir(filepath.Join(args.WorkingDir, args.Config.FolderPath))
+	require.Nil(t, err)
+	require.Equal(t, 1, len(files))
+
+	file, err := os.Open(filepath.Join(args.WorkingDir, args.Config.FolderPath, files[0].Name()))
+	require.Nil(t, err)
+	defer func() {
+		_ = file.Close()
+	}()
+
+	persistedRounds := make([]int64, 0)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		timeline := &common.ConsensusRoundTimeline{}
+		require.Nil(t, json.Unmarshal(scanner.Bytes(), timeline))
+		persistedRounds = append(persistedRounds, timeline.Round)
+		if timeline.Round == 1 {
+			assert.Empty(t, timeline.Messages)
+		}
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4}, persistedRounds)
+}
@@ -0,0 +1,20 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/debug/consensus"
+	"github.com/multiversx/mx-chain-go/ntp"
+)
+
+// CreateConsensusRoundsTimeline creates a new instance of type RoundsTimelineRecorder
+func CreateConsensusRoundsTimeline(configs config.ConsensusRoundsDebugConfig, workingDir string, syncTimer ntp.SyncTimer) (RoundsTimelineRecorder, error) {
+	if !configs.Enabled {
+		return consensus.NewDisabledRoundsTimeline(), nil
+	}
+
+	return consensus.NewRoundsTimeline(consensus.ArgsRoundsTimeline{
+		Config:     configs,
+		WorkingDir: workingDir,
+		SyncTimer:  syncTimer,
+	})
+}
@@ -0,0 +1,49 @@
+package factory
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCreateConsensusRoundsTimeline(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create disabled rounds timeline", func(t *testing.T) {
+		t.Parallel()
+
+		configs := config.ConsensusRoundsDebugConfig{
+			Enabled: false,
+		}
+		rt, err := CreateConsensusRoundsTimeline(configs, "", nil)
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rt))
+		assert.Equal(t, "*consensus.disabledRoundsTimeline", fmt.Sprintf("%T", rt))
+	})
+	t.Run("create real rounds timeline", func(t *testing.T) {
+		t.Parallel()
+
+		configs := config.ConsensusRoundsDebugConfig{
+			Enabled:         true,
+			NumRoundsToKeep: 10,
+		}
+		rt, err := CreateConsensusRoundsTimeline(configs, "", &testscommon.SyncTimerStub{})
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(rt))
+		assert.Equal(t, "*consensus.roundsTimeline", fmt.Sprintf("%T", rt))
+	})
+	t.Run("create real rounds timeline errors", func(t *testing.T) {
+		t.Parallel()
+
+		configs := config.ConsensusRoundsDebugConfig{
+			Enabled: true,
+		}
+		rt, err := CreateConsensusRoundsTimeline(configs, "", &testscommon.SyncTimerStub{})
+		assert.NotNil(t, err)
+		assert.True(t, check.IfNil(rt))
+	})
+}
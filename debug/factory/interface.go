@@ -1,5 +1,12 @@
 package factory
 
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
 // InterceptorResolverDebugHandler hold information about requested and received information
 type InterceptorResolverDebugHandler interface {
 	LogRequestedData(topic string, hashes [][]byte, numReqIntra int, numReqCross int)
@@ -18,3 +25,15 @@ type ProcessDebugger interface {
 	Close() error
 	IsInterfaceNil() bool
 }
+
+// RoundsTimelineRecorder defines what a consensus rounds timeline implementation should do
+type RoundsTimelineRecorder interface {
+	RoundStarted(round int64, roundTimeStamp time.Time, leader string, consensusGroup []string)
+	SubroundStarted(round int64, subroundName string)
+	SubroundEnded(round int64, subroundName string, finished bool)
+	MessageReceived(round int64, messageType string, pubKey []byte, pid core.PeerID)
+	BlockCommitted(round int64, headerHash []byte, bitmap []byte)
+	GetRounds() []*common.ConsensusRoundTimeline
+	Close() error
+	IsInterfaceNil() bool
+}
@@ -3,6 +3,7 @@ package bootstrap
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -23,6 +24,7 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever/factory/containers"
 	"github.com/multiversx/mx-chain-go/dataRetriever/factory/resolverscontainer"
 	"github.com/multiversx/mx-chain-go/dataRetriever/requestHandlers"
+	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/peersResponseTracker"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	factoryInterceptors "github.com/multiversx/mx-chain-go/epochStart/bootstrap/factory"
@@ -35,10 +37,12 @@ import (
 	"github.com/multiversx/mx-chain-go/process/interceptors"
 	disabledInterceptors "github.com/multiversx/mx-chain-go/process/interceptors/disabled"
 	"github.com/multiversx/mx-chain-go/process/peer"
+	antifloodDisabled "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	"github.com/multiversx/mx-chain-go/redundancy"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
 	"github.com/multiversx/mx-chain-go/state/syncer"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/cache"
@@ -1047,15 +1051,25 @@ func (e *epochStartBootstrap) updateDataForScheduled(
 }
 
 func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
+	e.mutTrieStorageManagers.RLock()
+	trieStorageManager := e.trieStorageManagers[factory.UserAccountTrie]
+	e.mutTrieStorageManagers.RUnlock()
+
+	if len(e.flagsConfig.StateSnapshotDirectory) > 0 {
+		isImported, err := e.importUserAccountsState(rootHash, trieStorageManager)
+		if err != nil {
+			return err
+		}
+		if isImported {
+			return nil
+		}
+	}
+
 	thr, err := throttler.NewNumGoRoutinesThrottler(int32(e.numConcurrentTrieSyncers))
 	if err != nil {
 		return err
 	}
 
-	e.mutTrieStorageManagers.RLock()
-	trieStorageManager := e.trieStorageManagers[factory.UserAccountTrie]
-	e.mutTrieStorageManagers.RUnlock()
-
 	argsUserAccountsSyncer := syncer.ArgsNewUserAccountsSyncer{
 		ArgsNewBaseAccountsSyncer: syncer.ArgsNewBaseAccountsSyncer{
 			Hasher:                            e.coreComponentsHolder.Hasher(),
@@ -1089,6 +1103,36 @@ func (e *epochStartBootstrap) syncUserAccountsState(rootHash []byte) error {
 	return nil
 }
 
+// importUserAccountsState loads the accounts state from the local state snapshot. A snapshot exported at another
+// root hash is not an error, as the node might have been restarted some epochs later with the same flags, so the state
+// will be synced from the network. Any other failure stops the bootstrap, as the snapshot was explicitly requested
+func (e *epochStartBootstrap) importUserAccountsState(rootHash []byte, trieStorageManager common.StorageManager) (bool, error) {
+	importer, err := stateSnapshot.NewSnapshotImporter(stateSnapshot.ArgsSnapshotImporter{
+		DB:             trieStorageManager,
+		Marshalizer:    e.coreComponentsHolder.InternalMarshalizer(),
+		Hasher:         e.coreComponentsHolder.Hasher(),
+		InputDirectory: e.flagsConfig.StateSnapshotDirectory,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = importer.Import(rootHash, e.shardCoordinator.SelfId())
+	if errors.Is(err, stateSnapshot.ErrRootHashMismatch) {
+		log.Warn("start in epoch bootstrap: the state snapshot does not match the epoch start state, syncing it from the network",
+			"directory", e.flagsConfig.StateSnapshotDirectory, "error", err)
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("%w while importing the state snapshot from %s", err, e.flagsConfig.StateSnapshotDirectory)
+	}
+
+	storageMarker.NewTrieStorageMarker().MarkStorerAsSyncedAndActive(trieStorageManager)
+	log.Info("start in epoch bootstrap: accounts state imported from the state snapshot", "root hash", rootHash)
+
+	return true, nil
+}
+
 func (e *epochStartBootstrap) createStorageService(
 	shardCoordinator sharding.Coordinator,
 	pathManager storage.PathManagerHandler,
@@ -1189,6 +1233,8 @@ func (e *epochStartBootstrap) createRequestHandler() error {
 		PreferredPeersHolder:        disabled.NewPreferredPeersHolder(),
 		ResolverConfig:              e.generalConfig.Resolvers,
 		PeersRatingHandler:          disabled.NewDisabledPeersRatingHandler(),
+		PeerReputationHandler:       &antifloodDisabled.PeerReputationHandler{},
+		PeersResponseTracker:        peersResponseTracker.NewDisabledPeersResponseTracker(),
 		PayloadValidator:            payloadValidator,
 	}
 	resolverFactory, err := resolverscontainer.NewMetaResolversContainerFactory(resolversContainerArgs)
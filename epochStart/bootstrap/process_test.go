@@ -2,9 +2,12 @@ package bootstrap
 
 import (
 	"context"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"testing"
@@ -26,6 +29,7 @@ import (
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	epochStartMocks "github.com/multiversx/mx-chain-go/testscommon/bootstrapMocks/epochStart"
@@ -1050,6 +1054,55 @@ func TestSyncUserAccountsState(t *testing.T) {
 	assert.Equal(t, state.ErrNilRequestHandler, err)
 }
 
+func TestSyncUserAccountsState_FromStateSnapshot(t *testing.T) {
+	t.Parallel()
+
+	createEpochStartProvider := func(stateSnapshotDirectory string) *epochStartBootstrap {
+		coreComp, cryptoComp := createComponentsForEpochStart()
+		args := createMockEpochStartBootstrapArgs(coreComp, cryptoComp)
+		args.FlagsConfig.StateSnapshotDirectory = stateSnapshotDirectory
+
+		epochStartProvider, _ := NewEpochStartBootstrap(args)
+		epochStartProvider.shardCoordinator = mock.NewMultipleShardsCoordinatorMock()
+		epochStartProvider.dataPool = &dataRetrieverMock.PoolsHolderStub{
+			TrieNodesCalled: func() storage.Cacher {
+				return &testscommon.CacherStub{}
+			},
+		}
+		_, trieStorageManagers, _ := factory.CreateTriesComponentsForShardId(
+			args.GeneralConfig,
+			coreComp,
+			disabled.NewChainStorer(),
+		)
+		epochStartProvider.trieStorageManagers = trieStorageManagers
+
+		return epochStartProvider
+	}
+
+	t.Run("missing snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		epochStartProvider := createEpochStartProvider(t.TempDir())
+		err := epochStartProvider.syncUserAccountsState([]byte("rootHash"))
+		assert.NotNil(t, err)
+		assert.NotEqual(t, state.ErrNilRequestHandler, err)
+	})
+	t.Run("snapshot of another root hash should sync from the network", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		manifestBytes, _ := json.Marshal(&stateSnapshot.Manifest{
+			Version:  stateSnapshot.CurrentVersion,
+			RootHash: hex.EncodeToString([]byte("other rootHash")),
+		})
+		require.Nil(t, os.WriteFile(filepath.Join(directory, stateSnapshot.ManifestFileName), manifestBytes, 0644))
+
+		epochStartProvider := createEpochStartProvider(directory)
+		err := epochStartProvider.syncUserAccountsState([]byte("rootHash"))
+		assert.Equal(t, state.ErrNilRequestHandler, err)
+	})
+}
+
 func TestRequestAndProcessForShard_ShouldFail(t *testing.T) {
 	notarizedShardHeaderHash := []byte("notarizedShardHeaderHash")
 	prevShardHeaderHash := []byte("prevShardHeaderHash")
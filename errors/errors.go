@@ -122,6 +122,9 @@ var ErrNilBootstrapParamsHandler = errors.New("nil bootstrap parameters handler"
 // ErrNilBroadcastMessenger is raised when a valid broadcast messenger is expected but nil used
 var ErrNilBroadcastMessenger = errors.New("broadcast messenger is nil")
 
+// ErrNilRoundsTimelineRecorder signals that a nil consensus rounds timeline recorder was provided
+var ErrNilRoundsTimelineRecorder = errors.New("nil consensus rounds timeline recorder")
+
 // ErrNilChronologyHandler is raised when a valid chronology handler is expected but nil used
 var ErrNilChronologyHandler = errors.New("chronology handler is nil")
 
@@ -260,6 +263,12 @@ var ErrNilPeerBlackListHandler = errors.New("nil peer black list handler")
 // ErrNilPeerHonestyHandler signals that a nil peer honesty handler was provided
 var ErrNilPeerHonestyHandler = errors.New("nil peer honesty handler")
 
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler was provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNilPeersResponseTracker signals that a nil peers response tracker was provided
+var ErrNilPeersResponseTracker = errors.New("nil peers response tracker")
+
 // ErrNilPeerShardMapper signals that a nil peer shard mapper was provided
 var ErrNilPeerShardMapper = errors.New("nil peer shard mapper")
 
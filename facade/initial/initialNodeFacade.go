@@ -20,6 +20,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 )
 
 var errNodeStarting = errors.New("node is starting")
@@ -70,6 +71,71 @@ func (inf *initialNodeFacade) VerifyProof(_ string, _ string, _ [][]byte) (bool,
 	return false, errNodeStarting
 }
 
+// GetMultiProof -
+func (inf *initialNodeFacade) GetMultiProof(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetMultiProofDataTrie -
+func (inf *initialNodeFacade) GetMultiProofDataTrie(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// GetRangeProof -
+func (inf *initialNodeFacade) GetRangeProof(_ string, _ string, _ string, _ int) (*common.GetRangeProofResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetRangeProofDataTrie -
+func (inf *initialNodeFacade) GetRangeProofDataTrie(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	return nil, nil, errNodeStarting
+}
+
+// VerifyMultiProof -
+func (inf *initialNodeFacade) VerifyMultiProof(_ string, _ []string, _ [][]byte) (*common.VerifyProofsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// VerifyRangeProof -
+func (inf *initialNodeFacade) VerifyRangeProof(_ string, _ string, _ string, _ [][]byte) (*common.VerifyProofsResponse, error) {
+	return nil, errNodeStarting
+}
+
+// GetStorageStats -
+func (inf *initialNodeFacade) GetStorageStats() ([]*common.StorageUnitStats, error) {
+	return nil, errNodeStarting
+}
+
+// CompactStorageUnit -
+func (inf *initialNodeFacade) CompactStorageUnit(_ string) error {
+	return errNodeStarting
+}
+
+// VerifyTrieIntegrity -
+func (inf *initialNodeFacade) VerifyTrieIntegrity(_ string, _ bool) (*trieIntegrity.Report, error) {
+	return nil, errNodeStarting
+}
+
+// GetTrieSnapshotsStatus -
+func (inf *initialNodeFacade) GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error) {
+	return nil, errNodeStarting
+}
+
+// ControlTrieSnapshots -
+func (inf *initialNodeFacade) ControlTrieSnapshots(_ string, _ string) error {
+	return errNodeStarting
+}
+
+// GetConsensusRounds -
+func (inf *initialNodeFacade) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	return nil, errNodeStarting
+}
+
+// GetNetworkTopology -
+func (inf *initialNodeFacade) GetNetworkTopology() (*data.NetworkTopology, error) {
+	return nil, errNodeStarting
+}
+
 // SetSyncer does nothing
 func (inf *initialNodeFacade) SetSyncer(_ ntp.SyncTimer) {
 }
@@ -151,7 +217,12 @@ func (inf *initialNodeFacade) SendBulkTransactions(_ []*transaction.Transaction)
 }
 
 // SimulateTransactionExecution returns nil and error
-func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (inf *initialNodeFacade) SimulateTransactionExecution(_ *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
+	return nil, errNodeStarting
+}
+
+// SimulateTransactionsBundleExecution returns nil and error
+func (inf *initialNodeFacade) SimulateTransactionsBundleExecution(_ []*transaction.Transaction, _ bool) (*txSimData.BundleSimulationResults, error) {
 	return nil, errNodeStarting
 }
 
@@ -161,7 +232,7 @@ func (inf *initialNodeFacade) GetTransaction(_ string, _ bool) (*transaction.Api
 }
 
 // ComputeTransactionGasLimit returns 0 and error
-func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction) (*transaction.CostResponse, error) {
+func (inf *initialNodeFacade) ComputeTransactionGasLimit(_ *transaction.Transaction, _ common.StateOverrides) (*transaction.CostResponse, error) {
 	return nil, errNodeStarting
 }
 
@@ -201,8 +272,8 @@ func (inf *initialNodeFacade) GetTotalStakedValue() (*api.StakeValues, error) {
 }
 
 // ExecuteSCQuery returns nil and error
-func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputApi, error) {
-	return nil, errNodeStarting
+func (inf *initialNodeFacade) ExecuteSCQuery(_ *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+	return nil, nil, errNodeStarting
 }
 
 // PprofEnabled returns false
@@ -236,7 +307,7 @@ func (inf *initialNodeFacade) GetQueryHandler(_ string) (debug.QueryHandler, err
 }
 
 // GetPeerInfo returns nil and error
-func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]core.QueryP2PPeerInfo, error) {
+func (inf *initialNodeFacade) GetPeerInfo(_ string) ([]common.PeerInfoAPI, error) {
 	return nil, errNodeStarting
 }
 
@@ -320,6 +391,21 @@ func (inf *initialNodeFacade) Close() error {
 	return errNodeStarting
 }
 
+// GetKeyValuePairsPage returns nil and error
+func (inf *initialNodeFacade) GetKeyValuePairsPage(_ string, _ api.AccountQueryOptions, _ string, _ int) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+	return nil, "", api.BlockInfo{}, errNodeStarting
+}
+
+// GetAccountStoragePage returns nil and error
+func (inf *initialNodeFacade) GetAccountStoragePage(_ string, _ api.AccountQueryOptions, _ string, _ int) (*common.AccountStoragePage, api.BlockInfo, error) {
+	return nil, api.BlockInfo{}, errNodeStarting
+}
+
+// GetStateDiff returns nil and error
+func (inf *initialNodeFacade) GetStateDiff(_ string, _ string, _ string, _ int) (*common.StateDiffAPI, error) {
+	return nil, errNodeStarting
+}
+
 // GetKeyValuePairs nil map
 func (inf *initialNodeFacade) GetKeyValuePairs(_ string, _ api.AccountQueryOptions) (map[string]string, api.BlockInfo, error) {
 	return nil, api.BlockInfo{}, errNodeStarting
@@ -87,15 +87,19 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	assert.Equal(t, uint64(0), u1)
 	assert.Equal(t, errNodeStarting, err)
 
-	u2, err := inf.SimulateTransactionExecution(nil)
+	u2, err := inf.SimulateTransactionExecution(nil, nil, false)
 	assert.Nil(t, u2)
 	assert.Equal(t, errNodeStarting, err)
 
+	bundleResults, err := inf.SimulateTransactionsBundleExecution(nil, false)
+	assert.Nil(t, bundleResults)
+	assert.Equal(t, errNodeStarting, err)
+
 	t1, err := inf.GetTransaction("", false)
 	assert.Nil(t, t1)
 	assert.Equal(t, errNodeStarting, err)
 
-	resp, err := inf.ComputeTransactionGasLimit(nil)
+	resp, err := inf.ComputeTransactionGasLimit(nil, nil)
 	assert.Nil(t, resp)
 	assert.Equal(t, errNodeStarting, err)
 
@@ -110,8 +114,9 @@ func TestInitialNodeFacade_AllMethodsShouldNotPanic(t *testing.T) {
 	sm := inf.StatusMetrics()
 	assert.NotNil(t, sm)
 
-	vo, err := inf.ExecuteSCQuery(nil)
+	vo, callTrace, err := inf.ExecuteSCQuery(nil)
 	assert.Nil(t, vo)
+	assert.Nil(t, callTrace)
 	assert.Equal(t, errNodeStarting, err)
 
 	b = inf.PprofEnabled()
@@ -4,7 +4,6 @@ import (
 	"context"
 	"math/big"
 
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
@@ -16,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -39,6 +39,15 @@ type NodeHandler interface {
 	// GetKeyValuePairs returns the key-value pairs under a given address
 	GetKeyValuePairs(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
 
+	// GetKeyValuePairsPage returns a page of the key-value pairs under a given address, starting with the provided key
+	GetKeyValuePairsPage(address string, options api.AccountQueryOptions, fromKey string, limit int, ctx context.Context) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error)
+
+	// GetAccountStoragePage returns a page of the key-value pairs under a given address
+	GetAccountStoragePage(address string, options api.AccountQueryOptions, continuationToken string, pageSize int, ctx context.Context) (*common.AccountStoragePage, api.BlockInfo, error)
+
+	// GetStateDiff returns a page of the accounts changed between two root hashes of the accounts trie
+	GetStateDiff(fromRootHash string, toRootHash string, fromAddress string, limit int, ctx context.Context) (*common.StateDiffAPI, error)
+
 	// GetAllIssuedESDTs returns all the issued esdt tokens from esdt system smart contract
 	GetAllIssuedESDTs(tokenType string, ctx context.Context) ([]string, error)
 
@@ -92,25 +101,39 @@ type NodeHandler interface {
 	DecodeAddressPubkey(pk string) ([]byte, error)
 
 	GetQueryHandler(name string) (debug.QueryHandler, error)
-	GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeerInfo(pid string) ([]common.PeerInfoAPI, error)
 
 	GetEpochStartDataAPI(epoch uint32) (*common.EpochStartDataAPI, error)
 
 	GetProof(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrie(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProof(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error)
+	GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	GetStorageStats() ([]*common.StorageUnitStats, error)
+	CompactStorageUnit(unitName string) error
+	VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error)
+	GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error)
+	ControlTrieSnapshots(trieName string, action string) error
+	GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error)
+	GetNetworkTopology() (*data.NetworkTopology, error)
 }
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	ProcessBundle(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 	IsInterfaceNil() bool
 }
 
 // ApiResolver defines a structure capable of resolving REST API requests
 type ApiResolver interface {
-	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	StatusMetrics() external.StatusMetricsHandler
 	GetTotalStakedValue(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedList(ctx context.Context) ([]*api.DirectStakedValue, error)
@@ -15,9 +15,9 @@ import (
 
 // ApiResolverStub -
 type ApiResolverStub struct {
-	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteSCQueryHandler                       func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
 	StatusMetricsHandler                        func() external.StatusMetricsHandler
-	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitHandler           func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	GetTotalStakedValueHandler                  func(ctx context.Context) (*api.StakeValues, error)
 	GetDirectStakedListHandler                  func(ctx context.Context) ([]*api.DirectStakedValue, error)
 	GetDelegatorsListHandler                    func(ctx context.Context) ([]*api.Delegator, error)
@@ -90,12 +90,12 @@ func (ars *ApiResolverStub) GetAlteredAccountsForBlock(options api.GetAlteredAcc
 }
 
 // ExecuteSCQuery -
-func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+func (ars *ApiResolverStub) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	if ars.ExecuteSCQueryHandler != nil {
 		return ars.ExecuteSCQueryHandler(query)
 	}
 
-	return nil, nil
+	return nil, nil, nil
 }
 
 // StatusMetrics -
@@ -108,9 +108,9 @@ func (ars *ApiResolverStub) StatusMetrics() external.StatusMetricsHandler {
 }
 
 // ComputeTransactionGasLimit -
-func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (ars *ApiResolverStub) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	if ars.ComputeTransactionGasLimitHandler != nil {
-		return ars.ComputeTransactionGasLimitHandler(tx)
+		return ars.ComputeTransactionGasLimitHandler(tx, stateOverrides)
 	}
 
 	return nil, nil
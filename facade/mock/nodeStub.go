@@ -5,7 +5,6 @@ import (
 	"encoding/hex"
 	"math/big"
 
-	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/esdt"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -14,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/heartbeat/data"
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 )
 
 // NodeStub -
@@ -37,7 +37,7 @@ type NodeStub struct {
 	GetQueryHandlerCalled                          func(name string) (debug.QueryHandler, error)
 	GetValueForKeyCalled                           func(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetGuardianDataCalled                          func(address string, options api.AccountQueryOptions) (api.GuardianData, api.BlockInfo, error)
-	GetPeerInfoCalled                              func(pid string) ([]core.QueryP2PPeerInfo, error)
+	GetPeerInfoCalled                              func(pid string) ([]common.PeerInfoAPI, error)
 	GetEpochStartDataAPICalled                     func(epoch uint32) (*common.EpochStartDataAPI, error)
 	GetUsernameCalled                              func(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error)
 	GetCodeHashCalled                              func(address string, options api.AccountQueryOptions) ([]byte, api.BlockInfo, error)
@@ -47,10 +47,26 @@ type NodeStub struct {
 	GetESDTsWithRoleCalled                         func(address string, role string, options api.AccountQueryOptions, ctx context.Context) ([]string, api.BlockInfo, error)
 	GetESDTsRolesCalled                            func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string][]string, api.BlockInfo, error)
 	GetKeyValuePairsCalled                         func(address string, options api.AccountQueryOptions, ctx context.Context) (map[string]string, api.BlockInfo, error)
+	GetKeyValuePairsPageCalled                     func(address string, options api.AccountQueryOptions, fromKey string, limit int, ctx context.Context) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error)
+	GetAccountStoragePageCalled                    func(address string, options api.AccountQueryOptions, continuationToken string, pageSize int, ctx context.Context) (*common.AccountStoragePage, api.BlockInfo, error)
+	GetStateDiffCalled                             func(fromRootHash string, toRootHash string, fromAddress string, limit int, ctx context.Context) (*common.StateDiffAPI, error)
 	GetAllIssuedESDTsCalled                        func(tokenType string, ctx context.Context) ([]string, error)
 	GetProofCalled                                 func(rootHash string, key string) (*common.GetProofResponse, error)
 	GetProofDataTrieCalled                         func(rootHash string, address string, key string) (*common.GetProofResponse, *common.GetProofResponse, error)
 	VerifyProofCalled                              func(rootHash string, address string, proof [][]byte) (bool, error)
+	GetMultiProofCalled                            func(rootHash string, addresses []string) (*common.GetMultiProofResponse, error)
+	GetMultiProofDataTrieCalled                    func(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error)
+	GetRangeProofCalled                            func(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error)
+	GetRangeProofDataTrieCalled                    func(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error)
+	VerifyMultiProofCalled                         func(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	VerifyRangeProofCalled                         func(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error)
+	GetStorageStatsCalled                          func() ([]*common.StorageUnitStats, error)
+	CompactStorageUnitCalled                       func(unitName string) error
+	VerifyTrieIntegrityCalled                      func(rootHash string, repair bool) (*trieIntegrity.Report, error)
+	GetTrieSnapshotsStatusCalled                   func() (map[string]*common.TrieSnapshotsStatus, error)
+	ControlTrieSnapshotsCalled                     func(trieName string, action string) error
+	GetConsensusRoundsCalled                       func() ([]*common.ConsensusRoundTimeline, error)
+	GetNetworkTopologyCalled                       func() (*data.NetworkTopology, error)
 }
 
 // GetProof -
@@ -80,6 +96,123 @@ func (ns *NodeStub) VerifyProof(rootHash string, address string, proof [][]byte)
 	return false, nil
 }
 
+// GetMultiProof -
+func (ns *NodeStub) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofCalled != nil {
+		return ns.GetMultiProofCalled(rootHash, addresses)
+	}
+
+	return nil, nil
+}
+
+// GetMultiProofDataTrie -
+func (ns *NodeStub) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	if ns.GetMultiProofDataTrieCalled != nil {
+		return ns.GetMultiProofDataTrieCalled(rootHash, address, keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (ns *NodeStub) GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	if ns.GetRangeProofCalled != nil {
+		return ns.GetRangeProofCalled(rootHash, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil
+}
+
+// GetRangeProofDataTrie -
+func (ns *NodeStub) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	if ns.GetRangeProofDataTrieCalled != nil {
+		return ns.GetRangeProofDataTrieCalled(rootHash, address, startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil
+}
+
+// VerifyMultiProof -
+func (ns *NodeStub) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	if ns.VerifyMultiProofCalled != nil {
+		return ns.VerifyMultiProofCalled(rootHash, keys, proof)
+	}
+
+	return nil, nil
+}
+
+// VerifyRangeProof -
+func (ns *NodeStub) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	if ns.VerifyRangeProofCalled != nil {
+		return ns.VerifyRangeProofCalled(rootHash, startKey, endKey, proof)
+	}
+
+	return nil, nil
+}
+
+// GetStorageStats -
+func (ns *NodeStub) GetStorageStats() ([]*common.StorageUnitStats, error) {
+	if ns.GetStorageStatsCalled != nil {
+		return ns.GetStorageStatsCalled()
+	}
+
+	return nil, nil
+}
+
+// CompactStorageUnit -
+func (ns *NodeStub) CompactStorageUnit(unitName string) error {
+	if ns.CompactStorageUnitCalled != nil {
+		return ns.CompactStorageUnitCalled(unitName)
+	}
+
+	return nil
+}
+
+// VerifyTrieIntegrity -
+func (ns *NodeStub) VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+	if ns.VerifyTrieIntegrityCalled != nil {
+		return ns.VerifyTrieIntegrityCalled(rootHash, repair)
+	}
+
+	return &trieIntegrity.Report{}, nil
+}
+
+// GetTrieSnapshotsStatus -
+func (ns *NodeStub) GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error) {
+	if ns.GetTrieSnapshotsStatusCalled != nil {
+		return ns.GetTrieSnapshotsStatusCalled()
+	}
+
+	return nil, nil
+}
+
+// ControlTrieSnapshots -
+func (ns *NodeStub) ControlTrieSnapshots(trieName string, action string) error {
+	if ns.ControlTrieSnapshotsCalled != nil {
+		return ns.ControlTrieSnapshotsCalled(trieName, action)
+	}
+
+	return nil
+}
+
+// GetConsensusRounds -
+func (ns *NodeStub) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	if ns.GetConsensusRoundsCalled != nil {
+		return ns.GetConsensusRoundsCalled()
+	}
+
+	return nil, nil
+}
+
+// GetNetworkTopology -
+func (ns *NodeStub) GetNetworkTopology() (*data.NetworkTopology, error) {
+	if ns.GetNetworkTopologyCalled != nil {
+		return ns.GetNetworkTopologyCalled()
+	}
+
+	return nil, nil
+}
+
 // GetUsername -
 func (ns *NodeStub) GetUsername(address string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetUsernameCalled != nil {
@@ -107,6 +240,33 @@ func (ns *NodeStub) GetKeyValuePairs(address string, options api.AccountQueryOpt
 	return nil, api.BlockInfo{}, nil
 }
 
+// GetKeyValuePairsPage -
+func (ns *NodeStub) GetKeyValuePairsPage(address string, options api.AccountQueryOptions, fromKey string, limit int, ctx context.Context) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+	if ns.GetKeyValuePairsPageCalled != nil {
+		return ns.GetKeyValuePairsPageCalled(address, options, fromKey, limit, ctx)
+	}
+
+	return nil, "", api.BlockInfo{}, nil
+}
+
+// GetAccountStoragePage -
+func (ns *NodeStub) GetAccountStoragePage(address string, options api.AccountQueryOptions, continuationToken string, pageSize int, ctx context.Context) (*common.AccountStoragePage, api.BlockInfo, error) {
+	if ns.GetAccountStoragePageCalled != nil {
+		return ns.GetAccountStoragePageCalled(address, options, continuationToken, pageSize, ctx)
+	}
+
+	return nil, api.BlockInfo{}, nil
+}
+
+// GetStateDiff -
+func (ns *NodeStub) GetStateDiff(fromRootHash string, toRootHash string, fromAddress string, limit int, ctx context.Context) (*common.StateDiffAPI, error) {
+	if ns.GetStateDiffCalled != nil {
+		return ns.GetStateDiffCalled(fromRootHash, toRootHash, fromAddress, limit, ctx)
+	}
+
+	return nil, nil
+}
+
 // GetValueForKey -
 func (ns *NodeStub) GetValueForKey(address string, key string, options api.AccountQueryOptions) (string, api.BlockInfo, error) {
 	if ns.GetValueForKeyCalled != nil {
@@ -145,7 +305,7 @@ func (ns *NodeStub) CreateTransaction(txArgs *external.ArgsCreateTransaction) (*
 	return ns.CreateTransactionHandler(txArgs)
 }
 
-//ValidateTransaction -
+// ValidateTransaction -
 func (ns *NodeStub) ValidateTransaction(tx *transaction.Transaction) error {
 	return ns.ValidateTransactionHandler(tx)
 }
@@ -204,12 +364,12 @@ func (ns *NodeStub) GetQueryHandler(name string) (debug.QueryHandler, error) {
 }
 
 // GetPeerInfo -
-func (ns *NodeStub) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
+func (ns *NodeStub) GetPeerInfo(pid string) ([]common.PeerInfoAPI, error) {
 	if ns.GetPeerInfoCalled != nil {
 		return ns.GetPeerInfoCalled(pid)
 	}
 
-	return make([]core.QueryP2PPeerInfo, 0), nil
+	return make([]common.PeerInfoAPI, 0), nil
 }
 
 // GetEpochStartDataAPI -
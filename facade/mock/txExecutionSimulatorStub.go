@@ -2,23 +2,34 @@ package mock
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 )
 
 // TxExecutionSimulatorStub -
 type TxExecutionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled     func(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	ProcessBundleCalled func(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 }
 
 // ProcessTx -
-func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (t *TxExecutionSimulatorStub) ProcessTx(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error) {
 	if t.ProcessTxCalled != nil {
-		return t.ProcessTxCalled(tx)
+		return t.ProcessTxCalled(tx, stateOverrides, withTrace)
 	}
 
 	return &txSimData.SimulationResults{}, nil
 }
 
+// ProcessBundle -
+func (t *TxExecutionSimulatorStub) ProcessBundle(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error) {
+	if t.ProcessBundleCalled != nil {
+		return t.ProcessBundleCalled(txs, withTrace)
+	}
+
+	return &txSimData.BundleSimulationResults{}, nil
+}
+
 // IsInterfaceNil -
 func (t *TxExecutionSimulatorStub) IsInterfaceNil() bool {
 	return t == nil
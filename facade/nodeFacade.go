@@ -27,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 	logger "github.com/multiversx/mx-chain-logger-go"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -237,6 +238,40 @@ func (nf *nodeFacade) GetKeyValuePairs(address string, options apiData.AccountQu
 	return nf.node.GetKeyValuePairs(address, options, ctx)
 }
 
+// GetKeyValuePairsPage returns a page of the key-value pairs under the provided address, starting with the provided key
+func (nf *nodeFacade) GetKeyValuePairsPage(
+	address string,
+	options apiData.AccountQueryOptions,
+	fromKey string,
+	limit int,
+) ([]*common.KeyValuePairAPI, string, apiData.BlockInfo, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetKeyValuePairsPage(address, options, fromKey, limit, ctx)
+}
+
+// GetAccountStoragePage returns a page of the key-value pairs under the provided address
+func (nf *nodeFacade) GetAccountStoragePage(
+	address string,
+	options apiData.AccountQueryOptions,
+	continuationToken string,
+	pageSize int,
+) (*common.AccountStoragePage, apiData.BlockInfo, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetAccountStoragePage(address, options, continuationToken, pageSize, ctx)
+}
+
+// GetStateDiff returns a page of the accounts changed between the provided root hashes, starting with the provided address
+func (nf *nodeFacade) GetStateDiff(fromRootHash string, toRootHash string, fromAddress string, limit int) (*common.StateDiffAPI, error) {
+	ctx, cancel := nf.getContextForApiTrieRangeOperations()
+	defer cancel()
+
+	return nf.node.GetStateDiff(fromRootHash, toRootHash, fromAddress, limit, ctx)
+}
+
 // GetGuardianData returns the guardian data for the provided address
 func (nf *nodeFacade) GetGuardianData(address string, options apiData.AccountQueryOptions) (apiData.GuardianData, apiData.BlockInfo, error) {
 	return nf.node.GetGuardianData(address, options)
@@ -298,8 +333,13 @@ func (nf *nodeFacade) SendBulkTransactions(txs []*transaction.Transaction) (uint
 }
 
 // SimulateTransactionExecution will simulate a transaction's execution and will return the results
-func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
-	return nf.txSimulatorProc.ProcessTx(tx)
+func (nf *nodeFacade) SimulateTransactionExecution(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error) {
+	return nf.txSimulatorProc.ProcessTx(tx, stateOverrides, withTrace)
+}
+
+// SimulateTransactionsBundleExecution will simulate the execution of an ordered bundle of transactions and will return the results
+func (nf *nodeFacade) SimulateTransactionsBundleExecution(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error) {
+	return nf.txSimulatorProc.ProcessBundle(txs, withTrace)
 }
 
 // GetTransaction gets the transaction with a specified hash
@@ -333,8 +373,8 @@ func (nf *nodeFacade) GetTransactionsPoolNonceGapsForSender(sender string) (*com
 }
 
 // ComputeTransactionGasLimit will estimate how many gas a transaction will consume
-func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return nf.apiResolver.ComputeTransactionGasLimit(tx)
+func (nf *nodeFacade) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
+	return nf.apiResolver.ComputeTransactionGasLimit(tx, stateOverrides)
 }
 
 // GetAccount returns a response containing information about the account correlated with provided address
@@ -419,14 +459,14 @@ func (nf *nodeFacade) GetDelegatorsList() ([]*apiData.Delegator, error) {
 	return nf.apiResolver.GetDelegatorsList(ctx)
 }
 
-// ExecuteSCQuery retrieves data from existing SC trie
-func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, error) {
-	vmOutput, err := nf.apiResolver.ExecuteSCQuery(query)
+// ExecuteSCQuery retrieves data from existing SC trie, together with the call trace if the query requested it
+func (nf *nodeFacade) ExecuteSCQuery(query *process.SCQuery) (*vm.VMOutputApi, *common.CallTrace, error) {
+	vmOutput, callTrace, err := nf.apiResolver.ExecuteSCQuery(query)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return nf.convertVmOutputToApiResponse(vmOutput), nil
+	return nf.convertVmOutputToApiResponse(vmOutput), callTrace, nil
 }
 
 // PprofEnabled returns if profiling mode should be active or not on the application
@@ -465,7 +505,7 @@ func (nf *nodeFacade) GetEpochStartDataAPI(epoch uint32) (*common.EpochStartData
 }
 
 // GetPeerInfo returns the peer info of a provided pid
-func (nf *nodeFacade) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
+func (nf *nodeFacade) GetPeerInfo(pid string) ([]common.PeerInfoAPI, error) {
 	return nf.node.GetPeerInfo(pid)
 }
 
@@ -585,6 +625,74 @@ func (nf *nodeFacade) VerifyProof(rootHash string, address string, proof [][]byt
 	return nf.node.VerifyProof(rootHash, address, proof)
 }
 
+// GetMultiProof returns a single Merkle proof for all the given addresses and the given root hash
+func (nf *nodeFacade) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProof(rootHash, addresses)
+}
+
+// GetMultiProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for all the given
+// keys of the account's data trie
+func (nf *nodeFacade) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	return nf.node.GetMultiProofDataTrie(rootHash, address, keys)
+}
+
+// GetRangeProof returns a single Merkle proof for all the accounts placed between the given keys
+func (nf *nodeFacade) GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	return nf.node.GetRangeProof(rootHash, startKey, endKey, maxLeaves)
+}
+
+// GetRangeProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for all the keys of
+// the account's data trie placed between the given keys
+func (nf *nodeFacade) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	return nf.node.GetRangeProofDataTrie(rootHash, address, startKey, endKey, maxLeaves)
+}
+
+// VerifyMultiProof verifies the given multiproof
+func (nf *nodeFacade) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	return nf.node.VerifyMultiProof(rootHash, keys, proof)
+}
+
+// VerifyRangeProof verifies the given range proof
+func (nf *nodeFacade) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	return nf.node.VerifyRangeProof(rootHash, startKey, endKey, proof)
+}
+
+// GetStorageStats returns the statistics of the node's storage units
+func (nf *nodeFacade) GetStorageStats() ([]*common.StorageUnitStats, error) {
+	return nf.node.GetStorageStats()
+}
+
+// CompactStorageUnit triggers a manual compaction of the storage unit with the given name
+func (nf *nodeFacade) CompactStorageUnit(unitName string) error {
+	return nf.node.CompactStorageUnit(unitName)
+}
+
+// VerifyTrieIntegrity verifies the accounts trie with the given root hash and optionally repairs its damaged nodes
+// by requesting them from the peers
+func (nf *nodeFacade) VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+	return nf.node.VerifyTrieIntegrity(rootHash, repair)
+}
+
+// GetTrieSnapshotsStatus returns the status of the snapshots and checkpoints of the node's tries
+func (nf *nodeFacade) GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error) {
+	return nf.node.GetTrieSnapshotsStatus()
+}
+
+// ControlTrieSnapshots pauses, resumes or cancels the snapshots and checkpoints of the node's tries
+func (nf *nodeFacade) ControlTrieSnapshots(trieName string, action string) error {
+	return nf.node.ControlTrieSnapshots(trieName, action)
+}
+
+// GetConsensusRounds returns the timeline of the last consensus rounds, as recorded by the node
+func (nf *nodeFacade) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	return nf.node.GetConsensusRounds()
+}
+
+// GetNetworkTopology returns the network topology snapshot, as seen by the node
+func (nf *nodeFacade) GetNetworkTopology() (*data.NetworkTopology, error) {
+	return nf.node.GetNetworkTopology()
+}
+
 func (nf *nodeFacade) convertVmOutputToApiResponse(input *vmcommon.VMOutput) *vm.VMOutputApi {
 	outputAccounts := make(map[string]*vm.OutputAccountApi)
 	for key, acc := range input.OutputAccounts {
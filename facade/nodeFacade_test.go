@@ -27,6 +27,7 @@ import (
 	"github.com/multiversx/mx-chain-go/node/external"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
@@ -449,15 +450,15 @@ func TestNodeFacade_GetDataValue(t *testing.T) {
 	wasCalled := false
 	arg := createMockArguments()
 	arg.ApiResolver = &mock.ApiResolverStub{
-		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteSCQueryHandler: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			wasCalled = true
-			return &vmcommon.VMOutput{}, nil
+			return &vmcommon.VMOutput{}, nil, nil
 		},
 	}
 	nf, err := NewNodeFacade(arg)
 	require.NoError(t, err)
 
-	_, _ = nf.ExecuteSCQuery(nil)
+	_, _, _ = nf.ExecuteSCQuery(nil)
 	assert.True(t, wasCalled)
 }
 
@@ -671,13 +672,15 @@ func TestNodeFacade_GetQueryHandler(t *testing.T) {
 func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	t.Parallel()
 
-	pinfo := core.QueryP2PPeerInfo{
-		Pid: "pid",
+	pinfo := common.PeerInfoAPI{
+		QueryP2PPeerInfo: core.QueryP2PPeerInfo{
+			Pid: "pid",
+		},
 	}
 	arg := createMockArguments()
 	arg.Node = &mock.NodeStub{
-		GetPeerInfoCalled: func(pid string) ([]core.QueryP2PPeerInfo, error) {
-			return []core.QueryP2PPeerInfo{pinfo}, nil
+		GetPeerInfoCalled: func(pid string) ([]common.PeerInfoAPI, error) {
+			return []common.PeerInfoAPI{pinfo}, nil
 		},
 	}
 	nf, _ := NewNodeFacade(arg)
@@ -685,7 +688,7 @@ func TestNodeFacade_GetPeerInfo(t *testing.T) {
 	val, err := nf.GetPeerInfo("")
 
 	assert.Nil(t, err)
-	assert.Equal(t, []core.QueryP2PPeerInfo{pinfo}, val)
+	assert.Equal(t, []common.PeerInfoAPI{pinfo}, val)
 }
 
 func TestNodeFacade_GetThrottlerForEndpointNoConfigShouldReturnNilAndFalse(t *testing.T) {
@@ -758,6 +761,80 @@ func TestNodeFacade_GetKeyValuePairs(t *testing.T) {
 	assert.Equal(t, expectedPairs, res)
 }
 
+func TestNodeFacade_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	expectedPairs := []*common.KeyValuePairAPI{{Key: "6b", Value: "76"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetKeyValuePairsPageCalled: func(address string, _ api.AccountQueryOptions, fromKey string, limit int, ctx context.Context) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "6b", fromKey)
+			assert.Equal(t, 10, limit)
+			assert.NotNil(t, ctx)
+			return expectedPairs, "6c", api.BlockInfo{}, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, nextCursor, _, err := nf.GetKeyValuePairsPage("addr", api.AccountQueryOptions{}, "6b", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPairs, res)
+	assert.Equal(t, "6c", nextCursor)
+}
+
+func TestNodeFacade_GetAccountStoragePage(t *testing.T) {
+	t.Parallel()
+
+	expectedPage := &common.AccountStoragePage{
+		Pairs:             []*common.KeyValuePairAPI{{Key: "6b", Value: "76"}},
+		ContinuationToken: "next",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetAccountStoragePageCalled: func(address string, _ api.AccountQueryOptions, continuationToken string, pageSize int, ctx context.Context) (*common.AccountStoragePage, api.BlockInfo, error) {
+			assert.Equal(t, "addr", address)
+			assert.Equal(t, "token", continuationToken)
+			assert.Equal(t, 10, pageSize)
+			assert.NotNil(t, ctx)
+			return expectedPage, api.BlockInfo{}, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, _, err := nf.GetAccountStoragePage("addr", api.AccountQueryOptions{}, "token", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedPage, res)
+}
+
+func TestNodeFacade_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	expectedDiff := &common.StateDiffAPI{
+		Accounts:    []*common.AccountDiffAPI{{Address: "addr", OldBalance: "1", NewBalance: "2"}},
+		NextAddress: "next",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStateDiffCalled: func(fromRootHash string, toRootHash string, fromAddress string, limit int, ctx context.Context) (*common.StateDiffAPI, error) {
+			assert.Equal(t, "aa", fromRootHash)
+			assert.Equal(t, "bb", toRootHash)
+			assert.Equal(t, "addr", fromAddress)
+			assert.Equal(t, 10, limit)
+			assert.NotNil(t, ctx)
+			return expectedDiff, nil
+		},
+	}
+
+	nf, _ := NewNodeFacade(arg)
+
+	res, err := nf.GetStateDiff("aa", "bb", "addr", 10)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDiff, res)
+}
+
 func TestNodeFacade_GetGuardianData(t *testing.T) {
 	t.Parallel()
 	arg := createMockArguments()
@@ -1105,6 +1182,257 @@ func TestNodeFacade_VerifyProof(t *testing.T) {
 	assert.True(t, response)
 }
 
+func TestNodeFacade_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.GetMultiProofResponse{
+		Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+		RootHash: "rootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofCalled: func(_ string, _ []string) (*common.GetMultiProofResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetMultiProof("hash", []string{"addr1", "addr2"})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	expectedResponseMainTrie := &common.GetProofResponse{
+		Proof:    [][]byte{[]byte("mainTrie")},
+		RootHash: "rootHash",
+	}
+	expectedResponseDataTrie := &common.GetMultiProofResponse{
+		Proof:    [][]byte{[]byte("dataTrie")},
+		RootHash: "dataTrieRootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetMultiProofDataTrieCalled: func(_ string, _ string, _ []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+			return expectedResponseMainTrie, expectedResponseDataTrie, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	mainTrieResponse, dataTrieResponse, err := nf.GetMultiProofDataTrie("hash", "addr", []string{"key"})
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponseMainTrie, mainTrieResponse)
+	assert.Equal(t, expectedResponseDataTrie, dataTrieResponse)
+}
+
+func TestNodeFacade_GetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	expectedResponse := &common.GetRangeProofResponse{
+		Proof:    [][]byte{[]byte("valid"), []byte("proof")},
+		EndKey:   []byte("end"),
+		RootHash: "rootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetRangeProofCalled: func(_ string, _ string, _ string, _ int) (*common.GetRangeProofResponse, error) {
+			return expectedResponse, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.GetRangeProof("hash", "start", "end", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponse, response)
+}
+
+func TestNodeFacade_GetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	expectedResponseMainTrie := &common.GetProofResponse{
+		Proof:    [][]byte{[]byte("mainTrie")},
+		RootHash: "rootHash",
+	}
+	expectedResponseDataTrie := &common.GetRangeProofResponse{
+		Proof:    [][]byte{[]byte("dataTrie")},
+		RootHash: "dataTrieRootHash",
+	}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetRangeProofDataTrieCalled: func(_ string, _ string, _ string, _ string, _ int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+			return expectedResponseMainTrie, expectedResponseDataTrie, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	mainTrieResponse, dataTrieResponse, err := nf.GetRangeProofDataTrie("hash", "addr", "start", "end", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedResponseMainTrie, mainTrieResponse)
+	assert.Equal(t, expectedResponseDataTrie, dataTrieResponse)
+}
+
+func TestNodeFacade_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyMultiProofCalled: func(_ string, _ []string, _ [][]byte) (*common.VerifyProofsResponse, error) {
+			return &common.VerifyProofsResponse{Ok: true}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.VerifyMultiProof("hash", []string{"addr"}, [][]byte{[]byte("proof")})
+	assert.Nil(t, err)
+	assert.True(t, response.Ok)
+}
+
+func TestNodeFacade_VerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyRangeProofCalled: func(_ string, _ string, _ string, _ [][]byte) (*common.VerifyProofsResponse, error) {
+			return &common.VerifyProofsResponse{Ok: true}, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	response, err := nf.VerifyRangeProof("hash", "start", "end", [][]byte{[]byte("proof")})
+	assert.Nil(t, err)
+	assert.True(t, response.Ok)
+}
+
+func TestNodeFacade_GetStorageStats(t *testing.T) {
+	t.Parallel()
+
+	expectedStats := []*common.StorageUnitStats{{Unit: "TransactionUnit"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetStorageStatsCalled: func() ([]*common.StorageUnitStats, error) {
+			return expectedStats, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	stats, err := nf.GetStorageStats()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStats, stats)
+}
+
+func TestNodeFacade_CompactStorageUnit(t *testing.T) {
+	t.Parallel()
+
+	compactedUnit := ""
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		CompactStorageUnitCalled: func(unitName string) error {
+			compactedUnit = unitName
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.CompactStorageUnit("TransactionUnit")
+	assert.Nil(t, err)
+	assert.Equal(t, "TransactionUnit", compactedUnit)
+}
+
+func TestNodeFacade_VerifyTrieIntegrity(t *testing.T) {
+	t.Parallel()
+
+	expectedReport := &trieIntegrity.Report{RootHash: "aabb", NumNodes: 10}
+	providedRootHash := ""
+	providedRepair := false
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		VerifyTrieIntegrityCalled: func(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+			providedRootHash = rootHash
+			providedRepair = repair
+			return expectedReport, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	report, err := nf.VerifyTrieIntegrity("aabb", true)
+	assert.Nil(t, err)
+	assert.Equal(t, expectedReport, report)
+	assert.Equal(t, "aabb", providedRootHash)
+	assert.True(t, providedRepair)
+}
+
+func TestNodeFacade_GetTrieSnapshotsStatus(t *testing.T) {
+	t.Parallel()
+
+	expectedStatus := map[string]*common.TrieSnapshotsStatus{"0": {NumRunningSnapshots: 1}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetTrieSnapshotsStatusCalled: func() (map[string]*common.TrieSnapshotsStatus, error) {
+			return expectedStatus, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	status, err := nf.GetTrieSnapshotsStatus()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedStatus, status)
+}
+
+func TestNodeFacade_ControlTrieSnapshots(t *testing.T) {
+	t.Parallel()
+
+	providedTrie, providedAction := "", ""
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		ControlTrieSnapshotsCalled: func(trieName string, action string) error {
+			providedTrie, providedAction = trieName, action
+			return nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	err := nf.ControlTrieSnapshots("0", common.TrieSnapshotsPauseAction)
+	assert.Nil(t, err)
+	assert.Equal(t, "0", providedTrie)
+	assert.Equal(t, common.TrieSnapshotsPauseAction, providedAction)
+}
+
+func TestNodeFacade_GetConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	expectedRounds := []*common.ConsensusRoundTimeline{{Round: 37}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetConsensusRoundsCalled: func() ([]*common.ConsensusRoundTimeline, error) {
+			return expectedRounds, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	rounds, err := nf.GetConsensusRounds()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedRounds, rounds)
+}
+
+func TestNodeFacade_GetNetworkTopology(t *testing.T) {
+	t.Parallel()
+
+	expectedTopology := &data.NetworkTopology{Observers: []string{"pid"}}
+	arg := createMockArguments()
+	arg.Node = &mock.NodeStub{
+		GetNetworkTopologyCalled: func() (*data.NetworkTopology, error) {
+			return expectedTopology, nil
+		},
+	}
+	nf, _ := NewNodeFacade(arg)
+
+	topology, err := nf.GetNetworkTopology()
+	assert.Nil(t, err)
+	assert.Equal(t, expectedTopology, topology)
+}
+
 func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 	t.Parallel()
 
@@ -1123,18 +1451,20 @@ func TestNodeFacade_ExecuteSCQuery(t *testing.T) {
 			},
 		},
 	}
+	expectedTrace := &common.CallTrace{GasUsed: 10}
 	arg.ApiResolver = &mock.ApiResolverStub{
-		ExecuteSCQueryHandler: func(_ *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteSCQueryHandler: func(_ *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			executeScQueryHandlerWasCalled = true
-			return expectedVmOutput, nil
+			return expectedVmOutput, expectedTrace, nil
 		},
 	}
 
 	nf, _ := NewNodeFacade(arg)
 
-	apiVmOutput, err := nf.ExecuteSCQuery(&process.SCQuery{})
+	apiVmOutput, callTrace, err := nf.ExecuteSCQuery(&process.SCQuery{})
 	require.NoError(t, err)
 	require.True(t, executeScQueryHandlerWasCalled)
+	require.True(t, expectedTrace == callTrace)
 	require.Equal(t, expectedVmOutput.ReturnData, apiVmOutput.ReturnData)
 	require.Equal(t, expectedVmOutput.ReturnCode.String(), apiVmOutput.ReturnCode)
 	require.Equal(t, 1, len(apiVmOutput.OutputAccounts))
@@ -29,7 +29,9 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/trace"
 	"github.com/multiversx/mx-chain-go/process/transaction"
+	"github.com/multiversx/mx-chain-go/process/txsimulator"
 	"github.com/multiversx/mx-chain-go/process/txstatus"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state"
@@ -337,10 +339,19 @@ func createScQueryElement(
 		return nil, errDecode
 	}
 
+	queryAccountsDB, err := txsimulator.NewReadOnlyAccountsDBWithHistory(
+		args.stateComponents.AccountsAdapterAPI(),
+		args.stateComponents.AccountsRepository(),
+		args.coreComponents.Hasher(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	builtInFuncFactory, err := createBuiltinFuncs(
 		args.gasScheduleNotifier,
 		args.coreComponents.InternalMarshalizer(),
-		args.stateComponents.AccountsAdapterAPI(),
+		queryAccountsDB,
 		args.processComponents.ShardCoordinator(),
 		args.coreComponents.EpochNotifier(),
 		args.coreComponents.EnableEpochsHandler(),
@@ -361,7 +372,7 @@ func createScQueryElement(
 	scStorage := args.generalConfig.SmartContractsStorageForSCQuery
 	scStorage.DB.FilePath += fmt.Sprintf("%d", args.index)
 	argsHook := hooks.ArgBlockChainHook{
-		Accounts:              args.stateComponents.AccountsAdapterAPI(),
+		Accounts:              queryAccountsDB,
 		PubkeyConv:            args.coreComponents.AddressPubKeyConverter(),
 		StorageService:        args.dataComponents.StorageService(),
 		BlockChain:            args.dataComponents.Blockchain(),
@@ -457,6 +468,32 @@ func createScQueryElement(
 		return nil, err
 	}
 
+	callTraceBuilder, err := trace.NewCallTraceBuilder(trace.ArgsCallTraceBuilder{
+		PubkeyConverter: pkConverter,
+		BlockChainHook:  vmFactory.BlockChainHookImpl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var historicalStateHandler process.HistoricalStateHandler
+	var blockRootHashResolver process.BlockRootHashResolver
+	keepsFullStateHistory := !args.generalConfig.StateTriesConfig.AccountsStatePruningEnabled
+	if keepsFullStateHistory {
+		historicalStateHandler = queryAccountsDB
+		blockRootHashResolver, err = smartContract.NewBlockRootHashResolver(smartContract.ArgsBlockRootHashResolver{
+			StorageService:               args.dataComponents.StorageService(),
+			Marshaller:                   args.coreComponents.InternalMarshalizer(),
+			Uint64ByteSliceConverter:     args.coreComponents.Uint64ByteSliceConverter(),
+			ShardCoordinator:             args.processComponents.ShardCoordinator(),
+			ScheduledTxsExecutionHandler: args.processComponents.ScheduledTxsExecutionHandler(),
+			HistoryRepository:            args.processComponents.HistoryRepository(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	argsNewSCQueryService := smartContract.ArgsNewSCQueryService{
 		VmContainer:              vmContainer,
 		EconomicsFee:             args.coreComponents.EconomicsData(),
@@ -466,6 +503,10 @@ func createScQueryElement(
 		Bootstrapper:             args.bootstrapper,
 		AllowExternalQueriesChan: args.allowVMQueriesChan,
 		MaxGasLimitPerQuery:      maxGasForVmQueries,
+		StateOverridesHandler:    queryAccountsDB,
+		CallTraceBuilder:         callTraceBuilder,
+		HistoricalStateHandler:   historicalStateHandler,
+		BlockRootHashResolver:    blockRootHashResolver,
 	}
 
 	return smartContract.NewSCQueryService(argsNewSCQueryService)
@@ -313,6 +313,9 @@ func createMockSCQueryElementArgs() api.SCQueryElementArgs {
 			PeerAccountsCalled: func() state.AccountsAdapter {
 				return &stateMocks.AccountsStub{}
 			},
+			AccountsRepositoryCalled: func() state.AccountsRepository {
+				return &stateMocks.AccountsRepositoryStub{}
+			},
 		},
 		DataComponents: &mock.DataComponentsMock{
 			Storage:  &genericMocks.ChainStorerMock{},
@@ -1,6 +1,7 @@
 package consensus
 
 import (
+	"path/filepath"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -14,8 +15,11 @@ import (
 	"github.com/multiversx/mx-chain-go/consensus"
 	"github.com/multiversx/mx-chain-go/consensus/chronology"
 	"github.com/multiversx/mx-chain-go/consensus/signing"
+	"github.com/multiversx/mx-chain-go/consensus/slashingProtection"
+	disabledSlashingProtection "github.com/multiversx/mx-chain-go/consensus/slashingProtection/disabled"
 	"github.com/multiversx/mx-chain-go/consensus/spos"
 	"github.com/multiversx/mx-chain-go/consensus/spos/sposFactory"
+	debugFactory "github.com/multiversx/mx-chain-go/debug/factory"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/process"
@@ -23,6 +27,8 @@ import (
 	"github.com/multiversx/mx-chain-go/process/sync/storageBootstrap"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/state/syncer"
+	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
 	"github.com/multiversx/mx-chain-go/trie/statistics"
 	"github.com/multiversx/mx-chain-go/trie/storageMarker"
@@ -47,6 +53,7 @@ type ConsensusComponentsFactoryArgs struct {
 	ScheduledProcessor    consensus.ScheduledProcessor
 	IsInImportMode        bool
 	ShouldDisableWatchdog bool
+	WorkingDir            string
 }
 
 type consensusComponentsFactory struct {
@@ -63,6 +70,7 @@ type consensusComponentsFactory struct {
 	scheduledProcessor    consensus.ScheduledProcessor
 	isInImportMode        bool
 	shouldDisableWatchdog bool
+	workingDir            string
 }
 
 type consensusComponents struct {
@@ -70,6 +78,8 @@ type consensusComponents struct {
 	bootstrapper       process.Bootstrapper
 	broadcastMessenger consensus.BroadcastMessenger
 	worker             factory.ConsensusWorker
+	slashingProtector  consensus.SlashingProtector
+	roundsTimeline     consensus.RoundsTimelineRecorder
 	consensusTopic     string
 	consensusGroupSize int
 }
@@ -118,6 +128,7 @@ func NewConsensusComponentsFactory(args ConsensusComponentsFactoryArgs) (*consen
 		scheduledProcessor:    args.ScheduledProcessor,
 		isInImportMode:        args.IsInImportMode,
 		shouldDisableWatchdog: args.ShouldDisableWatchdog,
+		workingDir:            args.WorkingDir,
 	}, nil
 }
 
@@ -183,6 +194,15 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.roundsTimeline, err = debugFactory.CreateConsensusRoundsTimeline(
+		ccf.config.Debug.ConsensusRounds,
+		ccf.workingDir,
+		ccf.coreComponents.SyncTimer(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	marshalizer := ccf.coreComponents.InternalMarshalizer()
 	sizeCheckDelta := ccf.config.Marshalizer.SizeCheckDelta
 	if sizeCheckDelta > 0 {
@@ -214,6 +234,7 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		PublicKeySize:            ccf.config.ValidatorPubkeyConverter.Length,
 		AppStatusHandler:         ccf.statusCoreComponents.AppStatusHandler(),
 		NodeRedundancyHandler:    ccf.processComponents.NodeRedundancyHandler(),
+		RoundsTimelineRecorder:   cc.roundsTimeline,
 	}
 
 	cc.worker, err = spos.NewWorker(workerArgs)
@@ -239,6 +260,11 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		return nil, err
 	}
 
+	cc.slashingProtector, err = ccf.createSlashingProtector()
+	if err != nil {
+		return nil, err
+	}
+
 	consensusArgs := &spos.ConsensusCoreArgs{
 		BlockChain:                    ccf.dataComponents.Blockchain(),
 		BlockProcessor:                ccf.processComponents.BlockProcessor(),
@@ -262,6 +288,8 @@ func (ccf *consensusComponentsFactory) Create() (*consensusComponents, error) {
 		NodeRedundancyHandler:         ccf.processComponents.NodeRedundancyHandler(),
 		ScheduledProcessor:            ccf.scheduledProcessor,
 		SignatureHandler:              signatureHandler,
+		SlashingProtector:             cc.slashingProtector,
+		RoundsTimelineRecorder:        cc.roundsTimeline,
 	}
 
 	consensusDataContainer, err := spos.NewConsensusCore(
@@ -315,6 +343,14 @@ func (cc *consensusComponents) Close() error {
 	if err != nil {
 		return err
 	}
+	err = cc.slashingProtector.Close()
+	if err != nil {
+		return err
+	}
+	err = cc.roundsTimeline.Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -672,6 +708,29 @@ func (ccf *consensusComponentsFactory) createBlsSignatureHandler() (consensus.Si
 	return signing.NewSignatureHolder(signatureHolderArgs)
 }
 
+func (ccf *consensusComponentsFactory) createSlashingProtector() (consensus.SlashingProtector, error) {
+	slashingProtectionConfig := ccf.config.SlashingProtection
+	if !slashingProtectionConfig.Enabled {
+		log.Debug("slashing protection is disabled")
+		return disabledSlashingProtection.NewSlashingProtector(), nil
+	}
+
+	// the records are kept outside the db directory, so that they survive a storage cleanup
+	dbConfig := storageFactory.GetDBFromConfig(slashingProtectionConfig.StorageConfig.DB)
+	dbConfig.FilePath = filepath.Join(ccf.workingDir, slashingProtectionConfig.StorageConfig.DB.FilePath)
+	storer, err := storageunit.NewStorageUnitFromConf(
+		storageFactory.GetCacherFromConfig(slashingProtectionConfig.StorageConfig.Cache),
+		dbConfig,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return slashingProtection.NewSlashingProtectionDB(slashingProtection.ArgsSlashingProtectionDB{
+		Storer: storer,
+	})
+}
+
 func (ccf *consensusComponentsFactory) addCloserInstances(closers ...update.Closer) error {
 	hardforkTrigger := ccf.processComponents.HardforkTrigger()
 	for _, c := range closers {
@@ -130,6 +130,9 @@ func (mcc *managedConsensusComponents) CheckSubcomponents() error {
 	if check.IfNil(mcc.broadcastMessenger) {
 		return errors.ErrNilBroadcastMessenger
 	}
+	if check.IfNil(mcc.roundsTimeline) {
+		return errors.ErrNilRoundsTimelineRecorder
+	}
 
 	return nil
 }
@@ -146,6 +149,18 @@ func (mcc *managedConsensusComponents) Bootstrapper() process.Bootstrapper {
 	return mcc.consensusComponents.bootstrapper
 }
 
+// RoundsTimelineRecorder returns the consensus rounds timeline recorder
+func (mcc *managedConsensusComponents) RoundsTimelineRecorder() consensus.RoundsTimelineRecorder {
+	mcc.mutConsensusComponents.RLock()
+	defer mcc.mutConsensusComponents.RUnlock()
+
+	if mcc.consensusComponents == nil {
+		return nil
+	}
+
+	return mcc.consensusComponents.roundsTimeline
+}
+
 // IsInterfaceNil returns true if the underlying object is nil
 func (mcc *managedConsensusComponents) IsInterfaceNil() bool {
 	return mcc == nil
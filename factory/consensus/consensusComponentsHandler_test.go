@@ -44,6 +44,7 @@ func TestManagedConsensusComponents_CreateShouldWork(t *testing.T) {
 	require.Nil(t, managedConsensusComponents.BroadcastMessenger())
 	require.Nil(t, managedConsensusComponents.Chronology())
 	require.Nil(t, managedConsensusComponents.ConsensusWorker())
+	require.Nil(t, managedConsensusComponents.RoundsTimelineRecorder())
 	require.Error(t, managedConsensusComponents.CheckSubcomponents())
 
 	err = managedConsensusComponents.Create()
@@ -51,6 +52,7 @@ func TestManagedConsensusComponents_CreateShouldWork(t *testing.T) {
 	require.NotNil(t, managedConsensusComponents.BroadcastMessenger())
 	require.NotNil(t, managedConsensusComponents.Chronology())
 	require.NotNil(t, managedConsensusComponents.ConsensusWorker())
+	require.NotNil(t, managedConsensusComponents.RoundsTimelineRecorder())
 	require.NoError(t, managedConsensusComponents.CheckSubcomponents())
 }
 
@@ -15,6 +15,7 @@ import (
 	"github.com/multiversx/mx-chain-go/heartbeat/processor"
 	"github.com/multiversx/mx-chain-go/heartbeat/sender"
 	"github.com/multiversx/mx-chain-go/heartbeat/status"
+	"github.com/multiversx/mx-chain-go/heartbeat/topology"
 	processFactory "github.com/multiversx/mx-chain-go/process/factory"
 	"github.com/multiversx/mx-chain-go/process/peer"
 	"github.com/multiversx/mx-chain-go/update"
@@ -57,6 +58,7 @@ type heartbeatV2Components struct {
 	monitor                   factory.HeartbeatV2Monitor
 	statusHandler             update.Closer
 	directConnectionProcessor update.Closer
+	topologyProvider          factory.NetworkTopologyProvider
 }
 
 // NewHeartbeatV2ComponentsFactory creates a new instance of heartbeatV2ComponentsFactory
@@ -251,6 +253,17 @@ func (hcf *heartbeatV2ComponentsFactory) Create() (*heartbeatV2Components, error
 		return nil, err
 	}
 
+	argsTopologyProvider := topology.ArgTopologyProvider{
+		HeartbeatMonitor:          heartbeatsMonitor,
+		DirectConnectionsProvider: directConnectionProcessor,
+		SelfPeerID:                hcf.networkComponents.NetworkMessenger().ID(),
+		SelfShardID:               hcf.processComponents.ShardCoordinator().SelfId(),
+	}
+	topologyProvider, err := topology.NewTopologyProvider(argsTopologyProvider)
+	if err != nil {
+		return nil, err
+	}
+
 	argsCrossShardPeerTopicNotifier := monitor.ArgsCrossShardPeerTopicNotifier{
 		ShardCoordinator: hcf.processComponents.ShardCoordinator(),
 		PeerShardMapper:  hcf.processComponents.PeerShardMapper(),
@@ -271,6 +284,7 @@ func (hcf *heartbeatV2ComponentsFactory) Create() (*heartbeatV2Components, error
 		monitor:                   heartbeatsMonitor,
 		statusHandler:             statusHandler,
 		directConnectionProcessor: directConnectionProcessor,
+		topologyProvider:          topologyProvider,
 	}, nil
 }
 
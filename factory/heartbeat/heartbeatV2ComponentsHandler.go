@@ -68,6 +68,14 @@ func (mhc *managedHeartbeatV2Components) Monitor() factory.HeartbeatV2Monitor {
 	return mhc.monitor
 }
 
+// TopologyProvider returns the network topology provider
+func (mhc *managedHeartbeatV2Components) TopologyProvider() factory.NetworkTopologyProvider {
+	mhc.mutHeartbeatV2Components.Lock()
+	defer mhc.mutHeartbeatV2Components.Unlock()
+
+	return mhc.topologyProvider
+}
+
 // Close closes the heartbeat components
 func (mhc *managedHeartbeatV2Components) Close() error {
 	mhc.mutHeartbeatV2Components.Lock()
@@ -65,6 +65,7 @@ type P2PAntifloodHandler interface {
 	SetMaxMessagesForTopic(topic string, maxNum uint32)
 	SetDebugger(debugger process.AntifloodDebugger) error
 	SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error
+	SetPeerReputationHandler(handler process.PeerReputationHandler) error
 	SetTopicsForAll(topics ...string)
 	ApplyConsensusSize(size int)
 	BlacklistPeer(peer core.PeerID, reason string, duration time.Duration)
@@ -242,6 +243,8 @@ type NetworkComponentsHolder interface {
 	PeerHonestyHandler() PeerHonestyHandler
 	PreferredPeersHolderHandler() PreferredPeersHolderHandler
 	PeersRatingHandler() p2p.PeersRatingHandler
+	PeerReputationHandler() process.PeerReputationHandler
+	PeersResponseTracker() dataRetriever.PeersResponseTracker
 	IsInterfaceNil() bool
 }
 
@@ -253,7 +256,8 @@ type NetworkComponentsHandler interface {
 
 // TransactionSimulatorProcessor defines the actions which a transaction simulator processor has to implement
 type TransactionSimulatorProcessor interface {
-	ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTx(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	ProcessBundle(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 	IsInterfaceNil() bool
 }
 
@@ -344,9 +348,16 @@ type HeartbeatV2Monitor interface {
 	IsInterfaceNil() bool
 }
 
+// NetworkTopologyProvider provides the network topology snapshot, as seen by the current node
+type NetworkTopologyProvider interface {
+	GetNetworkTopology() *heartbeatData.NetworkTopology
+	IsInterfaceNil() bool
+}
+
 // HeartbeatV2ComponentsHolder holds the heartbeatV2 components
 type HeartbeatV2ComponentsHolder interface {
 	Monitor() HeartbeatV2Monitor
+	TopologyProvider() NetworkTopologyProvider
 	IsInterfaceNil() bool
 }
 
@@ -404,6 +415,7 @@ type ConsensusComponentsHolder interface {
 	BroadcastMessenger() consensus.BroadcastMessenger
 	ConsensusGroupSize() (int, error)
 	Bootstrapper() process.Bootstrapper
+	RoundsTimelineRecorder() consensus.RoundsTimelineRecorder
 	IsInterfaceNil() bool
 }
 
@@ -4,7 +4,8 @@ import "github.com/multiversx/mx-chain-go/factory"
 
 // HeartbeatV2ComponentsStub -
 type HeartbeatV2ComponentsStub struct {
-	MonitorField factory.HeartbeatV2Monitor
+	MonitorField          factory.HeartbeatV2Monitor
+	TopologyProviderField factory.NetworkTopologyProvider
 }
 
 // Create -
@@ -32,6 +33,11 @@ func (hbc *HeartbeatV2ComponentsStub) Monitor() factory.HeartbeatV2Monitor {
 	return hbc.MonitorField
 }
 
+// TopologyProvider -
+func (hbc *HeartbeatV2ComponentsStub) TopologyProvider() factory.NetworkTopologyProvider {
+	return hbc.TopologyProviderField
+}
+
 // IsInterfaceNil -
 func (hbc *HeartbeatV2ComponentsStub) IsInterfaceNil() bool {
 	return hbc == nil
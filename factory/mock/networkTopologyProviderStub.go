@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-go/heartbeat/data"
+
+// NetworkTopologyProviderStub -
+type NetworkTopologyProviderStub struct {
+	GetNetworkTopologyCalled func() *data.NetworkTopology
+}
+
+// GetNetworkTopology -
+func (stub *NetworkTopologyProviderStub) GetNetworkTopology() *data.NetworkTopology {
+	if stub.GetNetworkTopologyCalled != nil {
+		return stub.GetNetworkTopologyCalled()
+	}
+
+	return &data.NetworkTopology{}
+}
+
+// IsInterfaceNil -
+func (stub *NetworkTopologyProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
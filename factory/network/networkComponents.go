@@ -7,9 +7,12 @@ import (
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/random"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/peersResponseTracker"
 	"github.com/multiversx/mx-chain-go/debug/antiflood"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
@@ -18,6 +21,8 @@ import (
 	p2pFactory "github.com/multiversx/mx-chain-go/p2p/factory"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/rating/peerHonesty"
+	"github.com/multiversx/mx-chain-go/process/rating/peerReputation"
+	antifloodDisabled "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	antifloodFactory "github.com/multiversx/mx-chain-go/process/throttle/antiflood/factory"
 	"github.com/multiversx/mx-chain-go/storage/cache"
 	storageFactory "github.com/multiversx/mx-chain-go/storage/factory"
@@ -67,6 +72,8 @@ type networkComponents struct {
 	peerHonestyHandler     consensus.PeerHonestyHandler
 	peersHolder            factory.PreferredPeersHolderHandler
 	peersRatingHandler     p2p.PeersRatingHandler
+	peerReputationHandler  process.PeerReputationHandler
+	peersResponseTracker   dataRetriever.PeersResponseTracker
 	closeFunc              context.CancelFunc
 }
 
@@ -200,6 +207,27 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		return nil, err
 	}
 
+	var peerReputationHandler process.PeerReputationHandler
+	peerReputationHandler, err = ncf.createPeerReputationHandler(
+		antiFloodComponents.BlacklistHandler,
+		peerHonestyHandler,
+		netMessenger.ID(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = inputAntifloodHandler.SetPeerReputationHandler(peerReputationHandler)
+	if err != nil {
+		return nil, err
+	}
+
+	var responseTracker dataRetriever.PeersResponseTracker
+	responseTracker, err = ncf.createPeersResponseTracker()
+	if err != nil {
+		return nil, err
+	}
+
 	err = netMessenger.Bootstrap()
 	if err != nil {
 		return nil, err
@@ -219,6 +247,8 @@ func (ncf *networkComponentsFactory) Create() (*networkComponents, error) {
 		peerHonestyHandler:     peerHonestyHandler,
 		peersHolder:            ph,
 		peersRatingHandler:     peersRatingHandler,
+		peerReputationHandler:  peerReputationHandler,
+		peersResponseTracker:   responseTracker,
 		closeFunc:              cancelFunc,
 	}, nil
 }
@@ -237,6 +267,43 @@ func (ncf *networkComponentsFactory) createPeerHonestyHandler(
 	return peerHonesty.NewP2pPeerHonesty(ratingConfig.PeerHonesty, pkTimeCache, suCache)
 }
 
+func (ncf *networkComponentsFactory) createPeerReputationHandler(
+	blacklistHandler process.PeerBlackListCacher,
+	peerHonestyHandler consensus.PeerHonestyHandler,
+	selfPid core.PeerID,
+) (process.PeerReputationHandler, error) {
+	if !ncf.mainConfig.PeerReputation.Enabled {
+		return &antifloodDisabled.PeerReputationHandler{}, nil
+	}
+
+	honestyScoreProvider, ok := peerHonestyHandler.(process.PeerHonestyScoreProvider)
+	if !ok {
+		return nil, fmt.Errorf("%w when casting peer honesty handler to PeerHonestyScoreProvider", errors.ErrWrongTypeAssertion)
+	}
+
+	args := peerReputation.ArgPeerReputation{
+		Config:               ncf.mainConfig.PeerReputation,
+		PeerBlacklistCacher:  blacklistHandler,
+		HonestyScoreProvider: honestyScoreProvider,
+		SelfPid:              selfPid,
+	}
+
+	return peerReputation.NewPeerReputation(args)
+}
+
+func (ncf *networkComponentsFactory) createPeersResponseTracker() (dataRetriever.PeersResponseTracker, error) {
+	if !ncf.mainConfig.Resolvers.AdaptiveRouting.Enabled {
+		return peersResponseTracker.NewDisabledPeersResponseTracker(), nil
+	}
+
+	args := peersResponseTracker.ArgPeersResponseTracker{
+		Config:     ncf.mainConfig.Resolvers.AdaptiveRouting,
+		Randomizer: &random.ConcurrentSafeIntRandomizer{},
+	}
+
+	return peersResponseTracker.NewPeersResponseTracker(args)
+}
+
 // Close closes all underlying components that need closing
 func (nc *networkComponents) Close() error {
 	nc.closeFunc()
@@ -253,6 +320,12 @@ func (nc *networkComponents) Close() error {
 	if !check.IfNil(nc.peerHonestyHandler) {
 		log.LogIfError(nc.peerHonestyHandler.Close())
 	}
+	if !check.IfNil(nc.peerReputationHandler) {
+		log.LogIfError(nc.peerReputationHandler.Close())
+	}
+	if !check.IfNil(nc.peersResponseTracker) {
+		log.LogIfError(nc.peersResponseTracker.Close())
+	}
 
 	if nc.netMessenger != nil {
 		log.Debug("calling close on the network messenger instance...")
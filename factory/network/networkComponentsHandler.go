@@ -5,6 +5,7 @@ import (
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
@@ -89,6 +90,12 @@ func (mnc *managedNetworkComponents) CheckSubcomponents() error {
 	if check.IfNil(mnc.peerHonestyHandler) {
 		return errors.ErrNilPeerHonestyHandler
 	}
+	if check.IfNil(mnc.peerReputationHandler) {
+		return errors.ErrNilPeerReputationHandler
+	}
+	if check.IfNil(mnc.peersResponseTracker) {
+		return errors.ErrNilPeersResponseTracker
+	}
 
 	return nil
 }
@@ -189,6 +196,30 @@ func (mnc *managedNetworkComponents) PeersRatingHandler() p2p.PeersRatingHandler
 	return mnc.networkComponents.peersRatingHandler
 }
 
+// PeerReputationHandler returns the peer reputation handler
+func (mnc *managedNetworkComponents) PeerReputationHandler() process.PeerReputationHandler {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.peerReputationHandler
+}
+
+// PeersResponseTracker returns the peers response tracker
+func (mnc *managedNetworkComponents) PeersResponseTracker() dataRetriever.PeersResponseTracker {
+	mnc.mutNetworkComponents.RLock()
+	defer mnc.mutNetworkComponents.RUnlock()
+
+	if mnc.networkComponents == nil {
+		return nil
+	}
+
+	return mnc.networkComponents.peersResponseTracker
+}
+
 // IsInterfaceNil returns true if the value under the interface is nil
 func (mnc *managedNetworkComponents) IsInterfaceNil() bool {
 	return mnc == nil
@@ -38,6 +38,8 @@ func TestManagedNetworkComponents_CreateShouldWork(t *testing.T) {
 	require.Nil(t, managedNetworkComponents.PubKeyCacher())
 	require.Nil(t, managedNetworkComponents.PreferredPeersHolderHandler())
 	require.Nil(t, managedNetworkComponents.PeerHonestyHandler())
+	require.Nil(t, managedNetworkComponents.PeerReputationHandler())
+	require.Nil(t, managedNetworkComponents.PeersResponseTracker())
 
 	err = managedNetworkComponents.Create()
 	require.NoError(t, err)
@@ -48,6 +50,8 @@ func TestManagedNetworkComponents_CreateShouldWork(t *testing.T) {
 	require.NotNil(t, managedNetworkComponents.PubKeyCacher())
 	require.NotNil(t, managedNetworkComponents.PreferredPeersHolderHandler())
 	require.NotNil(t, managedNetworkComponents.PeerHonestyHandler())
+	require.NotNil(t, managedNetworkComponents.PeerReputationHandler())
+	require.NotNil(t, managedNetworkComponents.PeersResponseTracker())
 }
 
 func TestManagedNetworkComponents_CheckSubcomponents(t *testing.T) {
@@ -33,6 +33,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/trace"
 	"github.com/multiversx/mx-chain-go/process/throttle"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/txsimulator"
@@ -953,10 +954,11 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 	wasmVMChangeLocker common.Locker,
 	mapDNSAddresses map[string]struct{},
 ) (process.VirtualMachinesContainerFactory, error) {
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI(), pcf.coreData.Hasher())
 	if err != nil {
 		return nil, err
 	}
+	txSimulatorProcessorArgs.SimulationAccounts = readOnlyAccountsDB
 
 	interimProcFactory, err := shard.NewIntermediateProcessorsContainerFactory(
 		pcf.bootstrapComponents.ShardCoordinator(),
@@ -1042,6 +1044,14 @@ func (pcf *processComponentsFactory) createShardTxSimulatorProcessor(
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
 
+	txSimulatorProcessorArgs.CallTraceBuilder, err = trace.NewCallTraceBuilder(trace.ArgsCallTraceBuilder{
+		PubkeyConverter: pcf.coreData.AddressPubKeyConverter(),
+		BlockChainHook:  vmFactory.BlockChainHookImpl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return vmFactory, nil
 }
 
@@ -1085,10 +1095,11 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	scProcArgs.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
 
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI())
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(pcf.state.AccountsAdapterAPI(), pcf.coreData.Hasher())
 	if err != nil {
 		return nil, err
 	}
+	txSimulatorProcessorArgs.SimulationAccounts = readOnlyAccountsDB
 
 	builtInFuncFactory, err := pcf.createBuiltInFunctionContainer(readOnlyAccountsDB, make(map[string]struct{}))
 	if err != nil {
@@ -1140,6 +1151,14 @@ func (pcf *processComponentsFactory) createMetaTxSimulatorProcessor(
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
 
+	txSimulatorProcessorArgs.CallTraceBuilder, err = trace.NewCallTraceBuilder(trace.ArgsCallTraceBuilder{
+		PubkeyConverter: pcf.coreData.AddressPubKeyConverter(),
+		BlockChainHook:  vmFactory.BlockChainHookImpl(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return vmFactory, nil
 }
 
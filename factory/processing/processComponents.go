@@ -489,6 +489,11 @@ func (pcf *processComponentsFactory) Create() (*processComponents, error) {
 		return nil, err
 	}
 
+	err = pcf.setPeerHandlersOnInterceptors(interceptorsContainer)
+	if err != nil {
+		return nil, err
+	}
+
 	exportFactoryHandler, err := pcf.createExportFactoryHandler(
 		headerValidator,
 		requestHandler,
@@ -1343,6 +1348,8 @@ func (pcf *processComponentsFactory) newShardResolverContainerFactory(
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
 		PeersRatingHandler:          pcf.network.PeersRatingHandler(),
+		PeerReputationHandler:       pcf.network.PeerReputationHandler(),
+		PeersResponseTracker:        pcf.network.PeersResponseTracker(),
 		PayloadValidator:            payloadValidator,
 	}
 	resolversContainerFactory, err := resolverscontainer.NewShardResolversContainerFactory(resolversContainerFactoryArgs)
@@ -1381,6 +1388,8 @@ func (pcf *processComponentsFactory) newMetaResolverContainerFactory(
 		ResolverConfig:              pcf.config.Resolvers,
 		PreferredPeersHolder:        pcf.network.PreferredPeersHolderHandler(),
 		PeersRatingHandler:          pcf.network.PeersRatingHandler(),
+		PeerReputationHandler:       pcf.network.PeerReputationHandler(),
+		PeersResponseTracker:        pcf.network.PeersResponseTracker(),
 		PayloadValidator:            payloadValidator,
 	}
 	resolversContainerFactory, err := resolverscontainer.NewMetaResolversContainerFactory(resolversContainerFactoryArgs)
@@ -1683,9 +1692,38 @@ func (pcf *processComponentsFactory) prepareNetworkShardingCollector() (*network
 		return nil, err
 	}
 
+	err = pcf.network.PeerReputationHandler().SetPeerValidatorMapper(networkShardingCollector)
+	if err != nil {
+		return nil, err
+	}
+
 	return networkShardingCollector, nil
 }
 
+func (pcf *processComponentsFactory) setPeerHandlersOnInterceptors(interceptorsContainer process.InterceptorsContainer) error {
+	peerReputationHandler := pcf.network.PeerReputationHandler()
+	peersResponseTracker := pcf.network.PeersResponseTracker()
+
+	var errFound error
+	interceptorsContainer.Iterate(func(key string, interceptor process.Interceptor) bool {
+		err := interceptor.SetPeerReputationHandler(peerReputationHandler)
+		if err != nil {
+			errFound = fmt.Errorf("%w for interceptor %s", err, key)
+			return false
+		}
+
+		err = interceptor.SetPeersResponseTracker(peersResponseTracker)
+		if err != nil {
+			errFound = fmt.Errorf("%w for interceptor %s", err, key)
+			return false
+		}
+
+		return true
+	})
+
+	return errFound
+}
+
 func (pcf *processComponentsFactory) createExportFactoryHandler(
 	headerValidator epochStart.HeaderValidator,
 	requestHandler process.RequestHandler,
@@ -21,6 +21,7 @@ import (
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/outport"
 	outportDriverFactory "github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/filedriver"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
@@ -214,6 +215,7 @@ func (scf *statusComponentsFactory) createOutportDriver() (outport.OutportHandle
 			Enabled:                                 scf.externalConfig.WebSocketConnector.Enabled,
 			OutportDriverWebSocketSenderFactoryArgs: webSocketSenderDriverFactoryArgs,
 		},
+		FileDriverFactoryArgs: scf.makeFileDriverArgs(),
 	}
 
 	return outportDriverFactory.CreateOutport(outportFactoryArgs)
@@ -241,15 +243,30 @@ func (scf *statusComponentsFactory) makeElasticIndexerArgs() indexerFactory.Args
 func (scf *statusComponentsFactory) makeEventNotifierArgs() *outportDriverFactory.EventNotifierFactoryArgs {
 	eventNotifierConfig := scf.externalConfig.EventNotifierConnector
 	return &outportDriverFactory.EventNotifierFactoryArgs{
-		Enabled:           eventNotifierConfig.Enabled,
-		UseAuthorization:  eventNotifierConfig.UseAuthorization,
-		ProxyUrl:          eventNotifierConfig.ProxyUrl,
-		Username:          eventNotifierConfig.Username,
-		Password:          eventNotifierConfig.Password,
-		RequestTimeoutSec: eventNotifierConfig.RequestTimeoutSec,
-		Marshaller:        scf.coreComponents.InternalMarshalizer(),
-		Hasher:            scf.coreComponents.Hasher(),
-		PubKeyConverter:   scf.coreComponents.AddressPubKeyConverter(),
+		Enabled:                        eventNotifierConfig.Enabled,
+		UseAuthorization:               eventNotifierConfig.UseAuthorization,
+		ProxyUrl:                       eventNotifierConfig.ProxyUrl,
+		Username:                       eventNotifierConfig.Username,
+		Password:                       eventNotifierConfig.Password,
+		RequestTimeoutSec:              eventNotifierConfig.RequestTimeoutSec,
+		QueueDirectory:                 eventNotifierConfig.QueueDirectory,
+		MinRetryIntervalInMilliseconds: eventNotifierConfig.MinRetryIntervalInMilliseconds,
+		MaxRetryIntervalInMilliseconds: eventNotifierConfig.MaxRetryIntervalInMilliseconds,
+		Marshaller:                     scf.coreComponents.InternalMarshalizer(),
+		Hasher:                         scf.coreComponents.Hasher(),
+		PubKeyConverter:                scf.coreComponents.AddressPubKeyConverter(),
+	}
+}
+
+func (scf *statusComponentsFactory) makeFileDriverArgs() outportDriverFactory.FileDriverFactoryArgs {
+	fileDriverConfig := scf.externalConfig.FileDriverConnector
+	return outportDriverFactory.FileDriverFactoryArgs{
+		Enabled: fileDriverConfig.Enabled,
+		ArgsFileDriver: filedriver.ArgsFileDriver{
+			Directory:       fileDriverConfig.Directory,
+			Format:          fileDriverConfig.Format,
+			MaxFileSizeInMB: fileDriverConfig.MaxFileSizeInMB,
+		},
 	}
 }
 
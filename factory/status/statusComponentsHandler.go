@@ -23,6 +23,7 @@ import (
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/storage"
 )
 
 var _ factory.ComponentHandler = (*managedStatusComponents)(nil)
@@ -130,6 +131,11 @@ func (msc *managedStatusComponents) StartPolling() error {
 		return err
 	}
 
+	err = msc.startStorageStatisticsPolling(ctx)
+	if err != nil {
+		return err
+	}
+
 	msc.attachEpochGoRoutineAnalyser()
 
 	return nil
@@ -408,6 +414,83 @@ func registerCpuStatistics(ctx context.Context, appStatusPollingHandler *appStat
 	})
 }
 
+func (msc *managedStatusComponents) startStorageStatisticsPolling(ctx context.Context) error {
+	pollingIntervalSec := msc.statusComponentsFactory.config.GeneralSettings.StorageStatsPollingIntervalSec
+	if pollingIntervalSec <= 0 {
+		log.Debug("storage statistics polling is disabled")
+		return nil
+	}
+
+	appStatusPollingHandler, err := appStatusPolling.NewAppStatusPolling(
+		msc.statusComponentsFactory.statusCoreComponents.AppStatusHandler(),
+		time.Duration(pollingIntervalSec)*time.Second,
+		log,
+	)
+	if err != nil {
+		return fmt.Errorf("%w, cannot init AppStatusPolling", err)
+	}
+
+	err = registerStorageStatistics(appStatusPollingHandler, msc.statusComponentsFactory.dataComponents)
+	if err != nil {
+		return err
+	}
+
+	appStatusPollingHandler.Poll(ctx)
+
+	return nil
+}
+
+func registerStorageStatistics(
+	appStatusPollingHandler *appStatusPolling.AppStatusPolling,
+	dataComponents factory.DataComponentsHolder,
+) error {
+	if check.IfNil(dataComponents) {
+		return errors.ErrNilDataComponentsHolder
+	}
+
+	return appStatusPollingHandler.RegisterPollingFunc(func(appStatusHandler core.AppStatusHandler) {
+		storageService := dataComponents.StorageService()
+		if check.IfNil(storageService) {
+			return
+		}
+
+		for unitType, storer := range storageService.GetAllStorers() {
+			statsProvider, ok := storer.(storage.PersistersStatsProvider)
+			if !ok {
+				continue
+			}
+
+			setStorageUnitMetrics(appStatusHandler, unitType.String(), statsProvider.GetPersistersStats())
+		}
+	})
+}
+
+// setStorageUnitMetrics sets the metrics of a storage unit, summed over all its active persisters
+func setStorageUnitMetrics(appStatusHandler core.AppStatusHandler, unitName string, persistersStats []*common.PersisterStats) {
+	sizeInBytes, numFiles := uint64(0), uint64(0)
+	numCompactions, compactionDebt := uint64(0), uint64(0)
+	blockCacheHits, blockCacheMisses := uint64(0), uint64(0)
+	for _, stats := range persistersStats {
+		sizeInBytes += stats.SizeInBytes
+		numFiles += stats.NumFiles
+		if stats.Engine == nil {
+			continue
+		}
+
+		numCompactions += stats.Engine.NumCompactions
+		compactionDebt += stats.Engine.CompactionDebtInBytes
+		blockCacheHits += stats.Engine.BlockCacheHits
+		blockCacheMisses += stats.Engine.BlockCacheMisses
+	}
+
+	appStatusHandler.SetUInt64Value(common.MetricStorageSizeInBytesPrefix+unitName, sizeInBytes)
+	appStatusHandler.SetUInt64Value(common.MetricStorageNumFilesPrefix+unitName, numFiles)
+	appStatusHandler.SetUInt64Value(common.MetricStorageNumCompactionsPrefix+unitName, numCompactions)
+	appStatusHandler.SetUInt64Value(common.MetricStorageCompactionDebtPrefix+unitName, compactionDebt)
+	appStatusHandler.SetUInt64Value(common.MetricStorageBlockCacheHitsPrefix+unitName, blockCacheHits)
+	appStatusHandler.SetUInt64Value(common.MetricStorageBlockCacheMissesPrefix+unitName, blockCacheMisses)
+}
+
 // String returns the name of the component
 func (msc *managedStatusComponents) String() string {
 	return factory.StatusComponentsName
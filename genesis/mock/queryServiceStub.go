@@ -2,6 +2,7 @@ package mock
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -9,7 +10,7 @@ import (
 // QueryServiceStub -
 type QueryServiceStub struct {
 	ComputeScCallGasLimitCalled func(tx *transaction.Transaction) (uint64, error)
-	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryCalled          func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
 	CloseCalled                 func() error
 }
 
@@ -23,12 +24,12 @@ func (qss *QueryServiceStub) ComputeScCallGasLimit(tx *transaction.Transaction)
 }
 
 // ExecuteQuery -
-func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+func (qss *QueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	if qss.ExecuteQueryCalled != nil {
 		return qss.ExecuteQueryCalled(query)
 	}
 
-	return &vmcommon.VMOutput{}, nil
+	return &vmcommon.VMOutput{}, nil, nil
 }
 
 // Close -
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/genesis"
 	"github.com/multiversx/mx-chain-go/genesis/data"
 	"github.com/multiversx/mx-chain-go/genesis/mock"
@@ -198,10 +199,10 @@ func TestDeployProcessor_DeployShouldWork(t *testing.T) {
 		},
 	}
 	arg.QueryService = &mock.QueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			return &vmcommon.VMOutput{
 				ReturnData: [][]byte{[]byte(version)},
-			}, nil
+			}, nil, nil
 		},
 	}
 	dp, _ := NewDeployProcessor(arg)
@@ -0,0 +1,33 @@
+package data
+
+import (
+	"time"
+)
+
+// NetworkTopology holds a snapshot of the network, as seen by one or more nodes
+type NetworkTopology struct {
+	Timestamp         time.Time          `json:"timestamp"`
+	Observers         []string           `json:"observers"`
+	Peers             []TopologyPeer     `json:"peers"`
+	DirectConnections []DirectConnection `json:"directConnections"`
+}
+
+// TopologyPeer holds the information known about a peer from the network
+type TopologyPeer struct {
+	Pid              string    `json:"pid"`
+	PublicKey        string    `json:"publicKey,omitempty"`
+	ShardID          uint32    `json:"shardID"`
+	PeerType         string    `json:"peerType"`
+	AppVersion       string    `json:"appVersion,omitempty"`
+	NodeDisplayName  string    `json:"nodeDisplayName,omitempty"`
+	Identity         string    `json:"identity,omitempty"`
+	ConnectionStatus string    `json:"connectionStatus"`
+	LastSeen         time.Time `json:"lastSeen"`
+}
+
+// DirectConnection holds a direct connection between two peers
+type DirectConnection struct {
+	Source  string `json:"source"`
+	Target  string `json:"target"`
+	ShardID uint32 `json:"shardID"`
+}
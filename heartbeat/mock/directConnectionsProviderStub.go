@@ -0,0 +1,22 @@
+package mock
+
+import "github.com/multiversx/mx-chain-core-go/core"
+
+// DirectConnectionsProviderStub -
+type DirectConnectionsProviderStub struct {
+	GetDirectConnectionsCalled func() map[core.PeerID]uint32
+}
+
+// GetDirectConnections -
+func (stub *DirectConnectionsProviderStub) GetDirectConnections() map[core.PeerID]uint32 {
+	if stub.GetDirectConnectionsCalled != nil {
+		return stub.GetDirectConnectionsCalled()
+	}
+
+	return make(map[core.PeerID]uint32)
+}
+
+// IsInterfaceNil -
+func (stub *DirectConnectionsProviderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -3,6 +3,7 @@ package processor
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -32,6 +33,8 @@ type directConnectionProcessor struct {
 	cancel                      func()
 	intraShardTopic             string
 	baseCrossShardTopic         string
+	mutDirectConnections        sync.RWMutex
+	directConnections           map[core.PeerID]uint32
 }
 
 // NewDirectConnectionProcessor will create a new direct connection processor instance
@@ -50,6 +53,7 @@ func NewDirectConnectionProcessor(args ArgsDirectConnectionProcessor) (*directCo
 		timeToReadDirectConnections: args.TimeToReadDirectConnections,
 		intraShardTopic:             intraShardTopic,
 		baseCrossShardTopic:         args.BaseCrossShardTopic,
+		directConnections:           make(map[core.PeerID]uint32),
 	}
 
 	var ctx context.Context
@@ -99,37 +103,47 @@ func (processor *directConnectionProcessor) processLoop(ctx context.Context) {
 }
 
 func (processor *directConnectionProcessor) processDirectConnections() {
-	mapIntraShardPeers := processor.processIntraShardPeers()
+	directConnections := make(map[core.PeerID]uint32)
+	mapIntraShardPeers := processor.processIntraShardPeers(directConnections)
 
-	processor.processCrossShardPeers(mapIntraShardPeers)
+	processor.processCrossShardPeers(mapIntraShardPeers, directConnections)
+
+	processor.mutDirectConnections.Lock()
+	processor.directConnections = directConnections
+	processor.mutDirectConnections.Unlock()
 }
 
-func (processor *directConnectionProcessor) processIntraShardPeers() map[core.PeerID]struct{} {
+func (processor *directConnectionProcessor) processIntraShardPeers(directConnections map[core.PeerID]uint32) map[core.PeerID]struct{} {
 	mapIntraShardPeers := make(map[core.PeerID]struct{})
 	intraShardPeers := processor.messenger.ConnectedPeersOnTopic(processor.intraShardTopic)
 	for _, peer := range intraShardPeers {
 		processor.peerShardMapper.PutPeerIdShardId(peer, processor.shardCoordinator.SelfId())
 		mapIntraShardPeers[peer] = struct{}{}
+		directConnections[peer] = processor.shardCoordinator.SelfId()
 	}
 
 	return mapIntraShardPeers
 }
 
-func (processor *directConnectionProcessor) processCrossShardPeers(intraShardPeers map[core.PeerID]struct{}) {
+func (processor *directConnectionProcessor) processCrossShardPeers(intraShardPeers map[core.PeerID]struct{}, directConnections map[core.PeerID]uint32) {
 	for i := uint32(0); i < processor.shardCoordinator.NumberOfShards(); i++ {
 		if i == processor.shardCoordinator.SelfId() {
 			continue
 		}
 
-		processor.processCrossShardPeersOnShard(i, intraShardPeers)
+		processor.processCrossShardPeersOnShard(i, intraShardPeers, directConnections)
 	}
 
 	if processor.shardCoordinator.SelfId() != common.MetachainShardId {
-		processor.processCrossShardPeersOnShard(common.MetachainShardId, intraShardPeers)
+		processor.processCrossShardPeersOnShard(common.MetachainShardId, intraShardPeers, directConnections)
 	}
 }
 
-func (processor *directConnectionProcessor) processCrossShardPeersOnShard(shardID uint32, intraShardPeers map[core.PeerID]struct{}) {
+func (processor *directConnectionProcessor) processCrossShardPeersOnShard(
+	shardID uint32,
+	intraShardPeers map[core.PeerID]struct{},
+	directConnections map[core.PeerID]uint32,
+) {
 	// TODO create a component that can handle the topic creation & management
 	identifier := processor.baseCrossShardTopic + processor.shardCoordinator.CommunicationIdentifier(shardID)
 	crossShardPeers := processor.messenger.ConnectedPeersOnTopic(identifier)
@@ -140,7 +154,22 @@ func (processor *directConnectionProcessor) processCrossShardPeersOnShard(shardI
 		}
 
 		processor.peerShardMapper.PutPeerIdShardId(peer, shardID)
+		directConnections[peer] = shardID
+	}
+}
+
+// GetDirectConnections returns the peers the node was directly connected to, at the last processing, along with
+// the shard of each peer
+func (processor *directConnectionProcessor) GetDirectConnections() map[core.PeerID]uint32 {
+	processor.mutDirectConnections.RLock()
+	defer processor.mutDirectConnections.RUnlock()
+
+	directConnections := make(map[core.PeerID]uint32, len(processor.directConnections))
+	for pid, shardID := range processor.directConnections {
+		directConnections[pid] = shardID
 	}
+
+	return directConnections
 }
 
 // Close will end the direct connection processor main loop
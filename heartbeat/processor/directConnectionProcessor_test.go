@@ -157,6 +157,18 @@ func TestDirectConnectionProcessor_ProcessingShouldWork(t *testing.T) {
 		assert.Equal(t, []core.PeerID{"pid1", "pid2", "pid3"}, resultMap[1])
 		assert.Equal(t, []core.PeerID{"pid6", "pid7"}, resultMap[2])
 		assert.Equal(t, []core.PeerID{"pid8", "pid9"}, resultMap[common.MetachainShardId])
+		expectedDirectConnections := map[core.PeerID]uint32{
+			"pid1": 1,
+			"pid2": 1,
+			"pid3": 1,
+			"pid4": 0,
+			"pid5": 0,
+			"pid6": 2,
+			"pid7": 2,
+			"pid8": common.MetachainShardId,
+			"pid9": common.MetachainShardId,
+		}
+		assert.Equal(t, expectedDirectConnections, processor.GetDirectConnections())
 	})
 	t.Run("node is in shard meta, process should work", func(t *testing.T) {
 		t.Parallel()
@@ -0,0 +1,18 @@
+package topology
+
+import "errors"
+
+// ErrNilHeartbeatMonitor signals that a nil heartbeat monitor has been provided
+var ErrNilHeartbeatMonitor = errors.New("nil heartbeat monitor")
+
+// ErrNilDirectConnectionsProvider signals that a nil direct connections provider has been provided
+var ErrNilDirectConnectionsProvider = errors.New("nil direct connections provider")
+
+// ErrEmptySelfPeerID signals that an empty self peer ID has been provided
+var ErrEmptySelfPeerID = errors.New("empty self peer ID")
+
+// ErrNilNetworkTopology signals that a nil network topology has been provided
+var ErrNilNetworkTopology = errors.New("nil network topology")
+
+// ErrUnknownExportFormat signals that an unknown export format has been provided
+var ErrUnknownExportFormat = errors.New("unknown export format")
@@ -0,0 +1,296 @@
+package topology
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+)
+
+const (
+	// ExportFormatJSON is the JSON export format of the network topology
+	ExportFormatJSON = "json"
+	// ExportFormatDOT is the Graphviz DOT export format of the network topology
+	ExportFormatDOT = "dot"
+	// ExportFormatGraphML is the GraphML export format of the network topology
+	ExportFormatGraphML = "graphml"
+
+	graphName        = "network"
+	graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+)
+
+var contentTypes = map[string]string{
+	ExportFormatJSON:    "application/json",
+	ExportFormatDOT:     "text/vnd.graphviz",
+	ExportFormatGraphML: "application/graphml+xml",
+}
+
+type graphNode struct {
+	peer       data.TopologyPeer
+	hasShard   bool
+	isObserver bool
+}
+
+// Export returns the network topology in the provided format, one of json, dot or graphml
+func Export(topology *data.NetworkTopology, format string) ([]byte, error) {
+	if topology == nil {
+		return nil, ErrNilNetworkTopology
+	}
+
+	switch format {
+	case ExportFormatJSON:
+		return json.MarshalIndent(topology, "", "  ")
+	case ExportFormatDOT:
+		return exportDOT(topology), nil
+	case ExportFormatGraphML:
+		return exportGraphML(topology)
+	default:
+		return nil, fmt.Errorf("%w: %s, supported formats: %s, %s, %s",
+			ErrUnknownExportFormat, format, ExportFormatJSON, ExportFormatDOT, ExportFormatGraphML)
+	}
+}
+
+// GetContentType returns the content type of the provided export format
+func GetContentType(format string) (string, error) {
+	contentType, found := contentTypes[format]
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrUnknownExportFormat, format)
+	}
+
+	return contentType, nil
+}
+
+// collectGraphNodes returns all the peers of the topology, sorted by their shard and peer ID. The observers and
+// the direct connections ends without a peer entry are added as well, the observers without a known shard
+// being placed first
+func collectGraphNodes(topology *data.NetworkTopology) []graphNode {
+	nodes := make(map[string]*graphNode)
+	for _, peer := range topology.Peers {
+		nodes[peer.Pid] = &graphNode{
+			peer:     peer,
+			hasShard: true,
+		}
+	}
+
+	addMissingNode := func(pid string) *graphNode {
+		node, found := nodes[pid]
+		if !found {
+			node = &graphNode{
+				peer: data.TopologyPeer{
+					Pid:      pid,
+					PeerType: PeerTypeUnknown,
+				},
+			}
+			nodes[pid] = node
+		}
+
+		return node
+	}
+	for _, connection := range topology.DirectConnections {
+		node := addMissingNode(connection.Target)
+		if !node.hasShard {
+			node.peer.ShardID = connection.ShardID
+			node.hasShard = true
+		}
+	}
+	for _, observer := range topology.Observers {
+		addMissingNode(observer).isObserver = true
+	}
+	for _, connection := range topology.DirectConnections {
+		addMissingNode(connection.Source)
+	}
+
+	sortedNodes := make([]graphNode, 0, len(nodes))
+	for _, node := range nodes {
+		sortedNodes = append(sortedNodes, *node)
+	}
+	sort.Slice(sortedNodes, func(i, j int) bool {
+		if sortedNodes[i].hasShard != sortedNodes[j].hasShard {
+			return !sortedNodes[i].hasShard
+		}
+		if sortedNodes[i].peer.ShardID != sortedNodes[j].peer.ShardID {
+			return sortedNodes[i].peer.ShardID < sortedNodes[j].peer.ShardID
+		}
+
+		return sortedNodes[i].peer.Pid < sortedNodes[j].peer.Pid
+	})
+
+	return sortedNodes
+}
+
+func exportDOT(topology *data.NetworkTopology) []byte {
+	buff := bytes.Buffer{}
+	buff.WriteString(fmt.Sprintf("graph %s {\n", graphName))
+
+	nodes := collectGraphNodes(topology)
+	i := 0
+	for ; i < len(nodes) && !nodes[i].hasShard; i++ {
+		buff.WriteString("\t" + createDOTNode(nodes[i]) + "\n")
+	}
+	for i < len(nodes) {
+		shardID := nodes[i].peer.ShardID
+		buff.WriteString(fmt.Sprintf("\tsubgraph %s {\n", quoteDOT(fmt.Sprintf("cluster_shard_%s", core.GetShardIDString(shardID)))))
+		buff.WriteString(fmt.Sprintf("\t\tlabel=%s;\n", quoteDOT("shard "+core.GetShardIDString(shardID))))
+		for ; i < len(nodes) && nodes[i].peer.ShardID == shardID; i++ {
+			buff.WriteString("\t\t" + createDOTNode(nodes[i]) + "\n")
+		}
+		buff.WriteString("\t}\n")
+	}
+
+	for _, connection := range topology.DirectConnections {
+		buff.WriteString(fmt.Sprintf("\t%s -- %s [shard=%s];\n",
+			quoteDOT(connection.Source),
+			quoteDOT(connection.Target),
+			quoteDOT(core.GetShardIDString(connection.ShardID))))
+	}
+	buff.WriteString("}\n")
+
+	return buff.Bytes()
+}
+
+func createDOTNode(node graphNode) string {
+	label := node.peer.Pid
+	if len(node.peer.NodeDisplayName) > 0 {
+		label = node.peer.NodeDisplayName + "\n" + node.peer.Pid
+	}
+	shape := "ellipse"
+	if node.isObserver {
+		shape = "box"
+	}
+
+	attributes := []string{
+		"label=" + quoteDOT(label),
+		"shape=" + shape,
+		"peerType=" + quoteDOT(node.peer.PeerType),
+		"connectionStatus=" + quoteDOT(node.peer.ConnectionStatus),
+	}
+	if len(node.peer.PublicKey) > 0 {
+		attributes = append(attributes, "publicKey="+quoteDOT(node.peer.PublicKey))
+	}
+	if len(node.peer.AppVersion) > 0 {
+		attributes = append(attributes, "appVersion="+quoteDOT(node.peer.AppVersion))
+	}
+	if !node.peer.LastSeen.IsZero() {
+		attributes = append(attributes, "lastSeen="+quoteDOT(node.peer.LastSeen.UTC().Format(time.RFC3339)))
+	}
+
+	return fmt.Sprintf("%s [%s];", quoteDOT(node.peer.Pid), strings.Join(attributes, ", "))
+}
+
+func quoteDOT(value string) string {
+	value = strings.ReplaceAll(value, "\\", "\\\\")
+	value = strings.ReplaceAll(value, "\"", "\\\"")
+	value = strings.ReplaceAll(value, "\n", "\\n")
+
+	return "\"" + value + "\""
+}
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID   string `xml:"id,attr"`
+	For  string `xml:"for,attr"`
+	Name string `xml:"attr.name,attr"`
+	Type string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+func exportGraphML(topology *data.NetworkTopology) ([]byte, error) {
+	document := graphMLDocument{
+		XMLNS: graphMLNamespace,
+		Keys: []graphMLKey{
+			{ID: "publicKey", For: "node", Name: "publicKey", Type: "string"},
+			{ID: "shard", For: "node", Name: "shard", Type: "string"},
+			{ID: "peerType", For: "node", Name: "peerType", Type: "string"},
+			{ID: "appVersion", For: "node", Name: "appVersion", Type: "string"},
+			{ID: "nodeDisplayName", For: "node", Name: "nodeDisplayName", Type: "string"},
+			{ID: "identity", For: "node", Name: "identity", Type: "string"},
+			{ID: "connectionStatus", For: "node", Name: "connectionStatus", Type: "string"},
+			{ID: "lastSeen", For: "node", Name: "lastSeen", Type: "string"},
+			{ID: "isObserver", For: "node", Name: "isObserver", Type: "boolean"},
+			{ID: "edgeShard", For: "edge", Name: "shard", Type: "string"},
+		},
+		Graph: graphMLGraph{
+			ID:          graphName,
+			EdgeDefault: "undirected",
+			Nodes:       make([]graphMLNode, 0),
+			Edges:       make([]graphMLEdge, 0, len(topology.DirectConnections)),
+		},
+	}
+
+	for _, node := range collectGraphNodes(topology) {
+		lastSeen := ""
+		if !node.peer.LastSeen.IsZero() {
+			lastSeen = node.peer.LastSeen.UTC().Format(time.RFC3339)
+		}
+		shard := ""
+		if node.hasShard {
+			shard = core.GetShardIDString(node.peer.ShardID)
+		}
+
+		document.Graph.Nodes = append(document.Graph.Nodes, graphMLNode{
+			ID: node.peer.Pid,
+			Data: []graphMLData{
+				{Key: "publicKey", Value: node.peer.PublicKey},
+				{Key: "shard", Value: shard},
+				{Key: "peerType", Value: node.peer.PeerType},
+				{Key: "appVersion", Value: node.peer.AppVersion},
+				{Key: "nodeDisplayName", Value: node.peer.NodeDisplayName},
+				{Key: "identity", Value: node.peer.Identity},
+				{Key: "connectionStatus", Value: node.peer.ConnectionStatus},
+				{Key: "lastSeen", Value: lastSeen},
+				{Key: "isObserver", Value: fmt.Sprintf("%t", node.isObserver)},
+			},
+		})
+	}
+
+	for _, connection := range topology.DirectConnections {
+		document.Graph.Edges = append(document.Graph.Edges, graphMLEdge{
+			Source: connection.Source,
+			Target: connection.Target,
+			Data: []graphMLData{
+				{Key: "edgeShard", Value: core.GetShardIDString(connection.ShardID)},
+			},
+		})
+	}
+
+	buff, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(xml.Header), buff...), nil
+}
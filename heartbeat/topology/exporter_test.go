@@ -0,0 +1,120 @@
+package topology
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createTestTopology() *data.NetworkTopology {
+	lastSeen := time.Unix(1000, 0)
+
+	return &data.NetworkTopology{
+		Timestamp: lastSeen,
+		Observers: []string{"obs"},
+		Peers: []data.TopologyPeer{
+			{Pid: "obs", ShardID: 0, PeerType: PeerTypeUnknown, ConnectionStatus: ConnectionStatusSelf, LastSeen: lastSeen},
+			{Pid: "pid1", PublicKey: "pk1", NodeDisplayName: "node \"1\"", ShardID: 0, PeerType: "eligible", AppVersion: "v1", ConnectionStatus: ConnectionStatusConnected, LastSeen: lastSeen},
+			{Pid: "pid2", PublicKey: "pk2", ShardID: 4294967295, PeerType: "waiting", ConnectionStatus: ConnectionStatusInactive, LastSeen: lastSeen},
+		},
+		DirectConnections: []data.DirectConnection{
+			{Source: "obs", Target: "pid1", ShardID: 0},
+			{Source: "obs", Target: "pid3", ShardID: 1},
+		},
+	}
+}
+
+func TestExport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil topology should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := Export(nil, ExportFormatJSON)
+		assert.Equal(t, ErrNilNetworkTopology, err)
+		assert.Nil(t, buff)
+	})
+	t.Run("unknown format should error", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := Export(createTestTopology(), "csv")
+		assert.True(t, errors.Is(err, ErrUnknownExportFormat))
+		assert.Nil(t, buff)
+	})
+	t.Run("json format should work", func(t *testing.T) {
+		t.Parallel()
+
+		topology := createTestTopology()
+		buff, err := Export(topology, ExportFormatJSON)
+		require.Nil(t, err)
+
+		recovered := &data.NetworkTopology{}
+		err = json.Unmarshal(buff, recovered)
+		require.Nil(t, err)
+		assert.Equal(t, len(topology.Peers), len(recovered.Peers))
+		assert.Equal(t, topology.DirectConnections, recovered.DirectConnections)
+		assert.True(t, topology.Timestamp.Equal(recovered.Timestamp))
+	})
+	t.Run("dot format should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := Export(createTestTopology(), ExportFormatDOT)
+		require.Nil(t, err)
+
+		dot := string(buff)
+		assert.True(t, strings.HasPrefix(dot, "graph network {"))
+		assert.Contains(t, dot, `subgraph "cluster_shard_0" {`)
+		assert.Contains(t, dot, `subgraph "cluster_shard_1" {`)
+		assert.Contains(t, dot, `subgraph "cluster_shard_metachain" {`)
+		assert.Contains(t, dot, `"obs" [label="obs", shape=box`)
+		assert.Contains(t, dot, `label="node \"1\"\npid1"`)
+		assert.Contains(t, dot, `"pid3" [label="pid3", shape=ellipse, peerType="unknown"`)
+		assert.Contains(t, dot, `"obs" -- "pid1" [shard="0"];`)
+		assert.Contains(t, dot, `"obs" -- "pid3" [shard="1"];`)
+	})
+	t.Run("graphml format should work", func(t *testing.T) {
+		t.Parallel()
+
+		buff, err := Export(createTestTopology(), ExportFormatGraphML)
+		require.Nil(t, err)
+		assert.True(t, strings.HasPrefix(string(buff), xml.Header))
+
+		document := &graphMLDocument{}
+		err = xml.Unmarshal(buff, document)
+		require.Nil(t, err)
+		assert.Equal(t, "undirected", document.Graph.EdgeDefault)
+		require.Equal(t, 4, len(document.Graph.Nodes))
+		assert.Equal(t, "obs", document.Graph.Nodes[0].ID)
+		assert.Equal(t, "pid2", document.Graph.Nodes[3].ID)
+		require.Equal(t, 2, len(document.Graph.Edges))
+		assert.Equal(t, "obs", document.Graph.Edges[1].Source)
+		assert.Equal(t, "pid3", document.Graph.Edges[1].Target)
+	})
+}
+
+func TestGetContentType(t *testing.T) {
+	t.Parallel()
+
+	contentType, err := GetContentType(ExportFormatJSON)
+	assert.Nil(t, err)
+	assert.Equal(t, "application/json", contentType)
+
+	contentType, err = GetContentType(ExportFormatDOT)
+	assert.Nil(t, err)
+	assert.Equal(t, "text/vnd.graphviz", contentType)
+
+	contentType, err = GetContentType(ExportFormatGraphML)
+	assert.Nil(t, err)
+	assert.Equal(t, "application/graphml+xml", contentType)
+
+	contentType, err = GetContentType("csv")
+	assert.True(t, errors.Is(err, ErrUnknownExportFormat))
+	assert.Empty(t, contentType)
+}
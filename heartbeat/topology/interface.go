@@ -0,0 +1,19 @@
+package topology
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+)
+
+// HeartbeatMonitor defines the behavior of a component able to provide the heartbeats received from the network
+type HeartbeatMonitor interface {
+	GetHeartbeats() []data.PubKeyHeartbeat
+	IsInterfaceNil() bool
+}
+
+// DirectConnectionsProvider defines the behavior of a component able to provide the peers the node is directly
+// connected to, along with the shard of each peer
+type DirectConnectionsProvider interface {
+	GetDirectConnections() map[core.PeerID]uint32
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,95 @@
+package topology
+
+import (
+	"sort"
+
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+)
+
+var connectionStatusRank = map[string]int{
+	ConnectionStatusInactive:  1,
+	ConnectionStatusActive:    2,
+	ConnectionStatusConnected: 3,
+	ConnectionStatusSelf:      4,
+}
+
+// MergeTopologies merges the network topologies seen by more nodes into a single one. For a peer known by more
+// nodes, the data from the most recent heartbeat is kept, along with the best connection status and the last sighting
+func MergeTopologies(topologies []*data.NetworkTopology) *data.NetworkTopology {
+	merged := &data.NetworkTopology{
+		Observers:         make([]string, 0),
+		Peers:             make([]data.TopologyPeer, 0),
+		DirectConnections: make([]data.DirectConnection, 0),
+	}
+
+	observers := make(map[string]struct{})
+	peers := make(map[string]*data.TopologyPeer)
+	connections := make(map[data.DirectConnection]struct{})
+	for _, topology := range topologies {
+		if topology == nil {
+			continue
+		}
+
+		if topology.Timestamp.After(merged.Timestamp) {
+			merged.Timestamp = topology.Timestamp
+		}
+		for _, observer := range topology.Observers {
+			observers[observer] = struct{}{}
+		}
+		for idx := range topology.Peers {
+			mergePeer(peers, topology.Peers[idx])
+		}
+		for _, connection := range topology.DirectConnections {
+			connections[connection] = struct{}{}
+		}
+	}
+
+	for observer := range observers {
+		merged.Observers = append(merged.Observers, observer)
+	}
+	sort.Strings(merged.Observers)
+
+	merged.Peers = sortPeers(peers)
+
+	for connection := range connections {
+		merged.DirectConnections = append(merged.DirectConnections, connection)
+	}
+	sort.Slice(merged.DirectConnections, func(i, j int) bool {
+		if merged.DirectConnections[i].Source != merged.DirectConnections[j].Source {
+			return merged.DirectConnections[i].Source < merged.DirectConnections[j].Source
+		}
+
+		return merged.DirectConnections[i].Target < merged.DirectConnections[j].Target
+	})
+
+	return merged
+}
+
+func mergePeer(peers map[string]*data.TopologyPeer, peer data.TopologyPeer) {
+	existing, found := peers[peer.Pid]
+	if !found {
+		peers[peer.Pid] = &peer
+		return
+	}
+
+	connectionStatus := existing.ConnectionStatus
+	if connectionStatusRank[peer.ConnectionStatus] > connectionStatusRank[connectionStatus] {
+		connectionStatus = peer.ConnectionStatus
+	}
+	lastSeen := existing.LastSeen
+	if peer.LastSeen.After(lastSeen) {
+		lastSeen = peer.LastSeen
+	}
+
+	// the data coming from a heartbeat is preferred over the one of a direct connection without a heartbeat
+	hasHeartbeatData := len(peer.PublicKey) > 0
+	existingHasHeartbeatData := len(existing.PublicKey) > 0
+	isNewerSighting := peer.LastSeen.After(existing.LastSeen)
+	shouldReplace := hasHeartbeatData && (isNewerSighting || !existingHasHeartbeatData)
+	shouldReplace = shouldReplace || (isNewerSighting && !existingHasHeartbeatData)
+	if shouldReplace {
+		*existing = peer
+	}
+	existing.ConnectionStatus = connectionStatus
+	existing.LastSeen = lastSeen
+}
@@ -0,0 +1,73 @@
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeTopologies(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no topologies should return an empty topology", func(t *testing.T) {
+		t.Parallel()
+
+		merged := MergeTopologies([]*data.NetworkTopology{nil})
+		assert.Equal(t, 0, len(merged.Observers))
+		assert.Equal(t, 0, len(merged.Peers))
+		assert.Equal(t, 0, len(merged.DirectConnections))
+		assert.True(t, merged.Timestamp.IsZero())
+	})
+	t.Run("should merge", func(t *testing.T) {
+		t.Parallel()
+
+		time1 := time.Unix(100, 0)
+		time2 := time.Unix(200, 0)
+		topology1 := &data.NetworkTopology{
+			Timestamp: time1,
+			Observers: []string{"obs2"},
+			Peers: []data.TopologyPeer{
+				{Pid: "obs2", ShardID: 1, PeerType: PeerTypeUnknown, ConnectionStatus: ConnectionStatusSelf, LastSeen: time1},
+				{Pid: "pid1", PublicKey: "pk1", AppVersion: "v1", ShardID: 0, PeerType: "eligible", ConnectionStatus: ConnectionStatusConnected, LastSeen: time1},
+				{Pid: "pid2", ShardID: 1, PeerType: PeerTypeUnknown, ConnectionStatus: ConnectionStatusConnected, LastSeen: time1},
+			},
+			DirectConnections: []data.DirectConnection{
+				{Source: "obs2", Target: "pid1", ShardID: 0},
+				{Source: "obs2", Target: "pid2", ShardID: 1},
+			},
+		}
+		topology2 := &data.NetworkTopology{
+			Timestamp: time2,
+			Observers: []string{"obs1"},
+			Peers: []data.TopologyPeer{
+				{Pid: "obs1", ShardID: 0, PeerType: PeerTypeUnknown, ConnectionStatus: ConnectionStatusSelf, LastSeen: time2},
+				{Pid: "obs2", PublicKey: "pkObs2", ShardID: 1, PeerType: "observer", ConnectionStatus: ConnectionStatusConnected, LastSeen: time2},
+				{Pid: "pid1", PublicKey: "pk1", AppVersion: "v2", ShardID: 0, PeerType: "eligible", ConnectionStatus: ConnectionStatusInactive, LastSeen: time2},
+				{Pid: "pid2", PublicKey: "pk2", ShardID: 1, PeerType: "waiting", ConnectionStatus: ConnectionStatusActive, LastSeen: time1},
+			},
+			DirectConnections: []data.DirectConnection{
+				{Source: "obs1", Target: "obs2", ShardID: 1},
+			},
+		}
+
+		merged := MergeTopologies([]*data.NetworkTopology{topology1, topology2})
+		expectedTopology := &data.NetworkTopology{
+			Timestamp: time2,
+			Observers: []string{"obs1", "obs2"},
+			Peers: []data.TopologyPeer{
+				{Pid: "obs1", ShardID: 0, PeerType: PeerTypeUnknown, ConnectionStatus: ConnectionStatusSelf, LastSeen: time2},
+				{Pid: "obs2", PublicKey: "pkObs2", ShardID: 1, PeerType: "observer", ConnectionStatus: ConnectionStatusSelf, LastSeen: time2},
+				{Pid: "pid1", PublicKey: "pk1", AppVersion: "v2", ShardID: 0, PeerType: "eligible", ConnectionStatus: ConnectionStatusConnected, LastSeen: time2},
+				{Pid: "pid2", PublicKey: "pk2", ShardID: 1, PeerType: "waiting", ConnectionStatus: ConnectionStatusConnected, LastSeen: time1},
+			},
+			DirectConnections: []data.DirectConnection{
+				{Source: "obs1", Target: "obs2", ShardID: 1},
+				{Source: "obs2", Target: "pid1", ShardID: 0},
+				{Source: "obs2", Target: "pid2", ShardID: 1},
+			},
+		}
+		assert.Equal(t, expectedTopology, merged)
+	})
+}
@@ -0,0 +1,156 @@
+package topology
+
+import (
+	"sort"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+)
+
+const (
+	// ConnectionStatusSelf is the status of the observer node which created the topology
+	ConnectionStatusSelf = "self"
+	// ConnectionStatusConnected is the status of a peer directly connected to the observer node
+	ConnectionStatusConnected = "connected"
+	// ConnectionStatusActive is the status of a peer which recently sent a heartbeat, without being directly connected
+	ConnectionStatusActive = "active"
+	// ConnectionStatusInactive is the status of a peer which did not send a heartbeat lately
+	ConnectionStatusInactive = "inactive"
+	// PeerTypeUnknown is the type of a directly connected peer without a heartbeat
+	PeerTypeUnknown = "unknown"
+)
+
+// ArgTopologyProvider is the argument structure used to create a new topology provider instance
+type ArgTopologyProvider struct {
+	HeartbeatMonitor          HeartbeatMonitor
+	DirectConnectionsProvider DirectConnectionsProvider
+	SelfPeerID                core.PeerID
+	SelfShardID               uint32
+}
+
+type topologyProvider struct {
+	heartbeatMonitor          HeartbeatMonitor
+	directConnectionsProvider DirectConnectionsProvider
+	selfPeerID                core.PeerID
+	selfShardID               uint32
+	getTimeHandler            func() time.Time
+}
+
+// NewTopologyProvider creates a new topology provider, able to build a network topology snapshot from the
+// heartbeats received from the network and the direct connections of the node
+func NewTopologyProvider(args ArgTopologyProvider) (*topologyProvider, error) {
+	if check.IfNil(args.HeartbeatMonitor) {
+		return nil, ErrNilHeartbeatMonitor
+	}
+	if check.IfNil(args.DirectConnectionsProvider) {
+		return nil, ErrNilDirectConnectionsProvider
+	}
+	if len(args.SelfPeerID) == 0 {
+		return nil, ErrEmptySelfPeerID
+	}
+
+	return &topologyProvider{
+		heartbeatMonitor:          args.HeartbeatMonitor,
+		directConnectionsProvider: args.DirectConnectionsProvider,
+		selfPeerID:                args.SelfPeerID,
+		selfShardID:               args.SelfShardID,
+		getTimeHandler:            time.Now,
+	}, nil
+}
+
+// GetNetworkTopology returns a snapshot of the network, as seen by the current node: every peer known from the
+// heartbeats, along with the peers the node is directly connected to and the node itself
+func (tp *topologyProvider) GetNetworkTopology() *data.NetworkTopology {
+	now := tp.getTimeHandler()
+	selfPid := tp.selfPeerID.Pretty()
+
+	peers := make(map[string]*data.TopologyPeer)
+	for _, hb := range tp.heartbeatMonitor.GetHeartbeats() {
+		peers[hb.PidString] = createPeerFromHeartbeat(hb)
+	}
+
+	directConnections := tp.directConnectionsProvider.GetDirectConnections()
+	connections := make([]data.DirectConnection, 0, len(directConnections))
+	for pid, shardID := range directConnections {
+		pidString := pid.Pretty()
+		connections = append(connections, data.DirectConnection{
+			Source:  selfPid,
+			Target:  pidString,
+			ShardID: shardID,
+		})
+
+		peer, found := peers[pidString]
+		if !found {
+			peer = &data.TopologyPeer{
+				Pid:      pidString,
+				ShardID:  shardID,
+				PeerType: PeerTypeUnknown,
+			}
+			peers[pidString] = peer
+		}
+		peer.ConnectionStatus = ConnectionStatusConnected
+		peer.LastSeen = now
+	}
+
+	selfPeer, found := peers[selfPid]
+	if !found {
+		selfPeer = &data.TopologyPeer{
+			Pid:      selfPid,
+			ShardID:  tp.selfShardID,
+			PeerType: PeerTypeUnknown,
+		}
+		peers[selfPid] = selfPeer
+	}
+	selfPeer.ConnectionStatus = ConnectionStatusSelf
+	selfPeer.LastSeen = now
+
+	sort.Slice(connections, func(i, j int) bool {
+		return connections[i].Target < connections[j].Target
+	})
+
+	return &data.NetworkTopology{
+		Timestamp:         now,
+		Observers:         []string{selfPid},
+		Peers:             sortPeers(peers),
+		DirectConnections: connections,
+	}
+}
+
+func createPeerFromHeartbeat(hb data.PubKeyHeartbeat) *data.TopologyPeer {
+	connectionStatus := ConnectionStatusInactive
+	if hb.IsActive {
+		connectionStatus = ConnectionStatusActive
+	}
+
+	return &data.TopologyPeer{
+		Pid:              hb.PidString,
+		PublicKey:        hb.PublicKey,
+		ShardID:          hb.ComputedShardID,
+		PeerType:         hb.PeerType,
+		AppVersion:       hb.VersionNumber,
+		NodeDisplayName:  hb.NodeDisplayName,
+		Identity:         hb.Identity,
+		ConnectionStatus: connectionStatus,
+		LastSeen:         hb.TimeStamp,
+	}
+}
+
+func sortPeers(peers map[string]*data.TopologyPeer) []data.TopologyPeer {
+	sortedPeers := make([]data.TopologyPeer, 0, len(peers))
+	for _, peer := range peers {
+		sortedPeers = append(sortedPeers, *peer)
+	}
+
+	sort.Slice(sortedPeers, func(i, j int) bool {
+		return sortedPeers[i].Pid < sortedPeers[j].Pid
+	})
+
+	return sortedPeers
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tp *topologyProvider) IsInterfaceNil() bool {
+	return tp == nil
+}
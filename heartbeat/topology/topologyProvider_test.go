@@ -0,0 +1,160 @@
+package topology
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/heartbeat/mock"
+	"github.com/stretchr/testify/assert"
+)
+
+func createMockArgTopologyProvider() ArgTopologyProvider {
+	return ArgTopologyProvider{
+		HeartbeatMonitor:          &mock.HeartbeatMonitorStub{},
+		DirectConnectionsProvider: &mock.DirectConnectionsProviderStub{},
+		SelfPeerID:                "self",
+		SelfShardID:               1,
+	}
+}
+
+func TestNewTopologyProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil heartbeat monitor should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTopologyProvider()
+		args.HeartbeatMonitor = nil
+
+		tp, err := NewTopologyProvider(args)
+		assert.Equal(t, ErrNilHeartbeatMonitor, err)
+		assert.True(t, check.IfNil(tp))
+	})
+	t.Run("nil direct connections provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTopologyProvider()
+		args.DirectConnectionsProvider = nil
+
+		tp, err := NewTopologyProvider(args)
+		assert.Equal(t, ErrNilDirectConnectionsProvider, err)
+		assert.True(t, check.IfNil(tp))
+	})
+	t.Run("empty self peer ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgTopologyProvider()
+		args.SelfPeerID = ""
+
+		tp, err := NewTopologyProvider(args)
+		assert.Equal(t, ErrEmptySelfPeerID, err)
+		assert.True(t, check.IfNil(tp))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tp, err := NewTopologyProvider(createMockArgTopologyProvider())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(tp))
+	})
+}
+
+func TestTopologyProvider_GetNetworkTopology(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1000, 0)
+	hbTime := time.Unix(900, 0)
+	pid1 := core.PeerID("pid1")
+	pid2 := core.PeerID("pid2")
+	pid3 := core.PeerID("pid3")
+	selfPid := core.PeerID("self")
+
+	args := createMockArgTopologyProvider()
+	args.HeartbeatMonitor = &mock.HeartbeatMonitorStub{
+		GetHeartbeatsCalled: func() []data.PubKeyHeartbeat {
+			return []data.PubKeyHeartbeat{
+				{
+					PublicKey:       "pk1",
+					TimeStamp:       hbTime,
+					IsActive:        true,
+					VersionNumber:   "v1",
+					NodeDisplayName: "node1",
+					Identity:        "identity1",
+					PeerType:        "eligible",
+					ComputedShardID: 0,
+					PidString:       pid1.Pretty(),
+				},
+				{
+					PublicKey:       "pk2",
+					TimeStamp:       hbTime,
+					IsActive:        false,
+					VersionNumber:   "v2",
+					PeerType:        "observer",
+					ComputedShardID: core.MetachainShardId,
+					PidString:       pid2.Pretty(),
+				},
+			}
+		},
+	}
+	args.DirectConnectionsProvider = &mock.DirectConnectionsProviderStub{
+		GetDirectConnectionsCalled: func() map[core.PeerID]uint32 {
+			return map[core.PeerID]uint32{
+				pid3: 2,
+				pid1: 0,
+			}
+		},
+	}
+	tp, _ := NewTopologyProvider(args)
+	tp.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	expectedTopology := &data.NetworkTopology{
+		Timestamp: now,
+		Observers: []string{selfPid.Pretty()},
+		Peers: []data.TopologyPeer{
+			{
+				Pid:              pid1.Pretty(),
+				PublicKey:        "pk1",
+				ShardID:          0,
+				PeerType:         "eligible",
+				AppVersion:       "v1",
+				NodeDisplayName:  "node1",
+				Identity:         "identity1",
+				ConnectionStatus: ConnectionStatusConnected,
+				LastSeen:         now,
+			},
+			{
+				Pid:              pid2.Pretty(),
+				PublicKey:        "pk2",
+				ShardID:          core.MetachainShardId,
+				PeerType:         "observer",
+				AppVersion:       "v2",
+				ConnectionStatus: ConnectionStatusInactive,
+				LastSeen:         hbTime,
+			},
+			{
+				Pid:              pid3.Pretty(),
+				ShardID:          2,
+				PeerType:         PeerTypeUnknown,
+				ConnectionStatus: ConnectionStatusConnected,
+				LastSeen:         now,
+			},
+			{
+				Pid:              selfPid.Pretty(),
+				ShardID:          1,
+				PeerType:         PeerTypeUnknown,
+				ConnectionStatus: ConnectionStatusSelf,
+				LastSeen:         now,
+			},
+		},
+		DirectConnections: []data.DirectConnection{
+			{Source: selfPid.Pretty(), Target: pid1.Pretty(), ShardID: 0},
+			{Source: selfPid.Pretty(), Target: pid3.Pretty(), ShardID: 2},
+		},
+	}
+	assert.Equal(t, expectedTopology, tp.GetNetworkTopology())
+}
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
@@ -8,13 +9,15 @@ import (
 
 // NetworkComponentsStub -
 type NetworkComponentsStub struct {
-	Messenger               p2p.Messenger
-	InputAntiFlood          factory.P2PAntifloodHandler
-	OutputAntiFlood         factory.P2PAntifloodHandler
-	PeerBlackList           process.PeerBlackListCacher
-	PeerHonesty             factory.PeerHonestyHandler
-	PreferredPeersHolder    factory.PreferredPeersHolderHandler
-	PeersRatingHandlerField p2p.PeersRatingHandler
+	Messenger                 p2p.Messenger
+	InputAntiFlood            factory.P2PAntifloodHandler
+	OutputAntiFlood           factory.P2PAntifloodHandler
+	PeerBlackList             process.PeerBlackListCacher
+	PeerHonesty               factory.PeerHonestyHandler
+	PreferredPeersHolder      factory.PreferredPeersHolderHandler
+	PeersRatingHandlerField   p2p.PeersRatingHandler
+	PeerReputation            process.PeerReputationHandler
+	PeersResponseTrackerField dataRetriever.PeersResponseTracker
 }
 
 // PubKeyCacher -
@@ -72,6 +75,16 @@ func (ncs *NetworkComponentsStub) PeersRatingHandler() p2p.PeersRatingHandler {
 	return ncs.PeersRatingHandlerField
 }
 
+// PeerReputationHandler -
+func (ncs *NetworkComponentsStub) PeerReputationHandler() process.PeerReputationHandler {
+	return ncs.PeerReputation
+}
+
+// PeersResponseTracker -
+func (ncs *NetworkComponentsStub) PeersResponseTracker() dataRetriever.PeersResponseTracker {
+	return ncs.PeersResponseTrackerField
+}
+
 // String -
 func (ncs *NetworkComponentsStub) String() string {
 	return "NetworkComponentsStub"
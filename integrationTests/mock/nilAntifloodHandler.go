@@ -49,6 +49,11 @@ func (nah *NilAntifloodHandler) SetPeerValidatorMapper(_ process.PeerValidatorMa
 	return nil
 }
 
+// SetPeerReputationHandler -
+func (nah *NilAntifloodHandler) SetPeerReputationHandler(_ process.PeerReputationHandler) error {
+	return nil
+}
+
 // SetTopicsForAll -
 func (nah *NilAntifloodHandler) SetTopicsForAll(_ ...string) {
 }
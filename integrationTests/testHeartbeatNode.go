@@ -463,8 +463,10 @@ func (thn *TestHeartbeatNode) initResolvers() {
 			NumTotalPeers:       3,
 			NumFullHistoryPeers: 3,
 		},
-		PeersRatingHandler: &p2pmocks.PeersRatingHandlerStub{},
-		PayloadValidator:   payloadValidator,
+		PeersRatingHandler:    &p2pmocks.PeersRatingHandlerStub{},
+		PeerReputationHandler: &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTracker:  &testscommon.PeersResponseTrackerStub{},
+		PayloadValidator:      payloadValidator,
 	}
 
 	if thn.ShardCoordinator.SelfId() == core.MetachainShardId {
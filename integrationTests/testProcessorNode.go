@@ -1294,8 +1294,10 @@ func (tpn *TestProcessorNode) initResolvers() {
 			NumTotalPeers:       3,
 			NumFullHistoryPeers: 3,
 		},
-		PeersRatingHandler: tpn.PeersRatingHandler,
-		PayloadValidator:   payloadValidator,
+		PeersRatingHandler:    tpn.PeersRatingHandler,
+		PeerReputationHandler: &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTracker:  &testscommon.PeersResponseTrackerStub{},
+		PayloadValidator:      payloadValidator,
 	}
 
 	var err error
@@ -3106,11 +3108,13 @@ func GetDefaultStateComponents() *testscommon.StateComponentsMock {
 // GetDefaultNetworkComponents -
 func GetDefaultNetworkComponents() *mock.NetworkComponentsStub {
 	return &mock.NetworkComponentsStub{
-		Messenger:               &p2pmocks.MessengerStub{},
-		InputAntiFlood:          &mock.P2PAntifloodHandlerStub{},
-		OutputAntiFlood:         &mock.P2PAntifloodHandlerStub{},
-		PeerBlackList:           &mock.PeerBlackListCacherStub{},
-		PeersRatingHandlerField: &p2pmocks.PeersRatingHandlerStub{},
+		Messenger:                 &p2pmocks.MessengerStub{},
+		InputAntiFlood:            &mock.P2PAntifloodHandlerStub{},
+		OutputAntiFlood:           &mock.P2PAntifloodHandlerStub{},
+		PeerBlackList:             &mock.PeerBlackListCacherStub{},
+		PeersRatingHandlerField:   &p2pmocks.PeersRatingHandlerStub{},
+		PeerReputation:            &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTrackerField: &testscommon.PeersResponseTrackerStub{},
 	}
 }
 
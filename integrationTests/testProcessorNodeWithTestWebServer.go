@@ -10,6 +10,7 @@ import (
 	dataTransaction "github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-go/api/groups"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	nodeFacade "github.com/multiversx/mx-chain-go/facade"
 	"github.com/multiversx/mx-chain-go/integrationTests/mock"
@@ -111,7 +112,7 @@ func createTestApiConfig() config.ApiRoutesConfig {
 		"log":         {"/log"},
 		"validator":   {"/statistics"},
 		"vm-values":   {"/hex", "/string", "/int", "/query"},
-		"transaction": {"/send", "/simulate", "/send-multiple", "/cost", "/:txhash", "/pool"},
+		"transaction": {"/send", "/simulate", "/simulate-bundle", "/send-multiple", "/cost", "/:txhash", "/pool"},
 		"block":       {"/by-nonce/:nonce", "/by-hash/:hash", "/by-round/:round"},
 	}
 
@@ -169,7 +170,7 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		txTypeHandler,
 		tpn.EconomicsData,
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *dataTransaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *dataTransaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{}, nil
 			},
 		},
@@ -278,6 +279,8 @@ func createFacadeComponents(tpn *TestProcessorNode) (nodeFacade.ApiResolver, nod
 		Marshalizer:               TestMarshalizer,
 		Hasher:                    TestHasher,
 		VMOutputCacher:            &testscommon.CacherMock{},
+		SimulationAccounts:        &state.SimulationAccountsHandlerStub{},
+		CallTraceBuilder:          &testscommon.CallTraceBuilderStub{},
 	}
 
 	txSimulator, err := txsimulator.NewTransactionSimulator(argSimulator)
@@ -264,7 +264,7 @@ func CheckNumCallBacks(
 			CallValue:  big.NewInt(0),
 			Arguments:  [][]byte{},
 		}
-		vmOutput, err := node.SCQueryService.ExecuteQuery(scQuery)
+		vmOutput, _, err := node.SCQueryService.ExecuteQuery(scQuery)
 		require.Nil(t, err)
 		require.NotNil(t, vmOutput)
 		require.Equal(t, vmOutput.ReturnCode, vmcommon.Ok)
@@ -298,7 +298,7 @@ func CheckSavedCallBackData(
 				{byte(callbackIndex)},
 			},
 		}
-		vmOutput, err := node.SCQueryService.ExecuteQuery(scQuery)
+		vmOutput, _, err := node.SCQueryService.ExecuteQuery(scQuery)
 		require.Nil(t, err)
 		require.Equal(t, vmcommon.Ok, vmOutput.ReturnCode)
 		require.GreaterOrEqual(t, 3, len(vmOutput.ReturnData))
@@ -585,7 +585,7 @@ func TestESDTcallsSC(t *testing.T) {
 		FuncName:  "getCurrentFunds",
 		Arguments: [][]byte{},
 	}
-	vmOutput1, _ := nodes[0].SCQueryService.ExecuteQuery(scQuery1)
+	vmOutput1, _, _ := nodes[0].SCQueryService.ExecuteQuery(scQuery1)
 	require.Equal(t, big.NewInt(60).Bytes(), vmOutput1.ReturnData[0])
 
 	nodesBalance := valueToSend - valueToSendToSc
@@ -1352,7 +1352,7 @@ func TestScACallsScBWithExecOnDestScAPerformsAsyncCall_NoCallbackInScB(t *testin
 		Arguments:  [][]byte{},
 	}
 
-	res, err := scQuery.ExecuteQuery(childScAddressQuery)
+	res, _, err := scQuery.ExecuteQuery(childScAddressQuery)
 	require.Nil(t, err)
 
 	receiverScAddress := res.ReturnData[0]
@@ -1365,7 +1365,7 @@ func TestScACallsScBWithExecOnDestScAPerformsAsyncCall_NoCallbackInScB(t *testin
 		Arguments:  [][]byte{},
 	}
 
-	res, err = scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(tokenIdQuery)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(string(res.ReturnData[0]), ticker))
 
@@ -1530,7 +1530,7 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithIntermediaryExecOnDest_NotEn
 		Arguments:  [][]byte{},
 	}
 
-	res, err := scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err := scQuery.ExecuteQuery(tokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStr := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStr, ticker))
@@ -1820,7 +1820,7 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithScCall_GasUsedMismatch(t *te
 		Arguments:  [][]byte{},
 	}
 
-	res, err := scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err := scQuery.ExecuteQuery(tokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStrLendBusd := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStrLendBusd, ticker))
@@ -1834,7 +1834,7 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithScCall_GasUsedMismatch(t *te
 		Arguments:  [][]byte{},
 	}
 
-	res, err = scQuery.ExecuteQuery(tokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(tokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStrBorrow := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStrBorrow, ticker))
@@ -1881,12 +1881,12 @@ func TestExecOnDestWithTokenTransferFromScAtoScBWithScCall_GasUsedMismatch(t *te
 		Arguments:  [][]byte{},
 	}
 
-	res, err = scQuery.ExecuteQuery(borrowWEGLDtokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(borrowWEGLDtokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStr := string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStr, tickerWEGLD))
 
-	res, err = scQuery.ExecuteQuery(lendWEGLDtokenIdQuery)
+	res, _, err = scQuery.ExecuteQuery(lendWEGLDtokenIdQuery)
 	require.Nil(t, err)
 	tokenIdStr = string(res.ReturnData[0])
 	require.True(t, strings.Contains(tokenIdStr, tickerWEGLD))
@@ -2170,7 +2170,7 @@ func TestIssueAndBurnESDT_MaxGasPerBlockExceeded(t *testing.T) {
 			CallValue:  big.NewInt(0),
 			Arguments:  [][]byte{[]byte(tokenIdentifier)},
 		}
-		vmOutput, err := n.SCQueryService.ExecuteQuery(scQuery)
+		vmOutput, _, err := n.SCQueryService.ExecuteQuery(scQuery)
 		require.Nil(t, err)
 		require.Equal(t, vmOutput.ReturnCode, vmcommon.Ok)
 
@@ -42,6 +42,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/smartContract/builtInFunctions"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks"
 	"github.com/multiversx/mx-chain-go/process/smartContract/hooks/counters"
+	"github.com/multiversx/mx-chain-go/process/smartContract/trace"
 	syncDisabled "github.com/multiversx/mx-chain-go/process/sync/disabled"
 	"github.com/multiversx/mx-chain-go/process/transaction"
 	"github.com/multiversx/mx-chain-go/process/transactionLog"
@@ -251,7 +252,7 @@ func (vmTestContext *VMTestContext) GetVMOutputWithTransientVM(funcName string,
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
-	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
 		FuncName:  funcName,
 		Arguments: args,
@@ -863,7 +864,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 	}
 
 	// create transaction simulator
-	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts)
+	readOnlyAccountsDB, err := txsimulator.NewReadOnlyAccountsDB(accnts, integrationtests.TestHasher)
 	if err != nil {
 		return nil, err
 	}
@@ -921,6 +922,7 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 		VMOutputCacher:         vmOutputCacher,
 		Marshalizer:            integrationtests.TestMarshalizer,
 		Hasher:                 integrationtests.TestHasher,
+		SimulationAccounts:     readOnlyAccountsDB,
 	}
 
 	argsNewSCProcessor.VMOutputCacher = txSimulatorProcessorArgs.VMOutputCacher
@@ -940,6 +942,14 @@ func CreateTxProcessorWithOneSCExecutorWithVMs(
 
 	txSimulatorProcessorArgs.IntermediateProcContainer = interimProcContainer
 
+	txSimulatorProcessorArgs.CallTraceBuilder, err = trace.NewCallTraceBuilder(trace.ArgsCallTraceBuilder{
+		PubkeyConverter: pubkeyConv,
+		BlockChainHook:  blockChainHook,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	txSimulator, err := txsimulator.NewTransactionSimulator(txSimulatorProcessorArgs)
 	if err != nil {
 		return nil, err
@@ -1476,7 +1486,7 @@ func GetVmOutput(gasSchedule map[string]map[string]uint64, accnts state.Accounts
 	}
 	scQueryService, _ := smartContract.NewSCQueryService(argsNewSCQueryService)
 
-	vmOutput, err := scQueryService.ExecuteQuery(&process.SCQuery{
+	vmOutput, _, err := scQueryService.ExecuteQuery(&process.SCQuery{
 		ScAddress: scAddressBytes,
 		FuncName:  funcName,
 		Arguments: args,
@@ -45,7 +45,7 @@ func TestSCCallCostTransactionCost(t *testing.T) {
 
 	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, scAddress, gasPrice, gasLimit, []byte("increment"))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(387), res.GasUnits)
 }
@@ -65,7 +65,7 @@ func TestScDeployTransactionCost(t *testing.T) {
 	scCode := wasm.GetSCCode("../wasm/testdata/misc/fib_wasm/output/fib_wasm.wasm")
 	tx := vm.CreateTransaction(0, big.NewInt(0), sndAddr, vm.CreateEmptyAddress(), 0, 0, []byte(wasm.CreateDeployTxData(scCode)))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(849), res.GasUnits)
 }
@@ -96,7 +96,7 @@ func TestAsyncCallsTransactionCost(t *testing.T) {
 	secondSCAddress := utils.DoDeploySecond(t, testContext, pathToContract, ownerAccount, gasPrice, deployGasLimit, args, big.NewInt(50))
 
 	tx := vm.CreateTransaction(1, big.NewInt(0), senderAddr, secondSCAddress, 0, 0, []byte("doSomething"))
-	resWithCost, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	resWithCost, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(99991601), resWithCost.GasUnits)
 }
@@ -122,7 +122,7 @@ func TestBuiltInFunctionTransactionCost(t *testing.T) {
 
 	txData := []byte(core.BuiltInFunctionChangeOwnerAddress + "@" + hex.EncodeToString(newOwner))
 	tx := vm.CreateTransaction(1, big.NewInt(0), owner, scAddress, 0, 0, txData)
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(85), res.GasUnits)
 }
@@ -145,7 +145,7 @@ func TestESDTTransfer(t *testing.T) {
 	utils.CreateAccountWithESDTBalance(t, testContext.Accounts, sndAddr, egldBalance, token, 0, esdtBalance)
 
 	tx := utils.CreateESDTTransferTx(0, sndAddr, rcvAddr, token, big.NewInt(100), 0, 0)
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(36), res.GasUnits)
 }
@@ -188,7 +188,7 @@ func TestAsyncESDTTransfer(t *testing.T) {
 	tx := utils.CreateESDTTransferTx(0, sndAddr, firstSCAddress, token, big.NewInt(5000), 0, 0)
 	tx.Data = []byte(string(tx.Data) + "@" + hex.EncodeToString([]byte("transferToSecondContractHalf")))
 
-	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx)
+	res, err := testContext.TxCostHandler.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, uint64(34156), res.GasUnits)
 }
@@ -138,3 +138,36 @@ var ErrNilStatusHandler = errors.New("nil status handler")
 
 // ErrNilCreateTransactionArgs signals that create transaction args is nil
 var ErrNilCreateTransactionArgs = errors.New("nil args for create transaction")
+
+// ErrInvalidPageSize signals that an invalid page size was provided
+var ErrInvalidPageSize = errors.New("invalid page size")
+
+// ErrInvalidContinuationToken signals that an invalid continuation token was provided
+var ErrInvalidContinuationToken = errors.New("invalid continuation token")
+
+// ErrInvalidCursor signals that an invalid cursor was provided
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrUnknownStorageUnit signals that the provided storage unit name does not match any of the node's storage units
+var ErrUnknownStorageUnit = errors.New("unknown storage unit")
+
+// ErrUnknownTrie signals that the provided trie name does not match any of the node's tries
+var ErrUnknownTrie = errors.New("unknown trie")
+
+// ErrInvalidTrieSnapshotsAction signals that an invalid trie snapshots action has been provided
+var ErrInvalidTrieSnapshotsAction = errors.New("invalid trie snapshots action")
+
+// ErrNilConsensusComponents signals that a nil consensus components instance has been provided
+var ErrNilConsensusComponents = errors.New("nil consensus components")
+
+// ErrNilRoundsTimelineRecorder signals that a nil consensus rounds timeline recorder has been provided
+var ErrNilRoundsTimelineRecorder = errors.New("nil consensus rounds timeline recorder")
+
+// ErrNilHeartbeatV2Components signals that a nil heartbeatV2 components instance has been provided
+var ErrNilHeartbeatV2Components = errors.New("nil heartbeatV2 components")
+
+// ErrNilNetworkTopologyProvider signals that a nil network topology provider has been provided
+var ErrNilNetworkTopologyProvider = errors.New("nil network topology provider")
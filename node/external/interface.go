@@ -12,7 +12,7 @@ import (
 
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -34,7 +34,7 @@ type StatusMetricsHandler interface {
 
 // TransactionCostHandler defines the actions which should be handler by a transaction cost estimator
 type TransactionCostHandler interface {
-	ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 	IsInterfaceNil() bool
 }
 
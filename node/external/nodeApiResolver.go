@@ -114,7 +114,7 @@ func NewNodeApiResolver(arg ArgNodeApiResolver) (*nodeApiResolver, error) {
 }
 
 // ExecuteSCQuery retrieves data stored in a SC account through a VM
-func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+func (nar *nodeApiResolver) ExecuteSCQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	return nar.scQueryService.ExecuteQuery(query)
 }
 
@@ -124,8 +124,8 @@ func (nar *nodeApiResolver) StatusMetrics() StatusMetricsHandler {
 }
 
 // ComputeTransactionGasLimit will calculate how many gas a transaction will consume
-func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
-	return nar.txCostHandler.ComputeTransactionGasLimit(tx)
+func (nar *nodeApiResolver) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
+	return nar.txCostHandler.ComputeTransactionGasLimit(tx, stateOverrides)
 }
 
 // Close closes all underlying components
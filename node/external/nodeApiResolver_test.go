@@ -154,14 +154,14 @@ func TestNodeApiResolver_GetDataValueShouldCall(t *testing.T) {
 	arg := createMockArgs()
 	wasCalled := false
 	arg.SCQueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (vmOutput *vmcommon.VMOutput, e error) {
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			wasCalled = true
-			return &vmcommon.VMOutput{}, nil
+			return &vmcommon.VMOutput{}, nil, nil
 		},
 	}
 	nar, _ := external.NewNodeApiResolver(arg)
 
-	_, _ = nar.ExecuteSCQuery(&process.SCQuery{
+	_, _, _ = nar.ExecuteSCQuery(&process.SCQuery{
 		ScAddress: []byte{0},
 		FuncName:  "",
 	})
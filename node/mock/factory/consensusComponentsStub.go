@@ -0,0 +1,72 @@
+package factory
+
+import (
+	"github.com/multiversx/mx-chain-go/consensus"
+	"github.com/multiversx/mx-chain-go/factory"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// ConsensusComponentsStub -
+type ConsensusComponentsStub struct {
+	ChronologyHandler       consensus.ChronologyHandler
+	Worker                  factory.ConsensusWorker
+	BroadcastMessengerField consensus.BroadcastMessenger
+	GroupSize               int
+	BootstrapperField       process.Bootstrapper
+	RoundsTimeline          consensus.RoundsTimelineRecorder
+}
+
+// Create -
+func (ccs *ConsensusComponentsStub) Create() error {
+	return nil
+}
+
+// Close -
+func (ccs *ConsensusComponentsStub) Close() error {
+	return nil
+}
+
+// CheckSubcomponents -
+func (ccs *ConsensusComponentsStub) CheckSubcomponents() error {
+	return nil
+}
+
+// Chronology -
+func (ccs *ConsensusComponentsStub) Chronology() consensus.ChronologyHandler {
+	return ccs.ChronologyHandler
+}
+
+// ConsensusWorker -
+func (ccs *ConsensusComponentsStub) ConsensusWorker() factory.ConsensusWorker {
+	return ccs.Worker
+}
+
+// BroadcastMessenger -
+func (ccs *ConsensusComponentsStub) BroadcastMessenger() consensus.BroadcastMessenger {
+	return ccs.BroadcastMessengerField
+}
+
+// ConsensusGroupSize -
+func (ccs *ConsensusComponentsStub) ConsensusGroupSize() (int, error) {
+	return ccs.GroupSize, nil
+}
+
+// Bootstrapper -
+func (ccs *ConsensusComponentsStub) Bootstrapper() process.Bootstrapper {
+	return ccs.BootstrapperField
+}
+
+// RoundsTimelineRecorder -
+func (ccs *ConsensusComponentsStub) RoundsTimelineRecorder() consensus.RoundsTimelineRecorder {
+	return ccs.RoundsTimeline
+}
+
+// String -
+func (ccs *ConsensusComponentsStub) String() string {
+	return "ConsensusComponentsStub"
+}
+
+// IsInterfaceNil -
+func (ccs *ConsensusComponentsStub) IsInterfaceNil() bool {
+	return ccs == nil
+}
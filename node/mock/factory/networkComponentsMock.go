@@ -1,6 +1,7 @@
 package factory
 
 import (
+	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/factory"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
@@ -8,12 +9,14 @@ import (
 
 // NetworkComponentsMock -
 type NetworkComponentsMock struct {
-	Messenger               p2p.Messenger
-	InputAntiFlood          factory.P2PAntifloodHandler
-	OutputAntiFlood         factory.P2PAntifloodHandler
-	PeerBlackList           process.PeerBlackListCacher
-	PreferredPeersHolder    factory.PreferredPeersHolderHandler
-	PeersRatingHandlerField p2p.PeersRatingHandler
+	Messenger                 p2p.Messenger
+	InputAntiFlood            factory.P2PAntifloodHandler
+	OutputAntiFlood           factory.P2PAntifloodHandler
+	PeerBlackList             process.PeerBlackListCacher
+	PreferredPeersHolder      factory.PreferredPeersHolderHandler
+	PeersRatingHandlerField   p2p.PeersRatingHandler
+	PeerReputation            process.PeerReputationHandler
+	PeersResponseTrackerField dataRetriever.PeersResponseTracker
 }
 
 // PubKeyCacher -
@@ -71,6 +74,16 @@ func (ncm *NetworkComponentsMock) PeersRatingHandler() p2p.PeersRatingHandler {
 	return ncm.PeersRatingHandlerField
 }
 
+// PeerReputationHandler -
+func (ncm *NetworkComponentsMock) PeerReputationHandler() process.PeerReputationHandler {
+	return ncm.PeerReputation
+}
+
+// PeersResponseTracker -
+func (ncm *NetworkComponentsMock) PeersResponseTracker() dataRetriever.PeersResponseTracker {
+	return ncm.PeersResponseTrackerField
+}
+
 // String -
 func (ncm *NetworkComponentsMock) String() string {
 	return "NetworkComponentsMock"
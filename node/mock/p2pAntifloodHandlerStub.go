@@ -80,6 +80,11 @@ func (p2pahs *P2PAntifloodHandlerStub) SetPeerValidatorMapper(_ process.PeerVali
 	return nil
 }
 
+// SetPeerReputationHandler -
+func (p2pahs *P2PAntifloodHandlerStub) SetPeerReputationHandler(_ process.PeerReputationHandler) error {
+	return nil
+}
+
 // SetTopicsForAll -
 func (p2pahs *P2PAntifloodHandlerStub) SetTopicsForAll(_ ...string) {
 
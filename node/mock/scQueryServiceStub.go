@@ -2,19 +2,20 @@ package mock
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 // SCQueryServiceStub -
 type SCQueryServiceStub struct {
-	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryCalled           func(*process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
 
 // ExecuteQuery -
-func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+func (serviceStub *SCQueryServiceStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	return serviceStub.ExecuteQueryCalled(query)
 }
 
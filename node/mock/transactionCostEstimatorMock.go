@@ -1,16 +1,19 @@
 package mock
 
-import "github.com/multiversx/mx-chain-core-go/data/transaction"
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
+)
 
 // TransactionCostEstimatorMock  --
 type TransactionCostEstimatorMock struct {
-	ComputeTransactionGasLimitCalled func(tx *transaction.Transaction) (*transaction.CostResponse, error)
+	ComputeTransactionGasLimitCalled func(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error)
 }
 
 // ComputeTransactionGasLimit --
-func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+func (tcem *TransactionCostEstimatorMock) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	if tcem.ComputeTransactionGasLimitCalled != nil {
-		return tcem.ComputeTransactionGasLimitCalled(tx)
+		return tcem.ComputeTransactionGasLimitCalled(tx, stateOverrides)
 	}
 	return &transaction.CostResponse{}, nil
 }
@@ -1086,7 +1086,7 @@ func (n *Node) GetQueryHandler(name string) (debug.QueryHandler, error) {
 }
 
 // GetPeerInfo returns information about a peer id
-func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
+func (n *Node) GetPeerInfo(pid string) ([]common.PeerInfoAPI, error) {
 	peers := n.networkComponents.NetworkMessenger().Peers()
 	pidsFound := make([]core.PeerID, 0)
 	for _, p := range peers {
@@ -1103,7 +1103,7 @@ func (n *Node) GetPeerInfo(pid string) ([]core.QueryP2PPeerInfo, error) {
 		return pidsFound[i].Pretty() < pidsFound[j].Pretty()
 	})
 
-	peerInfoSlice := make([]core.QueryP2PPeerInfo, 0, len(pidsFound))
+	peerInfoSlice := make([]common.PeerInfoAPI, 0, len(pidsFound))
 	for _, p := range pidsFound {
 		pidInfo := n.createPidInfo(p)
 		peerInfoSlice = append(peerInfoSlice, pidInfo)
@@ -1247,11 +1247,14 @@ func (n *Node) GetStatusComponents() mainFactory.StatusComponentsHolder {
 	return n.statusComponents
 }
 
-func (n *Node) createPidInfo(p core.PeerID) core.QueryP2PPeerInfo {
-	result := core.QueryP2PPeerInfo{
-		Pid:           p.Pretty(),
-		Addresses:     n.networkComponents.NetworkMessenger().PeerAddresses(p),
-		IsBlacklisted: n.peerDenialEvaluator.IsDenied(p),
+func (n *Node) createPidInfo(p core.PeerID) common.PeerInfoAPI {
+	result := common.PeerInfoAPI{
+		QueryP2PPeerInfo: core.QueryP2PPeerInfo{
+			Pid:           p.Pretty(),
+			Addresses:     n.networkComponents.NetworkMessenger().PeerAddresses(p),
+			IsBlacklisted: n.peerDenialEvaluator.IsDenied(p),
+		},
+		Reputation: n.networkComponents.PeerReputationHandler().GetPeerReputation(p),
 	}
 
 	peerInfo := n.processComponents.PeerShardMapper().GetPeerInfo(p)
@@ -1392,16 +1395,11 @@ func (n *Node) getRootHashAndAddressAsBytes(rootHash string, address string) ([]
 }
 
 func (n *Node) getAccountRootHashAndVal(address []byte, accBytes []byte, key []byte) ([]byte, []byte, error) {
-	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	userAccount, err := n.getUserAccountFromBytes(address, accBytes)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	userAccount, ok := account.(state.UserAccountHandler)
-	if !ok {
-		return nil, nil, fmt.Errorf("the address does not belong to a user account")
-	}
-
 	dataTrieRootHash := userAccount.GetRootHash()
 	if len(dataTrieRootHash) == 0 {
 		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
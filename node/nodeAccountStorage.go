@@ -0,0 +1,201 @@
+package node
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// accountStorageContinuationToken binds the next page of an account's storage to the state the previous pages were
+// read from, so that all the pages of an iteration are consistent with each other
+type accountStorageContinuationToken struct {
+	RootHash  []byte              `json:"rootHash"`
+	HintEpoch core.OptionalUint32 `json:"hintEpoch"`
+	NextKey   []byte              `json:"nextKey"`
+}
+
+// GetAccountStoragePage returns at most pageSize key-value pairs under the address, starting with the position
+// recorded in the continuation token. An empty continuation token starts the iteration from the beginning
+// of the storage, using the state selected by the provided options
+func (n *Node) GetAccountStoragePage(
+	address string,
+	options api.AccountQueryOptions,
+	continuationToken string,
+	pageSize int,
+	ctx context.Context,
+) (*common.AccountStoragePage, api.BlockInfo, error) {
+	if pageSize <= 0 {
+		return nil, api.BlockInfo{}, ErrInvalidPageSize
+	}
+
+	var startKey []byte
+	if len(continuationToken) > 0 {
+		token, err := decodeAccountStorageContinuationToken(continuationToken)
+		if err != nil {
+			return nil, api.BlockInfo{}, err
+		}
+
+		options = api.AccountQueryOptions{
+			BlockRootHash: token.RootHash,
+			HintEpoch:     token.HintEpoch,
+		}
+		startKey = token.NextKey
+	}
+
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		adaptedBlockInfo, isEmptyAccount := extractBlockInfoIfNewAccount(err)
+		if isEmptyAccount {
+			return &common.AccountStoragePage{Pairs: make([]*common.KeyValuePairAPI, 0)}, adaptedBlockInfo, nil
+		}
+
+		return nil, api.BlockInfo{}, err
+	}
+
+	if check.IfNil(userAccount.DataTrie()) {
+		return &common.AccountStoragePage{Pairs: make([]*common.KeyValuePairAPI, 0)}, blockInfo, nil
+	}
+
+	pairs, nextKey, err := getAccountStoragePairs(userAccount, startKey, pageSize, ctx)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	page := &common.AccountStoragePage{
+		Pairs: pairs,
+	}
+	if len(nextKey) == 0 {
+		return page, blockInfo, nil
+	}
+
+	rootHash, err := hex.DecodeString(blockInfo.RootHash)
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	page.ContinuationToken, err = encodeAccountStorageContinuationToken(accountStorageContinuationToken{
+		RootHash:  rootHash,
+		HintEpoch: options.HintEpoch,
+		NextKey:   nextKey,
+	})
+	if err != nil {
+		return nil, api.BlockInfo{}, err
+	}
+
+	return page, blockInfo, nil
+}
+
+// GetKeyValuePairsPage returns at most limit key-value pairs under the address, in the data trie iteration order,
+// starting with the provided hex encoded key. The returned cursor is the hex encoded key the next page starts
+// with, and it is empty if there are no more pairs
+func (n *Node) GetKeyValuePairsPage(
+	address string,
+	options api.AccountQueryOptions,
+	fromKey string,
+	limit int,
+	ctx context.Context,
+) ([]*common.KeyValuePairAPI, string, api.BlockInfo, error) {
+	if limit <= 0 {
+		return nil, "", api.BlockInfo{}, ErrInvalidPageSize
+	}
+
+	startKey, err := hex.DecodeString(fromKey)
+	if err != nil {
+		return nil, "", api.BlockInfo{}, fmt.Errorf("%w: %s", ErrInvalidCursor, err.Error())
+	}
+
+	userAccount, blockInfo, err := n.loadUserAccountHandlerByAddress(address, options)
+	if err != nil {
+		adaptedBlockInfo, isEmptyAccount := extractBlockInfoIfNewAccount(err)
+		if isEmptyAccount {
+			return make([]*common.KeyValuePairAPI, 0), "", adaptedBlockInfo, nil
+		}
+
+		return nil, "", api.BlockInfo{}, err
+	}
+
+	if check.IfNil(userAccount.DataTrie()) {
+		return make([]*common.KeyValuePairAPI, 0), "", blockInfo, nil
+	}
+
+	pairs, nextKey, err := getAccountStoragePairs(userAccount, startKey, limit, ctx)
+	if err != nil {
+		return nil, "", api.BlockInfo{}, err
+	}
+
+	return pairs, hex.EncodeToString(nextKey), blockInfo, nil
+}
+
+// getAccountStoragePairs returns at most pageSize pairs from the account's data trie, starting with the provided key,
+// together with the key the next page starts with
+func getAccountStoragePairs(
+	userAccount state.UserAccountHandler,
+	startKey []byte,
+	pageSize int,
+	ctx context.Context,
+) ([]*common.KeyValuePairAPI, []byte, error) {
+	rootHash, err := userAccount.DataTrie().RootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaves, nextKey, err := userAccount.DataTrie().GetLeavesPage(rootHash, startKey, pageSize, ctx)
+	if common.IsContextDone(ctx) {
+		return nil, nil, ErrTrieOperationsTimeout
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pairs := make([]*common.KeyValuePairAPI, 0, len(leaves))
+	for _, leaf := range leaves {
+		suffix := append(leaf.Key(), userAccount.AddressBytes()...)
+		value, errVal := leaf.ValueWithoutSuffix(suffix)
+		if errVal != nil {
+			log.Warn("cannot get value without suffix", "error", errVal, "key", leaf.Key())
+			continue
+		}
+
+		pairs = append(pairs, &common.KeyValuePairAPI{
+			Key:   hex.EncodeToString(leaf.Key()),
+			Value: hex.EncodeToString(value),
+		})
+	}
+
+	return pairs, nextKey, nil
+}
+
+func encodeAccountStorageContinuationToken(token accountStorageContinuationToken) (string, error) {
+	tokenBytes, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(tokenBytes), nil
+}
+
+func decodeAccountStorageContinuationToken(encodedToken string) (*accountStorageContinuationToken, error) {
+	tokenBytes, err := base64.RawURLEncoding.DecodeString(encodedToken)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidContinuationToken, err.Error())
+	}
+
+	token := &accountStorageContinuationToken{}
+	err = json.Unmarshal(tokenBytes, token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidContinuationToken, err.Error())
+	}
+	if len(token.RootHash) == 0 || len(token.NextKey) == 0 {
+		return nil, ErrInvalidContinuationToken
+	}
+
+	return token, nil
+}
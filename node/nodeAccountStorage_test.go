@@ -0,0 +1,232 @@
+package node_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeWithAccountStorage(t *testing.T, keys [][]byte, values [][]byte, recordedOptions *[]common.RootHashHolder) *node.Node {
+	acc, _ := state.NewUserAccount([]byte("newaddress"))
+	acc.SetDataTrie(
+		&trieMock.TrieStub{
+			GetLeavesPageCalled: func(rootHash []byte, startKey []byte, maxLeaves int, ctx context.Context) ([]core.KeyValueHolder, []byte, error) {
+				if common.IsContextDone(ctx) {
+					return nil, nil, errors.New("context closing")
+				}
+
+				startIndex := 0
+				for i := range keys {
+					if bytes.Equal(keys[i], startKey) {
+						startIndex = i
+					}
+				}
+
+				leaves := make([]core.KeyValueHolder, 0)
+				for i := startIndex; i < len(keys); i++ {
+					if len(leaves) == maxLeaves {
+						return leaves, keys[i], nil
+					}
+
+					suffix := append(keys[i], acc.AddressBytes()...)
+					leaves = append(leaves, keyValStorage.NewKeyValStorage(keys[i], append(values[i], suffix...)))
+				}
+
+				return leaves, nil, nil
+			},
+			RootCalled: func() ([]byte, error) {
+				return []byte("data trie root"), nil
+			},
+		})
+
+	accDB := &stateMock.AccountsStub{
+		GetAccountWithBlockInfoCalled: func(address []byte, options common.RootHashHolder) (vmcommon.AccountHandler, common.BlockInfo, error) {
+			*recordedOptions = append(*recordedOptions, options)
+			return acc, dummyBlockInfo.forProcessing(), nil
+		},
+		RecreateTrieCalled: func(_ []byte) error {
+			return nil
+		},
+	}
+
+	coreComponents := getDefaultCoreComponents()
+	coreComponents.IntMarsh = getMarshalizer()
+	coreComponents.VmMarsh = getMarshalizer()
+	coreComponents.Hash = getHasher()
+	coreComponents.AddrPubKeyConv = createMockPubkeyConverter()
+	dataComponents := getDefaultDataComponents()
+	stateComponents := getDefaultStateComponents()
+	args := state.ArgsAccountsRepository{
+		FinalStateAccountsWrapper:      accDB,
+		CurrentStateAccountsWrapper:    accDB,
+		HistoricalStateAccountsWrapper: accDB,
+	}
+	stateComponents.AccountsRepo, _ = state.NewAccountsRepository(args)
+	n, err := node.NewNode(
+		node.WithCoreComponents(coreComponents),
+		node.WithStateComponents(stateComponents),
+		node.WithDataComponents(dataComponents),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetAccountStoragePage(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("key1"), []byte("key2"), []byte("key3")}
+	values := [][]byte{[]byte("value1"), []byte("value2"), []byte("value3")}
+
+	t.Run("invalid page size should error", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		page, _, err := n.GetAccountStoragePage(createDummyHexAddress(64), api.AccountQueryOptions{}, "", 0, context.Background())
+		assert.Equal(t, node.ErrInvalidPageSize, err)
+		assert.Nil(t, page)
+	})
+	t.Run("invalid continuation token should error", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		page, _, err := n.GetAccountStoragePage(createDummyHexAddress(64), api.AccountQueryOptions{}, "not a token", 2, context.Background())
+		assert.True(t, errors.Is(err, node.ErrInvalidContinuationToken))
+		assert.Nil(t, page)
+	})
+	t.Run("whole storage in one page should not return a continuation token", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		page, blockInfo, err := n.GetAccountStoragePage(createDummyHexAddress(64), api.AccountQueryOptions{}, "", 3, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, dummyBlockInfo.apiResult(), blockInfo)
+		assert.Len(t, page.Pairs, 3)
+		assert.Empty(t, page.ContinuationToken)
+	})
+	t.Run("should iterate the storage in pages", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+		address := createDummyHexAddress(64)
+
+		firstPage, _, err := n.GetAccountStoragePage(address, api.AccountQueryOptions{}, "", 2, context.Background())
+		require.Nil(t, err)
+		require.Len(t, firstPage.Pairs, 2)
+		assert.Equal(t, hex.EncodeToString(keys[0]), firstPage.Pairs[0].Key)
+		assert.Equal(t, hex.EncodeToString(values[0]), firstPage.Pairs[0].Value)
+		assert.Equal(t, hex.EncodeToString(keys[1]), firstPage.Pairs[1].Key)
+		require.NotEmpty(t, firstPage.ContinuationToken)
+
+		secondPage, _, err := n.GetAccountStoragePage(address, api.AccountQueryOptions{}, firstPage.ContinuationToken, 2, context.Background())
+		require.Nil(t, err)
+		require.Len(t, secondPage.Pairs, 1)
+		assert.Equal(t, hex.EncodeToString(keys[2]), secondPage.Pairs[0].Key)
+		assert.Equal(t, hex.EncodeToString(values[2]), secondPage.Pairs[0].Value)
+		assert.Empty(t, secondPage.ContinuationToken)
+
+		// the second page should be read from the state the first page was read from
+		require.Len(t, recordedOptions, 2)
+		assert.Equal(t, []byte("root"), recordedOptions[1].GetRootHash())
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		page, _, err := n.GetAccountStoragePage(createDummyHexAddress(64), api.AccountQueryOptions{}, "", 10, ctx)
+		assert.Equal(t, node.ErrTrieOperationsTimeout, err)
+		assert.Nil(t, page)
+	})
+}
+
+func TestNode_GetKeyValuePairsPage(t *testing.T) {
+	t.Parallel()
+
+	keys := [][]byte{[]byte("key1"), []byte("key2"), []byte("key3")}
+	values := [][]byte{[]byte("value1"), []byte("value2"), []byte("value3")}
+
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		pairs, cursor, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, "", 0, context.Background())
+		assert.Equal(t, node.ErrInvalidPageSize, err)
+		assert.Nil(t, pairs)
+		assert.Empty(t, cursor)
+	})
+	t.Run("invalid cursor should error", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		pairs, cursor, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, "not hex", 2, context.Background())
+		assert.True(t, errors.Is(err, node.ErrInvalidCursor))
+		assert.Nil(t, pairs)
+		assert.Empty(t, cursor)
+	})
+	t.Run("should iterate the storage using the cursor", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+		address := createDummyHexAddress(64)
+
+		pairs, cursor, blockInfo, err := n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, "", 2, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, dummyBlockInfo.apiResult(), blockInfo)
+		assert.Equal(t, []*common.KeyValuePairAPI{
+			{Key: hex.EncodeToString(keys[0]), Value: hex.EncodeToString(values[0])},
+			{Key: hex.EncodeToString(keys[1]), Value: hex.EncodeToString(values[1])},
+		}, pairs)
+		assert.Equal(t, hex.EncodeToString(keys[2]), cursor)
+
+		pairs, cursor, _, err = n.GetKeyValuePairsPage(address, api.AccountQueryOptions{}, cursor, 2, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, []*common.KeyValuePairAPI{
+			{Key: hex.EncodeToString(keys[2]), Value: hex.EncodeToString(values[2])},
+		}, pairs)
+		assert.Empty(t, cursor)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		recordedOptions := make([]common.RootHashHolder, 0)
+		n := createNodeWithAccountStorage(t, keys, values, &recordedOptions)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		pairs, _, _, err := n.GetKeyValuePairsPage(createDummyHexAddress(64), api.AccountQueryOptions{}, "", 10, ctx)
+		assert.Equal(t, node.ErrTrieOperationsTimeout, err)
+		assert.Nil(t, pairs)
+	})
+}
@@ -0,0 +1,20 @@
+package node
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GetConsensusRounds returns the timeline of the last consensus rounds, as recorded by the node
+func (n *Node) GetConsensusRounds() ([]*common.ConsensusRoundTimeline, error) {
+	if check.IfNil(n.consensusComponents) {
+		return nil, ErrNilConsensusComponents
+	}
+
+	recorder := n.consensusComponents.RoundsTimelineRecorder()
+	if check.IfNil(recorder) {
+		return nil, ErrNilRoundsTimelineRecorder
+	}
+
+	return recorder.GetRounds(), nil
+}
@@ -0,0 +1,53 @@
+package node_test
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/consensus/mock"
+	"github.com/multiversx/mx-chain-go/node"
+	factoryMock "github.com/multiversx/mx-chain-go/node/mock/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetConsensusRounds(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil consensus components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		rounds, err := n.GetConsensusRounds()
+		assert.Nil(t, rounds)
+		assert.Equal(t, node.ErrNilConsensusComponents, err)
+	})
+	t.Run("nil rounds timeline recorder should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, err := node.NewNode(node.WithConsensusComponents(&factoryMock.ConsensusComponentsStub{}))
+		require.Nil(t, err)
+
+		rounds, err := n.GetConsensusRounds()
+		assert.Nil(t, rounds)
+		assert.Equal(t, node.ErrNilRoundsTimelineRecorder, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedRounds := []*common.ConsensusRoundTimeline{{Round: 4}, {Round: 5}}
+		consensusComponents := &factoryMock.ConsensusComponentsStub{
+			RoundsTimeline: &mock.RoundsTimelineRecorderStub{
+				GetRoundsCalled: func() []*common.ConsensusRoundTimeline {
+					return expectedRounds
+				},
+			},
+		}
+		n, err := node.NewNode(node.WithConsensusComponents(consensusComponents))
+		require.Nil(t, err)
+
+		rounds, err := n.GetConsensusRounds()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedRounds, rounds)
+	})
+}
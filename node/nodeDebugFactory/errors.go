@@ -10,3 +10,6 @@ var ErrNilInterceptorContainer = errors.New("nil interceptor container")
 
 // ErrNilResolverContainer signals that a nil resolver container has been provided
 var ErrNilResolverContainer = errors.New("nil resolver container")
+
+// ErrNilPeersResponseTracker signals that a nil peers response tracker has been provided
+var ErrNilPeersResponseTracker = errors.New("nil peers response tracker")
@@ -0,0 +1,22 @@
+package nodeDebugFactory
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+)
+
+// PeersResponseTrackerDebugger is the constant string for the peers response tracker debugger
+const PeersResponseTrackerDebugger = "peers response tracker"
+
+// AddPeersResponseTrackerDebugHandler registers the peers response tracker as a query handler, so the
+// latency and success rate of each peer on each topic can be fetched through the debug API
+func AddPeersResponseTrackerDebugHandler(node NodeWrapper, tracker dataRetriever.PeersResponseTracker) error {
+	if check.IfNil(node) {
+		return ErrNilNodeWrapper
+	}
+	if check.IfNil(tracker) {
+		return ErrNilPeersResponseTracker
+	}
+
+	return node.AddQueryHandler(PeersResponseTrackerDebugger, tracker)
+}
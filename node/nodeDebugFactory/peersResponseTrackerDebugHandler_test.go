@@ -0,0 +1,47 @@
+package nodeDebugFactory
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/debug"
+	"github.com/multiversx/mx-chain-go/node/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAddPeersResponseTrackerDebugHandler_NilNodeWrapperShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := AddPeersResponseTrackerDebugHandler(nil, &testscommon.PeersResponseTrackerStub{})
+
+	assert.Equal(t, ErrNilNodeWrapper, err)
+}
+
+func TestAddPeersResponseTrackerDebugHandler_NilTrackerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	err := AddPeersResponseTrackerDebugHandler(&mock.NodeWrapperStub{}, nil)
+
+	assert.Equal(t, ErrNilPeersResponseTracker, err)
+}
+
+func TestAddPeersResponseTrackerDebugHandler_ShouldWork(t *testing.T) {
+	t.Parallel()
+
+	tracker := &testscommon.PeersResponseTrackerStub{}
+	wasCalled := false
+	nodeWrapper := &mock.NodeWrapperStub{
+		AddQueryHandlerCalled: func(name string, handler debug.QueryHandler) error {
+			wasCalled = true
+			assert.Equal(t, PeersResponseTrackerDebugger, name)
+			assert.True(t, handler == tracker) // pointer testing
+
+			return nil
+		},
+	}
+
+	err := AddPeersResponseTrackerDebugHandler(nodeWrapper, tracker)
+
+	assert.Nil(t, err)
+	assert.True(t, wasCalled)
+}
@@ -120,5 +120,10 @@ func CreateNode(
 		return nil, err
 	}
 
+	err = nodeDebugFactory.AddPeersResponseTrackerDebugHandler(nd, networkComponents.PeersResponseTracker())
+	if err != nil {
+		return nil, err
+	}
+
 	return nd, nil
 }
@@ -0,0 +1,20 @@
+package node
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
+)
+
+// GetNetworkTopology returns the network topology snapshot, as seen by the node
+func (n *Node) GetNetworkTopology() (*heartbeatData.NetworkTopology, error) {
+	if check.IfNil(n.heartbeatV2Components) {
+		return nil, ErrNilHeartbeatV2Components
+	}
+
+	topologyProvider := n.heartbeatV2Components.TopologyProvider()
+	if check.IfNil(topologyProvider) {
+		return nil, ErrNilNetworkTopologyProvider
+	}
+
+	return topologyProvider.GetNetworkTopology(), nil
+}
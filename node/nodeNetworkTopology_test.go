@@ -0,0 +1,54 @@
+package node_test
+
+import (
+	"testing"
+
+	factoryMock "github.com/multiversx/mx-chain-go/factory/mock"
+	heartbeatData "github.com/multiversx/mx-chain-go/heartbeat/data"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetNetworkTopology(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil heartbeatV2 components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+		topology, err := n.GetNetworkTopology()
+		assert.Nil(t, topology)
+		assert.Equal(t, node.ErrNilHeartbeatV2Components, err)
+	})
+	t.Run("nil topology provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, err := node.NewNode(node.WithHeartbeatV2Components(&factoryMock.HeartbeatV2ComponentsStub{}))
+		require.Nil(t, err)
+
+		topology, err := n.GetNetworkTopology()
+		assert.Nil(t, topology)
+		assert.Equal(t, node.ErrNilNetworkTopologyProvider, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTopology := &heartbeatData.NetworkTopology{
+			Observers: []string{"pid"},
+		}
+		heartbeatV2Components := &factoryMock.HeartbeatV2ComponentsStub{
+			TopologyProviderField: &factoryMock.NetworkTopologyProviderStub{
+				GetNetworkTopologyCalled: func() *heartbeatData.NetworkTopology {
+					return expectedTopology
+				},
+			},
+		}
+		n, err := node.NewNode(node.WithHeartbeatV2Components(heartbeatV2Components))
+		require.Nil(t, err)
+
+		topology, err := n.GetNetworkTopology()
+		assert.Nil(t, err)
+		assert.Equal(t, expectedTopology, topology)
+	})
+}
@@ -0,0 +1,268 @@
+package node
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+// GetMultiProof returns a single Merkle proof for all the given addresses, under the given root hash
+func (n *Node) GetMultiProof(rootHash string, addresses []string) (*common.GetMultiProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := n.getKeysBytes(addresses)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getMultiProof(rootHashBytes, keys)
+}
+
+// GetMultiProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for all the given
+// keys of the account's data trie
+func (n *Node) GetMultiProofDataTrie(rootHash string, address string, keys []string) (*common.GetProofResponse, *common.GetMultiProofResponse, error) {
+	mainProofResponse, dataTrieRootHash, err := n.getProofAndDataTrieRootHash(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keysBytes, err := decodeHexKeys(keys)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieProofResponse, err := n.getMultiProof(dataTrieRootHash, keysBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// GetRangeProof returns a single Merkle proof for all the accounts placed between the given keys, under the given
+// root hash. At most maxLeaves accounts are returned
+func (n *Node) GetRangeProof(rootHash string, startKey string, endKey string, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	startKeyBytes, endKeyBytes, err := getRangeBoundsBytes(startKey, endKey, n.getKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return n.getRangeProof(rootHashBytes, startKeyBytes, endKeyBytes, maxLeaves)
+}
+
+// GetRangeProofDataTrie returns the Merkle proof for the given address, and a single Merkle proof for all the keys
+// of the account's data trie placed between the given keys. At most maxLeaves keys are returned
+func (n *Node) GetRangeProofDataTrie(rootHash string, address string, startKey string, endKey string, maxLeaves int) (*common.GetProofResponse, *common.GetRangeProofResponse, error) {
+	mainProofResponse, dataTrieRootHash, err := n.getProofAndDataTrieRootHash(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	startKeyBytes, endKeyBytes, err := getRangeBoundsBytes(startKey, endKey, hex.DecodeString)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieProofResponse, err := n.getRangeProof(dataTrieRootHash, startKeyBytes, endKeyBytes, maxLeaves)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mainProofResponse, dataTrieProofResponse, nil
+}
+
+// VerifyMultiProof verifies the given multiproof and returns the values that it binds to the given keys
+func (n *Node) VerifyMultiProof(rootHash string, keys []string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	keysBytes, err := n.getKeysBytes(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	return createVerifyProofsResponse(mpv.VerifyMultiProof(rootHashBytes, keysBytes, proof))
+}
+
+// VerifyRangeProof verifies the given range proof and returns all the leaves placed between the given keys
+func (n *Node) VerifyRangeProof(rootHash string, startKey string, endKey string, proof [][]byte) (*common.VerifyProofsResponse, error) {
+	rootHashBytes, err := hex.DecodeString(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	startKeyBytes, endKeyBytes, err := getRangeBoundsBytes(startKey, endKey, n.getKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	mpv, err := trie.NewMerkleProofVerifier(n.coreComponents.InternalMarshalizer(), n.coreComponents.Hasher())
+	if err != nil {
+		return nil, err
+	}
+
+	return createVerifyProofsResponse(mpv.VerifyRangeProof(rootHashBytes, startKeyBytes, endKeyBytes, proof))
+}
+
+func createVerifyProofsResponse(leaves []core.KeyValueHolder, err error) (*common.VerifyProofsResponse, error) {
+	if errors.Is(err, trie.ErrInvalidProof) {
+		return &common.VerifyProofsResponse{Ok: false}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.VerifyProofsResponse{
+		Ok:     true,
+		Leaves: leaves,
+	}, nil
+}
+
+func (n *Node) getProofAndDataTrieRootHash(rootHash string, address string) (*common.GetProofResponse, []byte, error) {
+	rootHashBytes, addressBytes, err := n.getRootHashAndAddressAsBytes(rootHash, address)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mainProofResponse, err := n.getProof(rootHashBytes, addressBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userAccount, err := n.getUserAccountFromBytes(addressBytes, mainProofResponse.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataTrieRootHash := userAccount.GetRootHash()
+	if len(dataTrieRootHash) == 0 {
+		return nil, nil, fmt.Errorf("empty dataTrie rootHash")
+	}
+
+	return mainProofResponse, dataTrieRootHash, nil
+}
+
+func (n *Node) getUserAccountFromBytes(address []byte, accBytes []byte) (state.UserAccountHandler, error) {
+	account, err := n.stateComponents.AccountsAdapterAPI().GetAccountFromBytes(address, accBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	userAccount, ok := account.(state.UserAccountHandler)
+	if !ok {
+		return nil, fmt.Errorf("the address does not belong to a user account")
+	}
+
+	return userAccount, nil
+}
+
+func (n *Node) getMultiProof(rootHash []byte, keys [][]byte) (*common.GetMultiProofResponse, error) {
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	computedProof, values, err := tr.GetMultiProof(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	leaves := make([]core.KeyValueHolder, 0, len(keys))
+	for i, key := range keys {
+		leaves = append(leaves, keyValStorage.NewKeyValStorage(key, values[i]))
+	}
+
+	return &common.GetMultiProofResponse{
+		Proof:    computedProof,
+		Leaves:   leaves,
+		RootHash: hex.EncodeToString(rootHash),
+	}, nil
+}
+
+func (n *Node) getRangeProof(rootHash []byte, startKey []byte, endKey []byte, maxLeaves int) (*common.GetRangeProofResponse, error) {
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	computedProof, leaves, provenEndKey, err := tr.GetRangeProof(startKey, endKey, maxLeaves)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.GetRangeProofResponse{
+		Proof:    computedProof,
+		Leaves:   leaves,
+		EndKey:   provenEndKey,
+		RootHash: hex.EncodeToString(rootHash),
+	}, nil
+}
+
+func (n *Node) getKeysBytes(keys []string) ([][]byte, error) {
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := n.getKeyBytes(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %s", err, key)
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
+}
+
+// getRangeBoundsBytes decodes the bounds of a range. An empty bound is left empty, as it marks an open range
+func getRangeBoundsBytes(startKey string, endKey string, decodeKey func(string) ([]byte, error)) ([]byte, []byte, error) {
+	var startKeyBytes, endKeyBytes []byte
+	var err error
+	if len(startKey) > 0 {
+		startKeyBytes, err = decodeKey(startKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the start key", err)
+		}
+	}
+	if len(endKey) > 0 {
+		endKeyBytes, err = decodeKey(endKey)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%w for the end key", err)
+		}
+	}
+
+	return startKeyBytes, endKeyBytes, nil
+}
+
+func decodeHexKeys(keys []string) ([][]byte, error) {
+	keysBytes := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		keyBytes, err := hex.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("%w for key %s", err, key)
+		}
+
+		keysBytes = append(keysBytes, keyBytes)
+	}
+
+	return keysBytes, nil
+}
@@ -0,0 +1,278 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNode_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithStateComponents(getDefaultStateComponents()))
+
+		response, err := n.GetMultiProof("invalidRootHash", []string{"0123"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123", "address"})
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+						return nil, nil, expectedErr
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123"})
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proof := [][]byte{[]byte("valid"), []byte("proof")}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(rootHash []byte) (common.Trie, error) {
+				assert.Equal(t, "deadbeef", hex.EncodeToString(rootHash))
+				return &trieMock.TrieStub{
+					GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+						require.Len(t, keys, 2)
+						assert.Equal(t, "0123", hex.EncodeToString(keys[0]))
+						assert.Equal(t, "4567", hex.EncodeToString(keys[1]))
+						return proof, [][]byte{[]byte("value"), nil}, nil
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetMultiProof("deadbeef", []string{"0123", "4567"})
+		require.Nil(t, err)
+		assert.Equal(t, proof, response.Proof)
+		assert.Equal(t, "deadbeef", response.RootHash)
+		expectedLeaves := []core.KeyValueHolder{
+			keyValStorage.NewKeyValStorage([]byte{0x01, 0x23}, []byte("value")),
+			keyValStorage.NewKeyValStorage([]byte{0x45, 0x67}, nil),
+		}
+		assert.Equal(t, expectedLeaves, response.Leaves)
+	})
+}
+
+func TestNode_GetMultiProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid key should error", func(t *testing.T) {
+		t.Parallel()
+
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = createAccountsStubWithDataTrie(&trieMock.TrieStub{})
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		mainTrieResponse, dataTrieResponse, err := n.GetMultiProofDataTrie("deadbeef", "0123", []string{"key"})
+		assert.Nil(t, mainTrieResponse)
+		assert.Nil(t, dataTrieResponse)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		dataTrieProof := [][]byte{[]byte("data"), []byte("proof")}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = createAccountsStubWithDataTrie(&trieMock.TrieStub{
+			GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+				return [][]byte{[]byte("main")}, []byte("account"), nil
+			},
+			GetMultiProofCalled: func(keys [][]byte) ([][]byte, [][]byte, error) {
+				require.Len(t, keys, 1)
+				assert.Equal(t, "aabb", hex.EncodeToString(keys[0]))
+				return dataTrieProof, [][]byte{[]byte("value")}, nil
+			},
+		})
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		mainTrieResponse, dataTrieResponse, err := n.GetMultiProofDataTrie("deadbeef", "0123", []string{"aabb"})
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("main")}, mainTrieResponse.Proof)
+		assert.Equal(t, dataTrieProof, dataTrieResponse.Proof)
+		assert.Equal(t, hex.EncodeToString([]byte("dataTrieRoot")), dataTrieResponse.RootHash)
+	})
+}
+
+func TestNode_GetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid start key should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(
+			node.WithStateComponents(getDefaultStateComponents()),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetRangeProof("deadbeef", "address", "", 10)
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		proof := [][]byte{[]byte("valid"), []byte("proof")}
+		leaves := []core.KeyValueHolder{keyValStorage.NewKeyValStorage([]byte{0x01, 0x23}, []byte("value"))}
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return &trieMock.TrieStub{
+					GetRangeProofCalled: func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, []byte, error) {
+						assert.Equal(t, "0123", hex.EncodeToString(startKey))
+						assert.Nil(t, endKey)
+						assert.Equal(t, 10, maxLeaves)
+						return proof, leaves, []byte{0x01, 0x23}, nil
+					},
+				}, nil
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetRangeProof("deadbeef", "0123", "", 10)
+		require.Nil(t, err)
+		assert.Equal(t, proof, response.Proof)
+		assert.Equal(t, leaves, response.Leaves)
+		assert.Equal(t, []byte{0x01, 0x23}, response.EndKey)
+		assert.Equal(t, "deadbeef", response.RootHash)
+	})
+}
+
+func TestNode_GetRangeProofDataTrie(t *testing.T) {
+	t.Parallel()
+
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = createAccountsStubWithDataTrie(&trieMock.TrieStub{
+		GetProofCalled: func(key []byte) ([][]byte, []byte, error) {
+			return [][]byte{[]byte("main")}, []byte("account"), nil
+		},
+		GetRangeProofCalled: func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, []byte, error) {
+			assert.Equal(t, "aa", hex.EncodeToString(startKey))
+			assert.Equal(t, "bb", hex.EncodeToString(endKey))
+			return [][]byte{[]byte("data")}, nil, endKey, nil
+		},
+	})
+	n, _ := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+
+	mainTrieResponse, dataTrieResponse, err := n.GetRangeProofDataTrie("deadbeef", "0123", "aa", "bb", 10)
+	require.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte("main")}, mainTrieResponse.Proof)
+	assert.Equal(t, [][]byte{[]byte("data")}, dataTrieResponse.Proof)
+	assert.Equal(t, []byte{0xbb}, dataTrieResponse.EndKey)
+}
+
+func TestNode_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithCoreComponents(getDefaultCoreComponents()))
+
+		response, err := n.VerifyMultiProof("invalidRootHash", []string{"0123"}, nil)
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("incomplete proof should not be ok", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithCoreComponents(getDefaultCoreComponents()))
+
+		response, err := n.VerifyMultiProof("deadbeef", []string{"0123"}, [][]byte{})
+		require.Nil(t, err)
+		assert.False(t, response.Ok)
+		assert.Nil(t, response.Leaves)
+	})
+}
+
+func TestNode_VerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid bounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithCoreComponents(getDefaultCoreComponents()))
+
+		response, err := n.VerifyRangeProof("deadbeef", "4567", "0123", nil)
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("incomplete proof should not be ok", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode(node.WithCoreComponents(getDefaultCoreComponents()))
+
+		response, err := n.VerifyRangeProof("deadbeef", "0123", "4567", [][]byte{})
+		require.Nil(t, err)
+		assert.False(t, response.Ok)
+	})
+}
+
+func createAccountsStubWithDataTrie(tr *trieMock.TrieStub) *stateMock.AccountsStub {
+	return &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return tr, nil
+		},
+		GetAccountFromBytesCalled: func(address []byte, accountBytes []byte) (vmcommon.AccountHandler, error) {
+			acc := &stateMock.AccountWrapMock{}
+			acc.SetRootHash([]byte("dataTrieRoot"))
+			return acc, nil
+		},
+	}
+}
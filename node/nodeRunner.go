@@ -20,8 +20,10 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/closing"
 	"github.com/multiversx/mx-chain-core-go/core/throttler"
 	"github.com/multiversx/mx-chain-core-go/data/endProcess"
+	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/api/gin"
 	"github.com/multiversx/mx-chain-go/api/shared"
+	"github.com/multiversx/mx-chain-go/api/subscriptions"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/disabled"
 	"github.com/multiversx/mx-chain-go/common/forking"
@@ -51,6 +53,10 @@ import (
 	"github.com/multiversx/mx-chain-go/health"
 	"github.com/multiversx/mx-chain-go/node/metrics"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/filedriver"
+	outportDisabled "github.com/multiversx/mx-chain-go/outport/process/disabled"
+	outportProcessFactory "github.com/multiversx/mx-chain-go/outport/process/factory"
+	outportReplay "github.com/multiversx/mx-chain-go/outport/replay"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors"
@@ -304,8 +310,20 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	argsSubscriptionsHub := subscriptions.ArgsSubscriptionsHub{
+		Marshaller:                    &marshal.JsonMarshalizer{},
+		PubKeyConverter:               managedCoreComponents.AddressPubKeyConverter(),
+		MaxSubscribers:                configs.ApiRoutesConfig.Subscriptions.MaxSubscribers,
+		MaxSubscriptionsPerSubscriber: configs.ApiRoutesConfig.Subscriptions.MaxSubscriptionsPerSubscriber,
+		SubscriberQueueSize:           configs.ApiRoutesConfig.Subscriptions.SubscriberQueueSize,
+	}
+	subscriptionsHub, err := subscriptions.NewSubscriptionsHub(argsSubscriptionsHub)
+	if err != nil {
+		return true, err
+	}
+
 	log.Debug("creating disabled API services")
-	webServerHandler, err := nr.createHttpServer(managedStatusCoreComponents)
+	webServerHandler, err := nr.createHttpServer(managedStatusCoreComponents, subscriptionsHub)
 	if err != nil {
 		return true, err
 	}
@@ -398,6 +416,14 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if gin.IsSubscriptionsRouteEnabled(*configs.ApiRoutesConfig) {
+		log.Debug("subscribing the web socket subscriptions hub to the outport")
+		err = managedStatusComponents.OutportHandler().SubscribeDriver(subscriptionsHub)
+		if err != nil {
+			return true, err
+		}
+	}
+
 	argsGasScheduleNotifier := forking.ArgsNewGasScheduleNotifier{
 		GasScheduleConfig:  configs.EpochConfig.GasSchedule,
 		ConfigDir:          configurationPaths.GasScheduleDirectoryName,
@@ -426,6 +452,27 @@ func (nr *nodeRunner) executeOneComponentCreationCycle(
 		return true, err
 	}
 
+	if configs.OutportReplayConfig.IsOutportReplayMode && len(configs.OutportReplayConfig.RecordsDirectory) > 0 {
+		log.Debug("replaying the outport records into the outport")
+		err = replayOutportRecords(configs.OutportReplayConfig, managedStatusComponents)
+		return true, err
+	}
+
+	if configs.OutportReplayConfig.IsOutportReplayMode {
+		log.Debug("replaying the stored blocks into the outport")
+		err = replayOutportBlocks(
+			configs.OutportReplayConfig,
+			managedCoreComponents,
+			managedBootstrapComponents,
+			managedDataComponents,
+			managedStateComponents,
+			managedStatusComponents,
+			managedProcessComponents,
+			nodesCoordinatorInstance,
+		)
+		return true, err
+	}
+
 	err = addSyncersToAccountsDB(
 		configs.GeneralConfig,
 		managedCoreComponents,
@@ -747,7 +794,10 @@ func (nr *nodeRunner) createApiFacade(
 	return ef, nil
 }
 
-func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.StatusCoreComponentsHolder) (shared.UpgradeableHttpServerHandler, error) {
+func (nr *nodeRunner) createHttpServer(
+	managedStatusCoreComponents mainFactory.StatusCoreComponentsHolder,
+	subscriptionsHandler shared.SubscriptionsHandler,
+) (shared.UpgradeableHttpServerHandler, error) {
 	if check.IfNil(managedStatusCoreComponents) {
 		return nil, ErrNilStatusHandler
 	}
@@ -757,9 +807,10 @@ func (nr *nodeRunner) createHttpServer(managedStatusCoreComponents mainFactory.S
 	}
 
 	httpServerArgs := gin.ArgsNewWebServer{
-		Facade:          initialFacade,
-		ApiConfig:       *nr.configs.ApiRoutesConfig,
-		AntiFloodConfig: nr.configs.GeneralConfig.WebServerAntiflood,
+		Facade:               initialFacade,
+		ApiConfig:            *nr.configs.ApiRoutesConfig,
+		AntiFloodConfig:      nr.configs.GeneralConfig.WebServerAntiflood,
+		SubscriptionsHandler: subscriptionsHandler,
 	}
 
 	httpServerWrapper, err := gin.NewGinWebServerHandler(httpServerArgs)
@@ -863,6 +914,7 @@ func (nr *nodeRunner) CreateManagedConsensusComponents(
 		ScheduledProcessor:    scheduledProcessor,
 		IsInImportMode:        nr.configs.ImportDbConfig.IsImportDBMode,
 		ShouldDisableWatchdog: nr.configs.FlagsConfig.DisableConsensusWatchdog,
+		WorkingDir:            nr.configs.FlagsConfig.WorkingDir,
 	}
 
 	consensusFactory, err := consensusComp.NewConsensusComponentsFactory(consensusArgs)
@@ -1647,6 +1699,100 @@ func copySingleFile(destinationDirectory string, sourceFile string) {
 	}
 }
 
+func replayOutportBlocks(
+	replayConfig *config.OutportReplayConfig,
+	coreComponents mainFactory.CoreComponentsHolder,
+	bootstrapComponents mainFactory.BootstrapComponentsHolder,
+	dataComponents mainFactory.DataComponentsHolder,
+	stateComponents mainFactory.StateComponentsHolder,
+	statusComponents mainFactory.StatusComponentsHolder,
+	processComponents mainFactory.ProcessComponentsHolder,
+	nodesCoordinatorInstance nodesCoordinator.NodesCoordinator,
+) error {
+	storageService := dataComponents.StorageService()
+	txsStorer, err := storageService.GetStorer(dataRetriever.TransactionUnit)
+	if err != nil {
+		return err
+	}
+	mbsStorer, err := storageService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return err
+	}
+
+	transactionsLoader, err := outportReplay.NewStorageTransactionsProvider(outportReplay.ArgsStorageTransactionsProvider{
+		StorageService:     storageService,
+		Marshaller:         coreComponents.InternalMarshalizer(),
+		ReceiptsRepository: processComponents.ReceiptsRepository(),
+	})
+	if err != nil {
+		return err
+	}
+
+	outportHandler := statusComponents.OutportHandler()
+	dataProvider, err := outportProcessFactory.CreateOutportDataProvider(outportProcessFactory.ArgOutportDataProviderFactory{
+		HasDrivers:             outportHandler.HasDrivers(),
+		AddressConverter:       coreComponents.AddressPubKeyConverter(),
+		AccountsDB:             stateComponents.AccountsAdapter(),
+		Marshaller:             coreComponents.InternalMarshalizer(),
+		EsdtDataStorageHandler: processComponents.ESDTDataStorageHandlerForAPI(),
+		TransactionsStorer:     txsStorer,
+		ShardCoordinator:       bootstrapComponents.ShardCoordinator(),
+		TxCoordinator:          transactionsLoader,
+		NodesCoordinator:       nodesCoordinatorInstance,
+		GasConsumedProvider:    outportDisabled.NewDisabledGasConsumedProvider(),
+		EconomicsData:          coreComponents.EconomicsData(),
+		Hasher:                 coreComponents.Hasher(),
+		MbsStorer:              mbsStorer,
+		EnableEpochsHandler:    coreComponents.EnableEpochsHandler(),
+	})
+	if err != nil {
+		return err
+	}
+
+	replayer, err := outportReplay.NewBlocksReplayer(outportReplay.ArgsBlocksReplayer{
+		ShardID:            bootstrapComponents.ShardCoordinator().SelfId(),
+		StorageService:     storageService,
+		Marshaller:         coreComponents.InternalMarshalizer(),
+		Uint64Converter:    coreComponents.Uint64ByteSliceConverter(),
+		AccountsDB:         stateComponents.AccountsAdapter(),
+		TransactionsLoader: transactionsLoader,
+		DataProvider:       dataProvider,
+		OutportHandler:     outportHandler,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = replayer.ReplayBlocks(replayConfig.FromNonce, replayConfig.ToNonce)
+	if err != nil {
+		return err
+	}
+
+	// closing the outport handler will make the drivers flush everything they received
+	return outportHandler.Close()
+}
+
+func replayOutportRecords(
+	replayConfig *config.OutportReplayConfig,
+	statusComponents mainFactory.StatusComponentsHolder,
+) error {
+	outportHandler := statusComponents.OutportHandler()
+	numReplayed, err := filedriver.ReplayRecordsIntoOutport(
+		replayConfig.RecordsDirectory,
+		replayConfig.FromNonce,
+		replayConfig.ToNonce,
+		outportHandler,
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Info("outport records replayed", "directory", replayConfig.RecordsDirectory, "num records", numReplayed)
+
+	// closing the outport handler will make the drivers flush everything they received
+	return outportHandler.Close()
+}
+
 func indexValidatorsListIfNeeded(
 	outportHandler outport.OutportHandler,
 	coordinator nodesCoordinator.NodesCoordinator,
@@ -84,7 +84,8 @@ func createConfigs(tb testing.TB) *config.Configs {
 			NoKeyProvided: true,
 			Version:       "test version",
 		},
-		ImportDbConfig: &config.ImportDbConfig{},
+		ImportDbConfig:      &config.ImportDbConfig{},
+		OutportReplayConfig: &config.OutportReplayConfig{},
 		ConfigurationPathsHolder: &config.ConfigurationPathsHolder{
 			GasScheduleDirectoryName: path.Join(newConfigsPath, "gasSchedules"),
 			Nodes:                    path.Join(newConfigsPath, "nodesSetup.json"),
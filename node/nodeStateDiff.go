@@ -0,0 +1,105 @@
+package node
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+)
+
+// errStateDiffPageFull stops the accounts diff after a page was filled
+var errStateDiffPageFull = errors.New("state diff page full")
+
+// GetStateDiff returns at most limit accounts changed between the two hex encoded root hashes of the accounts trie,
+// starting with the provided address. Only the subtries with different hashes are walked, so the cost depends on the
+// size of the changes rather than on the size of the state
+func (n *Node) GetStateDiff(
+	fromRootHash string,
+	toRootHash string,
+	fromAddress string,
+	limit int,
+	ctx context.Context,
+) (*common.StateDiffAPI, error) {
+	if limit <= 0 {
+		return nil, ErrInvalidPageSize
+	}
+
+	oldRootHash, err := hex.DecodeString(fromRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the old root hash", err)
+	}
+	newRootHash, err := hex.DecodeString(toRootHash)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the new root hash", err)
+	}
+
+	var startAddress []byte
+	if len(fromAddress) > 0 {
+		startAddress, err = n.getKeyBytes(fromAddress)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalidCursor, err.Error())
+		}
+	}
+
+	tr, err := n.stateComponents.AccountsAdapterAPI().GetTrie(newRootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	stateDiff := &common.StateDiffAPI{
+		Accounts: make([]*common.AccountDiffAPI, 0),
+	}
+	handler := func(diff *state.AccountDiff) error {
+		if len(stateDiff.Accounts) == limit {
+			stateDiff.NextAddress = n.coreComponents.AddressPubKeyConverter().Encode(diff.Address)
+			return errStateDiffPageFull
+		}
+
+		stateDiff.Accounts = append(stateDiff.Accounts, n.accountDiffToAPI(diff))
+		return nil
+	}
+
+	err = state.GetAccountsDiff(tr, n.coreComponents.InternalMarshalizer(), oldRootHash, newRootHash, startAddress, handler, ctx)
+	if common.IsContextDone(ctx) {
+		return nil, ErrTrieOperationsTimeout
+	}
+	if err != nil && err != errStateDiffPageFull {
+		return nil, err
+	}
+
+	return stateDiff, nil
+}
+
+func (n *Node) accountDiffToAPI(diff *state.AccountDiff) *common.AccountDiffAPI {
+	accountDiff := &common.AccountDiffAPI{
+		Address:         n.coreComponents.AddressPubKeyConverter().Encode(diff.Address),
+		Created:         diff.OldAccount == nil,
+		Removed:         diff.NewAccount == nil,
+		OldBalance:      "0",
+		NewBalance:      "0",
+		DataTrieChanges: make([]*common.KeyValueDiffAPI, 0, len(diff.DataTrieChanges)),
+	}
+	if diff.OldAccount != nil {
+		accountDiff.OldBalance = bigToString(diff.OldAccount.Balance)
+		accountDiff.OldNonce = diff.OldAccount.Nonce
+		accountDiff.OldCodeHash = hex.EncodeToString(diff.OldAccount.CodeHash)
+	}
+	if diff.NewAccount != nil {
+		accountDiff.NewBalance = bigToString(diff.NewAccount.Balance)
+		accountDiff.NewNonce = diff.NewAccount.Nonce
+		accountDiff.NewCodeHash = hex.EncodeToString(diff.NewAccount.CodeHash)
+	}
+
+	for _, change := range diff.DataTrieChanges {
+		accountDiff.DataTrieChanges = append(accountDiff.DataTrieChanges, &common.KeyValueDiffAPI{
+			Key:      hex.EncodeToString(change.Key),
+			OldValue: hex.EncodeToString(change.OldValue),
+			NewValue: hex.EncodeToString(change.NewValue),
+		})
+	}
+
+	return accountDiff
+}
@@ -0,0 +1,170 @@
+package node_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func marshalAccountData(t *testing.T, account *state.UserAccountData) []byte {
+	buff, err := (&testscommon.MarshalizerMock{}).Marshal(account)
+	require.Nil(t, err)
+
+	return buff
+}
+
+func createNodeWithStateDiffTrie(t *testing.T, tr common.Trie) *node.Node {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.AccountsAPI = &stateMock.AccountsStub{
+		GetTrieCalled: func(_ []byte) (common.Trie, error) {
+			return tr, nil
+		},
+	}
+	n, err := node.NewNode(
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetStateDiff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithStateDiffTrie(t, &trieMock.TrieStub{})
+		response, err := n.GetStateDiff("aa", "bb", "", 0, context.Background())
+		assert.Nil(t, response)
+		assert.Equal(t, node.ErrInvalidPageSize, err)
+	})
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithStateDiffTrie(t, &trieMock.TrieStub{})
+		response, err := n.GetStateDiff("not hex", "bb", "", 10, context.Background())
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+
+		response, err = n.GetStateDiff("aa", "not hex", "", 10, context.Background())
+		assert.Nil(t, response)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid from address should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithStateDiffTrie(t, &trieMock.TrieStub{})
+		response, err := n.GetStateDiff("aa", "bb", "not an address", 10, context.Background())
+		assert.Nil(t, response)
+		assert.True(t, errors.Is(err, node.ErrInvalidCursor))
+	})
+	t.Run("get trie error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		stateComponents := getDefaultStateComponents()
+		stateComponents.AccountsAPI = &stateMock.AccountsStub{
+			GetTrieCalled: func(_ []byte) (common.Trie, error) {
+				return nil, expectedErr
+			},
+		}
+		n, _ := node.NewNode(
+			node.WithStateComponents(stateComponents),
+			node.WithCoreComponents(getDefaultCoreComponents()),
+		)
+
+		response, err := n.GetStateDiff("aa", "bb", "", 10, context.Background())
+		assert.Nil(t, response)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should return a page of changed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		modifiedAddress := bytes.Repeat([]byte{1}, 32)
+		createdAddress := bytes.Repeat([]byte{2}, 32)
+		removedAddress := bytes.Repeat([]byte{3}, 32)
+		oldModified := marshalAccountData(t, &state.UserAccountData{Balance: big.NewInt(10), Nonce: 1})
+		newModified := marshalAccountData(t, &state.UserAccountData{
+			Balance:  big.NewInt(15),
+			Nonce:    2,
+			CodeHash: []byte{0xcc},
+			RootHash: []byte("data root hash"),
+		})
+		created := marshalAccountData(t, &state.UserAccountData{Balance: big.NewInt(7)})
+
+		tr := &trieMock.TrieStub{
+			GetDiffCalled: func(oldRootHash []byte, newRootHash []byte, startKey []byte, handler common.TrieDiffHandlerFunc, _ context.Context) error {
+				if bytes.Equal(newRootHash, []byte("data root hash")) {
+					assert.Nil(t, oldRootHash)
+					suffix := append([]byte("key"), modifiedAddress...)
+					return handler([]byte("key"), nil, append([]byte("value"), suffix...))
+				}
+
+				assert.Equal(t, "aa", hex.EncodeToString(oldRootHash))
+				assert.Equal(t, "bb", hex.EncodeToString(newRootHash))
+				assert.Equal(t, modifiedAddress, startKey)
+				err := handler(modifiedAddress, oldModified, newModified)
+				if err != nil {
+					return err
+				}
+				err = handler(createdAddress, nil, created)
+				if err != nil {
+					return err
+				}
+
+				return handler(removedAddress, created, nil)
+			},
+		}
+		n := createNodeWithStateDiffTrie(t, tr)
+		converter := testscommon.RealWorldBech32PubkeyConverter
+
+		response, err := n.GetStateDiff("aa", "bb", converter.Encode(modifiedAddress), 2, context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, converter.Encode(removedAddress), response.NextAddress)
+		expectedAccounts := []*common.AccountDiffAPI{
+			{
+				Address:     converter.Encode(modifiedAddress),
+				OldBalance:  "10",
+				NewBalance:  "15",
+				OldNonce:    1,
+				NewNonce:    2,
+				NewCodeHash: "cc",
+				DataTrieChanges: []*common.KeyValueDiffAPI{
+					{
+						Key:      hex.EncodeToString([]byte("key")),
+						NewValue: hex.EncodeToString([]byte("value")),
+					},
+				},
+			},
+			{
+				Address:         converter.Encode(createdAddress),
+				Created:         true,
+				OldBalance:      "0",
+				NewBalance:      "7",
+				DataTrieChanges: make([]*common.KeyValueDiffAPI, 0),
+			},
+		}
+		assert.Equal(t, expectedAccounts, response.Accounts)
+
+		response, err = n.GetStateDiff("aa", "bb", converter.Encode(modifiedAddress), 3, context.Background())
+		require.Nil(t, err)
+		assert.Empty(t, response.NextAddress)
+		require.Equal(t, 3, len(response.Accounts))
+		assert.True(t, response.Accounts[2].Removed)
+		assert.Equal(t, "7", response.Accounts[2].OldBalance)
+	})
+}
@@ -0,0 +1,63 @@
+package node
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// GetStorageStats returns the statistics of the active persisters of each storage unit able to report them
+func (n *Node) GetStorageStats() ([]*common.StorageUnitStats, error) {
+	storageService := n.dataComponents.StorageService()
+	if check.IfNil(storageService) {
+		return nil, ErrNilStorageService
+	}
+
+	unitsStats := make([]*common.StorageUnitStats, 0)
+	for unitType, storer := range storageService.GetAllStorers() {
+		statsProvider, ok := storer.(storage.PersistersStatsProvider)
+		if !ok {
+			continue
+		}
+
+		unitsStats = append(unitsStats, &common.StorageUnitStats{
+			Unit:       unitType.String(),
+			Persisters: statsProvider.GetPersistersStats(),
+		})
+	}
+
+	sort.Slice(unitsStats, func(i, j int) bool {
+		return unitsStats[i].Unit < unitsStats[j].Unit
+	})
+
+	return unitsStats, nil
+}
+
+// CompactStorageUnit triggers a manual compaction of the active persisters of the storage unit with the given name.
+// The call blocks until the compaction is done
+func (n *Node) CompactStorageUnit(unitName string) error {
+	storageService := n.dataComponents.StorageService()
+	if check.IfNil(storageService) {
+		return ErrNilStorageService
+	}
+
+	for unitType, storer := range storageService.GetAllStorers() {
+		if unitType.String() != unitName {
+			continue
+		}
+
+		compactor, ok := storer.(storage.Compactor)
+		if !ok {
+			return fmt.Errorf("%w for %s", storage.ErrCompactionNotSupported, unitName)
+		}
+
+		log.Info("compacting storage unit", "unit", unitName)
+
+		return compactor.Compact()
+	}
+
+	return fmt.Errorf("%w: %s", ErrUnknownStorageUnit, unitName)
+}
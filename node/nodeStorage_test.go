@@ -0,0 +1,100 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/storage"
+	mockStorage "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type storerWithStatsStub struct {
+	*mockStorage.StorerStub
+	persistersStats []*common.PersisterStats
+	compactCalled   func() error
+}
+
+func (stub *storerWithStatsStub) GetPersistersStats() []*common.PersisterStats {
+	return stub.persistersStats
+}
+
+func (stub *storerWithStatsStub) Compact() error {
+	return stub.compactCalled()
+}
+
+func createNodeWithStorers(t *testing.T, storers map[dataRetriever.UnitType]storage.Storer) *node.Node {
+	dataComponents := getDefaultDataComponents()
+	dataComponents.Store = &mockStorage.ChainStorerStub{
+		GetAllStorersCalled: func() map[dataRetriever.UnitType]storage.Storer {
+			return storers
+		},
+	}
+
+	n, err := node.NewNode(node.WithDataComponents(dataComponents))
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetStorageStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataComponents := getDefaultDataComponents()
+		dataComponents.Store = nil
+		n, _ := node.NewNode(node.WithDataComponents(dataComponents))
+
+		stats, err := n.GetStorageStats()
+		assert.Nil(t, stats)
+		assert.Equal(t, node.ErrNilStorageService, err)
+	})
+	t.Run("should return the stats sorted by unit name", func(t *testing.T) {
+		t.Parallel()
+
+		txStats := []*common.PersisterStats{{Path: "Epoch_2/Shard_0/Transactions", Epoch: 2, NumFiles: 4, SizeInBytes: 1024}}
+		miniBlocksStats := []*common.PersisterStats{{Path: "Epoch_2/Shard_0/MiniBlocks", Epoch: 2, NumFiles: 3, SizeInBytes: 512}}
+		n := createNodeWithStorers(t, map[dataRetriever.UnitType]storage.Storer{
+			dataRetriever.TransactionUnit: &storerWithStatsStub{persistersStats: txStats},
+			dataRetriever.MiniBlockUnit:   &storerWithStatsStub{persistersStats: miniBlocksStats},
+			dataRetriever.ReceiptsUnit:    &mockStorage.StorerStub{},
+		})
+
+		stats, err := n.GetStorageStats()
+		require.Nil(t, err)
+		expectedStats := []*common.StorageUnitStats{
+			{Unit: dataRetriever.MiniBlockUnit.String(), Persisters: miniBlocksStats},
+			{Unit: dataRetriever.TransactionUnit.String(), Persisters: txStats},
+		}
+		assert.Equal(t, expectedStats, stats)
+	})
+}
+
+func TestNode_CompactStorageUnit(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	n := createNodeWithStorers(t, map[dataRetriever.UnitType]storage.Storer{
+		dataRetriever.TransactionUnit: &storerWithStatsStub{
+			compactCalled: func() error {
+				return expectedErr
+			},
+		},
+		dataRetriever.ReceiptsUnit: &mockStorage.StorerStub{},
+	})
+
+	err := n.CompactStorageUnit("unknown")
+	assert.True(t, errors.Is(err, node.ErrUnknownStorageUnit))
+
+	err = n.CompactStorageUnit(dataRetriever.ReceiptsUnit.String())
+	assert.True(t, errors.Is(err, storage.ErrCompactionNotSupported))
+
+	err = n.CompactStorageUnit(dataRetriever.TransactionUnit.String())
+	assert.Equal(t, expectedErr, err)
+}
@@ -420,9 +420,11 @@ func getDefaultStateComponents() *testscommon.StateComponentsMock {
 
 func getDefaultNetworkComponents() *factoryMock.NetworkComponentsMock {
 	return &factoryMock.NetworkComponentsMock{
-		Messenger:       &p2pmocks.MessengerStub{},
-		InputAntiFlood:  &mock.P2PAntifloodHandlerStub{},
-		OutputAntiFlood: &mock.P2PAntifloodHandlerStub{},
-		PeerBlackList:   &mock.PeerBlackListHandlerStub{},
+		Messenger:                 &p2pmocks.MessengerStub{},
+		InputAntiFlood:            &mock.P2PAntifloodHandlerStub{},
+		OutputAntiFlood:           &mock.P2PAntifloodHandlerStub{},
+		PeerBlackList:             &mock.PeerBlackListHandlerStub{},
+		PeerReputation:            &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTrackerField: &testscommon.PeersResponseTrackerStub{},
 	}
 }
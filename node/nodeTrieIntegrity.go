@@ -0,0 +1,84 @@
+package node
+
+import (
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/process/factory"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
+	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
+)
+
+// the damaged nodes are requested again from the peers until they are all received or the timeout expires
+const timeoutFetchTrieNodesFromPeers = time.Minute
+
+// VerifyTrieIntegrity walks the accounts trie with the given hex encoded root hash and all its data tries, reading the
+// nodes through the trie storage manager, and reports the damaged nodes. An empty root hash verifies the current
+// accounts trie. If repair is set, the damaged nodes are requested from the peers through the trie nodes resolvers and
+// saved in the trie storage. The call blocks until the whole trie is verified
+func (n *Node) VerifyTrieIntegrity(rootHash string, repair bool) (*trieIntegrity.Report, error) {
+	if check.IfNil(n.stateComponents) {
+		return nil, ErrNilStateComponents
+	}
+
+	rootHashBytes, err := n.getRootHashToVerify(rootHash)
+	if err != nil {
+		return nil, err
+	}
+
+	storageManager, ok := n.stateComponents.TrieStorageManagers()[trieFactory.UserAccountTrie]
+	if !ok || check.IfNil(storageManager) {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownTrie, trieFactory.UserAccountTrie)
+	}
+
+	verifier, err := trieIntegrity.NewTrieIntegrityVerifier(trieIntegrity.ArgsTrieIntegrityVerifier{
+		TrieStorage: storageManager,
+		Marshalizer: n.coreComponents.InternalMarshalizer(),
+		Hasher:      n.coreComponents.Hasher(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !repair {
+		return verifier.Verify(rootHashBytes)
+	}
+
+	fetcher, err := n.createPeersNodesFetcher()
+	if err != nil {
+		return nil, err
+	}
+
+	return verifier.VerifyAndRepair(rootHashBytes, fetcher)
+}
+
+func (n *Node) getRootHashToVerify(rootHash string) ([]byte, error) {
+	if len(rootHash) > 0 {
+		return hex.DecodeString(rootHash)
+	}
+
+	accountsAdapter := n.stateComponents.AccountsAdapter()
+	if check.IfNil(accountsAdapter) {
+		return nil, ErrNilAccountsAdapter
+	}
+
+	return accountsAdapter.RootHash()
+}
+
+func (n *Node) createPeersNodesFetcher() (trieIntegrity.MissingNodesFetcher, error) {
+	if check.IfNil(n.processComponents) {
+		return nil, ErrNilProcessComponents
+	}
+	if check.IfNil(n.dataComponents) {
+		return nil, ErrNilDataComponents
+	}
+
+	return trieIntegrity.NewPeersNodesFetcher(trieIntegrity.ArgsPeersNodesFetcher{
+		RequestHandler:   n.processComponents.RequestHandler(),
+		InterceptedNodes: n.dataComponents.Datapool().TrieNodes(),
+		ShardID:          n.processComponents.ShardCoordinator().SelfId(),
+		Topic:            factory.AccountTrieNodesTopic,
+		Timeout:          timeoutFetchTrieNodesFromPeers,
+	})
+}
@@ -0,0 +1,108 @@
+package node_test
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	trieFactory "github.com/multiversx/mx-chain-go/trie/factory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createNodeForTrieIntegrity(t *testing.T, storageManager common.StorageManager, options ...node.Option) *node.Node {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.StorageManagers = map[string]common.StorageManager{
+		trieFactory.UserAccountTrie: storageManager,
+	}
+	stateComponents.Accounts = &stateMock.AccountsStub{
+		RootHashCalled: func() ([]byte, error) {
+			return []byte("current root hash"), nil
+		},
+	}
+
+	options = append(options,
+		node.WithStateComponents(stateComponents),
+		node.WithCoreComponents(getDefaultCoreComponents()),
+	)
+	n, err := node.NewNode(options...)
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_VerifyTrieIntegrity(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	missingNodesStorageManager := &testscommon.StorageManagerStub{
+		GetCalled: func(_ []byte) ([]byte, error) {
+			return nil, expectedErr
+		},
+	}
+
+	t.Run("nil state components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		report, err := n.VerifyTrieIntegrity("", false)
+		assert.Nil(t, report)
+		assert.Equal(t, node.ErrNilStateComponents, err)
+	})
+	t.Run("invalid root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForTrieIntegrity(t, missingNodesStorageManager)
+
+		report, err := n.VerifyTrieIntegrity("not hex", false)
+		assert.Nil(t, report)
+		assert.NotNil(t, err)
+	})
+	t.Run("missing accounts trie storage manager should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeWithStorageManagers(t, map[string]common.StorageManager{"0": &testscommon.StorageManagerStub{}})
+
+		report, err := n.VerifyTrieIntegrity("aabb", false)
+		assert.Nil(t, report)
+		assert.True(t, errors.Is(err, node.ErrUnknownTrie))
+	})
+	t.Run("repair without process components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForTrieIntegrity(t, missingNodesStorageManager)
+
+		report, err := n.VerifyTrieIntegrity("aabb", true)
+		assert.Nil(t, report)
+		assert.Equal(t, node.ErrNilProcessComponents, err)
+	})
+	t.Run("repair without trie nodes pool should error", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForTrieIntegrity(t, missingNodesStorageManager,
+			node.WithProcessComponents(getDefaultProcessComponents()),
+			node.WithDataComponents(getDefaultDataComponents()),
+		)
+
+		report, err := n.VerifyTrieIntegrity("aabb", true)
+		assert.Nil(t, report)
+		assert.Equal(t, trieIntegrity.ErrNilInterceptedNodesCache, err)
+	})
+	t.Run("empty root hash should verify the current accounts trie", func(t *testing.T) {
+		t.Parallel()
+
+		n := createNodeForTrieIntegrity(t, missingNodesStorageManager)
+
+		report, err := n.VerifyTrieIntegrity("", false)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString([]byte("current root hash")), report.RootHash)
+		require.Equal(t, 1, len(report.DamagedNodes))
+		assert.Equal(t, hex.EncodeToString([]byte("current root hash")), report.DamagedNodes[0].Hash)
+	})
+}
@@ -0,0 +1,82 @@
+package node
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// GetTrieSnapshotsStatus returns the status of the snapshots and checkpoints of each trie storage manager
+func (n *Node) GetTrieSnapshotsStatus() (map[string]*common.TrieSnapshotsStatus, error) {
+	controllers, err := n.getTrieSnapshotsControllers()
+	if err != nil {
+		return nil, err
+	}
+
+	snapshotsStatus := make(map[string]*common.TrieSnapshotsStatus, len(controllers))
+	for trieName, controller := range controllers {
+		snapshotsStatus[trieName] = controller.GetSnapshotsStatus()
+	}
+
+	return snapshotsStatus, nil
+}
+
+// ControlTrieSnapshots pauses, resumes or cancels the snapshots and checkpoints of the trie with the given name.
+// An empty trie name applies the action on all tries
+func (n *Node) ControlTrieSnapshots(trieName string, action string) error {
+	controllers, err := n.getTrieSnapshotsControllers()
+	if err != nil {
+		return err
+	}
+
+	if len(trieName) > 0 {
+		controller, ok := controllers[trieName]
+		if !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownTrie, trieName)
+		}
+
+		controllers = map[string]common.TrieSnapshotsController{trieName: controller}
+	}
+
+	var controlFunc func(controller common.TrieSnapshotsController)
+	switch action {
+	case common.TrieSnapshotsPauseAction:
+		controlFunc = common.TrieSnapshotsController.PauseSnapshots
+	case common.TrieSnapshotsResumeAction:
+		controlFunc = common.TrieSnapshotsController.ResumeSnapshots
+	case common.TrieSnapshotsCancelAction:
+		controlFunc = common.TrieSnapshotsController.CancelSnapshots
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidTrieSnapshotsAction, action)
+	}
+
+	for name, controller := range controllers {
+		log.Info("trie snapshots control", "trie", name, "action", action)
+		controlFunc(controller)
+	}
+
+	return nil
+}
+
+func (n *Node) getTrieSnapshotsControllers() (map[string]common.TrieSnapshotsController, error) {
+	if check.IfNil(n.stateComponents) {
+		return nil, ErrNilStateComponents
+	}
+
+	controllers := make(map[string]common.TrieSnapshotsController)
+	for trieName, storageManager := range n.stateComponents.TrieStorageManagers() {
+		if check.IfNil(storageManager) {
+			continue
+		}
+
+		controller, ok := storageManager.GetBaseTrieStorageManager().(common.TrieSnapshotsController)
+		if !ok {
+			continue
+		}
+
+		controllers[trieName] = controller
+	}
+
+	return controllers, nil
+}
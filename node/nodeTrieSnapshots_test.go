@@ -0,0 +1,118 @@
+package node_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/node"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type trieSnapshotsControllerStub struct {
+	*testscommon.StorageManagerStub
+	status  *common.TrieSnapshotsStatus
+	actions []string
+}
+
+func newTrieSnapshotsControllerStub(status *common.TrieSnapshotsStatus) *trieSnapshotsControllerStub {
+	stub := &trieSnapshotsControllerStub{
+		status: status,
+	}
+	stub.StorageManagerStub = &testscommon.StorageManagerStub{
+		GetBaseTrieStorageManagerCalled: func() common.StorageManager {
+			return stub
+		},
+	}
+
+	return stub
+}
+
+func (stub *trieSnapshotsControllerStub) GetSnapshotsStatus() *common.TrieSnapshotsStatus {
+	return stub.status
+}
+
+func (stub *trieSnapshotsControllerStub) PauseSnapshots() {
+	stub.actions = append(stub.actions, common.TrieSnapshotsPauseAction)
+}
+
+func (stub *trieSnapshotsControllerStub) ResumeSnapshots() {
+	stub.actions = append(stub.actions, common.TrieSnapshotsResumeAction)
+}
+
+func (stub *trieSnapshotsControllerStub) CancelSnapshots() {
+	stub.actions = append(stub.actions, common.TrieSnapshotsCancelAction)
+}
+
+func createNodeWithStorageManagers(t *testing.T, storageManagers map[string]common.StorageManager) *node.Node {
+	stateComponents := getDefaultStateComponents()
+	stateComponents.StorageManagers = storageManagers
+
+	n, err := node.NewNode(node.WithStateComponents(stateComponents))
+	require.Nil(t, err)
+
+	return n
+}
+
+func TestNode_GetTrieSnapshotsStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil state components should error", func(t *testing.T) {
+		t.Parallel()
+
+		n, _ := node.NewNode()
+
+		status, err := n.GetTrieSnapshotsStatus()
+		assert.Nil(t, status)
+		assert.Equal(t, node.ErrNilStateComponents, err)
+	})
+	t.Run("should return the status of the tries supporting it", func(t *testing.T) {
+		t.Parallel()
+
+		accountsStatus := &common.TrieSnapshotsStatus{NumRunningSnapshots: 1, NumNodesCopied: 100}
+		peersStatus := &common.TrieSnapshotsStatus{Paused: true}
+		n := createNodeWithStorageManagers(t, map[string]common.StorageManager{
+			"0": newTrieSnapshotsControllerStub(accountsStatus),
+			"1": newTrieSnapshotsControllerStub(peersStatus),
+			"2": &testscommon.StorageManagerStub{},
+		})
+
+		status, err := n.GetTrieSnapshotsStatus()
+		require.Nil(t, err)
+		expectedStatus := map[string]*common.TrieSnapshotsStatus{
+			"0": accountsStatus,
+			"1": peersStatus,
+		}
+		assert.Equal(t, expectedStatus, status)
+	})
+}
+
+func TestNode_ControlTrieSnapshots(t *testing.T) {
+	t.Parallel()
+
+	accounts := newTrieSnapshotsControllerStub(nil)
+	peers := newTrieSnapshotsControllerStub(nil)
+	n := createNodeWithStorageManagers(t, map[string]common.StorageManager{
+		"0": accounts,
+		"1": peers,
+	})
+
+	err := n.ControlTrieSnapshots("unknown", common.TrieSnapshotsPauseAction)
+	assert.True(t, errors.Is(err, node.ErrUnknownTrie))
+
+	err = n.ControlTrieSnapshots("0", "stop")
+	assert.True(t, errors.Is(err, node.ErrInvalidTrieSnapshotsAction))
+	assert.Empty(t, accounts.actions)
+
+	err = n.ControlTrieSnapshots("0", common.TrieSnapshotsPauseAction)
+	assert.Nil(t, err)
+	err = n.ControlTrieSnapshots("", common.TrieSnapshotsCancelAction)
+	assert.Nil(t, err)
+	err = n.ControlTrieSnapshots("", common.TrieSnapshotsResumeAction)
+	assert.Nil(t, err)
+
+	assert.Equal(t, []string{common.TrieSnapshotsPauseAction, common.TrieSnapshotsCancelAction, common.TrieSnapshotsResumeAction}, accounts.actions)
+	assert.Equal(t, []string{common.TrieSnapshotsCancelAction, common.TrieSnapshotsResumeAction}, peers.actions)
+}
@@ -3732,6 +3732,11 @@ func TestNode_ShouldWork(t *testing.T) {
 		},
 	}
 
+	pid1Reputation := &common.PeerReputation{
+		Score:          -30,
+		IsBadPeer:      true,
+		NumInvalidData: 3,
+	}
 	networkComponents := getDefaultNetworkComponents()
 	networkComponents.Messenger = &p2pmocks.MessengerStub{
 		PeersCalled: func() []core.PeerID {
@@ -3742,6 +3747,15 @@ func TestNode_ShouldWork(t *testing.T) {
 			return []string{"addr" + string(pid)}
 		},
 	}
+	networkComponents.PeerReputation = &p2pmocks.PeerReputationHandlerStub{
+		GetPeerReputationCalled: func(pid core.PeerID) *common.PeerReputation {
+			if pid == core.PeerID(pid1) {
+				return pid1Reputation
+			}
+
+			return nil
+		},
+	}
 
 	coreComponents := getDefaultCoreComponents()
 	coreComponents.ValPubKeyConv = mock.NewPubkeyConverterMock(32)
@@ -3762,22 +3776,27 @@ func TestNode_ShouldWork(t *testing.T) {
 	assert.Nil(t, err)
 	require.Equal(t, 2, len(vals))
 
-	expected := []core.QueryP2PPeerInfo{
+	expected := []common.PeerInfoAPI{
 		{
-			Pid:           core.PeerID(pid1).Pretty(),
-			Addresses:     []string{"addr" + pid1},
-			Pk:            hex.EncodeToString([]byte(pid1)),
-			IsBlacklisted: true,
-			PeerType:      core.UnknownPeer.String(),
-			PeerSubType:   core.RegularPeer.String(),
+			QueryP2PPeerInfo: core.QueryP2PPeerInfo{
+				Pid:           core.PeerID(pid1).Pretty(),
+				Addresses:     []string{"addr" + pid1},
+				Pk:            hex.EncodeToString([]byte(pid1)),
+				IsBlacklisted: true,
+				PeerType:      core.UnknownPeer.String(),
+				PeerSubType:   core.RegularPeer.String(),
+			},
+			Reputation: pid1Reputation,
 		},
 		{
-			Pid:           core.PeerID(pid2).Pretty(),
-			Addresses:     []string{"addr" + pid2},
-			Pk:            hex.EncodeToString([]byte(pid2)),
-			IsBlacklisted: false,
-			PeerType:      core.UnknownPeer.String(),
-			PeerSubType:   core.RegularPeer.String(),
+			QueryP2PPeerInfo: core.QueryP2PPeerInfo{
+				Pid:           core.PeerID(pid2).Pretty(),
+				Addresses:     []string{"addr" + pid2},
+				Pk:            hex.EncodeToString([]byte(pid2)),
+				IsBlacklisted: false,
+				PeerType:      core.UnknownPeer.String(),
+				PeerSubType:   core.RegularPeer.String(),
+			},
 		},
 	}
 
@@ -62,8 +62,8 @@ func TestDelegatedListProc_GetDelegatorsListGetAllContractAddressesFailsShouldEr
 	expectedErr := errors.New("expected error")
 	arg := createMockArgs()
 	arg.QueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
-			return nil, expectedErr
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
+			return nil, nil, expectedErr
 		},
 	}
 	dlp, _ := NewDelegatedListProcessor(arg)
@@ -74,10 +74,10 @@ func TestDelegatedListProc_GetDelegatorsListGetAllContractAddressesFailsShouldEr
 
 	arg = createMockArgs()
 	arg.QueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			return &vmcommon.VMOutput{
 				ReturnCode: vmcommon.UserError,
-			}, nil
+			}, nil, nil
 		},
 	}
 	dlp, _ = NewDelegatedListProcessor(arg)
@@ -96,24 +96,24 @@ func TestDelegatedListProc_GetDelegatorsListContextShouldTimeout(t *testing.T) {
 	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(10)
 	delegationSc := [][]byte{[]byte("delegationSc1"), []byte("delegationSc2")}
 	arg.QueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			switch query.FuncName {
 			case "getAllContractAddresses":
 				return &vmcommon.VMOutput{
 					ReturnData: delegationSc,
-				}, nil
+				}, nil, nil
 			case "getUserActiveStake":
 				for index, delegator := range delegators {
 					if bytes.Equal(delegator, query.Arguments[0]) {
 						value := big.NewInt(int64(index + 1))
 						return &vmcommon.VMOutput{
 							ReturnData: [][]byte{value.Bytes()},
-						}, nil
+						}, nil, nil
 					}
 				}
 			}
 
-			return nil, fmt.Errorf("not an expected call")
+			return nil, nil, fmt.Errorf("not an expected call")
 		},
 	}
 	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
@@ -143,24 +143,24 @@ func TestDelegatedListProc_GetDelegatorsListShouldWork(t *testing.T) {
 	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(10)
 	delegationSc := [][]byte{[]byte("delegationSc1"), []byte("delegationSc2")}
 	arg.QueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			switch query.FuncName {
 			case "getAllContractAddresses":
 				return &vmcommon.VMOutput{
 					ReturnData: delegationSc,
-				}, nil
+				}, nil, nil
 			case "getUserActiveStake":
 				for index, delegator := range delegators {
 					if bytes.Equal(delegator, query.Arguments[0]) {
 						value := big.NewInt(int64(index + 1))
 						return &vmcommon.VMOutput{
 							ReturnData: [][]byte{value.Bytes()},
-						}, nil
+						}, nil, nil
 					}
 				}
 			}
 
-			return nil, fmt.Errorf("not an expected call")
+			return nil, nil, fmt.Errorf("not an expected call")
 		},
 	}
 	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
@@ -63,8 +63,8 @@ func TestDirectStakedListProc_GetDelegatorsListContextShouldTimeout(t *testing.T
 	arg := createMockArgs()
 	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(10)
 	arg.QueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
-			return nil, fmt.Errorf("not an expected call")
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
+			return nil, nil, fmt.Errorf("not an expected call")
 		},
 	}
 	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
@@ -93,7 +93,7 @@ func TestDirectStakedListProc_GetDelegatorsListShouldWork(t *testing.T) {
 	arg := createMockArgs()
 	arg.PublicKeyConverter = mock.NewPubkeyConverterMock(10)
 	arg.QueryService = &mock.SCQueryServiceStub{
-		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+		ExecuteQueryCalled: func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 			switch query.FuncName {
 			case "getTotalStakedTopUpStakedBlsKeys":
 				for index, validator := range validators {
@@ -103,12 +103,12 @@ func TestDirectStakedListProc_GetDelegatorsListShouldWork(t *testing.T) {
 
 						return &vmcommon.VMOutput{
 							ReturnData: [][]byte{topUpValue.Bytes(), totalStakedValue.Bytes(), make([]byte, 0)},
-						}, nil
+						}, nil, nil
 					}
 				}
 			}
 
-			return nil, fmt.Errorf("not an expected call")
+			return nil, nil, fmt.Errorf("not an expected call")
 		},
 	}
 	arg.Accounts.AccountsAdapter = &stateMock.AccountsStub{
@@ -1,25 +1,37 @@
 package factory
 
 import (
+	"time"
+
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/notifier"
+	"github.com/multiversx/mx-chain-go/storage/storageunit"
+)
+
+const (
+	queueBatchDelaySeconds = 1
+	queueMaxBatchSize      = 1
+	queueMaxOpenFiles      = 10
 )
 
 // EventNotifierFactoryArgs defines the args needed for event notifier creation
 type EventNotifierFactoryArgs struct {
-	Enabled           bool
-	UseAuthorization  bool
-	ProxyUrl          string
-	Username          string
-	Password          string
-	RequestTimeoutSec int
-	Marshaller        marshal.Marshalizer
-	Hasher            hashing.Hasher
-	PubKeyConverter   core.PubkeyConverter
+	Enabled                        bool
+	UseAuthorization               bool
+	ProxyUrl                       string
+	Username                       string
+	Password                       string
+	RequestTimeoutSec              int
+	QueueDirectory                 string
+	MinRetryIntervalInMilliseconds uint32
+	MaxRetryIntervalInMilliseconds uint32
+	Marshaller                     marshal.Marshalizer
+	Hasher                         hashing.Hasher
+	PubKeyConverter                core.PubkeyConverter
 }
 
 // CreateEventNotifier will create a new event notifier client instance
@@ -47,9 +59,43 @@ func CreateEventNotifier(args *EventNotifierFactoryArgs) (outport.Driver, error)
 		PubKeyConverter: args.PubKeyConverter,
 	}
 
+	// a persistent events queue is placed in front of the http client if a queue directory is configured
+	if len(args.QueueDirectory) > 0 {
+		notifierArgs.HttpClient, err = createEventsQueue(args, httpClient)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return notifier.NewEventNotifier(notifierArgs)
 }
 
+func createEventsQueue(args *EventNotifierFactoryArgs, httpClient notifier.HTTPClientHandler) (notifier.HTTPClientHandler, error) {
+	persister, err := storageunit.NewDB(storageunit.ArgDB{
+		DBType:            storageunit.LvlDBSerial,
+		Path:              args.QueueDirectory,
+		BatchDelaySeconds: queueBatchDelaySeconds,
+		MaxBatchSize:      queueMaxBatchSize,
+		MaxOpenFiles:      queueMaxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	queue, err := notifier.NewEventsQueue(notifier.ArgsEventsQueue{
+		Persister:        persister,
+		HttpClient:       httpClient,
+		MinRetryInterval: time.Duration(args.MinRetryIntervalInMilliseconds) * time.Millisecond,
+		MaxRetryInterval: time.Duration(args.MaxRetryIntervalInMilliseconds) * time.Millisecond,
+	})
+	if err != nil {
+		_ = persister.Close()
+		return nil, err
+	}
+
+	return queue, nil
+}
+
 func checkInputArgs(args *EventNotifierFactoryArgs) error {
 	if check.IfNil(args.Marshaller) {
 		return core.ErrNilMarshalizer
@@ -1,11 +1,13 @@
 package factory_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/outport"
 	"github.com/multiversx/mx-chain-go/outport/factory"
+	"github.com/multiversx/mx-chain-go/outport/notifier"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	"github.com/stretchr/testify/require"
@@ -68,4 +70,29 @@ func TestCreateEventNotifier(t *testing.T) {
 		require.Nil(t, err)
 		require.NotNil(t, en)
 	})
+	t.Run("invalid retry intervals should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.QueueDirectory = t.TempDir()
+		args.MinRetryIntervalInMilliseconds = 0
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, en)
+		require.True(t, errors.Is(err, notifier.ErrInvalidValue))
+	})
+
+	t.Run("should work with events queue", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockNotifierFactoryArgs()
+		args.QueueDirectory = t.TempDir()
+		args.MinRetryIntervalInMilliseconds = 10
+		args.MaxRetryIntervalInMilliseconds = 100
+
+		en, err := factory.CreateEventNotifier(args)
+		require.Nil(t, err)
+		require.NotNil(t, en)
+		require.Nil(t, en.Close())
+	})
 }
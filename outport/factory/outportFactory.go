@@ -6,6 +6,7 @@ import (
 	wsDriverFactory "github.com/multiversx/mx-chain-core-go/websocketOutportDriver/factory"
 	indexerFactory "github.com/multiversx/mx-chain-es-indexer-go/process/factory"
 	"github.com/multiversx/mx-chain-go/outport"
+	"github.com/multiversx/mx-chain-go/outport/filedriver"
 )
 
 // WrappedOutportDriverWebSocketSenderFactoryArgs extends the wsDriverFactory.OutportDriverWebSocketSenderFactoryArgs structure with the Enabled field
@@ -14,12 +15,19 @@ type WrappedOutportDriverWebSocketSenderFactoryArgs struct {
 	wsDriverFactory.OutportDriverWebSocketSenderFactoryArgs
 }
 
+// FileDriverFactoryArgs extends the filedriver.ArgsFileDriver structure with the Enabled field
+type FileDriverFactoryArgs struct {
+	Enabled bool
+	filedriver.ArgsFileDriver
+}
+
 // OutportFactoryArgs holds the factory arguments of different outport drivers
 type OutportFactoryArgs struct {
 	RetrialInterval                  time.Duration
 	ElasticIndexerFactoryArgs        indexerFactory.ArgsIndexerFactory
 	EventNotifierFactoryArgs         *EventNotifierFactoryArgs
 	WebSocketSenderDriverFactoryArgs WrappedOutportDriverWebSocketSenderFactoryArgs
+	FileDriverFactoryArgs            FileDriverFactoryArgs
 }
 
 // CreateOutport will create a new instance of OutportHandler
@@ -53,7 +61,12 @@ func createAndSubscribeDrivers(outport outport.OutportHandler, args *OutportFact
 		return err
 	}
 
-	return createAndSubscribeWebSocketDriver(outport, args.WebSocketSenderDriverFactoryArgs)
+	err = createAndSubscribeWebSocketDriver(outport, args.WebSocketSenderDriverFactoryArgs)
+	if err != nil {
+		return err
+	}
+
+	return createAndSubscribeFileDriverIfNeeded(outport, args.FileDriverFactoryArgs)
 }
 
 func createAndSubscribeElasticDriverIfNeeded(
@@ -116,3 +129,19 @@ func createAndSubscribeWebSocketDriver(
 
 	return outport.SubscribeDriver(wsDriver)
 }
+
+func createAndSubscribeFileDriverIfNeeded(
+	outport outport.OutportHandler,
+	args FileDriverFactoryArgs,
+) error {
+	if !args.Enabled {
+		return nil
+	}
+
+	fileDriver, err := filedriver.NewFileDriver(args.ArgsFileDriver)
+	if err != nil {
+		return err
+	}
+
+	return outport.SubscribeDriver(fileDriver)
+}
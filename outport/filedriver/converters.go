@@ -0,0 +1,289 @@
+package filedriver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+func createSaveBlockPayload(args *outport.ArgsSaveBlockData) (*saveBlockPayload, error) {
+	headerBytes, err := json.Marshal(args.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := createPoolPayload(args.TransactionsPool)
+	if err != nil {
+		return nil, err
+	}
+
+	return &saveBlockPayload{
+		HeaderType:             core.GetHeaderType(args.Header),
+		HeaderHash:             args.HeaderHash,
+		Header:                 headerBytes,
+		Body:                   getBody(args.Body),
+		SignersIndexes:         args.SignersIndexes,
+		NotarizedHeadersHashes: args.NotarizedHeadersHashes,
+		HeaderGasConsumption:   args.HeaderGasConsumption,
+		TransactionsPool:       pool,
+		AlteredAccounts:        args.AlteredAccounts,
+		NumberOfShards:         args.NumberOfShards,
+		IsImportDB:             args.IsImportDB,
+	}, nil
+}
+
+func getBody(body data.BodyHandler) *block.Body {
+	blockBody, ok := body.(*block.Body)
+	if !ok {
+		return nil
+	}
+
+	return blockBody
+}
+
+func createPoolPayload(pool *outport.Pool) (*poolPayload, error) {
+	if pool == nil {
+		return nil, nil
+	}
+
+	payload := &poolPayload{
+		ScheduledExecutedSCRSHashesPrevBlock:       pool.ScheduledExecutedSCRSHashesPrevBlock,
+		ScheduledExecutedInvalidTxsHashesPrevBlock: pool.ScheduledExecutedInvalidTxsHashesPrevBlock,
+	}
+
+	var err error
+	payload.Txs, err = createTransactionsPayload(pool.Txs)
+	if err != nil {
+		return nil, err
+	}
+	payload.Scrs, err = createTransactionsPayload(pool.Scrs)
+	if err != nil {
+		return nil, err
+	}
+	payload.Rewards, err = createTransactionsPayload(pool.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	payload.Invalid, err = createTransactionsPayload(pool.Invalid)
+	if err != nil {
+		return nil, err
+	}
+	payload.Receipts, err = createTransactionsPayload(pool.Receipts)
+	if err != nil {
+		return nil, err
+	}
+	payload.Logs, err = createLogsPayload(pool.Logs)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+func createTransactionsPayload(txs map[string]data.TransactionHandlerWithGasUsedAndFee) (map[string]*transactionPayload, error) {
+	if len(txs) == 0 {
+		return nil, nil
+	}
+
+	payload := make(map[string]*transactionPayload, len(txs))
+	for hash, tx := range txs {
+		if check.IfNil(tx) {
+			continue
+		}
+
+		txPayload := &transactionPayload{
+			GasUsed:        tx.GetGasUsed(),
+			Fee:            tx.GetFee(),
+			InitialPaidFee: tx.GetInitialPaidFee(),
+			ExecutionOrder: tx.GetExecutionOrder(),
+		}
+
+		switch txHandler := tx.GetTxHandler().(type) {
+		case *transaction.Transaction:
+			txPayload.Transaction = txHandler
+		case *smartContractResult.SmartContractResult:
+			txPayload.SmartContractResult = txHandler
+		case *rewardTx.RewardTx:
+			txPayload.Reward = txHandler
+		case *receipt.Receipt:
+			txPayload.Receipt = txHandler
+		default:
+			return nil, fmt.Errorf("%w: %T", ErrUnknownTransactionType, txHandler)
+		}
+
+		payload[hex.EncodeToString([]byte(hash))] = txPayload
+	}
+
+	return payload, nil
+}
+
+func createLogsPayload(logs []*data.LogData) ([]*logPayload, error) {
+	payload := make([]*logPayload, 0, len(logs))
+	for _, logData := range logs {
+		if logData == nil || check.IfNil(logData.LogHandler) {
+			continue
+		}
+
+		txLog, ok := logData.LogHandler.(*transaction.Log)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnknownLogType, logData.LogHandler)
+		}
+
+		payload = append(payload, &logPayload{
+			TxHash: hex.EncodeToString([]byte(logData.TxHash)),
+			Log:    txLog,
+		})
+	}
+
+	return payload, nil
+}
+
+func (payload *saveBlockPayload) toArgsSaveBlockData() (*outport.ArgsSaveBlockData, error) {
+	header, err := unmarshalHeader(payload.HeaderType, payload.Header)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := payload.TransactionsPool.toPool()
+	if err != nil {
+		return nil, err
+	}
+
+	args := &outport.ArgsSaveBlockData{
+		HeaderHash:             payload.HeaderHash,
+		Header:                 header,
+		SignersIndexes:         payload.SignersIndexes,
+		NotarizedHeadersHashes: payload.NotarizedHeadersHashes,
+		HeaderGasConsumption:   payload.HeaderGasConsumption,
+		TransactionsPool:       pool,
+		AlteredAccounts:        payload.AlteredAccounts,
+		NumberOfShards:         payload.NumberOfShards,
+		IsImportDB:             payload.IsImportDB,
+	}
+	if payload.Body != nil {
+		args.Body = payload.Body
+	}
+
+	return args, nil
+}
+
+func unmarshalHeader(headerType core.HeaderType, buff []byte) (data.HeaderHandler, error) {
+	var header data.HeaderHandler
+	switch headerType {
+	case core.ShardHeaderV1:
+		header = &block.Header{}
+	case core.ShardHeaderV2:
+		header = &block.HeaderV2{}
+	case core.MetaHeader:
+		header = &block.MetaBlock{}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownHeaderType, headerType)
+	}
+
+	err := json.Unmarshal(buff, header)
+	if err != nil {
+		return nil, err
+	}
+
+	return header, nil
+}
+
+func (payload *poolPayload) toPool() (*outport.Pool, error) {
+	if payload == nil {
+		return nil, nil
+	}
+
+	pool := &outport.Pool{
+		Logs:                                 make([]*data.LogData, 0, len(payload.Logs)),
+		ScheduledExecutedSCRSHashesPrevBlock: payload.ScheduledExecutedSCRSHashesPrevBlock,
+		ScheduledExecutedInvalidTxsHashesPrevBlock: payload.ScheduledExecutedInvalidTxsHashesPrevBlock,
+	}
+
+	var err error
+	pool.Txs, err = toTransactions(payload.Txs)
+	if err != nil {
+		return nil, err
+	}
+	pool.Scrs, err = toTransactions(payload.Scrs)
+	if err != nil {
+		return nil, err
+	}
+	pool.Rewards, err = toTransactions(payload.Rewards)
+	if err != nil {
+		return nil, err
+	}
+	pool.Invalid, err = toTransactions(payload.Invalid)
+	if err != nil {
+		return nil, err
+	}
+	pool.Receipts, err = toTransactions(payload.Receipts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, logEntry := range payload.Logs {
+		txHash, errDecode := hex.DecodeString(logEntry.TxHash)
+		if errDecode != nil {
+			return nil, errDecode
+		}
+
+		pool.Logs = append(pool.Logs, &data.LogData{
+			LogHandler: logEntry.Log,
+			TxHash:     string(txHash),
+		})
+	}
+
+	return pool, nil
+}
+
+func toTransactions(payload map[string]*transactionPayload) (map[string]data.TransactionHandlerWithGasUsedAndFee, error) {
+	txs := make(map[string]data.TransactionHandlerWithGasUsedAndFee, len(payload))
+	for hexHash, txPayload := range payload {
+		hash, err := hex.DecodeString(hexHash)
+		if err != nil {
+			return nil, err
+		}
+
+		txHandler, err := txPayload.getTxHandler()
+		if err != nil {
+			return nil, err
+		}
+
+		txs[string(hash)] = &outport.TransactionHandlerWithGasAndFee{
+			TransactionHandler: txHandler,
+			FeeInfo: outport.FeeInfo{
+				GasUsed:        txPayload.GasUsed,
+				Fee:            txPayload.Fee,
+				InitialPaidFee: txPayload.InitialPaidFee,
+			},
+			ExecutionOrder: txPayload.ExecutionOrder,
+		}
+	}
+
+	return txs, nil
+}
+
+func (payload *transactionPayload) getTxHandler() (data.TransactionHandler, error) {
+	switch {
+	case payload.Transaction != nil:
+		return payload.Transaction, nil
+	case payload.SmartContractResult != nil:
+		return payload.SmartContractResult, nil
+	case payload.Reward != nil:
+		return payload.Reward, nil
+	case payload.Receipt != nil:
+		return payload.Receipt, nil
+	default:
+		return nil, ErrUnknownTransactionType
+	}
+}
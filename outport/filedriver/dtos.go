@@ -0,0 +1,122 @@
+package filedriver
+
+import (
+	"encoding/json"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+const (
+	// FormatJsonLines writes each record as a JSON document followed by a new line
+	FormatJsonLines = "jsonLines"
+	// FormatLengthPrefixed writes each record as a JSON document prefixed by its length, as a 4 bytes big endian number
+	FormatLengthPrefixed = "lengthPrefixed"
+)
+
+const (
+	// RecordSaveBlock is the type of the records written on SaveBlock calls
+	RecordSaveBlock = "saveBlock"
+	// RecordRevertIndexedBlock is the type of the records written on RevertIndexedBlock calls
+	RecordRevertIndexedBlock = "revertIndexedBlock"
+	// RecordSaveRoundsInfo is the type of the records written on SaveRoundsInfo calls
+	RecordSaveRoundsInfo = "saveRoundsInfo"
+	// RecordSaveValidatorsPubKeys is the type of the records written on SaveValidatorsPubKeys calls
+	RecordSaveValidatorsPubKeys = "saveValidatorsPubKeys"
+	// RecordSaveValidatorsRating is the type of the records written on SaveValidatorsRating calls
+	RecordSaveValidatorsRating = "saveValidatorsRating"
+	// RecordSaveAccounts is the type of the records written on SaveAccounts calls
+	RecordSaveAccounts = "saveAccounts"
+	// RecordFinalizedBlock is the type of the records written on FinalizedBlock calls
+	RecordFinalizedBlock = "finalizedBlock"
+)
+
+// Record is the unit written in the records files. The nonce is set only for the block related records
+type Record struct {
+	Type    string          `json:"type"`
+	Nonce   uint64          `json:"nonce,omitempty"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// IndexEntry holds the position of a block related record in the records files
+type IndexEntry struct {
+	Type   string `json:"type"`
+	Nonce  uint64 `json:"nonce"`
+	File   string `json:"file"`
+	Offset int64  `json:"offset"`
+}
+
+type saveBlockPayload struct {
+	HeaderType             core.HeaderType                    `json:"headerType"`
+	HeaderHash             []byte                             `json:"headerHash"`
+	Header                 json.RawMessage                    `json:"header"`
+	Body                   *block.Body                        `json:"body,omitempty"`
+	SignersIndexes         []uint64                           `json:"signersIndexes,omitempty"`
+	NotarizedHeadersHashes []string                           `json:"notarizedHeadersHashes,omitempty"`
+	HeaderGasConsumption   outport.HeaderGasConsumption       `json:"headerGasConsumption"`
+	TransactionsPool       *poolPayload                       `json:"transactionsPool,omitempty"`
+	AlteredAccounts        map[string]*outport.AlteredAccount `json:"alteredAccounts,omitempty"`
+	NumberOfShards         uint32                             `json:"numberOfShards"`
+	IsImportDB             bool                               `json:"isImportDB"`
+}
+
+// poolPayload holds the transactions pool. The map keys and the log hashes are hex encoded
+type poolPayload struct {
+	Txs                                        map[string]*transactionPayload `json:"txs,omitempty"`
+	Scrs                                       map[string]*transactionPayload `json:"scrs,omitempty"`
+	Rewards                                    map[string]*transactionPayload `json:"rewards,omitempty"`
+	Invalid                                    map[string]*transactionPayload `json:"invalid,omitempty"`
+	Receipts                                   map[string]*transactionPayload `json:"receipts,omitempty"`
+	Logs                                       []*logPayload                  `json:"logs,omitempty"`
+	ScheduledExecutedSCRSHashesPrevBlock       []string                       `json:"scheduledExecutedSCRSHashesPrevBlock,omitempty"`
+	ScheduledExecutedInvalidTxsHashesPrevBlock []string                       `json:"scheduledExecutedInvalidTxsHashesPrevBlock,omitempty"`
+}
+
+// transactionPayload holds exactly one of the transaction types, along with its fee information
+type transactionPayload struct {
+	Transaction         *transaction.Transaction                 `json:"transaction,omitempty"`
+	SmartContractResult *smartContractResult.SmartContractResult `json:"smartContractResult,omitempty"`
+	Reward              *rewardTx.RewardTx                       `json:"reward,omitempty"`
+	Receipt             *receipt.Receipt                         `json:"receipt,omitempty"`
+	GasUsed             uint64                                   `json:"gasUsed"`
+	Fee                 *big.Int                                 `json:"fee,omitempty"`
+	InitialPaidFee      *big.Int                                 `json:"initialPaidFee,omitempty"`
+	ExecutionOrder      int                                      `json:"executionOrder"`
+}
+
+type logPayload struct {
+	TxHash string           `json:"txHash"`
+	Log    *transaction.Log `json:"log"`
+}
+
+type revertIndexedBlockPayload struct {
+	HeaderType core.HeaderType `json:"headerType"`
+	Header     json.RawMessage `json:"header"`
+	Body       *block.Body     `json:"body,omitempty"`
+}
+
+type saveValidatorsPubKeysPayload struct {
+	ValidatorsPubKeys map[uint32][][]byte `json:"validatorsPubKeys"`
+	Epoch             uint32              `json:"epoch"`
+}
+
+type saveValidatorsRatingPayload struct {
+	IndexID    string                         `json:"indexID"`
+	InfoRating []*outport.ValidatorRatingInfo `json:"infoRating"`
+}
+
+type saveAccountsPayload struct {
+	Timestamp uint64                             `json:"timestamp"`
+	Accounts  map[string]*outport.AlteredAccount `json:"accounts"`
+	ShardID   uint32                             `json:"shardID"`
+}
+
+type finalizedBlockPayload struct {
+	HeaderHash []byte `json:"headerHash"`
+}
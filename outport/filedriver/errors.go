@@ -0,0 +1,44 @@
+package filedriver
+
+import (
+	"errors"
+)
+
+// ErrEmptyDirectory signals that an empty directory has been provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidFormat signals that an invalid records format has been provided
+var ErrInvalidFormat = errors.New("invalid records format")
+
+// ErrInvalidMaxFileSize signals that an invalid maximum file size has been provided
+var ErrInvalidMaxFileSize = errors.New("invalid maximum file size")
+
+// ErrNilDriver signals that a nil driver has been provided
+var ErrNilDriver = errors.New("nil driver")
+
+// ErrNilRecord signals that a nil record has been provided
+var ErrNilRecord = errors.New("nil record")
+
+// ErrUnknownRecordType signals that a record of an unknown type has been found
+var ErrUnknownRecordType = errors.New("unknown record type")
+
+// ErrUnknownHeaderType signals that a header of an unknown type has been found
+var ErrUnknownHeaderType = errors.New("unknown header type")
+
+// ErrUnknownTransactionType signals that a transaction of an unknown type has been found
+var ErrUnknownTransactionType = errors.New("unknown transaction type")
+
+// ErrUnknownLogType signals that a log of an unknown type has been found
+var ErrUnknownLogType = errors.New("unknown log type")
+
+// ErrNonceNotFound signals that no block with the requested nonce was found in the index
+var ErrNonceNotFound = errors.New("nonce not found in the records index")
+
+// ErrCorruptedRecord signals that a record could not be read
+var ErrCorruptedRecord = errors.New("corrupted record")
+
+// ErrDriverClosed signals that the driver was closed
+var ErrDriverClosed = errors.New("file driver is closed")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
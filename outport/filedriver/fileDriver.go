@@ -0,0 +1,180 @@
+package filedriver
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/filedriver")
+
+// ArgsFileDriver defines the arguments needed for the file driver creation
+type ArgsFileDriver struct {
+	Directory       string
+	Format          string
+	MaxFileSizeInMB uint64
+}
+
+type fileDriver struct {
+	mutWriter sync.Mutex
+	writer    *recordsWriter
+}
+
+// NewFileDriver creates a new outport driver that writes everything it receives as records in rotating files on the
+// local disk. The records can be read back with a records reader and replayed into any other outport driver
+func NewFileDriver(args ArgsFileDriver) (*fileDriver, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := newRecordsWriter(args.Directory, args.Format, int64(args.MaxFileSizeInMB*core.MegabyteSize))
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileDriver{
+		writer: writer,
+	}, nil
+}
+
+func checkArgs(args ArgsFileDriver) error {
+	if len(args.Directory) == 0 {
+		return ErrEmptyDirectory
+	}
+	_, err := getExtension(args.Format)
+	if err != nil {
+		return err
+	}
+	if args.MaxFileSizeInMB == 0 {
+		return ErrInvalidMaxFileSize
+	}
+
+	return nil
+}
+
+// SaveBlock writes the block data
+func (driver *fileDriver) SaveBlock(args *outport.ArgsSaveBlockData) error {
+	if args == nil || check.IfNil(args.Header) {
+		return ErrNilRecord
+	}
+
+	payload, err := createSaveBlockPayload(args)
+	if err != nil {
+		return err
+	}
+
+	return driver.writeRecord(RecordSaveBlock, args.Header.GetNonce(), payload)
+}
+
+// RevertIndexedBlock writes the reverted header and body
+func (driver *fileDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	if check.IfNil(header) {
+		return ErrNilRecord
+	}
+
+	headerBytes, err := json.Marshal(header)
+	if err != nil {
+		return err
+	}
+
+	payload := &revertIndexedBlockPayload{
+		HeaderType: core.GetHeaderType(header),
+		Header:     headerBytes,
+		Body:       getBody(body),
+	}
+
+	return driver.writeRecord(RecordRevertIndexedBlock, header.GetNonce(), payload)
+}
+
+// SaveRoundsInfo writes the rounds info
+func (driver *fileDriver) SaveRoundsInfo(roundsInfos []*outport.RoundInfo) error {
+	return driver.writeRecord(RecordSaveRoundsInfo, 0, roundsInfos)
+}
+
+// SaveValidatorsPubKeys writes the validators public keys
+func (driver *fileDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	payload := &saveValidatorsPubKeysPayload{
+		ValidatorsPubKeys: validatorsPubKeys,
+		Epoch:             epoch,
+	}
+
+	return driver.writeRecord(RecordSaveValidatorsPubKeys, 0, payload)
+}
+
+// SaveValidatorsRating writes the validators rating
+func (driver *fileDriver) SaveValidatorsRating(indexID string, infoRating []*outport.ValidatorRatingInfo) error {
+	payload := &saveValidatorsRatingPayload{
+		IndexID:    indexID,
+		InfoRating: infoRating,
+	}
+
+	return driver.writeRecord(RecordSaveValidatorsRating, 0, payload)
+}
+
+// SaveAccounts writes the altered accounts
+func (driver *fileDriver) SaveAccounts(blockTimestamp uint64, acc map[string]*outport.AlteredAccount, shardID uint32) error {
+	payload := &saveAccountsPayload{
+		Timestamp: blockTimestamp,
+		Accounts:  acc,
+		ShardID:   shardID,
+	}
+
+	return driver.writeRecord(RecordSaveAccounts, 0, payload)
+}
+
+// FinalizedBlock writes the finalized header hash
+func (driver *fileDriver) FinalizedBlock(headerHash []byte) error {
+	payload := &finalizedBlockPayload{
+		HeaderHash: headerHash,
+	}
+
+	return driver.writeRecord(RecordFinalizedBlock, 0, payload)
+}
+
+func (driver *fileDriver) writeRecord(recordType string, nonce uint64, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	record := &Record{
+		Type:    recordType,
+		Nonce:   nonce,
+		Payload: payloadBytes,
+	}
+
+	driver.mutWriter.Lock()
+	defer driver.mutWriter.Unlock()
+
+	if driver.writer == nil {
+		return ErrDriverClosed
+	}
+
+	return driver.writer.write(record)
+}
+
+// Close closes the opened files
+func (driver *fileDriver) Close() error {
+	driver.mutWriter.Lock()
+	defer driver.mutWriter.Unlock()
+
+	if driver.writer == nil {
+		return nil
+	}
+
+	err := driver.writer.close()
+	driver.writer = nil
+
+	return err
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (driver *fileDriver) IsInterfaceNil() bool {
+	return driver == nil
+}
@@ -0,0 +1,373 @@
+package filedriver_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/outport/filedriver"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	outportStub "github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsFileDriver(directory string) filedriver.ArgsFileDriver {
+	return filedriver.ArgsFileDriver{
+		Directory:       directory,
+		Format:          filedriver.FormatJsonLines,
+		MaxFileSizeInMB: 1,
+	}
+}
+
+func createArgsSaveBlock(nonce uint64) *outport.ArgsSaveBlockData {
+	tx := outport.NewTransactionHandlerWithGasAndFee(
+		&transaction.Transaction{Nonce: 1, Value: big.NewInt(10), SndAddr: []byte("sender"), RcvAddr: []byte("receiver")},
+		50,
+		big.NewInt(100),
+	)
+	tx.SetInitialPaidFee(big.NewInt(150))
+	tx.SetExecutionOrder(1)
+	scr := outport.NewTransactionHandlerWithGasAndFee(
+		&smartContractResult.SmartContractResult{Nonce: 2, Value: big.NewInt(0), PrevTxHash: []byte("tx hash")},
+		0,
+		big.NewInt(0),
+	)
+	reward := outport.NewTransactionHandlerWithGasAndFee(&rewardTx.RewardTx{Round: 4, Value: big.NewInt(5)}, 0, big.NewInt(0))
+	rcpt := outport.NewTransactionHandlerWithGasAndFee(&receipt.Receipt{Value: big.NewInt(1), TxHash: []byte("tx hash")}, 0, big.NewInt(0))
+
+	return &outport.ArgsSaveBlockData{
+		HeaderHash: []byte("header hash"),
+		Header:     &block.HeaderV2{Header: &block.Header{Nonce: nonce, Round: nonce + 1, RootHash: []byte("root hash")}},
+		Body: &block.Body{
+			MiniBlocks: []*block.MiniBlock{{TxHashes: [][]byte{[]byte("tx hash")}}},
+		},
+		SignersIndexes:         []uint64{0, 1},
+		NotarizedHeadersHashes: []string{"notarized"},
+		HeaderGasConsumption:   outport.HeaderGasConsumption{GasProvided: 50},
+		TransactionsPool: &outport.Pool{
+			Txs:      map[string]data.TransactionHandlerWithGasUsedAndFee{"tx hash": tx},
+			Scrs:     map[string]data.TransactionHandlerWithGasUsedAndFee{"scr hash": scr},
+			Rewards:  map[string]data.TransactionHandlerWithGasUsedAndFee{"reward hash": reward},
+			Invalid:  map[string]data.TransactionHandlerWithGasUsedAndFee{},
+			Receipts: map[string]data.TransactionHandlerWithGasUsedAndFee{"receipt hash": rcpt},
+			Logs: []*data.LogData{
+				{
+					TxHash: "tx hash",
+					LogHandler: &transaction.Log{
+						Address: []byte("address"),
+						Events:  []*transaction.Event{{Identifier: []byte("event")}},
+					},
+				},
+			},
+		},
+		AlteredAccounts: map[string]*outport.AlteredAccount{"address": {Nonce: 1, Address: "address", Balance: "10"}},
+		NumberOfShards:  3,
+	}
+}
+
+type recordedCall struct {
+	method string
+	args   interface{}
+}
+
+func createRecordingDriver(calls *[]recordedCall) *mock.DriverStub {
+	return &mock.DriverStub{
+		SaveBlockCalled: func(args *outport.ArgsSaveBlockData) error {
+			*calls = append(*calls, recordedCall{method: "SaveBlock", args: args})
+			return nil
+		},
+		RevertBlockCalled: func(header data.HeaderHandler, body data.BodyHandler) error {
+			*calls = append(*calls, recordedCall{method: "RevertIndexedBlock", args: []interface{}{header, body}})
+			return nil
+		},
+		SaveRoundsInfoCalled: func(roundsInfos []*outport.RoundInfo) error {
+			*calls = append(*calls, recordedCall{method: "SaveRoundsInfo", args: roundsInfos})
+			return nil
+		},
+		SaveValidatorsPubKeysCalled: func(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+			*calls = append(*calls, recordedCall{method: "SaveValidatorsPubKeys", args: []interface{}{validatorsPubKeys, epoch}})
+			return nil
+		},
+		SaveValidatorsRatingCalled: func(indexID string, infoRating []*outport.ValidatorRatingInfo) error {
+			*calls = append(*calls, recordedCall{method: "SaveValidatorsRating", args: []interface{}{indexID, infoRating}})
+			return nil
+		},
+		SaveAccountsCalled: func(timestamp uint64, acc map[string]*outport.AlteredAccount) error {
+			*calls = append(*calls, recordedCall{method: "SaveAccounts", args: []interface{}{timestamp, acc}})
+			return nil
+		},
+		FinalizedBlockCalled: func(headerHash []byte) error {
+			*calls = append(*calls, recordedCall{method: "FinalizedBlock", args: headerHash})
+			return nil
+		},
+	}
+}
+
+func TestNewFileDriver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := filedriver.NewFileDriver(createMockArgsFileDriver(""))
+		assert.True(t, check.IfNil(driver))
+		assert.Equal(t, filedriver.ErrEmptyDirectory, err)
+	})
+	t.Run("invalid format should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t.TempDir())
+		args.Format = "xml"
+		driver, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(driver))
+		assert.True(t, errors.Is(err, filedriver.ErrInvalidFormat))
+	})
+	t.Run("invalid max file size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsFileDriver(t.TempDir())
+		args.MaxFileSizeInMB = 0
+		driver, err := filedriver.NewFileDriver(args)
+		assert.True(t, check.IfNil(driver))
+		assert.Equal(t, filedriver.ErrInvalidMaxFileSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		driver, err := filedriver.NewFileDriver(createMockArgsFileDriver(t.TempDir()))
+		assert.False(t, check.IfNil(driver))
+		assert.Nil(t, err)
+		assert.Nil(t, driver.Close())
+	})
+}
+
+func TestFileDriver_SaveBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		driver, _ := filedriver.NewFileDriver(createMockArgsFileDriver(t.TempDir()))
+		defer func() {
+			_ = driver.Close()
+		}()
+
+		assert.Equal(t, filedriver.ErrNilRecord, driver.SaveBlock(nil))
+		assert.Equal(t, filedriver.ErrNilRecord, driver.SaveBlock(&outport.ArgsSaveBlockData{}))
+	})
+	t.Run("closed driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		driver, _ := filedriver.NewFileDriver(createMockArgsFileDriver(t.TempDir()))
+		_ = driver.Close()
+
+		err := driver.SaveBlock(createArgsSaveBlock(1))
+		assert.Equal(t, filedriver.ErrDriverClosed, err)
+	})
+}
+
+func testWriteAndReplay(t *testing.T, format string) {
+	directory := t.TempDir()
+	args := createMockArgsFileDriver(directory)
+	args.Format = format
+	driver, _ := filedriver.NewFileDriver(args)
+
+	argsSaveBlock := createArgsSaveBlock(7)
+	revertedHeader := &block.Header{Nonce: 7, Round: 8}
+	roundsInfo := []*outport.RoundInfo{{Index: 8, SignersIndexes: []uint64{1}, BlockWasProposed: true}}
+	validatorsPubKeys := map[uint32][][]byte{0: {[]byte("pk0")}, 1: {[]byte("pk1")}}
+	rating := []*outport.ValidatorRatingInfo{{PublicKey: "pk0", Rating: 50}}
+	accounts := map[string]*outport.AlteredAccount{"address": {Nonce: 2, Address: "address"}}
+
+	require.Nil(t, driver.SaveBlock(argsSaveBlock))
+	require.Nil(t, driver.SaveRoundsInfo(roundsInfo))
+	require.Nil(t, driver.RevertIndexedBlock(revertedHeader, &block.Body{}))
+	require.Nil(t, driver.SaveBlock(argsSaveBlock))
+	require.Nil(t, driver.SaveValidatorsPubKeys(validatorsPubKeys, 2))
+	require.Nil(t, driver.SaveValidatorsRating("rating", rating))
+	require.Nil(t, driver.SaveAccounts(100, accounts, 1))
+	require.Nil(t, driver.FinalizedBlock([]byte("header hash")))
+	require.Nil(t, driver.SaveBlock(createArgsSaveBlock(8)))
+	require.Nil(t, driver.Close())
+
+	calls := make([]recordedCall, 0)
+	numReplayed, err := filedriver.ReplayRecords(directory, 7, 7, createRecordingDriver(&calls))
+	require.Nil(t, err)
+	assert.Equal(t, 8, numReplayed)
+
+	expectedArgsSaveBlock := createArgsSaveBlock(7)
+	expectedArgsSaveBlock.TransactionsPool.Invalid = map[string]data.TransactionHandlerWithGasUsedAndFee{}
+	expectedCalls := []recordedCall{
+		{method: "SaveBlock", args: expectedArgsSaveBlock},
+		{method: "SaveRoundsInfo", args: roundsInfo},
+		{method: "RevertIndexedBlock", args: []interface{}{revertedHeader, &block.Body{}}},
+		{method: "SaveBlock", args: expectedArgsSaveBlock},
+		{method: "SaveValidatorsPubKeys", args: []interface{}{validatorsPubKeys, uint32(2)}},
+		{method: "SaveValidatorsRating", args: []interface{}{"rating", rating}},
+		{method: "SaveAccounts", args: []interface{}{uint64(100), accounts}},
+		{method: "FinalizedBlock", args: []byte("header hash")},
+	}
+	require.Equal(t, len(expectedCalls), len(calls))
+	for i := range expectedCalls {
+		assert.Equal(t, expectedCalls[i], calls[i], "call %d", i)
+	}
+}
+
+func TestFileDriver_WriteAndReplay(t *testing.T) {
+	t.Parallel()
+
+	t.Run("json lines format", func(t *testing.T) {
+		t.Parallel()
+
+		testWriteAndReplay(t, filedriver.FormatJsonLines)
+	})
+	t.Run("length prefixed format", func(t *testing.T) {
+		t.Parallel()
+
+		testWriteAndReplay(t, filedriver.FormatLengthPrefixed)
+	})
+}
+
+func TestReplayRecords(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil driver should error", func(t *testing.T) {
+		t.Parallel()
+
+		numReplayed, err := filedriver.ReplayRecords(t.TempDir(), 0, 10, nil)
+		assert.Equal(t, filedriver.ErrNilDriver, err)
+		assert.Zero(t, numReplayed)
+	})
+	t.Run("missing nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		driver, _ := filedriver.NewFileDriver(createMockArgsFileDriver(directory))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(1)))
+		require.Nil(t, driver.Close())
+
+		numReplayed, err := filedriver.ReplayRecords(directory, 2, 10, &mock.DriverStub{})
+		assert.True(t, errors.Is(err, filedriver.ErrNonceNotFound))
+		assert.Zero(t, numReplayed)
+	})
+	t.Run("driver error should stop the replay", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		driver, _ := filedriver.NewFileDriver(createMockArgsFileDriver(directory))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(1)))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(2)))
+		require.Nil(t, driver.Close())
+
+		expectedErr := errors.New("expected error")
+		numSaveBlockCalls := 0
+		numReplayed, err := filedriver.ReplayRecords(directory, 0, 10, &mock.DriverStub{
+			SaveBlockCalled: func(args *outport.ArgsSaveBlockData) error {
+				numSaveBlockCalls++
+				return expectedErr
+			},
+		})
+		assert.True(t, errors.Is(err, expectedErr))
+		assert.Zero(t, numReplayed)
+		assert.Equal(t, 1, numSaveBlockCalls)
+	})
+	t.Run("should replay the records of a restarted driver", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		driver, _ := filedriver.NewFileDriver(createMockArgsFileDriver(directory))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(1)))
+		require.Nil(t, driver.Close())
+
+		driver, _ = filedriver.NewFileDriver(createMockArgsFileDriver(directory))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(2)))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(3)))
+		require.Nil(t, driver.Close())
+
+		nonces := make([]uint64, 0)
+		numReplayed, err := filedriver.ReplayRecords(directory, 0, 2, &mock.DriverStub{
+			SaveBlockCalled: func(args *outport.ArgsSaveBlockData) error {
+				nonces = append(nonces, args.Header.GetNonce())
+				return nil
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numReplayed)
+		assert.Equal(t, []uint64{1, 2}, nonces)
+	})
+}
+
+func TestReplayRecordsIntoOutport(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		numReplayed, err := filedriver.ReplayRecordsIntoOutport(t.TempDir(), 0, 10, nil)
+		assert.Equal(t, filedriver.ErrNilOutportHandler, err)
+		assert.Zero(t, numReplayed)
+	})
+	t.Run("should replay the records into the outport handler", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		driver, _ := filedriver.NewFileDriver(createMockArgsFileDriver(directory))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(1)))
+		require.Nil(t, driver.FinalizedBlock([]byte("hash 1")))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(2)))
+		require.Nil(t, driver.FinalizedBlock([]byte("hash 2")))
+		require.Nil(t, driver.SaveBlock(createArgsSaveBlock(3)))
+		require.Nil(t, driver.Close())
+
+		nonces := make([]uint64, 0)
+		finalizedHashes := make([][]byte, 0)
+		numReplayed, err := filedriver.ReplayRecordsIntoOutport(directory, 2, 2, &outportStub.OutportStub{
+			SaveBlockCalled: func(args *outport.ArgsSaveBlockData) {
+				nonces = append(nonces, args.Header.GetNonce())
+			},
+			FinalizedBlockCalled: func(headerHash []byte) {
+				finalizedHashes = append(finalizedHashes, headerHash)
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numReplayed)
+		assert.Equal(t, []uint64{2}, nonces)
+		assert.Equal(t, [][]byte{[]byte("hash 2")}, finalizedHashes)
+	})
+}
+
+func TestDispatchRecord(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil record should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := filedriver.DispatchRecord(nil, &mock.DriverStub{})
+		assert.Equal(t, filedriver.ErrNilRecord, err)
+	})
+	t.Run("unknown record type should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := filedriver.DispatchRecord(&filedriver.Record{Type: "unknown"}, &mock.DriverStub{})
+		assert.True(t, errors.Is(err, filedriver.ErrUnknownRecordType))
+	})
+	t.Run("unknown header type should error", func(t *testing.T) {
+		t.Parallel()
+
+		record := &filedriver.Record{
+			Type:    filedriver.RecordRevertIndexedBlock,
+			Payload: []byte(`{"headerType":"unknown","header":{}}`),
+		}
+		err := filedriver.DispatchRecord(record, &mock.DriverStub{})
+		assert.True(t, errors.Is(err, filedriver.ErrUnknownHeaderType))
+	})
+}
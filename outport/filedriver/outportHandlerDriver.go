@@ -0,0 +1,76 @@
+package filedriver
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	outportDriver "github.com/multiversx/mx-chain-go/outport"
+)
+
+// outportHandlerDriver exposes an outport handler as a driver, so the records can be replayed into all the drivers
+// subscribed to the handler. The handler retries the failed calls on its own, so no error is ever returned
+type outportHandlerDriver struct {
+	handler outportDriver.OutportHandler
+}
+
+// ReplayRecordsIntoOutport pushes the records found in the provided directory into all the drivers subscribed to the
+// provided outport handler. It returns the number of replayed records
+func ReplayRecordsIntoOutport(directory string, fromNonce uint64, toNonce uint64, handler outportDriver.OutportHandler) (int, error) {
+	if check.IfNil(handler) {
+		return 0, ErrNilOutportHandler
+	}
+
+	return ReplayRecords(directory, fromNonce, toNonce, &outportHandlerDriver{handler: handler})
+}
+
+// SaveBlock forwards the block to the outport handler
+func (ohd *outportHandlerDriver) SaveBlock(args *outport.ArgsSaveBlockData) error {
+	ohd.handler.SaveBlock(args)
+	return nil
+}
+
+// RevertIndexedBlock forwards the reverted block to the outport handler
+func (ohd *outportHandlerDriver) RevertIndexedBlock(header data.HeaderHandler, body data.BodyHandler) error {
+	ohd.handler.RevertIndexedBlock(header, body)
+	return nil
+}
+
+// SaveRoundsInfo forwards the rounds info to the outport handler
+func (ohd *outportHandlerDriver) SaveRoundsInfo(roundsInfos []*outport.RoundInfo) error {
+	ohd.handler.SaveRoundsInfo(roundsInfos)
+	return nil
+}
+
+// SaveValidatorsPubKeys forwards the validators public keys to the outport handler
+func (ohd *outportHandlerDriver) SaveValidatorsPubKeys(validatorsPubKeys map[uint32][][]byte, epoch uint32) error {
+	ohd.handler.SaveValidatorsPubKeys(validatorsPubKeys, epoch)
+	return nil
+}
+
+// SaveValidatorsRating forwards the validators rating to the outport handler
+func (ohd *outportHandlerDriver) SaveValidatorsRating(indexID string, infoRating []*outport.ValidatorRatingInfo) error {
+	ohd.handler.SaveValidatorsRating(indexID, infoRating)
+	return nil
+}
+
+// SaveAccounts forwards the altered accounts to the outport handler
+func (ohd *outportHandlerDriver) SaveAccounts(blockTimestamp uint64, acc map[string]*outport.AlteredAccount, shardID uint32) error {
+	ohd.handler.SaveAccounts(blockTimestamp, acc, shardID)
+	return nil
+}
+
+// FinalizedBlock forwards the finalized block hash to the outport handler
+func (ohd *outportHandlerDriver) FinalizedBlock(headerHash []byte) error {
+	ohd.handler.FinalizedBlock(headerHash)
+	return nil
+}
+
+// Close does nothing, as the outport handler is owned by the caller
+func (ohd *outportHandlerDriver) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ohd *outportHandlerDriver) IsInterfaceNil() bool {
+	return ohd == nil
+}
@@ -0,0 +1,203 @@
+package filedriver
+
+import (
+	"bufio"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// recordsReader reads the records written by the file driver, in the order they were written
+type recordsReader struct {
+	directory string
+	files     []recordsFile
+
+	nextFile   int
+	file       *os.File
+	fileReader *bufio.Reader
+	format     string
+}
+
+// NewRecordsReader creates a reader over the records files found in the provided directory. The reader starts with
+// the first record of the oldest file
+func NewRecordsReader(directory string) (*recordsReader, error) {
+	if len(directory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+
+	files, err := listRecordsFiles(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordsReader{
+		directory: directory,
+		files:     files,
+	}, nil
+}
+
+// SeekNonce positions the reader on the first block record having a nonce greater or equal to the provided one
+func (reader *recordsReader) SeekNonce(nonce uint64) error {
+	entry, err := reader.findIndexEntry(nonce)
+	if err != nil {
+		return err
+	}
+
+	for i, file := range reader.files {
+		if file.name != entry.File {
+			continue
+		}
+
+		reader.nextFile = i
+		err = reader.openNextFile()
+		if err != nil {
+			return err
+		}
+
+		_, err = reader.file.Seek(entry.Offset, io.SeekStart)
+		if err != nil {
+			return err
+		}
+		reader.fileReader.Reset(reader.file)
+
+		return nil
+	}
+
+	return fmt.Errorf("%w: indexed file %s is missing", ErrNonceNotFound, entry.File)
+}
+
+func (reader *recordsReader) findIndexEntry(nonce uint64) (*IndexEntry, error) {
+	indexFile, err := os.Open(filepath.Join(reader.directory, indexFileName))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = indexFile.Close()
+	}()
+
+	scanner := bufio.NewScanner(indexFile)
+	for scanner.Scan() {
+		entry := &IndexEntry{}
+		err = json.Unmarshal(scanner.Bytes(), entry)
+		if err != nil {
+			log.Debug("file driver: skipping corrupted index entry", "error", err)
+			continue
+		}
+
+		if entry.Nonce >= nonce {
+			return entry, nil
+		}
+	}
+	if scanner.Err() != nil {
+		return nil, scanner.Err()
+	}
+
+	return nil, fmt.Errorf("%w: %d", ErrNonceNotFound, nonce)
+}
+
+// Next returns the next record. It returns io.EOF after the last record was read
+func (reader *recordsReader) Next() (*Record, error) {
+	for {
+		if reader.file == nil {
+			if reader.nextFile >= len(reader.files) {
+				return nil, io.EOF
+			}
+
+			err := reader.openNextFile()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		buff, err := reader.readFrame()
+		if err == io.EOF {
+			reader.closeFile()
+			continue
+		}
+		if errors.Is(err, io.ErrUnexpectedEOF) {
+			// the node was most probably stopped while writing the last record in this file
+			log.Warn("file driver: skipping truncated record", "file", reader.file.Name())
+			reader.closeFile()
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		record := &Record{}
+		err = json.Unmarshal(buff, record)
+		if err != nil {
+			return nil, fmt.Errorf("%w in file %s: %s", ErrCorruptedRecord, reader.file.Name(), err.Error())
+		}
+
+		return record, nil
+	}
+}
+
+func (reader *recordsReader) openNextFile() error {
+	reader.closeFile()
+
+	fileName := reader.files[reader.nextFile].name
+	format, err := getFormat(fileName)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.Open(filepath.Join(reader.directory, fileName))
+	if err != nil {
+		return err
+	}
+
+	reader.file = file
+	reader.fileReader = bufio.NewReader(file)
+	reader.format = format
+	reader.nextFile++
+
+	return nil
+}
+
+func (reader *recordsReader) readFrame() ([]byte, error) {
+	if reader.format == FormatJsonLines {
+		buff, err := reader.fileReader.ReadBytes('\n')
+		if err == io.EOF && len(buff) > 0 {
+			return nil, io.ErrUnexpectedEOF
+		}
+
+		return buff, err
+	}
+
+	prefix := make([]byte, lengthPrefixSize)
+	_, err := io.ReadFull(reader.fileReader, prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	buff := make([]byte, binary.BigEndian.Uint32(prefix))
+	_, err = io.ReadFull(reader.fileReader, buff)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return buff, err
+}
+
+func (reader *recordsReader) closeFile() {
+	if reader.file == nil {
+		return
+	}
+
+	_ = reader.file.Close()
+	reader.file = nil
+	reader.fileReader = nil
+}
+
+// Close closes the currently opened records file
+func (reader *recordsReader) Close() error {
+	reader.closeFile()
+
+	return nil
+}
@@ -0,0 +1,252 @@
+package filedriver
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	recordsFilePrefix        = "records_"
+	jsonLinesExtension       = ".jsonl"
+	lengthPrefixedExtension  = ".bin"
+	indexFileName            = "index.jsonl"
+	lengthPrefixSize         = 4
+	filesPermissions         = 0644
+	directoryPermissions     = 0755
+	recordsFileNameFormat    = recordsFilePrefix + "%06d%s"
+	recordsFileIndexNotFound = -1
+)
+
+type recordsFile struct {
+	name  string
+	index int
+}
+
+// recordsWriter appends the records in rotating files and indexes the block related records by nonce. Each writer
+// starts a new records file so that a record partially written before a crash will not be followed by valid records
+type recordsWriter struct {
+	directory   string
+	format      string
+	extension   string
+	maxFileSize int64
+
+	nextFileIndex int
+	file          *os.File
+	fileName      string
+	fileSize      int64
+	indexFile     *os.File
+}
+
+func newRecordsWriter(directory string, format string, maxFileSize int64) (*recordsWriter, error) {
+	extension, err := getExtension(format)
+	if err != nil {
+		return nil, err
+	}
+
+	err = os.MkdirAll(directory, directoryPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := listRecordsFiles(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	nextFileIndex := 0
+	if len(files) > 0 {
+		nextFileIndex = files[len(files)-1].index + 1
+	}
+
+	indexFile, err := os.OpenFile(filepath.Join(directory, indexFileName), os.O_CREATE|os.O_WRONLY|os.O_APPEND, filesPermissions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recordsWriter{
+		directory:     directory,
+		format:        format,
+		extension:     extension,
+		maxFileSize:   maxFileSize,
+		nextFileIndex: nextFileIndex,
+		indexFile:     indexFile,
+	}, nil
+}
+
+func getExtension(format string) (string, error) {
+	switch format {
+	case FormatJsonLines:
+		return jsonLinesExtension, nil
+	case FormatLengthPrefixed:
+		return lengthPrefixedExtension, nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidFormat, format)
+	}
+}
+
+func getFormat(fileName string) (string, error) {
+	switch filepath.Ext(fileName) {
+	case jsonLinesExtension:
+		return FormatJsonLines, nil
+	case lengthPrefixedExtension:
+		return FormatLengthPrefixed, nil
+	default:
+		return "", fmt.Errorf("%w for file %s", ErrInvalidFormat, fileName)
+	}
+}
+
+// listRecordsFiles returns the records files found in the directory, sorted by their index
+func listRecordsFiles(directory string) ([]recordsFile, error) {
+	entries, err := os.ReadDir(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]recordsFile, 0, len(entries))
+	for _, entry := range entries {
+		index := getRecordsFileIndex(entry.Name())
+		if entry.IsDir() || index == recordsFileIndexNotFound {
+			continue
+		}
+
+		files = append(files, recordsFile{
+			name:  entry.Name(),
+			index: index,
+		})
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].index < files[j].index
+	})
+
+	return files, nil
+}
+
+func getRecordsFileIndex(fileName string) int {
+	if !strings.HasPrefix(fileName, recordsFilePrefix) {
+		return recordsFileIndexNotFound
+	}
+	_, err := getFormat(fileName)
+	if err != nil {
+		return recordsFileIndexNotFound
+	}
+
+	indexString := strings.TrimSuffix(strings.TrimPrefix(fileName, recordsFilePrefix), filepath.Ext(fileName))
+	index, err := strconv.Atoi(indexString)
+	if err != nil || index < 0 {
+		return recordsFileIndexNotFound
+	}
+
+	return index
+}
+
+func (writer *recordsWriter) write(record *Record) error {
+	buff, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	frame := writer.frame(buff)
+	err = writer.rotateIfNeeded(int64(len(frame)))
+	if err != nil {
+		return err
+	}
+
+	offset := writer.fileSize
+	_, err = writer.file.Write(frame)
+	if err != nil {
+		return err
+	}
+	writer.fileSize += int64(len(frame))
+
+	if !isBlockRecord(record.Type) {
+		return nil
+	}
+
+	return writer.writeIndexEntry(&IndexEntry{
+		Type:   record.Type,
+		Nonce:  record.Nonce,
+		File:   writer.fileName,
+		Offset: offset,
+	})
+}
+
+func isBlockRecord(recordType string) bool {
+	return recordType == RecordSaveBlock || recordType == RecordRevertIndexedBlock
+}
+
+func (writer *recordsWriter) frame(buff []byte) []byte {
+	if writer.format == FormatJsonLines {
+		return append(buff, '\n')
+	}
+
+	frame := make([]byte, lengthPrefixSize, lengthPrefixSize+len(buff))
+	binary.BigEndian.PutUint32(frame, uint32(len(buff)))
+
+	return append(frame, buff...)
+}
+
+func (writer *recordsWriter) rotateIfNeeded(frameSize int64) error {
+	isFileFull := writer.fileSize > 0 && writer.fileSize+frameSize > writer.maxFileSize
+	if writer.file != nil && !isFileFull {
+		return nil
+	}
+
+	err := writer.closeRecordsFile()
+	if err != nil {
+		return err
+	}
+
+	fileName := fmt.Sprintf(recordsFileNameFormat, writer.nextFileIndex, writer.extension)
+	file, err := os.OpenFile(filepath.Join(writer.directory, fileName), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, filesPermissions)
+	if err != nil {
+		return err
+	}
+
+	log.Debug("file driver: opened new records file", "file", fileName)
+
+	writer.file = file
+	writer.fileName = fileName
+	writer.fileSize = 0
+	writer.nextFileIndex++
+
+	return nil
+}
+
+func (writer *recordsWriter) writeIndexEntry(entry *IndexEntry) error {
+	buff, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	_, err = writer.indexFile.Write(append(buff, '\n'))
+
+	return err
+}
+
+func (writer *recordsWriter) closeRecordsFile() error {
+	if writer.file == nil {
+		return nil
+	}
+
+	err := writer.file.Close()
+	writer.file = nil
+
+	return err
+}
+
+func (writer *recordsWriter) close() error {
+	errRecords := writer.closeRecordsFile()
+	errIndex := writer.indexFile.Close()
+	if errRecords != nil {
+		return errRecords
+	}
+
+	return errIndex
+}
@@ -0,0 +1,150 @@
+package filedriver
+
+import (
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createRecord(nonce uint64) *Record {
+	return &Record{
+		Type:    RecordSaveBlock,
+		Nonce:   nonce,
+		Payload: []byte(`{"headerType":"Header"}`),
+	}
+}
+
+func readAllRecords(t *testing.T, reader *recordsReader) []*Record {
+	records := make([]*Record, 0)
+	for {
+		record, err := reader.Next()
+		if err == io.EOF {
+			return records
+		}
+		require.Nil(t, err)
+
+		records = append(records, record)
+	}
+}
+
+func TestGetRecordsFileIndex(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, 0, getRecordsFileIndex("records_000000.jsonl"))
+	assert.Equal(t, 12, getRecordsFileIndex("records_000012.bin"))
+	assert.Equal(t, 1234567, getRecordsFileIndex("records_1234567.bin"))
+	assert.Equal(t, recordsFileIndexNotFound, getRecordsFileIndex("index.jsonl"))
+	assert.Equal(t, recordsFileIndexNotFound, getRecordsFileIndex("records_000001.txt"))
+	assert.Equal(t, recordsFileIndexNotFound, getRecordsFileIndex("records_abc.jsonl"))
+}
+
+func TestRecordsWriter_ShouldRotateFiles(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	frameSize := getFrameSize(t, FormatLengthPrefixed, createRecord(1))
+	writer, err := newRecordsWriter(directory, FormatLengthPrefixed, 2*frameSize)
+	require.Nil(t, err)
+
+	for nonce := uint64(1); nonce <= 5; nonce++ {
+		require.Nil(t, writer.write(createRecord(nonce)))
+	}
+	require.Nil(t, writer.close())
+
+	files, err := listRecordsFiles(directory)
+	require.Nil(t, err)
+	require.Len(t, files, 3)
+	assert.Equal(t, "records_000000.bin", files[0].name)
+	assert.Equal(t, "records_000002.bin", files[2].name)
+
+	reader, err := NewRecordsReader(directory)
+	require.Nil(t, err)
+	err = reader.SeekNonce(3)
+	require.Nil(t, err)
+	records := readAllRecords(t, reader)
+	require.Len(t, records, 3)
+	assert.Equal(t, uint64(3), records[0].Nonce)
+	assert.Equal(t, uint64(5), records[2].Nonce)
+}
+
+func TestRecordsWriter_RestartShouldStartNewFile(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	writer, _ := newRecordsWriter(directory, FormatJsonLines, 1024)
+	require.Nil(t, writer.write(createRecord(1)))
+	require.Nil(t, writer.close())
+
+	writer, _ = newRecordsWriter(directory, FormatLengthPrefixed, 1024)
+	require.Nil(t, writer.write(createRecord(2)))
+	require.Nil(t, writer.close())
+
+	files, _ := listRecordsFiles(directory)
+	require.Len(t, files, 2)
+	assert.Equal(t, "records_000000.jsonl", files[0].name)
+	assert.Equal(t, "records_000001.bin", files[1].name)
+
+	reader, _ := NewRecordsReader(directory)
+	records := readAllRecords(t, reader)
+	require.Len(t, records, 2)
+	assert.Equal(t, createRecord(1), records[0])
+	assert.Equal(t, createRecord(2), records[1])
+}
+
+func TestRecordsReader_ShouldSkipTruncatedRecords(t *testing.T) {
+	t.Parallel()
+
+	for _, format := range []string{FormatJsonLines, FormatLengthPrefixed} {
+		directory := t.TempDir()
+		writer, _ := newRecordsWriter(directory, format, 1024)
+		require.Nil(t, writer.write(createRecord(1)))
+		require.Nil(t, writer.write(createRecord(2)))
+		fileName := writer.fileName
+		require.Nil(t, writer.close())
+
+		// simulate a crash while the second record was written
+		filePath := filepath.Join(directory, fileName)
+		info, _ := os.Stat(filePath)
+		require.Nil(t, os.Truncate(filePath, info.Size()-3))
+
+		writer, _ = newRecordsWriter(directory, format, 1024)
+		require.Nil(t, writer.write(createRecord(3)))
+		require.Nil(t, writer.close())
+
+		reader, _ := NewRecordsReader(directory)
+		records := readAllRecords(t, reader)
+		require.Len(t, records, 2, format)
+		assert.Equal(t, uint64(1), records[0].Nonce)
+		assert.Equal(t, uint64(3), records[1].Nonce)
+	}
+}
+
+func TestRecordsReader_CorruptedRecordShouldError(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+	err := os.WriteFile(filepath.Join(directory, "records_000000.jsonl"), []byte("not a record\n"), filesPermissions)
+	require.Nil(t, err)
+
+	reader, _ := NewRecordsReader(directory)
+	record, err := reader.Next()
+	assert.Nil(t, record)
+	assert.True(t, errors.Is(err, ErrCorruptedRecord))
+}
+
+func getFrameSize(t *testing.T, format string, record *Record) int64 {
+	writer, err := newRecordsWriter(t.TempDir(), format, 1024)
+	require.Nil(t, err)
+	defer func() {
+		_ = writer.close()
+	}()
+
+	require.Nil(t, writer.write(record))
+
+	return writer.fileSize
+}
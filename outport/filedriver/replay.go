@@ -0,0 +1,146 @@
+package filedriver
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/outport"
+	outportDriver "github.com/multiversx/mx-chain-go/outport"
+)
+
+// ReplayRecords pushes the records found in the provided directory into the provided driver, in the order they
+// were written. The replay starts with the first block record having a nonce greater or equal to fromNonce and stops
+// before the first block saved with a nonce greater than toNonce. It returns the number of replayed records
+func ReplayRecords(directory string, fromNonce uint64, toNonce uint64, driver outportDriver.Driver) (int, error) {
+	if check.IfNil(driver) {
+		return 0, ErrNilDriver
+	}
+
+	reader, err := NewRecordsReader(directory)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	err = reader.SeekNonce(fromNonce)
+	if err != nil {
+		return 0, err
+	}
+
+	numReplayed := 0
+	for {
+		record, errNext := reader.Next()
+		if errNext == io.EOF {
+			return numReplayed, nil
+		}
+		if errNext != nil {
+			return numReplayed, errNext
+		}
+		if record.Type == RecordSaveBlock && record.Nonce > toNonce {
+			return numReplayed, nil
+		}
+
+		err = DispatchRecord(record, driver)
+		if err != nil {
+			return numReplayed, fmt.Errorf("%w while replaying %s record with nonce %d", err, record.Type, record.Nonce)
+		}
+		numReplayed++
+	}
+}
+
+// DispatchRecord decodes the provided record and calls the matching method of the provided driver
+func DispatchRecord(record *Record, driver outportDriver.Driver) error {
+	if record == nil {
+		return ErrNilRecord
+	}
+	if check.IfNil(driver) {
+		return ErrNilDriver
+	}
+
+	switch record.Type {
+	case RecordSaveBlock:
+		return dispatchSaveBlock(record.Payload, driver)
+	case RecordRevertIndexedBlock:
+		return dispatchRevertIndexedBlock(record.Payload, driver)
+	case RecordSaveRoundsInfo:
+		roundsInfos := make([]*outport.RoundInfo, 0)
+		err := json.Unmarshal(record.Payload, &roundsInfos)
+		if err != nil {
+			return err
+		}
+
+		return driver.SaveRoundsInfo(roundsInfos)
+	case RecordSaveValidatorsPubKeys:
+		payload := &saveValidatorsPubKeysPayload{}
+		err := json.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return err
+		}
+
+		return driver.SaveValidatorsPubKeys(payload.ValidatorsPubKeys, payload.Epoch)
+	case RecordSaveValidatorsRating:
+		payload := &saveValidatorsRatingPayload{}
+		err := json.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return err
+		}
+
+		return driver.SaveValidatorsRating(payload.IndexID, payload.InfoRating)
+	case RecordSaveAccounts:
+		payload := &saveAccountsPayload{}
+		err := json.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return err
+		}
+
+		return driver.SaveAccounts(payload.Timestamp, payload.Accounts, payload.ShardID)
+	case RecordFinalizedBlock:
+		payload := &finalizedBlockPayload{}
+		err := json.Unmarshal(record.Payload, payload)
+		if err != nil {
+			return err
+		}
+
+		return driver.FinalizedBlock(payload.HeaderHash)
+	default:
+		return fmt.Errorf("%w: %s", ErrUnknownRecordType, record.Type)
+	}
+}
+
+func dispatchSaveBlock(buff []byte, driver outportDriver.Driver) error {
+	payload := &saveBlockPayload{}
+	err := json.Unmarshal(buff, payload)
+	if err != nil {
+		return err
+	}
+
+	args, err := payload.toArgsSaveBlockData()
+	if err != nil {
+		return err
+	}
+
+	return driver.SaveBlock(args)
+}
+
+func dispatchRevertIndexedBlock(buff []byte, driver outportDriver.Driver) error {
+	payload := &revertIndexedBlockPayload{}
+	err := json.Unmarshal(buff, payload)
+	if err != nil {
+		return err
+	}
+
+	header, err := unmarshalHeader(payload.HeaderType, payload.Header)
+	if err != nil {
+		return err
+	}
+
+	if payload.Body == nil {
+		return driver.RevertIndexedBlock(header, nil)
+	}
+
+	return driver.RevertIndexedBlock(header, payload.Body)
+}
@@ -21,3 +21,9 @@ var ErrNilPubKeyConverter = errors.New("nil pub key converter")
 
 // ErrNilHasher is raised when a valid hasher is expected but nil used
 var ErrNilHasher = errors.New("hasher is nil")
+
+// ErrNilPersister signals that a nil persister has been provided
+var ErrNilPersister = errors.New("nil persister")
+
+// ErrCorruptedQueue signals that the events queue saved on disk is corrupted
+var ErrCorruptedQueue = errors.New("corrupted events queue")
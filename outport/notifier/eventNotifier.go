@@ -37,7 +37,7 @@ type FinalizedBlock struct {
 }
 
 type eventNotifier struct {
-	httpClient      httpClientHandler
+	httpClient      HTTPClientHandler
 	marshalizer     marshal.Marshalizer
 	hasher          hashing.Hasher
 	pubKeyConverter core.PubkeyConverter
@@ -45,7 +45,7 @@ type eventNotifier struct {
 
 // ArgsEventNotifier defines the arguments needed for event notifier creation
 type ArgsEventNotifier struct {
-	HttpClient      httpClientHandler
+	HttpClient      HTTPClientHandler
 	Marshaller      marshal.Marshalizer
 	Hasher          hashing.Hasher
 	PubKeyConverter core.PubkeyConverter
@@ -165,7 +165,7 @@ func (en *eventNotifier) IsInterfaceNil() bool {
 	return en == nil
 }
 
-// Close returns nil
+// Close closes the http client
 func (en *eventNotifier) Close() error {
-	return nil
+	return en.httpClient.Close()
 }
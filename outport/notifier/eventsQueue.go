@@ -0,0 +1,367 @@
+package notifier
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	outportSenderData "github.com/multiversx/mx-chain-core-go/websocketOutportDriver/data"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+const sequenceKeySize = 8
+
+// queuedEvent is the representation of an event saved in the persistent queue
+type queuedEvent struct {
+	Route     string          `json:"route"`
+	BlockHash string          `json:"blockHash"`
+	Payload   json.RawMessage `json:"payload"`
+}
+
+// queueEntry holds the in memory information about an event saved in the persistent queue. The payload is kept only
+// on disk, as the blocks data can be large
+type queueEntry struct {
+	key       []byte
+	route     string
+	blockHash string
+}
+
+// ArgsEventsQueue defines the arguments needed for the events queue creation
+type ArgsEventsQueue struct {
+	Persister        storage.Persister
+	HttpClient       HTTPClientHandler
+	MinRetryInterval time.Duration
+	MaxRetryInterval time.Duration
+}
+
+type eventsQueue struct {
+	persister        storage.Persister
+	httpClient       HTTPClientHandler
+	minRetryInterval time.Duration
+	maxRetryInterval time.Duration
+
+	mutQueue    sync.Mutex
+	entries     []*queueEntry
+	nextSeq     uint64
+	inFlightKey []byte
+
+	chanNewEvent chan struct{}
+	cancel       func()
+	wgSender     sync.WaitGroup
+}
+
+// NewEventsQueue creates a persistent queue placed in front of the notifier http client. The events are saved on disk
+// before the Post call returns and are sent afterwards, one by one and in the order they were received, by a go
+// routine that retries with an exponential backoff while the notifier can not be reached. The events still queued
+// when the node is stopped are sent after the next start
+func NewEventsQueue(args ArgsEventsQueue) (*eventsQueue, error) {
+	err := checkEventsQueueArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	queue := &eventsQueue{
+		persister:        args.Persister,
+		httpClient:       args.HttpClient,
+		minRetryInterval: args.MinRetryInterval,
+		maxRetryInterval: args.MaxRetryInterval,
+		chanNewEvent:     make(chan struct{}, 1),
+	}
+
+	err = queue.loadEntries()
+	if err != nil {
+		return nil, err
+	}
+
+	var ctx context.Context
+	ctx, queue.cancel = context.WithCancel(context.Background())
+	queue.wgSender.Add(1)
+	go queue.sendEvents(ctx)
+
+	return queue, nil
+}
+
+func checkEventsQueueArgs(args ArgsEventsQueue) error {
+	if check.IfNil(args.Persister) {
+		return ErrNilPersister
+	}
+	if check.IfNil(args.HttpClient) {
+		return ErrNilHTTPClientWrapper
+	}
+	if args.MinRetryInterval <= 0 {
+		return fmt.Errorf("%w for the min retry interval, provided: %v", ErrInvalidValue, args.MinRetryInterval)
+	}
+	if args.MaxRetryInterval < args.MinRetryInterval {
+		return fmt.Errorf("%w for the max retry interval, provided: %v, min retry interval: %v",
+			ErrInvalidValue, args.MaxRetryInterval, args.MinRetryInterval)
+	}
+
+	return nil
+}
+
+func (queue *eventsQueue) loadEntries() error {
+	var errLoad error
+	queue.persister.RangeKeys(func(key []byte, val []byte) bool {
+		if len(key) != sequenceKeySize {
+			errLoad = fmt.Errorf("%w: invalid key %s", ErrCorruptedQueue, hex.EncodeToString(key))
+			return false
+		}
+
+		event := &queuedEvent{}
+		err := json.Unmarshal(val, event)
+		if err != nil {
+			errLoad = fmt.Errorf("%w: %v, key %s", ErrCorruptedQueue, err, hex.EncodeToString(key))
+			return false
+		}
+
+		queue.entries = append(queue.entries, &queueEntry{
+			key:       key,
+			route:     event.Route,
+			blockHash: event.BlockHash,
+		})
+
+		return true
+	})
+	if errLoad != nil {
+		return errLoad
+	}
+
+	sort.Slice(queue.entries, func(i, j int) bool {
+		return binary.BigEndian.Uint64(queue.entries[i].key) < binary.BigEndian.Uint64(queue.entries[j].key)
+	})
+
+	numEntries := len(queue.entries)
+	if numEntries > 0 {
+		queue.nextSeq = binary.BigEndian.Uint64(queue.entries[numEntries-1].key) + 1
+		log.Info("eventsQueue: found events that were not sent to the notifier", "num events", numEntries)
+	}
+
+	return nil
+}
+
+// Post saves the event in the queue. A revert event removes all the queued events of the reverted block and it is
+// saved only if some data of that block might have already reached the notifier
+func (queue *eventsQueue) Post(route string, payload interface{}) error {
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	blockHash := getEventBlockHash(payload)
+
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	if route == revertEventsEndpoint {
+		wasPushEventRemoved, errRemove := queue.removeBlockEvents(blockHash)
+		if errRemove != nil {
+			return errRemove
+		}
+		if wasPushEventRemoved {
+			log.Debug("eventsQueue: reverted block was not sent to the notifier, dropped its events", "block hash", blockHash)
+			return nil
+		}
+	}
+
+	event := &queuedEvent{
+		Route:     route,
+		BlockHash: blockHash,
+		Payload:   payloadBytes,
+	}
+	eventBytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	key := make([]byte, sequenceKeySize)
+	binary.BigEndian.PutUint64(key, queue.nextSeq)
+	err = queue.persister.Put(key, eventBytes)
+	if err != nil {
+		return err
+	}
+
+	queue.nextSeq++
+	queue.entries = append(queue.entries, &queueEntry{
+		key:       key,
+		route:     route,
+		blockHash: blockHash,
+	})
+
+	select {
+	case queue.chanNewEvent <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// removeBlockEvents removes the queued events of the provided block, except the one being sent at the moment. It
+// returns true if the push event of the block was removed, meaning that the block never reached the notifier
+func (queue *eventsQueue) removeBlockEvents(blockHash string) (bool, error) {
+	wasPushEventRemoved := false
+	remainingEntries := make([]*queueEntry, 0, len(queue.entries))
+	for _, entry := range queue.entries {
+		shouldKeep := entry.blockHash != blockHash || string(entry.key) == string(queue.inFlightKey)
+		if shouldKeep {
+			remainingEntries = append(remainingEntries, entry)
+			continue
+		}
+
+		err := queue.persister.Remove(entry.key)
+		if err != nil {
+			return false, err
+		}
+		if entry.route == pushEventEndpoint {
+			wasPushEventRemoved = true
+		}
+	}
+	queue.entries = remainingEntries
+
+	return wasPushEventRemoved, nil
+}
+
+func getEventBlockHash(payload interface{}) string {
+	switch event := payload.(type) {
+	case outportSenderData.ArgsSaveBlock:
+		return hex.EncodeToString(event.HeaderHash)
+	case RevertBlock:
+		return event.Hash
+	case FinalizedBlock:
+		return event.Hash
+	default:
+		return ""
+	}
+}
+
+func (queue *eventsQueue) sendEvents(ctx context.Context) {
+	defer queue.wgSender.Done()
+
+	retryInterval := queue.minRetryInterval
+	for {
+		entry, payload, err := queue.getFirstEvent()
+		if err != nil {
+			log.Error("eventsQueue: cannot load queued event, dropping it",
+				"route", entry.route, "block hash", entry.blockHash, "error", err)
+			queue.removeEvent(entry)
+			continue
+		}
+		if entry == nil {
+			select {
+			case <-ctx.Done():
+				return
+			case <-queue.chanNewEvent:
+				continue
+			}
+		}
+
+		err = queue.httpClient.Post(entry.route, payload)
+		if err != nil {
+			log.Warn("eventsQueue: cannot send event to the notifier, will retry",
+				"route", entry.route, "block hash", entry.blockHash, "retry in", retryInterval, "error", err)
+			if !queue.waitOrClose(ctx, retryInterval) {
+				return
+			}
+
+			retryInterval = queue.computeNextRetryInterval(retryInterval)
+			continue
+		}
+
+		retryInterval = queue.minRetryInterval
+		queue.removeEvent(entry)
+	}
+}
+
+func (queue *eventsQueue) getFirstEvent() (*queueEntry, json.RawMessage, error) {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	if len(queue.entries) == 0 {
+		return nil, nil, nil
+	}
+
+	entry := queue.entries[0]
+	eventBytes, err := queue.persister.Get(entry.key)
+	if err != nil {
+		return entry, nil, err
+	}
+
+	event := &queuedEvent{}
+	err = json.Unmarshal(eventBytes, event)
+	if err != nil {
+		return entry, nil, err
+	}
+
+	queue.inFlightKey = entry.key
+
+	return entry, event.Payload, nil
+}
+
+func (queue *eventsQueue) removeEvent(entry *queueEntry) {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	queue.inFlightKey = nil
+	err := queue.persister.Remove(entry.key)
+	if err != nil {
+		log.Warn("eventsQueue: cannot remove event, it might be sent again", "block hash", entry.blockHash, "error", err)
+	}
+
+	if len(queue.entries) > 0 && queue.entries[0] == entry {
+		queue.entries = queue.entries[1:]
+	}
+}
+
+func (queue *eventsQueue) computeNextRetryInterval(retryInterval time.Duration) time.Duration {
+	retryInterval *= 2
+	if retryInterval > queue.maxRetryInterval {
+		return queue.maxRetryInterval
+	}
+
+	return retryInterval
+}
+
+func (queue *eventsQueue) waitOrClose(ctx context.Context, interval time.Duration) bool {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// NumQueuedEvents returns the number of events that were not yet sent to the notifier
+func (queue *eventsQueue) NumQueuedEvents() int {
+	queue.mutQueue.Lock()
+	defer queue.mutQueue.Unlock()
+
+	return len(queue.entries)
+}
+
+// Close stops sending the events, closes the persister and the wrapped http client. The events not yet sent remain
+// on disk
+func (queue *eventsQueue) Close() error {
+	queue.cancel()
+	queue.wgSender.Wait()
+
+	errClient := queue.httpClient.Close()
+	err := queue.persister.Close()
+	if err != nil {
+		return err
+	}
+
+	return errClient
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (queue *eventsQueue) IsInterfaceNil() bool {
+	return queue == nil
+}
@@ -0,0 +1,333 @@
+package notifier_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	outportSenderData "github.com/multiversx/mx-chain-core-go/websocketOutportDriver/data"
+	"github.com/multiversx/mx-chain-go/outport/mock"
+	"github.com/multiversx/mx-chain-go/outport/notifier"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	pushRoute     = "/events/push"
+	revertRoute   = "/events/revert"
+	finalizeRoute = "/events/finalized"
+	waitTimeout   = time.Second * 5
+)
+
+type sentEvent struct {
+	route   string
+	payload string
+}
+
+// closeCounterPersister wraps a persister and keeps it open after Close, so a queue can be restarted on the same data
+type closeCounterPersister struct {
+	storage.Persister
+	mut         sync.Mutex
+	closeCalled int
+}
+
+func (persister *closeCounterPersister) Close() error {
+	persister.mut.Lock()
+	persister.closeCalled++
+	persister.mut.Unlock()
+
+	return nil
+}
+
+type recordingClient struct {
+	mock.HTTPClientStub
+	mut        sync.Mutex
+	sentEvents []sentEvent
+	chanSent   chan struct{}
+}
+
+func newRecordingClient(shouldFail func(numCalls int) bool) *recordingClient {
+	client := &recordingClient{
+		chanSent: make(chan struct{}, 100),
+	}
+	numCalls := 0
+	client.PostCalled = func(route string, payload interface{}) error {
+		client.mut.Lock()
+		defer client.mut.Unlock()
+
+		numCalls++
+		if shouldFail != nil && shouldFail(numCalls) {
+			return errors.New("notifier not reachable")
+		}
+
+		payloadBytes, _ := json.Marshal(payload)
+		client.sentEvents = append(client.sentEvents, sentEvent{route: route, payload: string(payloadBytes)})
+		client.chanSent <- struct{}{}
+
+		return nil
+	}
+
+	return client
+}
+
+func (client *recordingClient) getSentEvents() []sentEvent {
+	client.mut.Lock()
+	defer client.mut.Unlock()
+
+	return append(make([]sentEvent, 0, len(client.sentEvents)), client.sentEvents...)
+}
+
+func (client *recordingClient) waitSent(t *testing.T, numEvents int) {
+	for i := 0; i < numEvents; i++ {
+		select {
+		case <-client.chanSent:
+		case <-time.After(waitTimeout):
+			require.Fail(t, "timeout waiting for the events to be sent")
+		}
+	}
+}
+
+func createMockArgsEventsQueue() notifier.ArgsEventsQueue {
+	return notifier.ArgsEventsQueue{
+		Persister:        database.NewMemDB(),
+		HttpClient:       &mock.HTTPClientStub{},
+		MinRetryInterval: time.Millisecond,
+		MaxRetryInterval: time.Millisecond * 10,
+	}
+}
+
+func TestNewEventsQueue(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil persister should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsQueue()
+		args.Persister = nil
+
+		queue, err := notifier.NewEventsQueue(args)
+		assert.Nil(t, queue)
+		assert.Equal(t, notifier.ErrNilPersister, err)
+	})
+	t.Run("nil http client should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsQueue()
+		args.HttpClient = nil
+
+		queue, err := notifier.NewEventsQueue(args)
+		assert.Nil(t, queue)
+		assert.Equal(t, notifier.ErrNilHTTPClientWrapper, err)
+	})
+	t.Run("invalid min retry interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsQueue()
+		args.MinRetryInterval = 0
+
+		queue, err := notifier.NewEventsQueue(args)
+		assert.Nil(t, queue)
+		assert.True(t, errors.Is(err, notifier.ErrInvalidValue))
+	})
+	t.Run("max retry interval lower than min should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsQueue()
+		args.MaxRetryInterval = args.MinRetryInterval / 2
+
+		queue, err := notifier.NewEventsQueue(args)
+		assert.Nil(t, queue)
+		assert.True(t, errors.Is(err, notifier.ErrInvalidValue))
+	})
+	t.Run("corrupted queue should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsEventsQueue()
+		_ = args.Persister.Put([]byte("invalid key"), []byte("{}"))
+
+		queue, err := notifier.NewEventsQueue(args)
+		assert.Nil(t, queue)
+		assert.True(t, errors.Is(err, notifier.ErrCorruptedQueue))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		queue, err := notifier.NewEventsQueue(createMockArgsEventsQueue())
+		require.Nil(t, err)
+		assert.False(t, queue.IsInterfaceNil())
+		assert.Equal(t, 0, queue.NumQueuedEvents())
+		assert.Nil(t, queue.Close())
+	})
+}
+
+func TestEventsQueue_PostShouldSendInOrder(t *testing.T) {
+	t.Parallel()
+
+	client := newRecordingClient(nil)
+	args := createMockArgsEventsQueue()
+	args.HttpClient = client
+	queue, _ := notifier.NewEventsQueue(args)
+	defer func() {
+		_ = queue.Close()
+	}()
+
+	numEvents := 10
+	expectedEvents := make([]sentEvent, 0, numEvents)
+	for i := 0; i < numEvents; i++ {
+		finalizedBlock := notifier.FinalizedBlock{Hash: hex.EncodeToString([]byte{byte(i)})}
+		require.Nil(t, queue.Post(finalizeRoute, finalizedBlock))
+
+		payloadBytes, _ := json.Marshal(finalizedBlock)
+		expectedEvents = append(expectedEvents, sentEvent{route: finalizeRoute, payload: string(payloadBytes)})
+	}
+
+	client.waitSent(t, numEvents)
+	assert.Equal(t, expectedEvents, client.getSentEvents())
+	assert.Equal(t, 0, queue.NumQueuedEvents())
+}
+
+func TestEventsQueue_ShouldRetryUntilSent(t *testing.T) {
+	t.Parallel()
+
+	numFailures := 5
+	client := newRecordingClient(func(numCalls int) bool {
+		return numCalls <= numFailures
+	})
+	args := createMockArgsEventsQueue()
+	args.HttpClient = client
+	queue, _ := notifier.NewEventsQueue(args)
+	defer func() {
+		_ = queue.Close()
+	}()
+
+	require.Nil(t, queue.Post(finalizeRoute, notifier.FinalizedBlock{Hash: "aa"}))
+	require.Nil(t, queue.Post(finalizeRoute, notifier.FinalizedBlock{Hash: "bb"}))
+
+	client.waitSent(t, 2)
+	sentEvents := client.getSentEvents()
+	require.Len(t, sentEvents, 2)
+	assert.Equal(t, `{"hash":"aa"}`, sentEvents[0].payload)
+	assert.Equal(t, `{"hash":"bb"}`, sentEvents[1].payload)
+}
+
+func TestEventsQueue_RevertShouldDropTheUnsentEventsOfTheBlock(t *testing.T) {
+	t.Parallel()
+
+	chanUnblock := make(chan struct{})
+	client := newRecordingClient(nil)
+	postRecorder := client.PostCalled
+	client.PostCalled = func(route string, payload interface{}) error {
+		<-chanUnblock
+		return postRecorder(route, payload)
+	}
+	args := createMockArgsEventsQueue()
+	args.HttpClient = client
+	queue, _ := notifier.NewEventsQueue(args)
+	defer func() {
+		_ = queue.Close()
+	}()
+
+	// the first event is picked up by the sender and blocked while in flight
+	require.Nil(t, queue.Post(finalizeRoute, notifier.FinalizedBlock{Hash: "00"}))
+	time.Sleep(time.Millisecond * 50)
+
+	blockHash := []byte("reverted block")
+	require.Nil(t, queue.Post(pushRoute, outportSenderData.ArgsSaveBlock{}))
+	saveBlock := outportSenderData.ArgsSaveBlock{}
+	saveBlock.HeaderHash = blockHash
+	require.Nil(t, queue.Post(pushRoute, saveBlock))
+	require.Equal(t, 3, queue.NumQueuedEvents())
+
+	require.Nil(t, queue.Post(revertRoute, notifier.RevertBlock{Hash: hex.EncodeToString(blockHash)}))
+	assert.Equal(t, 2, queue.NumQueuedEvents())
+
+	close(chanUnblock)
+	client.waitSent(t, 2)
+	sentEvents := client.getSentEvents()
+	require.Len(t, sentEvents, 2)
+	assert.Equal(t, finalizeRoute, sentEvents[0].route)
+	assert.Equal(t, pushRoute, sentEvents[1].route)
+}
+
+func TestEventsQueue_RevertShouldBeQueuedIfTheBlockWasSent(t *testing.T) {
+	t.Parallel()
+
+	client := newRecordingClient(nil)
+	args := createMockArgsEventsQueue()
+	args.HttpClient = client
+	queue, _ := notifier.NewEventsQueue(args)
+	defer func() {
+		_ = queue.Close()
+	}()
+
+	blockHash := []byte("block")
+	saveBlock := outportSenderData.ArgsSaveBlock{}
+	saveBlock.HeaderHash = blockHash
+	require.Nil(t, queue.Post(pushRoute, saveBlock))
+	client.waitSent(t, 1)
+
+	require.Nil(t, queue.Post(revertRoute, notifier.RevertBlock{Hash: hex.EncodeToString(blockHash)}))
+	client.waitSent(t, 1)
+
+	sentEvents := client.getSentEvents()
+	require.Len(t, sentEvents, 2)
+	assert.Equal(t, revertRoute, sentEvents[1].route)
+}
+
+func TestEventsQueue_ShouldSendQueuedEventsAfterRestart(t *testing.T) {
+	t.Parallel()
+
+	persister := &closeCounterPersister{Persister: database.NewMemDB()}
+	args := createMockArgsEventsQueue()
+	args.Persister = persister
+	args.HttpClient = newRecordingClient(func(numCalls int) bool {
+		return true
+	})
+	queue, _ := notifier.NewEventsQueue(args)
+
+	require.Nil(t, queue.Post(finalizeRoute, notifier.FinalizedBlock{Hash: "aa"}))
+	require.Nil(t, queue.Post(finalizeRoute, notifier.FinalizedBlock{Hash: "bb"}))
+	require.Nil(t, queue.Close())
+	assert.Equal(t, 1, persister.closeCalled)
+
+	client := newRecordingClient(nil)
+	args.HttpClient = client
+	queue, err := notifier.NewEventsQueue(args)
+	require.Nil(t, err)
+	defer func() {
+		_ = queue.Close()
+	}()
+
+	client.waitSent(t, 2)
+	sentEvents := client.getSentEvents()
+	require.Len(t, sentEvents, 2)
+	assert.Equal(t, `{"hash":"aa"}`, sentEvents[0].payload)
+	assert.Equal(t, `{"hash":"bb"}`, sentEvents[1].payload)
+
+	// new events continue the sequence of the reloaded ones
+	require.Nil(t, queue.Post(finalizeRoute, notifier.FinalizedBlock{Hash: "cc"}))
+	client.waitSent(t, 1)
+	assert.Equal(t, `{"hash":"cc"}`, client.getSentEvents()[2].payload)
+}
+
+func TestEventsQueue_CloseShouldCloseTheHttpClient(t *testing.T) {
+	t.Parallel()
+
+	closeCalled := false
+	args := createMockArgsEventsQueue()
+	args.HttpClient = &mock.HTTPClientStub{
+		CloseCalled: func() error {
+			closeCalled = true
+			return nil
+		},
+	}
+	queue, _ := notifier.NewEventsQueue(args)
+
+	assert.Nil(t, queue.Close())
+	assert.True(t, closeCalled)
+}
@@ -107,6 +107,11 @@ func (h *httpClientWrapper) Post(
 	return nil
 }
 
+// Close returns nil
+func (h *httpClientWrapper) Close() error {
+	return nil
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (h *httpClientWrapper) IsInterfaceNil() bool {
 	return h == nil
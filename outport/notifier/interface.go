@@ -1,6 +1,8 @@
 package notifier
 
-type httpClientHandler interface {
+// HTTPClientHandler defines what a component able to send events to the notifier should do
+type HTTPClientHandler interface {
 	Post(route string, payload interface{}) error
+	Close() error
 	IsInterfaceNil() bool
 }
@@ -0,0 +1,33 @@
+package disabled
+
+type disabledGasConsumedProvider struct{}
+
+// NewDisabledGasConsumedProvider will create a new instance of disabledGasConsumedProvider
+func NewDisabledGasConsumedProvider() *disabledGasConsumedProvider {
+	return &disabledGasConsumedProvider{}
+}
+
+// TotalGasProvided returns 0
+func (d *disabledGasConsumedProvider) TotalGasProvided() uint64 {
+	return 0
+}
+
+// TotalGasProvidedWithScheduled returns 0
+func (d *disabledGasConsumedProvider) TotalGasProvidedWithScheduled() uint64 {
+	return 0
+}
+
+// TotalGasRefunded returns 0
+func (d *disabledGasConsumedProvider) TotalGasRefunded() uint64 {
+	return 0
+}
+
+// TotalGasPenalized returns 0
+func (d *disabledGasConsumedProvider) TotalGasPenalized() uint64 {
+	return 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (d *disabledGasConsumedProvider) IsInterfaceNil() bool {
+	return d == nil
+}
@@ -4,6 +4,7 @@ import (
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
 )
@@ -20,6 +21,13 @@ type TransactionsFeeHandler interface {
 	IsInterfaceNil() bool
 }
 
+// TransactionsProvider defines the functionality needed for providing the transactions and logs of the current block
+type TransactionsProvider interface {
+	GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler
+	GetAllCurrentLogs() []*data.LogData
+	IsInterfaceNil() bool
+}
+
 // GasConsumedProvider defines the functionality needed for providing gas consumed information
 type GasConsumedProvider interface {
 	TotalGasProvided() uint64
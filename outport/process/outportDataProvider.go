@@ -10,7 +10,6 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
 	"github.com/multiversx/mx-chain-go/outport/process/alteredaccounts/shared"
-	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/sharding/nodesCoordinator"
 )
@@ -21,7 +20,7 @@ type ArgOutportDataProvider struct {
 	ShardCoordinator         sharding.Coordinator
 	AlteredAccountsProvider  AlteredAccountsProviderHandler
 	TransactionsFeeProcessor TransactionsFeeHandler
-	TxCoordinator            process.TransactionCoordinator
+	TxCoordinator            TransactionsProvider
 	NodesCoordinator         nodesCoordinator.NodesCoordinator
 	GasConsumedProvider      GasConsumedProvider
 	EconomicsData            EconomicsDataHandler
@@ -44,7 +43,7 @@ type outportDataProvider struct {
 	numOfShards              uint32
 	alteredAccountsProvider  AlteredAccountsProviderHandler
 	transactionsFeeProcessor TransactionsFeeHandler
-	txCoordinator            process.TransactionCoordinator
+	txCoordinator            TransactionsProvider
 	nodesCoordinator         nodesCoordinator.NodesCoordinator
 	gasConsumedProvider      GasConsumedProvider
 	economicsData            EconomicsDataHandler
@@ -0,0 +1,232 @@
+package replay
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/outport"
+	outportProcess "github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/state"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("outport/replay")
+
+// ArgsBlocksReplayer holds the arguments needed for creating a new blocks replayer
+type ArgsBlocksReplayer struct {
+	ShardID            uint32
+	StorageService     dataRetriever.StorageService
+	Marshaller         marshal.Marshalizer
+	Uint64Converter    typeConverters.Uint64ByteSliceConverter
+	AccountsDB         state.AccountsAdapter
+	TransactionsLoader BlockTransactionsLoader
+	DataProvider       outport.DataProviderOutport
+	OutportHandler     outport.OutportHandler
+}
+
+type blocksReplayer struct {
+	shardID            uint32
+	storageService     dataRetriever.StorageService
+	marshaller         marshal.Marshalizer
+	uint64Converter    typeConverters.Uint64ByteSliceConverter
+	accountsDB         state.AccountsAdapter
+	transactionsLoader BlockTransactionsLoader
+	dataProvider       outport.DataProviderOutport
+	outportHandler     outport.OutportHandler
+}
+
+// NewBlocksReplayer creates a component able to push the blocks already committed in the local storage into the
+// outport drivers, as if they were just processed. The transactions loader provided here should be the same instance
+// that the data provider uses as transactions provider
+func NewBlocksReplayer(args ArgsBlocksReplayer) (*blocksReplayer, error) {
+	err := checkArgsBlocksReplayer(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &blocksReplayer{
+		shardID:            args.ShardID,
+		storageService:     args.StorageService,
+		marshaller:         args.Marshaller,
+		uint64Converter:    args.Uint64Converter,
+		accountsDB:         args.AccountsDB,
+		transactionsLoader: args.TransactionsLoader,
+		dataProvider:       args.DataProvider,
+		outportHandler:     args.OutportHandler,
+	}, nil
+}
+
+func checkArgsBlocksReplayer(args ArgsBlocksReplayer) error {
+	if check.IfNil(args.StorageService) {
+		return ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return ErrNilMarshaller
+	}
+	if check.IfNil(args.Uint64Converter) {
+		return ErrNilUint64Converter
+	}
+	if check.IfNil(args.AccountsDB) {
+		return ErrNilAccountsAdapter
+	}
+	if check.IfNil(args.TransactionsLoader) {
+		return ErrNilTransactionsLoader
+	}
+	if check.IfNil(args.DataProvider) {
+		return ErrNilDataProvider
+	}
+	if check.IfNil(args.OutportHandler) {
+		return ErrNilOutportHandler
+	}
+
+	return nil
+}
+
+// ReplayBlocks pushes into the outport handler the blocks with the nonces in the provided closed interval. For each
+// block, the accounts state is recreated from the block root hash, so the altered accounts are reported as they were
+// right after the block was committed. Each replayed block is also reported as finalized. The states of the first and
+// the last blocks are checked before pushing anything, as a pruned state cannot be replayed
+func (br *blocksReplayer) ReplayBlocks(fromNonce uint64, toNonce uint64) error {
+	if fromNonce > toNonce {
+		return fmt.Errorf("%w: from nonce %d is greater than to nonce %d", ErrInvalidNoncesInterval, fromNonce, toNonce)
+	}
+	if !br.outportHandler.HasDrivers() {
+		return ErrNoDrivers
+	}
+
+	log.Info("replaying blocks into the outport", "shard", br.shardID, "from nonce", fromNonce, "to nonce", toNonce)
+
+	err := br.checkStateAvailable(fromNonce)
+	if err != nil {
+		return err
+	}
+	err = br.checkStateAvailable(toNonce)
+	if err != nil {
+		return err
+	}
+
+	previousHeader, err := br.getPreviousHeader(fromNonce)
+	if err != nil {
+		return err
+	}
+
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		header, headerHash, errGet := process.GetHeaderFromStorageWithNonce(nonce, br.shardID, br.storageService, br.uint64Converter, br.marshaller)
+		if errGet != nil {
+			return fmt.Errorf("%w while loading the header with nonce %d", errGet, nonce)
+		}
+
+		err = br.replayBlock(header, headerHash, previousHeader)
+		if err != nil {
+			return fmt.Errorf("%w while replaying the block with nonce %d, hash %s", err, nonce, hex.EncodeToString(headerHash))
+		}
+
+		log.Debug("replayed block", "nonce", nonce, "hash", headerHash)
+		previousHeader = header
+
+		if nonce == toNonce {
+			// avoid overflow when toNonce is the maximum uint64 value
+			break
+		}
+	}
+
+	log.Info("finished replaying blocks into the outport", "shard", br.shardID, "from nonce", fromNonce, "to nonce", toNonce)
+
+	return nil
+}
+
+func (br *blocksReplayer) checkStateAvailable(nonce uint64) error {
+	header, _, err := process.GetHeaderFromStorageWithNonce(nonce, br.shardID, br.storageService, br.uint64Converter, br.marshaller)
+	if err != nil {
+		return fmt.Errorf("%w while loading the header with nonce %d", err, nonce)
+	}
+
+	return br.recreateTrie(header)
+}
+
+func (br *blocksReplayer) recreateTrie(header data.HeaderHandler) error {
+	err := br.accountsDB.RecreateTrie(header.GetRootHash())
+	if err != nil {
+		return fmt.Errorf("%w: nonce %d, root hash %s, error %s",
+			ErrStateNotAvailable, header.GetNonce(), hex.EncodeToString(header.GetRootHash()), err.Error())
+	}
+
+	return nil
+}
+
+func (br *blocksReplayer) getPreviousHeader(nonce uint64) (data.HeaderHandler, error) {
+	if nonce == 0 {
+		return nil, nil
+	}
+
+	previousHeader, _, err := process.GetHeaderFromStorageWithNonce(nonce-1, br.shardID, br.storageService, br.uint64Converter, br.marshaller)
+	if err != nil {
+		return nil, fmt.Errorf("%w while loading the header with nonce %d", err, nonce-1)
+	}
+
+	return previousHeader, nil
+}
+
+func (br *blocksReplayer) replayBlock(header data.HeaderHandler, headerHash []byte, previousHeader data.HeaderHandler) error {
+	body, err := br.transactionsLoader.LoadBlock(header, headerHash)
+	if err != nil {
+		return err
+	}
+
+	err = br.recreateTrie(header)
+	if err != nil {
+		return err
+	}
+
+	argSaveBlock, err := br.dataProvider.PrepareOutportSaveBlockData(outportProcess.ArgPrepareOutportSaveBlockData{
+		HeaderHash:             headerHash,
+		Header:                 header,
+		Body:                   body,
+		PreviousHeader:         previousHeader,
+		RewardsTxs:             br.getRewardsTxs(),
+		NotarizedHeadersHashes: getNotarizedHeadersHashes(header),
+	})
+	if err != nil {
+		return err
+	}
+
+	br.outportHandler.SaveBlock(argSaveBlock)
+	br.outportHandler.FinalizedBlock(headerHash)
+
+	return nil
+}
+
+func (br *blocksReplayer) getRewardsTxs() map[string]data.TransactionHandler {
+	if br.shardID != core.MetachainShardId {
+		return nil
+	}
+
+	return br.transactionsLoader.GetAllCurrentUsedTxs(block.RewardsBlock)
+}
+
+func getNotarizedHeadersHashes(header data.HeaderHandler) []string {
+	metaBlock, ok := header.(*block.MetaBlock)
+	if !ok {
+		return nil
+	}
+
+	notarizedHeadersHashes := make([]string, 0, len(metaBlock.ShardInfo))
+	for _, shardData := range metaBlock.ShardInfo {
+		notarizedHeadersHashes = append(notarizedHeadersHashes, hex.EncodeToString(shardData.HeaderHash))
+	}
+
+	return notarizedHeadersHashes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (br *blocksReplayer) IsInterfaceNil() bool {
+	return br == nil
+}
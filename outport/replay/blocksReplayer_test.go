@@ -0,0 +1,326 @@
+package replay_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	outportcore "github.com/multiversx/mx-chain-core-go/data/outport"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-go/outport/process"
+	"github.com/multiversx/mx-chain-go/outport/replay"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/outport"
+	"github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testUint64Converter = uint64ByteSlice.NewBigEndianConverter()
+
+func createMockArgsBlocksReplayer(store *genericMocks.ChainStorerMock) replay.ArgsBlocksReplayer {
+	transactionsLoader, _ := replay.NewStorageTransactionsProvider(createMockArgsStorageTransactionsProvider(store))
+
+	return replay.ArgsBlocksReplayer{
+		ShardID:         0,
+		StorageService:  store,
+		Marshaller:      testMarshaller,
+		Uint64Converter: testUint64Converter,
+		AccountsDB: &state.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				return nil
+			},
+		},
+		TransactionsLoader: transactionsLoader,
+		DataProvider:       &outport.OutportDataProviderStub{},
+		OutportHandler: &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+		},
+	}
+}
+
+func putHeader(t *testing.T, store *genericMocks.ChainStorerMock, header data.HeaderHandler, hash string) {
+	nonceBytes := testUint64Converter.ToByteSlice(header.GetNonce())
+	if header.GetShardID() == core.MetachainShardId {
+		putObject(t, store.Metablocks, hash, header)
+		require.Nil(t, store.MetaHdrNonce.Put(nonceBytes, []byte(hash)))
+		return
+	}
+
+	putObject(t, store.BlockHeaders, hash, header)
+	require.Nil(t, store.ShardHdrNonce.Put(nonceBytes, []byte(hash)))
+}
+
+func TestNewBlocksReplayer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.StorageService = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilStorageService, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.Marshaller = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilMarshaller, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.Uint64Converter = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilUint64Converter, err)
+	})
+	t.Run("nil accounts adapter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.AccountsDB = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilAccountsAdapter, err)
+	})
+	t.Run("nil transactions loader should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.TransactionsLoader = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilTransactionsLoader, err)
+	})
+	t.Run("nil data provider should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.DataProvider = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilDataProvider, err)
+	})
+	t.Run("nil outport handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.OutportHandler = nil
+		replayer, err := replay.NewBlocksReplayer(args)
+		assert.Nil(t, replayer)
+		assert.Equal(t, replay.ErrNilOutportHandler, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, err := replay.NewBlocksReplayer(createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0)))
+		assert.Nil(t, err)
+		assert.False(t, replayer.IsInterfaceNil())
+	})
+}
+
+func TestBlocksReplayer_ReplayBlocks(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid interval should error", func(t *testing.T) {
+		t.Parallel()
+
+		replayer, _ := replay.NewBlocksReplayer(createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0)))
+		err := replayer.ReplayBlocks(3, 2)
+		assert.True(t, errors.Is(err, replay.ErrInvalidNoncesInterval))
+	})
+	t.Run("no drivers should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlocksReplayer(genericMocks.NewChainStorerMock(0))
+		args.OutportHandler = &outport.OutportStub{}
+		replayer, _ := replay.NewBlocksReplayer(args)
+		err := replayer.ReplayBlocks(1, 2)
+		assert.Equal(t, replay.ErrNoDrivers, err)
+	})
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		putHeader(t, store, &block.Header{Nonce: 1}, "h1")
+
+		numSavedBlocks := 0
+		args := createMockArgsBlocksReplayer(store)
+		args.OutportHandler = &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.ArgsSaveBlockData) {
+				numSavedBlocks++
+			},
+		}
+		replayer, _ := replay.NewBlocksReplayer(args)
+		err := replayer.ReplayBlocks(2, 3)
+		assert.NotNil(t, err)
+		assert.Zero(t, numSavedBlocks)
+	})
+	t.Run("pruned state should error before replaying any block", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		for nonce := uint64(1); nonce <= 4; nonce++ {
+			putHeader(t, store, &block.Header{Nonce: nonce, RootHash: []byte{byte(nonce)}}, string([]byte{byte(nonce)}))
+		}
+
+		prunedRootHashErr := errors.New("trie was not found")
+		numSavedBlocks := 0
+		args := createMockArgsBlocksReplayer(store)
+		args.AccountsDB = &state.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				if rootHash[0] < 3 {
+					return prunedRootHashErr
+				}
+
+				return nil
+			},
+		}
+		args.OutportHandler = &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.ArgsSaveBlockData) {
+				numSavedBlocks++
+			},
+		}
+		replayer, _ := replay.NewBlocksReplayer(args)
+
+		err := replayer.ReplayBlocks(2, 4)
+		assert.True(t, errors.Is(err, replay.ErrStateNotAvailable))
+		assert.Contains(t, err.Error(), prunedRootHashErr.Error())
+		assert.Contains(t, err.Error(), "full archive node")
+		assert.Zero(t, numSavedBlocks)
+
+		err = replayer.ReplayBlocks(3, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, numSavedBlocks)
+	})
+	t.Run("data provider error should error", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		putHeader(t, store, &block.Header{Nonce: 0}, "h0")
+		putHeader(t, store, &block.Header{Nonce: 1}, "h1")
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBlocksReplayer(store)
+		args.DataProvider = &outport.OutportDataProviderStub{
+			PrepareOutportSaveBlockDataCalled: func(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.ArgsSaveBlockData, error) {
+				return nil, expectedErr
+			},
+		}
+		replayer, _ := replay.NewBlocksReplayer(args)
+		err := replayer.ReplayBlocks(1, 1)
+		assert.True(t, errors.Is(err, expectedErr))
+	})
+	t.Run("should replay shard blocks", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		for nonce := uint64(1); nonce <= 4; nonce++ {
+			putHeader(t, store, &block.Header{Nonce: nonce, RootHash: []byte{byte(nonce)}}, string([]byte{byte(nonce)}))
+		}
+
+		recreatedRootHashes := make([][]byte, 0)
+		args := createMockArgsBlocksReplayer(store)
+		args.AccountsDB = &state.AccountsStub{
+			RecreateTrieCalled: func(rootHash []byte) error {
+				recreatedRootHashes = append(recreatedRootHashes, rootHash)
+				return nil
+			},
+		}
+		preparedArgs := make([]process.ArgPrepareOutportSaveBlockData, 0)
+		args.DataProvider = &outport.OutportDataProviderStub{
+			PrepareOutportSaveBlockDataCalled: func(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.ArgsSaveBlockData, error) {
+				preparedArgs = append(preparedArgs, arg)
+				return &outportcore.ArgsSaveBlockData{HeaderHash: arg.HeaderHash, Header: arg.Header}, nil
+			},
+		}
+		savedHashes := make([][]byte, 0)
+		finalizedHashes := make([][]byte, 0)
+		args.OutportHandler = &outport.OutportStub{
+			HasDriversCalled: func() bool {
+				return true
+			},
+			SaveBlockCalled: func(args *outportcore.ArgsSaveBlockData) {
+				savedHashes = append(savedHashes, args.HeaderHash)
+			},
+			FinalizedBlockCalled: func(headerHash []byte) {
+				finalizedHashes = append(finalizedHashes, headerHash)
+			},
+		}
+		replayer, _ := replay.NewBlocksReplayer(args)
+
+		err := replayer.ReplayBlocks(2, 4)
+		require.Nil(t, err)
+
+		expectedHashes := [][]byte{{2}, {3}, {4}}
+		assert.Equal(t, expectedHashes, savedHashes)
+		assert.Equal(t, expectedHashes, finalizedHashes)
+		// the states of the first and the last blocks are checked before replaying
+		expectedRootHashes := [][]byte{{2}, {4}, {2}, {3}, {4}}
+		assert.Equal(t, expectedRootHashes, recreatedRootHashes)
+		require.Len(t, preparedArgs, 3)
+		assert.Equal(t, uint64(1), preparedArgs[0].PreviousHeader.GetNonce())
+		assert.Equal(t, uint64(3), preparedArgs[2].PreviousHeader.GetNonce())
+		assert.Nil(t, preparedArgs[0].RewardsTxs)
+		assert.Nil(t, preparedArgs[0].NotarizedHeadersHashes)
+		assert.Equal(t, &block.Body{}, preparedArgs[0].Body)
+	})
+	t.Run("should replay meta blocks with rewards and notarized headers", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		rewardsMiniBlock := &block.MiniBlock{
+			TxHashes:        [][]byte{[]byte("reward")},
+			ReceiverShardID: 0,
+			SenderShardID:   core.MetachainShardId,
+			Type:            block.RewardsBlock,
+		}
+		putObject(t, store.Miniblocks, "rewardsMb", rewardsMiniBlock)
+		putObject(t, store.Rewards, "reward", &rewardTx.RewardTx{Round: 7})
+
+		metaBlock := &block.MetaBlock{
+			Nonce:            1,
+			ShardInfo:        []block.ShardData{{HeaderHash: []byte{0xaa}}, {HeaderHash: []byte{0xbb}}},
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("rewardsMb"), TxCount: 1, Type: block.RewardsBlock}},
+		}
+		putHeader(t, store, &block.MetaBlock{Nonce: 0}, "genesis")
+		putHeader(t, store, metaBlock, "meta")
+
+		var preparedArg process.ArgPrepareOutportSaveBlockData
+		args := createMockArgsBlocksReplayer(store)
+		args.ShardID = core.MetachainShardId
+		args.DataProvider = &outport.OutportDataProviderStub{
+			PrepareOutportSaveBlockDataCalled: func(arg process.ArgPrepareOutportSaveBlockData) (*outportcore.ArgsSaveBlockData, error) {
+				preparedArg = arg
+				return &outportcore.ArgsSaveBlockData{}, nil
+			},
+		}
+		replayer, _ := replay.NewBlocksReplayer(args)
+
+		err := replayer.ReplayBlocks(1, 1)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("meta"), preparedArg.HeaderHash)
+		assert.Equal(t, uint64(0), preparedArg.PreviousHeader.GetNonce())
+		assert.Equal(t, []string{"aa", "bb"}, preparedArg.NotarizedHeadersHashes)
+		assert.Equal(t, map[string]data.TransactionHandler{"reward": &rewardTx.RewardTx{Round: 7}}, preparedArg.RewardsTxs)
+		assert.Equal(t, &block.Body{MiniBlocks: []*block.MiniBlock{rewardsMiniBlock}}, preparedArg.Body)
+	})
+}
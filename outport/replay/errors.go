@@ -0,0 +1,48 @@
+package replay
+
+import "errors"
+
+// ErrNilStorageService signals that a nil storage service has been provided
+var ErrNilStorageService = errors.New("nil storage service")
+
+// ErrNilMarshaller signals that a nil marshaller has been provided
+var ErrNilMarshaller = errors.New("nil marshaller")
+
+// ErrNilUint64Converter signals that a nil uint64 byte slice converter has been provided
+var ErrNilUint64Converter = errors.New("nil uint64 byte slice converter")
+
+// ErrNilReceiptsRepository signals that a nil receipts repository has been provided
+var ErrNilReceiptsRepository = errors.New("nil receipts repository")
+
+// ErrNilAccountsAdapter signals that a nil accounts adapter has been provided
+var ErrNilAccountsAdapter = errors.New("nil accounts adapter")
+
+// ErrNilTransactionsLoader signals that a nil block transactions loader has been provided
+var ErrNilTransactionsLoader = errors.New("nil block transactions loader")
+
+// ErrNilDataProvider signals that a nil outport data provider has been provided
+var ErrNilDataProvider = errors.New("nil outport data provider")
+
+// ErrNilOutportHandler signals that a nil outport handler has been provided
+var ErrNilOutportHandler = errors.New("nil outport handler")
+
+// ErrNilHeader signals that a nil header has been provided
+var ErrNilHeader = errors.New("nil header")
+
+// ErrNoDrivers signals that the outport handler does not have any driver subscribed
+var ErrNoDrivers = errors.New("no outport driver is configured")
+
+// ErrInvalidNoncesInterval signals that an invalid nonces interval has been provided
+var ErrInvalidNoncesInterval = errors.New("invalid nonces interval")
+
+// ErrCannotLoadMiniblock signals that a miniblock could not be loaded from storage
+var ErrCannotLoadMiniblock = errors.New("cannot load miniblock")
+
+// ErrCannotLoadTransaction signals that a transaction could not be loaded from storage
+var ErrCannotLoadTransaction = errors.New("cannot load transaction")
+
+// ErrCannotLoadLog signals that a transaction log could not be loaded from storage
+var ErrCannotLoadLog = errors.New("cannot load transaction log")
+
+// ErrStateNotAvailable signals that the accounts state of a block to be replayed was pruned
+var ErrStateNotAvailable = errors.New("the accounts state of the block is not available, replaying blocks requires a full archive node")
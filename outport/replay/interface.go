@@ -0,0 +1,21 @@
+package replay
+
+import (
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// BlockTransactionsLoader defines the component able to load from storage the body, the transactions and the logs of
+// a committed block. The loaded transactions and logs are then provided as if they were the ones of the current block
+type BlockTransactionsLoader interface {
+	LoadBlock(header data.HeaderHandler, headerHash []byte) (*block.Body, error)
+	GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler
+	GetAllCurrentLogs() []*data.LogData
+	IsInterfaceNil() bool
+}
+
+type receiptsRepository interface {
+	LoadReceipts(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error)
+	IsInterfaceNil() bool
+}
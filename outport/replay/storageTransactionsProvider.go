@@ -0,0 +1,283 @@
+package replay
+
+import (
+	"encoding/hex"
+	"fmt"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/rewardTx"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/storage"
+)
+
+// ArgsStorageTransactionsProvider holds the arguments needed for creating a new storage transactions provider
+type ArgsStorageTransactionsProvider struct {
+	StorageService     dataRetriever.StorageService
+	Marshaller         marshal.Marshalizer
+	ReceiptsRepository receiptsRepository
+}
+
+type storageTransactionsProvider struct {
+	storageService     dataRetriever.StorageService
+	marshaller         marshal.Marshalizer
+	receiptsRepository receiptsRepository
+
+	mutCurrentBlock sync.RWMutex
+	txs             map[block.Type]map[string]data.TransactionHandler
+	logs            []*data.LogData
+}
+
+// NewStorageTransactionsProvider creates a component that loads from storage the transactions and the logs of an
+// already committed block
+func NewStorageTransactionsProvider(args ArgsStorageTransactionsProvider) (*storageTransactionsProvider, error) {
+	if check.IfNil(args.StorageService) {
+		return nil, ErrNilStorageService
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, ErrNilMarshaller
+	}
+	if check.IfNil(args.ReceiptsRepository) {
+		return nil, ErrNilReceiptsRepository
+	}
+
+	return &storageTransactionsProvider{
+		storageService:     args.StorageService,
+		marshaller:         args.Marshaller,
+		receiptsRepository: args.ReceiptsRepository,
+		txs:                make(map[block.Type]map[string]data.TransactionHandler),
+		logs:               make([]*data.LogData, 0),
+	}, nil
+}
+
+// LoadBlock loads the miniblocks of the provided header, together with their executed transactions, the receipts and
+// intra shard results saved in the receipts storage and the logs of all the loaded transactions. It returns the
+// block body rebuilt from the miniblocks referenced in the header
+func (provider *storageTransactionsProvider) LoadBlock(header data.HeaderHandler, headerHash []byte) (*block.Body, error) {
+	if check.IfNil(header) {
+		return nil, ErrNilHeader
+	}
+
+	loaded := &loadedBlock{
+		txs:  make(map[block.Type]map[string]data.TransactionHandler),
+		logs: make([]*data.LogData, 0),
+	}
+	epoch := header.GetEpoch()
+
+	body := &block.Body{}
+	for _, mbHeader := range header.GetMiniBlockHeaderHandlers() {
+		miniBlock, err := provider.loadMiniBlock(mbHeader.GetHash(), epoch)
+		if err != nil {
+			return nil, err
+		}
+		body.MiniBlocks = append(body.MiniBlocks, miniBlock)
+
+		executedTxHashes := extractExecutedTxHashes(miniBlock.TxHashes, mbHeader.GetIndexOfFirstTxProcessed(), mbHeader.GetIndexOfLastTxProcessed())
+		err = provider.loadTransactions(loaded, miniBlock.Type, executedTxHashes, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	receiptsHolder, err := provider.receiptsRepository.LoadReceipts(header, headerHash)
+	if err != nil {
+		return nil, err
+	}
+	for _, miniBlock := range receiptsHolder.GetMiniblocks() {
+		err = provider.loadTransactions(loaded, miniBlock.Type, miniBlock.TxHashes, epoch)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	provider.mutCurrentBlock.Lock()
+	provider.txs = loaded.txs
+	provider.logs = loaded.logs
+	provider.mutCurrentBlock.Unlock()
+
+	return body, nil
+}
+
+type loadedBlock struct {
+	txs  map[block.Type]map[string]data.TransactionHandler
+	logs []*data.LogData
+}
+
+func (provider *storageTransactionsProvider) loadMiniBlock(hash []byte, epoch uint32) (*block.MiniBlock, error) {
+	storer, err := provider.storageService.GetStorer(dataRetriever.MiniBlockUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	buff, err := storer.GetFromEpoch(hash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v, hash = %s", ErrCannotLoadMiniblock, err, hex.EncodeToString(hash))
+	}
+
+	miniBlock := &block.MiniBlock{}
+	err = provider.marshaller.Unmarshal(miniBlock, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v, hash = %s", ErrCannotLoadMiniblock, err, hex.EncodeToString(hash))
+	}
+
+	return miniBlock, nil
+}
+
+func (provider *storageTransactionsProvider) loadTransactions(loaded *loadedBlock, blockType block.Type, txHashes [][]byte, epoch uint32) error {
+	unit, ok := getStorageUnit(blockType)
+	if !ok {
+		// peer miniblocks and other miniblocks without transactions
+		return nil
+	}
+
+	storer, err := provider.storageService.GetStorer(unit)
+	if err != nil {
+		return err
+	}
+
+	txsOfType, ok := loaded.txs[blockType]
+	if !ok {
+		txsOfType = make(map[string]data.TransactionHandler)
+		loaded.txs[blockType] = txsOfType
+	}
+
+	for _, txHash := range txHashes {
+		_, found := txsOfType[string(txHash)]
+		if found {
+			continue
+		}
+
+		tx, errLoad := provider.loadTransaction(storer, blockType, txHash, epoch)
+		if errLoad != nil {
+			return errLoad
+		}
+		txsOfType[string(txHash)] = tx
+
+		if !canGenerateLogs(blockType) {
+			continue
+		}
+
+		errLoad = provider.loadLog(loaded, txHash, epoch)
+		if errLoad != nil {
+			return errLoad
+		}
+	}
+
+	return nil
+}
+
+func (provider *storageTransactionsProvider) loadTransaction(storer storage.Storer, blockType block.Type, txHash []byte, epoch uint32) (data.TransactionHandler, error) {
+	buff, err := storer.GetFromEpoch(txHash, epoch)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v, type = %s, hash = %s", ErrCannotLoadTransaction, err, blockType.String(), hex.EncodeToString(txHash))
+	}
+
+	tx := createEmptyTransaction(blockType)
+	err = provider.marshaller.Unmarshal(tx, buff)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v, type = %s, hash = %s", ErrCannotLoadTransaction, err, blockType.String(), hex.EncodeToString(txHash))
+	}
+
+	return tx, nil
+}
+
+func (provider *storageTransactionsProvider) loadLog(loaded *loadedBlock, txHash []byte, epoch uint32) error {
+	storer, err := provider.storageService.GetStorer(dataRetriever.TxLogsUnit)
+	if err != nil {
+		return err
+	}
+
+	buff, err := storer.GetFromEpoch(txHash, epoch)
+	if storage.IsNotFoundInStorageErr(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v, hash = %s", ErrCannotLoadLog, err, hex.EncodeToString(txHash))
+	}
+
+	txLog := &transaction.Log{}
+	err = provider.marshaller.Unmarshal(txLog, buff)
+	if err != nil {
+		return fmt.Errorf("%w: %v, hash = %s", ErrCannotLoadLog, err, hex.EncodeToString(txHash))
+	}
+
+	loaded.logs = append(loaded.logs, &data.LogData{
+		LogHandler: txLog,
+		TxHash:     string(txHash),
+	})
+
+	return nil
+}
+
+func getStorageUnit(blockType block.Type) (dataRetriever.UnitType, bool) {
+	switch blockType {
+	case block.TxBlock, block.InvalidBlock:
+		return dataRetriever.TransactionUnit, true
+	case block.SmartContractResultBlock, block.ReceiptBlock:
+		return dataRetriever.UnsignedTransactionUnit, true
+	case block.RewardsBlock:
+		return dataRetriever.RewardTransactionUnit, true
+	default:
+		return 0, false
+	}
+}
+
+func createEmptyTransaction(blockType block.Type) data.TransactionHandler {
+	switch blockType {
+	case block.SmartContractResultBlock:
+		return &smartContractResult.SmartContractResult{}
+	case block.ReceiptBlock:
+		return &receipt.Receipt{}
+	case block.RewardsBlock:
+		return &rewardTx.RewardTx{}
+	default:
+		return &transaction.Transaction{}
+	}
+}
+
+func canGenerateLogs(blockType block.Type) bool {
+	return blockType == block.TxBlock || blockType == block.SmartContractResultBlock
+}
+
+func extractExecutedTxHashes(txHashes [][]byte, firstProcessed int32, lastProcessed int32) [][]byte {
+	if firstProcessed < 0 || lastProcessed < firstProcessed || int(lastProcessed) >= len(txHashes) {
+		return txHashes
+	}
+
+	return txHashes[firstProcessed : lastProcessed+1]
+}
+
+// GetAllCurrentUsedTxs returns the transactions of the provided type loaded for the last block
+func (provider *storageTransactionsProvider) GetAllCurrentUsedTxs(blockType block.Type) map[string]data.TransactionHandler {
+	provider.mutCurrentBlock.RLock()
+	defer provider.mutCurrentBlock.RUnlock()
+
+	txs := make(map[string]data.TransactionHandler, len(provider.txs[blockType]))
+	for txHash, tx := range provider.txs[blockType] {
+		txs[txHash] = tx
+	}
+
+	return txs
+}
+
+// GetAllCurrentLogs returns the logs loaded for the last block
+func (provider *storageTransactionsProvider) GetAllCurrentLogs() []*data.LogData {
+	provider.mutCurrentBlock.RLock()
+	defer provider.mutCurrentBlock.RUnlock()
+
+	logs := make([]*data.LogData, 0, len(provider.logs))
+	logs = append(logs, provider.logs...)
+
+	return logs
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (provider *storageTransactionsProvider) IsInterfaceNil() bool {
+	return provider == nil
+}
@@ -0,0 +1,197 @@
+package replay_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/receipt"
+	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
+	"github.com/multiversx/mx-chain-go/outport/replay"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarshaller = &marshal.GogoProtoMarshalizer{}
+
+func createMockArgsStorageTransactionsProvider(store *genericMocks.ChainStorerMock) replay.ArgsStorageTransactionsProvider {
+	return replay.ArgsStorageTransactionsProvider{
+		StorageService:     store,
+		Marshaller:         testMarshaller,
+		ReceiptsRepository: &testscommon.ReceiptsRepositoryStub{},
+	}
+}
+
+func putObject(t *testing.T, storer *genericMocks.StorerMock, key string, obj interface{}) {
+	buff, err := testMarshaller.Marshal(obj)
+	require.Nil(t, err)
+	require.Nil(t, storer.Put([]byte(key), buff))
+}
+
+func TestNewStorageTransactionsProvider(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageTransactionsProvider(nil)
+		args.StorageService = nil
+		provider, err := replay.NewStorageTransactionsProvider(args)
+		assert.Nil(t, provider)
+		assert.Equal(t, replay.ErrNilStorageService, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageTransactionsProvider(genericMocks.NewChainStorerMock(0))
+		args.Marshaller = nil
+		provider, err := replay.NewStorageTransactionsProvider(args)
+		assert.Nil(t, provider)
+		assert.Equal(t, replay.ErrNilMarshaller, err)
+	})
+	t.Run("nil receipts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsStorageTransactionsProvider(genericMocks.NewChainStorerMock(0))
+		args.ReceiptsRepository = nil
+		provider, err := replay.NewStorageTransactionsProvider(args)
+		assert.Nil(t, provider)
+		assert.Equal(t, replay.ErrNilReceiptsRepository, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		provider, err := replay.NewStorageTransactionsProvider(createMockArgsStorageTransactionsProvider(genericMocks.NewChainStorerMock(0)))
+		assert.Nil(t, err)
+		assert.False(t, provider.IsInterfaceNil())
+		assert.Empty(t, provider.GetAllCurrentUsedTxs(block.TxBlock))
+		assert.Empty(t, provider.GetAllCurrentLogs())
+	})
+}
+
+func TestStorageTransactionsProvider_LoadBlock(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil header should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := replay.NewStorageTransactionsProvider(createMockArgsStorageTransactionsProvider(genericMocks.NewChainStorerMock(0)))
+		body, err := provider.LoadBlock(nil, []byte("hash"))
+		assert.Nil(t, body)
+		assert.Equal(t, replay.ErrNilHeader, err)
+	})
+	t.Run("missing miniblock should error", func(t *testing.T) {
+		t.Parallel()
+
+		provider, _ := replay.NewStorageTransactionsProvider(createMockArgsStorageTransactionsProvider(genericMocks.NewChainStorerMock(0)))
+		header := &block.Header{
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb"), TxCount: 1}},
+		}
+		body, err := provider.LoadBlock(header, []byte("hash"))
+		assert.Nil(t, body)
+		assert.True(t, errors.Is(err, replay.ErrCannotLoadMiniblock))
+	})
+	t.Run("missing transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		putObject(t, store.Miniblocks, "mb", &block.MiniBlock{TxHashes: [][]byte{[]byte("tx")}, Type: block.TxBlock})
+		provider, _ := replay.NewStorageTransactionsProvider(createMockArgsStorageTransactionsProvider(store))
+		header := &block.Header{
+			MiniBlockHeaders: []block.MiniBlockHeader{{Hash: []byte("mb"), TxCount: 1}},
+		}
+		body, err := provider.LoadBlock(header, []byte("hash"))
+		assert.Nil(t, body)
+		assert.True(t, errors.Is(err, replay.ErrCannotLoadTransaction))
+	})
+	t.Run("receipts repository error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsStorageTransactionsProvider(genericMocks.NewChainStorerMock(0))
+		args.ReceiptsRepository = &testscommon.ReceiptsRepositoryStub{
+			LoadReceiptsCalled: func(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error) {
+				return nil, expectedErr
+			},
+		}
+		provider, _ := replay.NewStorageTransactionsProvider(args)
+		body, err := provider.LoadBlock(&block.Header{}, []byte("hash"))
+		assert.Nil(t, body)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should load transactions, receipts storage miniblocks and logs", func(t *testing.T) {
+		t.Parallel()
+
+		store := genericMocks.NewChainStorerMock(0)
+		txMiniBlock := &block.MiniBlock{
+			TxHashes: [][]byte{[]byte("tx0"), []byte("tx1"), []byte("tx2")},
+			Type:     block.TxBlock,
+		}
+		peerMiniBlock := &block.MiniBlock{
+			TxHashes: [][]byte{[]byte("validator info")},
+			Type:     block.PeerBlock,
+		}
+		putObject(t, store.Miniblocks, "txMb", txMiniBlock)
+		putObject(t, store.Miniblocks, "peerMb", peerMiniBlock)
+		putObject(t, store.Transactions, "tx0", &transaction.Transaction{Nonce: 0})
+		putObject(t, store.Transactions, "tx1", &transaction.Transaction{Nonce: 1})
+		putObject(t, store.Unsigned, "scr", &smartContractResult.SmartContractResult{Nonce: 2})
+		putObject(t, store.Unsigned, "receipt", &receipt.Receipt{TxHash: []byte("tx1")})
+		txLog := &transaction.Log{Address: []byte("address")}
+		putObject(t, store.Logs, "scr", txLog)
+
+		args := createMockArgsStorageTransactionsProvider(store)
+		args.ReceiptsRepository = &testscommon.ReceiptsRepositoryStub{
+			LoadReceiptsCalled: func(header data.HeaderHandler, headerHash []byte) (common.ReceiptsHolder, error) {
+				if string(headerHash) != "hash" {
+					return holders.NewReceiptsHolder(nil), nil
+				}
+
+				return holders.NewReceiptsHolder([]*block.MiniBlock{
+					{TxHashes: [][]byte{[]byte("scr")}, Type: block.SmartContractResultBlock},
+					{TxHashes: [][]byte{[]byte("receipt")}, Type: block.ReceiptBlock},
+				}), nil
+			},
+		}
+		provider, _ := replay.NewStorageTransactionsProvider(args)
+
+		mbHeaderPartiallyExecuted := block.MiniBlockHeader{Hash: []byte("txMb"), TxCount: 3}
+		require.Nil(t, mbHeaderPartiallyExecuted.SetIndexOfLastTxProcessed(1))
+		header := &block.Header{
+			MiniBlockHeaders: []block.MiniBlockHeader{
+				mbHeaderPartiallyExecuted,
+				{Hash: []byte("peerMb"), TxCount: 1},
+			},
+		}
+		body, err := provider.LoadBlock(header, []byte("hash"))
+		require.Nil(t, err)
+		assert.Equal(t, []*block.MiniBlock{txMiniBlock, peerMiniBlock}, body.MiniBlocks)
+
+		txs := provider.GetAllCurrentUsedTxs(block.TxBlock)
+		assert.Equal(t, map[string]data.TransactionHandler{
+			"tx0": &transaction.Transaction{Nonce: 0},
+			"tx1": &transaction.Transaction{Nonce: 1},
+		}, txs)
+		assert.Equal(t, map[string]data.TransactionHandler{
+			"scr": &smartContractResult.SmartContractResult{Nonce: 2},
+		}, provider.GetAllCurrentUsedTxs(block.SmartContractResultBlock))
+		assert.Equal(t, map[string]data.TransactionHandler{
+			"receipt": &receipt.Receipt{TxHash: []byte("tx1")},
+		}, provider.GetAllCurrentUsedTxs(block.ReceiptBlock))
+		assert.Empty(t, provider.GetAllCurrentUsedTxs(block.RewardsBlock))
+		assert.Equal(t, []*data.LogData{{LogHandler: txLog, TxHash: "scr"}}, provider.GetAllCurrentLogs())
+
+		// loading the next block should replace the current data
+		_, err = provider.LoadBlock(&block.Header{}, []byte("next hash"))
+		require.Nil(t, err)
+		assert.Empty(t, provider.GetAllCurrentUsedTxs(block.TxBlock))
+		assert.Empty(t, provider.GetAllCurrentLogs())
+	})
+}
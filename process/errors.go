@@ -1202,3 +1202,24 @@ var ErrBuiltinFunctionMismatch = errors.New("builtin function mismatch")
 
 // ErrBuiltinFunctionNotExecutable signals that a builtin function is not executable
 var ErrBuiltinFunctionNotExecutable = errors.New("builtin function not executable")
+
+// ErrStateOverridesNotSupported signals that state overrides were provided to a component which does not support them
+var ErrStateOverridesNotSupported = errors.New("state overrides are not supported")
+
+// ErrCallTraceNotSupported signals that a call trace was requested from a component which is not able to build one
+var ErrCallTraceNotSupported = errors.New("call trace is not supported")
+
+// ErrHistoricalQueriesNotSupported signals that a query on a past block was provided to a component which does not support it
+var ErrHistoricalQueriesNotSupported = errors.New("queries on past blocks are not supported")
+
+// ErrTooManyBlockCoordinates signals that more than one block coordinate was provided
+var ErrTooManyBlockCoordinates = errors.New("only one block coordinate (block nonce, block hash or root hash) can be specified at a time")
+
+// ErrNilPeerReputationHandler signals that a nil peer reputation handler has been provided
+var ErrNilPeerReputationHandler = errors.New("nil peer reputation handler")
+
+// ErrNilPeerHonestyScoreProvider signals that a nil peer honesty score provider has been provided
+var ErrNilPeerHonestyScoreProvider = errors.New("nil peer honesty score provider")
+
+// ErrNilPeersResponseTracker signals that a nil peers response tracker has been provided
+var ErrNilPeersResponseTracker = errors.New("nil peers response tracker")
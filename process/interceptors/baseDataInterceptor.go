@@ -11,14 +11,18 @@ import (
 )
 
 type baseDataInterceptor struct {
-	throttler            process.InterceptorThrottler
-	antifloodHandler     process.P2PAntifloodHandler
-	topic                string
-	currentPeerId        core.PeerID
-	processor            process.InterceptorProcessor
-	mutDebugHandler      sync.RWMutex
-	debugHandler         process.InterceptedDebugger
-	preferredPeersHolder process.PreferredPeersHolderHandler
+	throttler             process.InterceptorThrottler
+	antifloodHandler      process.P2PAntifloodHandler
+	topic                 string
+	currentPeerId         core.PeerID
+	processor             process.InterceptorProcessor
+	mutDebugHandler       sync.RWMutex
+	debugHandler          process.InterceptedDebugger
+	preferredPeersHolder  process.PreferredPeersHolderHandler
+	mutPeerReputation     sync.RWMutex
+	peerReputationHandler process.PeerReputationHandler
+	mutResponseTracker    sync.RWMutex
+	peersResponseTracker  process.PeersResponseTracker
 }
 
 func (bdi *baseDataInterceptor) preProcessMesage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
@@ -131,3 +135,45 @@ func (bdi *baseDataInterceptor) SetInterceptedDebugHandler(handler process.Inter
 
 	return nil
 }
+
+func (bdi *baseDataInterceptor) recordInvalidData(fromConnectedPeer core.PeerID) {
+	bdi.mutPeerReputation.RLock()
+	bdi.peerReputationHandler.RecordInvalidData(fromConnectedPeer, bdi.topic)
+	bdi.mutPeerReputation.RUnlock()
+}
+
+func (bdi *baseDataInterceptor) recordReceivedData(fromConnectedPeer core.PeerID, isUseful bool) {
+	bdi.mutPeerReputation.RLock()
+	bdi.peerReputationHandler.RecordReceivedData(fromConnectedPeer, bdi.topic, isUseful)
+	bdi.mutPeerReputation.RUnlock()
+
+	bdi.mutResponseTracker.RLock()
+	bdi.peersResponseTracker.ResponseReceived(fromConnectedPeer, bdi.topic, isUseful)
+	bdi.mutResponseTracker.RUnlock()
+}
+
+// SetPeerReputationHandler will set a new peer reputation handler
+func (bdi *baseDataInterceptor) SetPeerReputationHandler(handler process.PeerReputationHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilPeerReputationHandler
+	}
+
+	bdi.mutPeerReputation.Lock()
+	bdi.peerReputationHandler = handler
+	bdi.mutPeerReputation.Unlock()
+
+	return nil
+}
+
+// SetPeersResponseTracker will set a new peers response tracker
+func (bdi *baseDataInterceptor) SetPeersResponseTracker(tracker process.PeersResponseTracker) error {
+	if check.IfNil(tracker) {
+		return process.ErrNilPeersResponseTracker
+	}
+
+	bdi.mutResponseTracker.Lock()
+	bdi.peersResponseTracker = tracker
+	bdi.mutResponseTracker.Unlock()
+
+	return nil
+}
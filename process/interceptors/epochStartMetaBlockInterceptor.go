@@ -135,6 +135,16 @@ func (e *epochStartMetaBlockInterceptor) SetInterceptedDebugHandler(_ process.In
 	return nil
 }
 
+// SetPeerReputationHandler won't do anything
+func (e *epochStartMetaBlockInterceptor) SetPeerReputationHandler(_ process.PeerReputationHandler) error {
+	return nil
+}
+
+// SetPeersResponseTracker won't do anything
+func (e *epochStartMetaBlockInterceptor) SetPeersResponseTracker(_ process.PeersResponseTracker) error {
+	return nil
+}
+
 // RegisterHandler will append the handler to the slice, so it will be called when the epoch start meta block is fetched
 func (e *epochStartMetaBlockInterceptor) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	if handler == nil {
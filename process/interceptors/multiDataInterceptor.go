@@ -8,10 +8,12 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/batch"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/peersResponseTracker"
 	"github.com/multiversx/mx-chain-go/debug/resolver"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/interceptors/disabled"
+	antifloodDisabled "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
@@ -72,13 +74,15 @@ func NewMultiDataInterceptor(arg ArgMultiDataInterceptor) (*MultiDataInterceptor
 
 	multiDataIntercept := &MultiDataInterceptor{
 		baseDataInterceptor: &baseDataInterceptor{
-			throttler:            arg.Throttler,
-			antifloodHandler:     arg.AntifloodHandler,
-			topic:                arg.Topic,
-			currentPeerId:        arg.CurrentPeerId,
-			processor:            arg.Processor,
-			preferredPeersHolder: arg.PreferredPeersHolder,
-			debugHandler:         resolver.NewDisabledInterceptorResolver(),
+			throttler:             arg.Throttler,
+			antifloodHandler:      arg.AntifloodHandler,
+			topic:                 arg.Topic,
+			currentPeerId:         arg.CurrentPeerId,
+			processor:             arg.Processor,
+			preferredPeersHolder:  arg.PreferredPeersHolder,
+			debugHandler:          resolver.NewDisabledInterceptorResolver(),
+			peerReputationHandler: &antifloodDisabled.PeerReputationHandler{},
+			peersResponseTracker:  peersResponseTracker.NewDisabledPeersResponseTracker(),
 		},
 		marshalizer:      arg.Marshalizer,
 		factory:          arg.DataFactory,
@@ -101,6 +105,7 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	err = mdi.marshalizer.Unmarshal(&b, message.Data())
 	if err != nil {
 		mdi.throttler.EndProcessing()
+		mdi.recordInvalidData(fromConnectedPeer)
 
 		// this situation is so severe that we need to black list de peers
 		reason := "unmarshalable data got on topic " + mdi.topic
@@ -148,6 +153,7 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 
 	listInterceptedData := make([]process.InterceptedData, len(multiDataBuff))
 	errOriginator := mdi.antifloodHandler.IsOriginatorEligibleForTopic(message.Peer(), mdi.topic)
+	isUseful := false
 
 	for index, dataBuff := range multiDataBuff {
 		var interceptedData process.InterceptedData
@@ -155,12 +161,15 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 		listInterceptedData[index] = interceptedData
 		if err != nil {
 			mdi.throttler.EndProcessing()
+			mdi.recordInvalidData(fromConnectedPeer)
 			return err
 		}
 
 		isWhiteListed := mdi.whiteListRequest.IsWhiteListed(interceptedData)
+		isUseful = isUseful || isWhiteListed
 		if !isWhiteListed && errOriginator != nil {
 			mdi.throttler.EndProcessing()
+			mdi.recordReceivedData(fromConnectedPeer, isUseful)
 			log.Trace("got message from peer on topic only for validators", "originator",
 				p2p.PeerIdToShortString(message.Peer()),
 				"topic", mdi.topic,
@@ -180,9 +189,11 @@ func (mdi *MultiDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 				"is white listed", isWhiteListed,
 			)
 			mdi.throttler.EndProcessing()
+			mdi.recordReceivedData(fromConnectedPeer, isUseful)
 			return process.ErrInterceptedDataNotForCurrentShard
 		}
 	}
+	mdi.recordReceivedData(fromConnectedPeer, isUseful)
 
 	go func() {
 		for _, interceptedData := range listInterceptedData {
@@ -709,6 +709,140 @@ func TestMultiDataInterceptor_ProcessReceivedMessageIsOriginatorNotOkButWhiteLis
 	assert.Equal(t, int32(2), throttler.EndProcessingCount())
 }
 
+func TestMultiDataInterceptor_SetPeerReputationHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	err := mdi.SetPeerReputationHandler(nil)
+
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageShouldRecordOnPeerReputationHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unmarshalable data should be recorded as invalid", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgMultiDataInterceptor()
+		mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+		numInvalidData := 0
+		_ = mdi.SetPeerReputationHandler(&p2pmocks.PeerReputationHandlerStub{
+			RecordInvalidDataCalled: func(pid core.PeerID, topic string) {
+				assert.Equal(t, fromConnectedPeerId, pid)
+				assert.Equal(t, arg.Topic, topic)
+				numInvalidData++
+			},
+		})
+
+		msg := &mock.P2PMessageMock{
+			DataField: []byte("not a batch"),
+		}
+		err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, numInvalidData)
+	})
+	t.Run("batch with at least one whitelisted item should be recorded as useful", func(t *testing.T) {
+		t.Parallel()
+
+		whitelistedData := []byte("buff2")
+		arg := createMockArgMultiDataInterceptor()
+		arg.DataFactory = &mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+				return &testscommon.InterceptedDataStub{
+					IsForCurrentShardCalled: func() bool {
+						return true
+					},
+					HashCalled: func() []byte {
+						return buff
+					},
+				}, nil
+			},
+		}
+		arg.Processor = createMockInterceptorStub(nil, nil)
+		arg.WhiteListRequest = &testscommon.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return bytes.Equal(interceptedData.Hash(), whitelistedData)
+			},
+		}
+		mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+		recordedData := make([]bool, 0)
+		_ = mdi.SetPeerReputationHandler(&p2pmocks.PeerReputationHandlerStub{
+			RecordReceivedDataCalled: func(pid core.PeerID, topic string, isUseful bool) {
+				assert.Equal(t, fromConnectedPeerId, pid)
+				assert.Equal(t, arg.Topic, topic)
+				recordedData = append(recordedData, isUseful)
+			},
+		})
+
+		dataField, _ := arg.Marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("buff1"), whitelistedData}})
+		msg := &mock.P2PMessageMock{
+			DataField: dataField,
+		}
+		err := mdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []bool{true}, recordedData)
+	})
+}
+
+func TestMultiDataInterceptor_SetPeersResponseTrackerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	err := mdi.SetPeersResponseTracker(nil)
+
+	assert.Equal(t, process.ErrNilPeersResponseTracker, err)
+}
+
+func TestMultiDataInterceptor_ProcessReceivedMessageShouldNotifyPeersResponseTracker(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgMultiDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{
+				IsForCurrentShardCalled: func() bool {
+					return true
+				},
+			}, nil
+		},
+	}
+	arg.Processor = createMockInterceptorStub(nil, nil)
+	arg.WhiteListRequest = &testscommon.WhiteListHandlerStub{
+		IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+			return true
+		},
+	}
+	mdi, _ := interceptors.NewMultiDataInterceptor(arg)
+
+	responses := make([]bool, 0)
+	err := mdi.SetPeersResponseTracker(&testscommon.PeersResponseTrackerStub{
+		ResponseReceivedCalled: func(pid core.PeerID, topic string, isRequested bool) {
+			assert.Equal(t, fromConnectedPeerId, pid)
+			assert.Equal(t, arg.Topic, topic)
+			responses = append(responses, isRequested)
+		},
+	})
+	assert.Nil(t, err)
+
+	dataField, _ := arg.Marshalizer.Marshal(&batch.Batch{Data: [][]byte{[]byte("buff1"), []byte("buff2")}})
+	msg := &mock.P2PMessageMock{
+		DataField: dataField,
+	}
+	err = mdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true}, responses)
+}
+
 func TestMultiDataInterceptor_RegisterHandler(t *testing.T) {
 	t.Parallel()
 
@@ -4,9 +4,11 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/peersResponseTracker"
 	"github.com/multiversx/mx-chain-go/debug/resolver"
 	"github.com/multiversx/mx-chain-go/p2p"
 	"github.com/multiversx/mx-chain-go/process"
+	antifloodDisabled "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 )
 
 // ArgSingleDataInterceptor is the argument for the single-data interceptor
@@ -57,13 +59,15 @@ func NewSingleDataInterceptor(arg ArgSingleDataInterceptor) (*SingleDataIntercep
 
 	singleDataIntercept := &SingleDataInterceptor{
 		baseDataInterceptor: &baseDataInterceptor{
-			throttler:            arg.Throttler,
-			antifloodHandler:     arg.AntifloodHandler,
-			topic:                arg.Topic,
-			currentPeerId:        arg.CurrentPeerId,
-			processor:            arg.Processor,
-			preferredPeersHolder: arg.PreferredPeersHolder,
-			debugHandler:         resolver.NewDisabledInterceptorResolver(),
+			throttler:             arg.Throttler,
+			antifloodHandler:      arg.AntifloodHandler,
+			topic:                 arg.Topic,
+			currentPeerId:         arg.CurrentPeerId,
+			processor:             arg.Processor,
+			preferredPeersHolder:  arg.PreferredPeersHolder,
+			debugHandler:          resolver.NewDisabledInterceptorResolver(),
+			peerReputationHandler: &antifloodDisabled.PeerReputationHandler{},
+			peersResponseTracker:  peersResponseTracker.NewDisabledPeersResponseTracker(),
 		},
 		factory:          arg.DataFactory,
 		whiteListRequest: arg.WhiteListRequest,
@@ -83,6 +87,7 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	interceptedData, err := sdi.factory.Create(message.Data())
 	if err != nil {
 		sdi.throttler.EndProcessing()
+		sdi.recordInvalidData(fromConnectedPeer)
 
 		// this situation is so severe that we need to black list the peers
 		reason := "can not create object from received bytes, topic " + sdi.topic + ", error " + err.Error()
@@ -98,6 +103,7 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 	if err != nil {
 		sdi.throttler.EndProcessing()
 		sdi.processDebugInterceptedData(interceptedData, err)
+		sdi.recordInvalidData(fromConnectedPeer)
 
 		isWrongVersion := err == process.ErrInvalidTransactionVersion || err == process.ErrInvalidChainID
 		if isWrongVersion {
@@ -112,6 +118,7 @@ func (sdi *SingleDataInterceptor) ProcessReceivedMessage(message p2p.MessageP2P,
 
 	errOriginator := sdi.antifloodHandler.IsOriginatorEligibleForTopic(message.Peer(), sdi.topic)
 	isWhiteListed := sdi.whiteListRequest.IsWhiteListed(interceptedData)
+	sdi.recordReceivedData(fromConnectedPeer, isWhiteListed)
 	if !isWhiteListed && errOriginator != nil {
 		log.Trace("got message from peer on topic only for validators",
 			"originator", p2p.PeerIdToShortString(message.Peer()), "topic",
@@ -458,6 +458,144 @@ func TestSingleDataInterceptor_SetInterceptedDebugHandlerShouldWork(t *testing.T
 	assert.True(t, debugger == sdi.InterceptedDebugHandler()) //pointer testing
 }
 
+//------- peer reputation
+
+func TestSingleDataInterceptor_SetPeerReputationHandlerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	err := sdi.SetPeerReputationHandler(nil)
+
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldRecordOnPeerReputationHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid data should be recorded", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgSingleDataInterceptor()
+		arg.DataFactory = &mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+				return &testscommon.InterceptedDataStub{
+					CheckValidityCalled: func() error {
+						return process.ErrInvalidChainID
+					},
+				}, nil
+			},
+		}
+		sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+		numInvalidData := 0
+		_ = sdi.SetPeerReputationHandler(&p2pmocks.PeerReputationHandlerStub{
+			RecordInvalidDataCalled: func(pid core.PeerID, topic string) {
+				assert.Equal(t, fromConnectedPeerId, pid)
+				assert.Equal(t, arg.Topic, topic)
+				numInvalidData++
+			},
+			RecordReceivedDataCalled: func(pid core.PeerID, topic string, isUseful bool) {
+				assert.Fail(t, "should have not been called")
+			},
+		})
+
+		msg := &mock.P2PMessageMock{
+			DataField: []byte("data to be processed"),
+		}
+		err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+		assert.Equal(t, process.ErrInvalidChainID, err)
+		assert.Equal(t, 1, numInvalidData)
+	})
+	t.Run("whitelisted data should be recorded as useful", func(t *testing.T) {
+		t.Parallel()
+
+		arg := createMockArgSingleDataInterceptor()
+		arg.DataFactory = &mock.InterceptedDataFactoryStub{
+			CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+				return &testscommon.InterceptedDataStub{}, nil
+			},
+		}
+		arg.Processor = createMockInterceptorStub(nil, nil)
+		arg.WhiteListRequest = &testscommon.WhiteListHandlerStub{
+			IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+				return true
+			},
+		}
+		sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+		numUsefulData := 0
+		_ = sdi.SetPeerReputationHandler(&p2pmocks.PeerReputationHandlerStub{
+			RecordReceivedDataCalled: func(pid core.PeerID, topic string, isUseful bool) {
+				assert.Equal(t, fromConnectedPeerId, pid)
+				assert.Equal(t, arg.Topic, topic)
+				assert.True(t, isUseful)
+				numUsefulData++
+			},
+		})
+
+		msg := &mock.P2PMessageMock{
+			DataField: []byte("data to be processed"),
+		}
+		err := sdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, numUsefulData)
+	})
+}
+
+//------- peers response tracker
+
+func TestSingleDataInterceptor_SetPeersResponseTrackerNilShouldErr(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	err := sdi.SetPeersResponseTracker(nil)
+
+	assert.Equal(t, process.ErrNilPeersResponseTracker, err)
+}
+
+func TestSingleDataInterceptor_ProcessReceivedMessageShouldNotifyPeersResponseTracker(t *testing.T) {
+	t.Parallel()
+
+	arg := createMockArgSingleDataInterceptor()
+	arg.DataFactory = &mock.InterceptedDataFactoryStub{
+		CreateCalled: func(buff []byte) (data process.InterceptedData, e error) {
+			return &testscommon.InterceptedDataStub{}, nil
+		},
+	}
+	arg.Processor = createMockInterceptorStub(nil, nil)
+	arg.WhiteListRequest = &testscommon.WhiteListHandlerStub{
+		IsWhiteListedCalled: func(interceptedData process.InterceptedData) bool {
+			return true
+		},
+	}
+	sdi, _ := interceptors.NewSingleDataInterceptor(arg)
+
+	numResponses := 0
+	err := sdi.SetPeersResponseTracker(&testscommon.PeersResponseTrackerStub{
+		ResponseReceivedCalled: func(pid core.PeerID, topic string, isRequested bool) {
+			assert.Equal(t, fromConnectedPeerId, pid)
+			assert.Equal(t, arg.Topic, topic)
+			assert.True(t, isRequested)
+			numResponses++
+		},
+	})
+	assert.Nil(t, err)
+
+	msg := &mock.P2PMessageMock{
+		DataField: []byte("data to be processed"),
+	}
+	err = sdi.ProcessReceivedMessage(msg, fromConnectedPeerId)
+
+	assert.Nil(t, err)
+	assert.Equal(t, 1, numResponses)
+}
+
 func TestSingleDataInterceptor_Close(t *testing.T) {
 	t.Parallel()
 
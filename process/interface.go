@@ -479,6 +479,35 @@ type PendingMiniBlocksHandler interface {
 	IsInterfaceNil() bool
 }
 
+// StateOverridesHandler defines the operations of an accounts wrapper able to temporarily override the state of some
+// accounts, without writing the original accounts
+type StateOverridesHandler interface {
+	StartSession()
+	ApplyStateOverrides(overrides common.StateOverrides) error
+	EndSession()
+	IsInterfaceNil() bool
+}
+
+// HistoricalStateHandler is able to redirect, for a while, all the state reads towards the state of a past block
+type HistoricalStateHandler interface {
+	StartHistoricalSession(options common.RootHashHolder) error
+	EndHistoricalSession()
+	IsInterfaceNil() bool
+}
+
+// BlockRootHashResolver is able to fetch a past block header, together with the root hash of the state it produced
+type BlockRootHashResolver interface {
+	GetBlockHeaderAndRootHashByNonce(nonce uint64) (data.HeaderHandler, []byte, error)
+	GetBlockHeaderAndRootHashByHash(hash []byte) (data.HeaderHandler, []byte, error)
+	IsInterfaceNil() bool
+}
+
+// CallTraceBuilder is able to build the structured call trace of a smart contract execution
+type CallTraceBuilder interface {
+	Build(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *common.CallTrace
+	IsInterfaceNil() bool
+}
+
 // BlockChainHookHandler defines the actions which should be performed by implementation
 type BlockChainHookHandler interface {
 	GetCode(account vmcommon.UserAccountHandler) []byte
@@ -529,6 +558,8 @@ type BlockChainHookHandler interface {
 type Interceptor interface {
 	ProcessReceivedMessage(message p2p.MessageP2P, fromConnectedPeer core.PeerID) error
 	SetInterceptedDebugHandler(handler InterceptedDebugger) error
+	SetPeerReputationHandler(handler PeerReputationHandler) error
+	SetPeersResponseTracker(tracker PeersResponseTracker) error
 	RegisterHandler(handler func(topic string, hash []byte, data interface{}))
 	Close() error
 	IsInterfaceNil() bool
@@ -761,6 +792,11 @@ type SCQuery struct {
 	Arguments      [][]byte
 	SameScState    bool
 	ShouldBeSynced bool
+	StateOverrides common.StateOverrides
+	WithTrace      bool
+	BlockNonce     core.OptionalUint64
+	BlockHash      []byte
+	BlockRootHash  []byte
 }
 
 // GasHandler is able to perform some gas calculation
@@ -899,9 +935,37 @@ type PeerValidatorMapper interface {
 	IsInterfaceNil() bool
 }
 
+// PeerReputationHandler defines the behavior of a component able to keep a unified reputation score for each peer,
+// combining the antiflood violations, the invalid intercepted data, the responses to the node's requests and
+// the consensus honesty
+type PeerReputationHandler interface {
+	RecordAntifloodViolation(pid core.PeerID)
+	RecordInvalidData(pid core.PeerID, topic string)
+	RecordRequestSent(pid core.PeerID, topic string)
+	RecordReceivedData(pid core.PeerID, topic string, isUseful bool)
+	GetPeersByReputation(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID
+	GetPeerReputation(pid core.PeerID) *common.PeerReputation
+	SetPeerValidatorMapper(validatorMapper PeerValidatorMapper) error
+	Close() error
+	IsInterfaceNil() bool
+}
+
+// PeersResponseTracker defines the behavior of a component able to track the responses received from the peers
+// on the requests sent by the node
+type PeersResponseTracker interface {
+	ResponseReceived(pid core.PeerID, topic string, isRequested bool)
+	IsInterfaceNil() bool
+}
+
+// PeerHonestyScoreProvider is able to provide the consensus honesty score of a public key
+type PeerHonestyScoreProvider interface {
+	GetScore(pk string) float64
+	IsInterfaceNil() bool
+}
+
 // SCQueryService defines how data should be get from a SC account
 type SCQueryService interface {
-	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQuery(query *SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
 	ComputeScCallGasLimit(tx *transaction.Transaction) (uint64, error)
 	Close() error
 	IsInterfaceNil() bool
@@ -2,23 +2,24 @@ package mock
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
 // ScQueryStub -
 type ScQueryStub struct {
-	ExecuteQueryCalled           func(query *process.SCQuery) (*vmcommon.VMOutput, error)
+	ExecuteQueryCalled           func(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error)
 	ComputeScCallGasLimitHandler func(tx *transaction.Transaction) (uint64, error)
 	CloseCalled                  func() error
 }
 
 // ExecuteQuery -
-func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+func (s *ScQueryStub) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	if s.ExecuteQueryCalled != nil {
 		return s.ExecuteQueryCalled(query)
 	}
-	return &vmcommon.VMOutput{}, nil
+	return &vmcommon.VMOutput{}, nil, nil
 }
 
 // ComputeScCallGasLimit -
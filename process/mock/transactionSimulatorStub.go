@@ -2,18 +2,29 @@ package mock
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 )
 
 // TransactionSimulatorStub -
 type TransactionSimulatorStub struct {
-	ProcessTxCalled func(tx *transaction.Transaction) (*txSimData.SimulationResults, error)
+	ProcessTxCalled     func(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error)
+	ProcessBundleCalled func(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error)
 }
 
 // ProcessTx -
-func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+func (tss *TransactionSimulatorStub) ProcessTx(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error) {
 	if tss.ProcessTxCalled != nil {
-		return tss.ProcessTxCalled(tx)
+		return tss.ProcessTxCalled(tx, stateOverrides, withTrace)
+	}
+
+	return nil, nil
+}
+
+// ProcessBundle -
+func (tss *TransactionSimulatorStub) ProcessBundle(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error) {
+	if tss.ProcessBundleCalled != nil {
+		return tss.ProcessBundleCalled(txs, withTrace)
 	}
 
 	return nil, nil
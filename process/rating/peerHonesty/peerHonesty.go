@@ -196,6 +196,33 @@ func (pph *p2pPeerHonesty) ChangeScore(pk string, topic string, units int) {
 	pph.checkBlacklistNoLock(ps)
 }
 
+// GetScore returns the lowest score of a public key across all topics, or 0 if the public key has no score
+func (pph *p2pPeerHonesty) GetScore(pk string) float64 {
+	pph.mut.RLock()
+	defer pph.mut.RUnlock()
+
+	psObj, ok := pph.cache.Peek([]byte(pk))
+	if !ok {
+		return 0
+	}
+
+	ps, ok := psObj.(*peerScore)
+	if !ok {
+		return 0
+	}
+
+	lowestScore := float64(0)
+	isFirstTopic := true
+	for _, score := range ps.scoresByTopic {
+		if isFirstTopic || score < lowestScore {
+			lowestScore = score
+			isFirstTopic = false
+		}
+	}
+
+	return lowestScore
+}
+
 func (pph *p2pPeerHonesty) getValidPeerScoreNoLock(pk string) *peerScore {
 	key := []byte(pk)
 
@@ -437,6 +437,26 @@ func TestP2pPeerHonesty_ApplyDecayWillEventuallyGoTheScoreToZero(t *testing.T) {
 	checkScore(t, pph, pk, topic, 0)
 }
 
+func TestP2pPeerHonesty_GetScore(t *testing.T) {
+	t.Parallel()
+
+	pph, _ := NewP2pPeerHonesty(
+		createMockPeerHonestyConfig(),
+		&testscommon.TimeCacheStub{},
+		testscommon.NewCacherMock(),
+	)
+
+	assert.Equal(t, float64(0), pph.GetScore("unknown pk"))
+
+	pph.Put("pkPositive", "topic1", 10)
+	pph.Put("pkPositive", "topic2", 5)
+	assert.Equal(t, float64(5), pph.GetScore("pkPositive"))
+
+	pph.Put("pkNegative", "topic1", 10)
+	pph.Put("pkNegative", "topic2", -20)
+	assert.Equal(t, float64(-20), pph.GetScore("pkNegative"))
+}
+
 func checkScore(t *testing.T, pph *p2pPeerHonesty, pk string, topic string, value float64) {
 	ps := pph.Get(pk)
 	assert.Equal(t, value, ps.scoresByTopic[topic])
@@ -0,0 +1,11 @@
+package peerReputation
+
+import "time"
+
+func (pr *peerReputation) SetTimeHandler(handler func() time.Time) {
+	pr.getTimeHandler = handler
+}
+
+func (pr *peerReputation) ApplyDecay() {
+	pr.applyDecay()
+}
@@ -0,0 +1,448 @@
+package peerReputation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/p2p"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/cache"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("process/rating/peerreputation")
+
+const peerScoreSize = 64 //this is an approximate size, used for fast computing
+const pendingRequestSize = 24
+const approximateZero = 0.00001
+
+const minDecayCoefficient = 0.0
+const maxDecayCoefficient = 1.0
+const minDecayIntervalInSeconds = uint32(1)
+
+// ArgPeerReputation is the argument structure used to create a new peer reputation instance
+type ArgPeerReputation struct {
+	Config               config.PeerReputationConfig
+	PeerBlacklistCacher  process.PeerBlackListCacher
+	HonestyScoreProvider process.PeerHonestyScoreProvider
+	SelfPid              core.PeerID
+}
+
+type peerScore struct {
+	score                  float64
+	numAntifloodViolations uint32
+	numInvalidData         uint32
+	numUsefulResponses     uint32
+	numUselessResponses    uint32
+	numLateResponses       uint32
+}
+
+type peerReputation struct {
+	mut                    sync.RWMutex
+	cache                  storage.Cacher
+	pendingRequests        storage.Cacher
+	mutPeerValidatorMapper sync.RWMutex
+	peerValidatorMapper    process.PeerValidatorMapper
+	peerBlacklistCacher    process.PeerBlackListCacher
+	honestyScoreProvider   process.PeerHonestyScoreProvider
+	selfPid                core.PeerID
+	decayCoefficient       float64
+	updateIntervalForDecay time.Duration
+	maxScore               float64
+	minScore               float64
+	badPeerThreshold       float64
+	blacklistThreshold     float64
+	blacklistDuration      time.Duration
+	responseTimeout        time.Duration
+	honestyWeight          float64
+	scores                 config.PeerReputationScoresConfig
+	getTimeHandler         func() time.Time
+	cancelFunc             func()
+}
+
+// NewPeerReputation creates a new peer reputation instance, able to keep a unified reputation score for each peer.
+// The score drives the selection of the peers the data is requested from and the blacklisting of the peers
+func NewPeerReputation(args ArgPeerReputation) (*peerReputation, error) {
+	err := checkArgs(args)
+	if err != nil {
+		return nil, fmt.Errorf("%w while creating an instance of peerReputation", err)
+	}
+
+	scoresCache, err := cache.NewLRUCache(args.Config.CacheCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for CacheCapacity while creating an instance of peerReputation", err)
+	}
+	pendingRequests, err := cache.NewLRUCache(args.Config.PendingRequestsCacheCapacity)
+	if err != nil {
+		return nil, fmt.Errorf("%w for PendingRequestsCacheCapacity while creating an instance of peerReputation", err)
+	}
+
+	pr := &peerReputation{
+		cache:                  scoresCache,
+		pendingRequests:        pendingRequests,
+		peerValidatorMapper:    &disabled.PeerValidatorMapper{},
+		peerBlacklistCacher:    args.PeerBlacklistCacher,
+		honestyScoreProvider:   args.HonestyScoreProvider,
+		selfPid:                args.SelfPid,
+		decayCoefficient:       args.Config.DecayCoefficient,
+		updateIntervalForDecay: time.Duration(args.Config.DecayUpdateIntervalInSeconds) * time.Second,
+		maxScore:               args.Config.MaxScore,
+		minScore:               args.Config.MinScore,
+		badPeerThreshold:       args.Config.BadPeerThreshold,
+		blacklistThreshold:     args.Config.BlacklistThreshold,
+		blacklistDuration:      time.Duration(args.Config.BlacklistDurationInSeconds) * time.Second,
+		responseTimeout:        time.Duration(args.Config.ResponseTimeoutInMilliseconds) * time.Millisecond,
+		honestyWeight:          args.Config.HonestyWeight,
+		scores:                 args.Config.Scores,
+		getTimeHandler:         time.Now,
+	}
+
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	pr.cancelFunc = cancelFunc
+
+	go pr.executeDecayContinuously(ctx)
+
+	return pr, nil
+}
+
+func checkArgs(args ArgPeerReputation) error {
+	if check.IfNil(args.PeerBlacklistCacher) {
+		return process.ErrNilBlackListCacher
+	}
+	if check.IfNil(args.HonestyScoreProvider) {
+		return process.ErrNilPeerHonestyScoreProvider
+	}
+
+	cfg := args.Config
+	isDecayCoefficientOk := cfg.DecayCoefficient > minDecayCoefficient && cfg.DecayCoefficient < maxDecayCoefficient
+	if !isDecayCoefficientOk {
+		return fmt.Errorf("%w, decay coefficient should be in interval (%.2f, %.2f)",
+			process.ErrInvalidDecayCoefficient,
+			minDecayCoefficient,
+			maxDecayCoefficient,
+		)
+	}
+	if cfg.DecayUpdateIntervalInSeconds < minDecayIntervalInSeconds {
+		return fmt.Errorf("%w, decay interval in seconds should be greater or equal to %d",
+			process.ErrInvalidDecayIntervalInSeconds,
+			minDecayIntervalInSeconds,
+		)
+	}
+	if cfg.MinScore > 0 {
+		return fmt.Errorf("%w, MinScore value should be negative or zero", process.ErrInvalidMinScore)
+	}
+	if cfg.MaxScore < 0 {
+		return fmt.Errorf("%w, MaxScore value should be positive or zero", process.ErrInvalidMaxScore)
+	}
+	isBadPeerThresholdOk := cfg.BadPeerThreshold <= 0 && cfg.MinScore < cfg.BadPeerThreshold
+	if !isBadPeerThresholdOk {
+		return fmt.Errorf("%w, BadPeerThreshold value should be in interval (MinScore, 0]",
+			process.ErrInvalidBadPeerThreshold,
+		)
+	}
+	isBlacklistThresholdOk := cfg.BlacklistThreshold <= cfg.BadPeerThreshold && cfg.MinScore < cfg.BlacklistThreshold
+	if !isBlacklistThresholdOk {
+		return fmt.Errorf("%w for BlacklistThreshold, the value should be in interval (MinScore, BadPeerThreshold]",
+			process.ErrInvalidValue,
+		)
+	}
+	if cfg.BlacklistDurationInSeconds == 0 {
+		return fmt.Errorf("%w for BlacklistDurationInSeconds, the value should be greater than 0", process.ErrInvalidValue)
+	}
+	if cfg.ResponseTimeoutInMilliseconds == 0 {
+		return fmt.Errorf("%w for ResponseTimeoutInMilliseconds, the value should be greater than 0", process.ErrInvalidValue)
+	}
+	if cfg.HonestyWeight < 0 {
+		return fmt.Errorf("%w for HonestyWeight, the value should be positive or zero", process.ErrInvalidValue)
+	}
+
+	return nil
+}
+
+func (pr *peerReputation) executeDecayContinuously(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(pr.updateIntervalForDecay):
+			pr.applyDecay()
+		case <-ctx.Done():
+			log.Debug("closing peerReputation.executeDecayContinuously go routine")
+			return
+		}
+	}
+}
+
+func (pr *peerReputation) applyDecay() {
+	pr.mut.Lock()
+	defer pr.mut.Unlock()
+
+	for _, key := range pr.cache.Keys() {
+		ps, ok := pr.getPeerScoreNoLock(key)
+		if !ok {
+			continue
+		}
+
+		ps.score = ps.score * pr.decayCoefficient
+		if check.IsZeroFloat64(ps.score, approximateZero) {
+			ps.score = 0
+		}
+	}
+}
+
+// RecordAntifloodViolation records a message of the peer rejected by the antiflood components
+func (pr *peerReputation) RecordAntifloodViolation(pid core.PeerID) {
+	if pid == pr.selfPid {
+		return
+	}
+
+	pr.mut.Lock()
+	ps := pr.getOrCreatePeerScoreNoLock(pid)
+	ps.numAntifloodViolations++
+	pr.changeScoreNoLock(ps, pr.scores.AntifloodViolation)
+	pr.mut.Unlock()
+
+	pr.checkBlacklist(pid)
+}
+
+// RecordInvalidData records invalid data received from the peer on the provided topic. If the data was a response
+// to one of the node's requests, the request is considered answered
+func (pr *peerReputation) RecordInvalidData(pid core.PeerID, topic string) {
+	if pid == pr.selfPid {
+		return
+	}
+
+	pr.pendingRequests.Remove(pendingRequestKey(pid, topic))
+
+	pr.mut.Lock()
+	ps := pr.getOrCreatePeerScoreNoLock(pid)
+	ps.numInvalidData++
+	pr.changeScoreNoLock(ps, pr.scores.InvalidData)
+	pr.mut.Unlock()
+
+	pr.checkBlacklist(pid)
+}
+
+// RecordRequestSent records a request sent to the peer, so that the data received from the peer on the same topic
+// is considered the response. Only the oldest request not yet answered is kept for each peer and topic
+func (pr *peerReputation) RecordRequestSent(pid core.PeerID, topic string) {
+	pr.pendingRequests.HasOrAdd(pendingRequestKey(pid, topic), pr.getTimeHandler(), pendingRequestSize)
+}
+
+// RecordReceivedData records valid data received from the peer on the provided topic. It is taken into account only
+// if a request was sent to the peer on the same topic: the response is late if it came after the response timeout,
+// otherwise it is useful if the node requested the received data and useless if not
+func (pr *peerReputation) RecordReceivedData(pid core.PeerID, topic string, isUseful bool) {
+	key := pendingRequestKey(pid, topic)
+	val, ok := pr.pendingRequests.Peek(key)
+	if !ok {
+		return
+	}
+	pr.pendingRequests.Remove(key)
+
+	sentTime, ok := val.(time.Time)
+	if !ok {
+		return
+	}
+
+	isLate := pr.getTimeHandler().Sub(sentTime) > pr.responseTimeout
+
+	pr.mut.Lock()
+	ps := pr.getOrCreatePeerScoreNoLock(pid)
+	change := pr.scores.UselessResponse
+	switch {
+	case isLate:
+		ps.numLateResponses++
+		change = pr.scores.LateResponse
+	case isUseful:
+		ps.numUsefulResponses++
+		change = pr.scores.UsefulResponse
+	default:
+		ps.numUselessResponses++
+	}
+	pr.changeScoreNoLock(ps, change)
+	pr.mut.Unlock()
+
+	if change < 0 {
+		pr.checkBlacklist(pid)
+	}
+}
+
+func pendingRequestKey(pid core.PeerID, topic string) []byte {
+	return []byte(string(pid) + topic)
+}
+
+// GetPeersByReputation returns the peers from the provided list which are not bad peers. If there are less than
+// the expected minimum number of peers, the bad peers with the highest scores are added as well
+func (pr *peerReputation) GetPeersByReputation(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID {
+	goodPeers := make([]core.PeerID, 0, len(peers))
+	badPeers := make([]core.PeerID, 0)
+	badPeersScores := make(map[core.PeerID]float64)
+	for _, pid := range peers {
+		score, _ := pr.computeScore(pid)
+		if score >= pr.badPeerThreshold {
+			goodPeers = append(goodPeers, pid)
+			continue
+		}
+
+		badPeers = append(badPeers, pid)
+		badPeersScores[pid] = score
+	}
+
+	if len(goodPeers) >= minNumOfPeersExpected || len(badPeers) == 0 {
+		return goodPeers
+	}
+
+	sort.SliceStable(badPeers, func(i, j int) bool {
+		return badPeersScores[badPeers[i]] > badPeersScores[badPeers[j]]
+	})
+
+	numBadPeersToAdd := minNumOfPeersExpected - len(goodPeers)
+	if numBadPeersToAdd > len(badPeers) {
+		numBadPeersToAdd = len(badPeers)
+	}
+
+	return append(goodPeers, badPeers[:numBadPeersToAdd]...)
+}
+
+// GetPeerReputation returns the reputation of the provided peer
+func (pr *peerReputation) GetPeerReputation(pid core.PeerID) *common.PeerReputation {
+	score, honestyScore := pr.computeScore(pid)
+	reputation := &common.PeerReputation{
+		Score:        score,
+		HonestyScore: honestyScore,
+		IsBadPeer:    score < pr.badPeerThreshold,
+	}
+
+	pr.mut.RLock()
+	defer pr.mut.RUnlock()
+
+	ps, ok := pr.getPeerScoreNoLock(pid.Bytes())
+	if !ok {
+		return reputation
+	}
+
+	reputation.NumAntifloodViolations = ps.numAntifloodViolations
+	reputation.NumInvalidData = ps.numInvalidData
+	reputation.NumUsefulResponses = ps.numUsefulResponses
+	reputation.NumUselessResponses = ps.numUselessResponses
+	reputation.NumLateResponses = ps.numLateResponses
+
+	return reputation
+}
+
+// SetPeerValidatorMapper sets the peer validator mapper, used to find the public key of a peer
+func (pr *peerReputation) SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error {
+	if check.IfNil(validatorMapper) {
+		return process.ErrNilPeerValidatorMapper
+	}
+
+	pr.mutPeerValidatorMapper.Lock()
+	pr.peerValidatorMapper = validatorMapper
+	pr.mutPeerValidatorMapper.Unlock()
+
+	return nil
+}
+
+// computeScore returns the score of the peer, including its weighted honesty score, and the honesty score
+func (pr *peerReputation) computeScore(pid core.PeerID) (float64, float64) {
+	honestyScore := pr.getHonestyScore(pid)
+
+	pr.mut.RLock()
+	defer pr.mut.RUnlock()
+
+	score := float64(0)
+	ps, ok := pr.getPeerScoreNoLock(pid.Bytes())
+	if ok {
+		score = ps.score
+	}
+
+	return score + pr.honestyWeight*honestyScore, honestyScore
+}
+
+func (pr *peerReputation) getHonestyScore(pid core.PeerID) float64 {
+	pr.mutPeerValidatorMapper.RLock()
+	peerInfo := pr.peerValidatorMapper.GetPeerInfo(pid)
+	pr.mutPeerValidatorMapper.RUnlock()
+
+	if len(peerInfo.PkBytes) == 0 {
+		return 0
+	}
+
+	return pr.honestyScoreProvider.GetScore(string(peerInfo.PkBytes))
+}
+
+func (pr *peerReputation) checkBlacklist(pid core.PeerID) {
+	score, _ := pr.computeScore(pid)
+	if score >= pr.blacklistThreshold {
+		return
+	}
+	if pr.peerBlacklistCacher.Has(pid) {
+		return
+	}
+
+	log.Debug("peerReputation.checkBlacklist: added blacklisted peer",
+		"pid", p2p.PeerIdToShortString(pid),
+		"score", fmt.Sprintf("%.2f", score),
+		"duration", pr.blacklistDuration,
+	)
+
+	err := pr.peerBlacklistCacher.Upsert(pid, pr.blacklistDuration)
+	if err != nil {
+		log.Warn("peerReputation.checkBlacklist",
+			"pid", p2p.PeerIdToShortString(pid),
+			"error", err,
+		)
+	}
+}
+
+func (pr *peerReputation) changeScoreNoLock(ps *peerScore, change float64) {
+	ps.score += change
+	if ps.score > pr.maxScore {
+		ps.score = pr.maxScore
+	}
+	if ps.score < pr.minScore {
+		ps.score = pr.minScore
+	}
+}
+
+func (pr *peerReputation) getPeerScoreNoLock(key []byte) (*peerScore, bool) {
+	val, ok := pr.cache.Peek(key)
+	if !ok {
+		return nil, false
+	}
+
+	ps, ok := val.(*peerScore)
+
+	return ps, ok
+}
+
+func (pr *peerReputation) getOrCreatePeerScoreNoLock(pid core.PeerID) *peerScore {
+	ps, ok := pr.getPeerScoreNoLock(pid.Bytes())
+	if ok {
+		return ps
+	}
+
+	ps = &peerScore{}
+	pr.cache.Put(pid.Bytes(), ps, peerScoreSize)
+
+	return ps
+}
+
+// Close closes the running go routines related to this instance
+func (pr *peerReputation) Close() error {
+	pr.cancelFunc()
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pr *peerReputation) IsInterfaceNil() bool {
+	return pr == nil
+}
@@ -0,0 +1,303 @@
+package peerReputation
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTopic = "topic"
+
+func createMockArgPeerReputation() ArgPeerReputation {
+	return ArgPeerReputation{
+		Config: config.PeerReputationConfig{
+			Enabled:                       true,
+			CacheCapacity:                 100,
+			PendingRequestsCacheCapacity:  100,
+			DecayCoefficient:              0.5,
+			DecayUpdateIntervalInSeconds:  10,
+			MaxScore:                      100,
+			MinScore:                      -100,
+			BadPeerThreshold:              -20,
+			BlacklistThreshold:            -80,
+			BlacklistDurationInSeconds:    60,
+			ResponseTimeoutInMilliseconds: 1000,
+			HonestyWeight:                 0.5,
+			Scores: config.PeerReputationScoresConfig{
+				AntifloodViolation: -1,
+				InvalidData:        -10,
+				UsefulResponse:     1,
+				UselessResponse:    -2,
+				LateResponse:       -3,
+			},
+		},
+		PeerBlacklistCacher:  &mock.PeerBlackListHandlerStub{},
+		HonestyScoreProvider: &testscommon.PeerHonestyHandlerStub{},
+		SelfPid:              "self",
+	}
+}
+
+func createPeerReputation(t *testing.T, args ArgPeerReputation) *peerReputation {
+	pr, err := NewPeerReputation(args)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		_ = pr.Close()
+	})
+
+	return pr
+}
+
+func TestNewPeerReputation(t *testing.T) {
+	t.Parallel()
+
+	testInvalidArgs := func(modifyArgs func(args *ArgPeerReputation), expectedErr error) func(t *testing.T) {
+		return func(t *testing.T) {
+			t.Parallel()
+
+			args := createMockArgPeerReputation()
+			modifyArgs(&args)
+			pr, err := NewPeerReputation(args)
+			assert.True(t, check.IfNil(pr))
+			assert.True(t, errors.Is(err, expectedErr))
+		}
+	}
+
+	t.Run("nil peer blacklist cacher should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.PeerBlacklistCacher = nil
+	}, process.ErrNilBlackListCacher))
+	t.Run("nil honesty score provider should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.HonestyScoreProvider = nil
+	}, process.ErrNilPeerHonestyScoreProvider))
+	t.Run("invalid decay coefficient should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.DecayCoefficient = 1
+	}, process.ErrInvalidDecayCoefficient))
+	t.Run("invalid decay interval should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.DecayUpdateIntervalInSeconds = 0
+	}, process.ErrInvalidDecayIntervalInSeconds))
+	t.Run("invalid min score should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.MinScore = 1
+	}, process.ErrInvalidMinScore))
+	t.Run("invalid max score should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.MaxScore = -1
+	}, process.ErrInvalidMaxScore))
+	t.Run("invalid bad peer threshold should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.BadPeerThreshold = args.Config.MinScore
+	}, process.ErrInvalidBadPeerThreshold))
+	t.Run("blacklist threshold above bad peer threshold should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.BlacklistThreshold = args.Config.BadPeerThreshold + 1
+	}, process.ErrInvalidValue))
+	t.Run("invalid blacklist duration should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.BlacklistDurationInSeconds = 0
+	}, process.ErrInvalidValue))
+	t.Run("invalid response timeout should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.ResponseTimeoutInMilliseconds = 0
+	}, process.ErrInvalidValue))
+	t.Run("negative honesty weight should error", testInvalidArgs(func(args *ArgPeerReputation) {
+		args.Config.HonestyWeight = -0.1
+	}, process.ErrInvalidValue))
+	t.Run("invalid cache capacity should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgPeerReputation()
+		args.Config.CacheCapacity = 0
+		pr, err := NewPeerReputation(args)
+		assert.True(t, check.IfNil(pr))
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		pr, err := NewPeerReputation(createMockArgPeerReputation())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(pr))
+		assert.Nil(t, pr.Close())
+	})
+}
+
+func TestPeerReputation_RecordEvents(t *testing.T) {
+	t.Parallel()
+
+	pr := createPeerReputation(t, createMockArgPeerReputation())
+	currentTime := time.Unix(1000, 0)
+	pr.SetTimeHandler(func() time.Time {
+		return currentTime
+	})
+
+	pr.RecordAntifloodViolation("pid")
+	pr.RecordInvalidData("pid", testTopic)
+	pr.RecordReceivedData("pid", testTopic, true) // no request sent, ignored
+
+	pr.RecordRequestSent("pid", testTopic)
+	pr.RecordReceivedData("pid", testTopic, true)
+	pr.RecordRequestSent("pid", testTopic)
+	pr.RecordReceivedData("pid", testTopic, false)
+	pr.RecordRequestSent("pid", testTopic)
+	currentTime = currentTime.Add(2 * time.Second)
+	pr.RecordReceivedData("pid", testTopic, true)
+
+	pr.RecordAntifloodViolation("self")
+
+	expected := &common.PeerReputation{
+		Score:                  -1 - 10 + 1 - 2 - 3,
+		IsBadPeer:              false,
+		NumAntifloodViolations: 1,
+		NumInvalidData:         1,
+		NumUsefulResponses:     1,
+		NumUselessResponses:    1,
+		NumLateResponses:       1,
+	}
+	assert.Equal(t, expected, pr.GetPeerReputation("pid"))
+	assert.Equal(t, &common.PeerReputation{}, pr.GetPeerReputation("self"))
+	assert.Equal(t, &common.PeerReputation{}, pr.GetPeerReputation("unknown"))
+}
+
+func TestPeerReputation_ScoreLimitsAndDecay(t *testing.T) {
+	t.Parallel()
+
+	pr := createPeerReputation(t, createMockArgPeerReputation())
+	for i := 0; i < 20; i++ {
+		pr.RecordInvalidData("pid", testTopic)
+	}
+	assert.Equal(t, float64(-100), pr.GetPeerReputation("pid").Score)
+	assert.True(t, pr.GetPeerReputation("pid").IsBadPeer)
+
+	pr.ApplyDecay()
+	assert.Equal(t, float64(-50), pr.GetPeerReputation("pid").Score)
+	pr.ApplyDecay()
+	assert.Equal(t, float64(-25), pr.GetPeerReputation("pid").Score)
+	pr.ApplyDecay()
+	assert.Equal(t, -12.5, pr.GetPeerReputation("pid").Score)
+	assert.False(t, pr.GetPeerReputation("pid").IsBadPeer)
+	assert.Equal(t, uint32(20), pr.GetPeerReputation("pid").NumInvalidData)
+}
+
+func TestPeerReputation_HonestyScore(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgPeerReputation()
+	args.HonestyScoreProvider = &testscommon.PeerHonestyHandlerStub{
+		GetScoreCalled: func(pk string) float64 {
+			if pk == "pk" {
+				return -60
+			}
+			return 0
+		},
+	}
+	pr := createPeerReputation(t, args)
+	pr.RecordInvalidData("pid", testTopic)
+
+	// the public key of the peer is not known yet
+	assert.Equal(t, float64(-10), pr.GetPeerReputation("pid").Score)
+
+	err := pr.SetPeerValidatorMapper(nil)
+	assert.Equal(t, process.ErrNilPeerValidatorMapper, err)
+	err = pr.SetPeerValidatorMapper(&mock.PeerShardMapperStub{
+		GetPeerInfoCalled: func(pid core.PeerID) core.P2PPeerInfo {
+			return core.P2PPeerInfo{PkBytes: []byte("pk")}
+		},
+	})
+	assert.Nil(t, err)
+
+	reputation := pr.GetPeerReputation("pid")
+	assert.Equal(t, float64(-10-30), reputation.Score)
+	assert.Equal(t, float64(-60), reputation.HonestyScore)
+	assert.True(t, reputation.IsBadPeer)
+}
+
+func TestPeerReputation_Blacklist(t *testing.T) {
+	t.Parallel()
+
+	mutBlacklisted := sync.Mutex{}
+	blacklisted := make(map[core.PeerID]time.Duration)
+	args := createMockArgPeerReputation()
+	args.PeerBlacklistCacher = &mock.PeerBlackListHandlerStub{
+		UpsertCalled: func(pid core.PeerID, span time.Duration) error {
+			mutBlacklisted.Lock()
+			blacklisted[pid] = span
+			mutBlacklisted.Unlock()
+			return nil
+		},
+		HasCalled: func(pid core.PeerID) bool {
+			mutBlacklisted.Lock()
+			defer mutBlacklisted.Unlock()
+
+			_, ok := blacklisted[pid]
+			return ok
+		},
+	}
+	pr := createPeerReputation(t, args)
+
+	for i := 0; i < 8; i++ {
+		pr.RecordInvalidData("pid", testTopic)
+	}
+	assert.Empty(t, blacklisted)
+
+	pr.RecordAntifloodViolation("pid")
+	assert.Equal(t, map[core.PeerID]time.Duration{"pid": time.Minute}, blacklisted)
+}
+
+func TestPeerReputation_GetPeersByReputation(t *testing.T) {
+	t.Parallel()
+
+	pr := createPeerReputation(t, createMockArgPeerReputation())
+	for i := 0; i < 3; i++ {
+		pr.RecordInvalidData("bad1", testTopic)
+	}
+	for i := 0; i < 5; i++ {
+		pr.RecordInvalidData("bad2", testTopic)
+	}
+	pr.RecordInvalidData("good1", testTopic)
+
+	peers := []core.PeerID{"bad2", "good1", "bad1", "good2"}
+	assert.Equal(t, []core.PeerID{"good1", "good2"}, pr.GetPeersByReputation(peers, 1))
+	assert.Equal(t, []core.PeerID{"good1", "good2"}, pr.GetPeersByReputation(peers, 2))
+	assert.Equal(t, []core.PeerID{"good1", "good2", "bad1"}, pr.GetPeersByReputation(peers, 3))
+	assert.Equal(t, []core.PeerID{"good1", "good2", "bad1", "bad2"}, pr.GetPeersByReputation(peers, 10))
+	assert.Empty(t, pr.GetPeersByReputation(nil, 2))
+}
+
+func TestPeerReputation_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	pr := createPeerReputation(t, createMockArgPeerReputation())
+	numOperations := 500
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			defer wg.Done()
+
+			switch idx % 8 {
+			case 0:
+				pr.RecordAntifloodViolation("pid")
+			case 1:
+				pr.RecordInvalidData("pid", testTopic)
+			case 2:
+				pr.RecordRequestSent("pid", testTopic)
+			case 3:
+				pr.RecordReceivedData("pid", testTopic, true)
+			case 4:
+				pr.GetPeersByReputation([]core.PeerID{"pid"}, 1)
+			case 5:
+				pr.GetPeerReputation("pid")
+			case 6:
+				pr.ApplyDecay()
+			case 7:
+				_ = pr.SetPeerValidatorMapper(&mock.PeerShardMapperStub{})
+			}
+		}(i)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,127 @@
+package smartContract
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/sharding"
+)
+
+var _ process.BlockRootHashResolver = (*blockRootHashResolver)(nil)
+
+// ArgsBlockRootHashResolver defines the arguments needed for the block root hash resolver
+type ArgsBlockRootHashResolver struct {
+	StorageService               dataRetriever.StorageService
+	Marshaller                   marshal.Marshalizer
+	Uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
+	ShardCoordinator             sharding.Coordinator
+	ScheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	HistoryRepository            HistoryRepository
+}
+
+type blockRootHashResolver struct {
+	storageService               dataRetriever.StorageService
+	marshaller                   marshal.Marshalizer
+	uint64ByteSliceConverter     typeConverters.Uint64ByteSliceConverter
+	shardCoordinator             sharding.Coordinator
+	scheduledTxsExecutionHandler process.ScheduledTxsExecutionHandler
+	historyRepository            HistoryRepository
+}
+
+// NewBlockRootHashResolver returns a new instance of blockRootHashResolver
+func NewBlockRootHashResolver(args ArgsBlockRootHashResolver) (*blockRootHashResolver, error) {
+	if check.IfNil(args.StorageService) {
+		return nil, process.ErrNilStore
+	}
+	if check.IfNil(args.Marshaller) {
+		return nil, process.ErrNilMarshalizer
+	}
+	if check.IfNil(args.Uint64ByteSliceConverter) {
+		return nil, process.ErrNilUint64Converter
+	}
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, process.ErrNilShardCoordinator
+	}
+	if check.IfNil(args.ScheduledTxsExecutionHandler) {
+		return nil, process.ErrNilScheduledTxsExecutionHandler
+	}
+	if check.IfNil(args.HistoryRepository) {
+		return nil, process.ErrNilHistoryRepository
+	}
+
+	return &blockRootHashResolver{
+		storageService:               args.StorageService,
+		marshaller:                   args.Marshaller,
+		uint64ByteSliceConverter:     args.Uint64ByteSliceConverter,
+		shardCoordinator:             args.ShardCoordinator,
+		scheduledTxsExecutionHandler: args.ScheduledTxsExecutionHandler,
+		historyRepository:            args.HistoryRepository,
+	}, nil
+}
+
+// GetBlockHeaderAndRootHashByNonce returns the self shard block header with the provided nonce, together with the
+// root hash of the state it produced
+func (resolver *blockRootHashResolver) GetBlockHeaderAndRootHashByNonce(nonce uint64) (data.HeaderHandler, []byte, error) {
+	hashByNonceUnit := dataRetriever.GetHdrNonceHashDataUnit(resolver.shardCoordinator.SelfId())
+	headerHash, err := process.GetHeaderHashFromStorageWithNonce(
+		nonce,
+		resolver.storageService,
+		resolver.uint64ByteSliceConverter,
+		resolver.marshaller,
+		hashByNonceUnit,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resolver.GetBlockHeaderAndRootHashByHash(headerHash)
+}
+
+// GetBlockHeaderAndRootHashByHash returns the self shard block header with the provided hash, together with the
+// root hash of the state it produced
+func (resolver *blockRootHashResolver) GetBlockHeaderAndRootHashByHash(hash []byte) (data.HeaderHandler, []byte, error) {
+	header, err := resolver.getBlockHeaderByHash(hash)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rootHash, err := resolver.scheduledTxsExecutionHandler.GetScheduledRootHashForHeaderWithEpoch(hash, header.GetEpoch())
+	if err != nil {
+		rootHash = header.GetRootHash()
+	}
+
+	return header, rootHash, nil
+}
+
+func (resolver *blockRootHashResolver) getBlockHeaderByHash(hash []byte) (data.HeaderHandler, error) {
+	shardID := resolver.shardCoordinator.SelfId()
+	storer, err := resolver.storageService.GetStorer(dataRetriever.GetHeadersDataUnit(shardID))
+	if err != nil {
+		return nil, err
+	}
+
+	var headerBuffer []byte
+	if resolver.historyRepository.IsEnabled() {
+		epoch, errGetEpoch := resolver.historyRepository.GetEpochByHash(hash)
+		if errGetEpoch != nil {
+			return nil, errGetEpoch
+		}
+
+		headerBuffer, err = storer.GetFromEpoch(hash, epoch)
+	} else {
+		headerBuffer, err = storer.Get(hash)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return process.UnmarshalHeader(shardID, resolver.marshaller, headerBuffer)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (resolver *blockRootHashResolver) IsInterfaceNil() bool {
+	return resolver == nil
+}
@@ -0,0 +1,267 @@
+package smartContract
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-core-go/data/typeConverters/uint64ByteSlice"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/dataRetriever"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/process/mock"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/dblookupext"
+	storageStubs "github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsBlockRootHashResolver() ArgsBlockRootHashResolver {
+	return ArgsBlockRootHashResolver{
+		StorageService:               &storageStubs.ChainStorerStub{},
+		Marshaller:                   &marshal.GogoProtoMarshalizer{},
+		Uint64ByteSliceConverter:     uint64ByteSlice.NewBigEndianConverter(),
+		ShardCoordinator:             mock.NewOneShardCoordinatorMock(),
+		ScheduledTxsExecutionHandler: &testscommon.ScheduledTxsExecutionStub{},
+		HistoryRepository: &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return false
+			},
+		},
+	}
+}
+
+func createStorageServiceWithHeader(
+	nonce uint64,
+	headerHash []byte,
+	headerBytes []byte,
+	getHeaderFromEpoch func(key []byte, epoch uint32) ([]byte, error),
+) dataRetriever.StorageService {
+	converter := uint64ByteSlice.NewBigEndianConverter()
+	return &storageStubs.ChainStorerStub{
+		GetStorerCalled: func(unitType dataRetriever.UnitType) (storage.Storer, error) {
+			switch unitType {
+			case dataRetriever.ShardHdrNonceHashDataUnit:
+				return &storageStubs.StorerStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						if string(key) == string(converter.ToByteSlice(nonce)) {
+							return headerHash, nil
+						}
+						return nil, errors.New("not found")
+					},
+				}, nil
+			case dataRetriever.BlockHeaderUnit:
+				return &storageStubs.StorerStub{
+					GetCalled: func(key []byte) ([]byte, error) {
+						if string(key) == string(headerHash) {
+							return headerBytes, nil
+						}
+						return nil, errors.New("not found")
+					},
+					GetFromEpochCalled: getHeaderFromEpoch,
+				}, nil
+			}
+
+			return nil, errors.New("unexpected unit")
+		},
+	}
+}
+
+func TestNewBlockRootHashResolver(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storage service should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = nil
+		resolver, err := NewBlockRootHashResolver(args)
+		assert.True(t, check.IfNil(resolver))
+		assert.Equal(t, process.ErrNilStore, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.Marshaller = nil
+		resolver, err := NewBlockRootHashResolver(args)
+		assert.True(t, check.IfNil(resolver))
+		assert.Equal(t, process.ErrNilMarshalizer, err)
+	})
+	t.Run("nil uint64 converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.Uint64ByteSliceConverter = nil
+		resolver, err := NewBlockRootHashResolver(args)
+		assert.True(t, check.IfNil(resolver))
+		assert.Equal(t, process.ErrNilUint64Converter, err)
+	})
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.ShardCoordinator = nil
+		resolver, err := NewBlockRootHashResolver(args)
+		assert.True(t, check.IfNil(resolver))
+		assert.Equal(t, process.ErrNilShardCoordinator, err)
+	})
+	t.Run("nil scheduled txs execution handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.ScheduledTxsExecutionHandler = nil
+		resolver, err := NewBlockRootHashResolver(args)
+		assert.True(t, check.IfNil(resolver))
+		assert.Equal(t, process.ErrNilScheduledTxsExecutionHandler, err)
+	})
+	t.Run("nil history repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.HistoryRepository = nil
+		resolver, err := NewBlockRootHashResolver(args)
+		assert.True(t, check.IfNil(resolver))
+		assert.Equal(t, process.ErrNilHistoryRepository, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		resolver, err := NewBlockRootHashResolver(createMockArgsBlockRootHashResolver())
+		assert.False(t, check.IfNil(resolver))
+		assert.Nil(t, err)
+	})
+}
+
+func TestBlockRootHashResolver_GetBlockHeaderAndRootHashByNonce(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	header := &block.HeaderV2{Header: &block.Header{Nonce: 7, Epoch: 2, RootHash: []byte("header root hash")}}
+	headerBytes, _ := marshaller.Marshal(header)
+	headerHash := []byte("header hash")
+
+	t.Run("missing nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = createStorageServiceWithHeader(7, headerHash, headerBytes, nil)
+		resolver, _ := NewBlockRootHashResolver(args)
+
+		recoveredHeader, rootHash, err := resolver.GetBlockHeaderAndRootHashByNonce(8)
+		assert.Equal(t, process.ErrMissingHashForHeaderNonce, err)
+		assert.Nil(t, recoveredHeader)
+		assert.Nil(t, rootHash)
+	})
+	t.Run("without scheduled root hash should return the header root hash", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = createStorageServiceWithHeader(7, headerHash, headerBytes, nil)
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(hash []byte, epoch uint32) ([]byte, error) {
+				assert.Equal(t, headerHash, hash)
+				assert.Equal(t, uint32(2), epoch)
+				return nil, errors.New("missing scheduled root hash")
+			},
+		}
+		resolver, _ := NewBlockRootHashResolver(args)
+
+		recoveredHeader, rootHash, err := resolver.GetBlockHeaderAndRootHashByNonce(7)
+		require.Nil(t, err)
+		assert.Equal(t, header, recoveredHeader)
+		assert.Equal(t, []byte("header root hash"), rootHash)
+	})
+	t.Run("with scheduled root hash should return it", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = createStorageServiceWithHeader(7, headerHash, headerBytes, nil)
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(hash []byte, epoch uint32) ([]byte, error) {
+				return []byte("scheduled root hash"), nil
+			},
+		}
+		resolver, _ := NewBlockRootHashResolver(args)
+
+		recoveredHeader, rootHash, err := resolver.GetBlockHeaderAndRootHashByNonce(7)
+		require.Nil(t, err)
+		assert.Equal(t, header, recoveredHeader)
+		assert.Equal(t, []byte("scheduled root hash"), rootHash)
+	})
+}
+
+func TestBlockRootHashResolver_GetBlockHeaderAndRootHashByHash(t *testing.T) {
+	t.Parallel()
+
+	marshaller := &marshal.GogoProtoMarshalizer{}
+	header := &block.HeaderV2{Header: &block.Header{Nonce: 7, Epoch: 2, RootHash: []byte("header root hash")}}
+	headerBytes, _ := marshaller.Marshal(header)
+	headerHash := []byte("header hash")
+
+	t.Run("missing header should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = createStorageServiceWithHeader(7, headerHash, headerBytes, nil)
+		resolver, _ := NewBlockRootHashResolver(args)
+
+		recoveredHeader, rootHash, err := resolver.GetBlockHeaderAndRootHashByHash([]byte("other hash"))
+		assert.NotNil(t, err)
+		assert.Nil(t, recoveredHeader)
+		assert.Nil(t, rootHash)
+	})
+	t.Run("history repository errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = createStorageServiceWithHeader(7, headerHash, headerBytes, nil)
+		args.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+				return 0, expectedErr
+			},
+		}
+		resolver, _ := NewBlockRootHashResolver(args)
+
+		recoveredHeader, rootHash, err := resolver.GetBlockHeaderAndRootHashByHash(headerHash)
+		assert.Equal(t, expectedErr, err)
+		assert.Nil(t, recoveredHeader)
+		assert.Nil(t, rootHash)
+	})
+	t.Run("with history repository enabled should search in the header epoch", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBlockRootHashResolver()
+		args.StorageService = createStorageServiceWithHeader(7, headerHash, headerBytes, func(key []byte, epoch uint32) ([]byte, error) {
+			assert.Equal(t, headerHash, key)
+			assert.Equal(t, uint32(2), epoch)
+			return headerBytes, nil
+		})
+		args.HistoryRepository = &dblookupext.HistoryRepositoryStub{
+			IsEnabledCalled: func() bool {
+				return true
+			},
+			GetEpochByHashCalled: func(hash []byte) (uint32, error) {
+				return 2, nil
+			},
+		}
+		args.ScheduledTxsExecutionHandler = &testscommon.ScheduledTxsExecutionStub{
+			GetScheduledRootHashForHeaderWithEpochCalled: func(hash []byte, epoch uint32) ([]byte, error) {
+				return nil, errors.New("missing scheduled root hash")
+			},
+		}
+		resolver, _ := NewBlockRootHashResolver(args)
+
+		recoveredHeader, rootHash, err := resolver.GetBlockHeaderAndRootHashByHash(headerHash)
+		require.Nil(t, err)
+		assert.Equal(t, header, recoveredHeader)
+		assert.Equal(t, []byte("header root hash"), rootHash)
+	})
+}
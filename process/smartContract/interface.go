@@ -0,0 +1,8 @@
+package smartContract
+
+// HistoryRepository defines the history repository operations needed when resolving past blocks
+type HistoryRepository interface {
+	GetEpochByHash(hash []byte) (uint32, error)
+	IsEnabled() bool
+	IsInterfaceNil() bool
+}
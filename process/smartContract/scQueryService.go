@@ -7,11 +7,13 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/process"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/multiversx/mx-chain-vm-common-go/parsers"
@@ -31,6 +33,10 @@ type SCQueryService struct {
 	wasmVMChangeLocker       common.Locker
 	bootstrapper             process.Bootstrapper
 	allowExternalQueriesChan chan struct{}
+	stateOverridesHandler    process.StateOverridesHandler
+	callTraceBuilder         process.CallTraceBuilder
+	historicalStateHandler   process.HistoricalStateHandler
+	blockRootHashResolver    process.BlockRootHashResolver
 }
 
 // ArgsNewSCQueryService defines the arguments needed for the sc query service
@@ -43,6 +49,13 @@ type ArgsNewSCQueryService struct {
 	Bootstrapper             process.Bootstrapper
 	AllowExternalQueriesChan chan struct{}
 	MaxGasLimitPerQuery      uint64
+	// StateOverridesHandler is optional. If not provided, the queries holding state overrides will be rejected
+	StateOverridesHandler process.StateOverridesHandler
+	// CallTraceBuilder is optional. If not provided, the queries requesting a call trace will be rejected
+	CallTraceBuilder process.CallTraceBuilder
+	// HistoricalStateHandler and BlockRootHashResolver are optional. If not provided, the queries on past blocks will be rejected
+	HistoricalStateHandler process.HistoricalStateHandler
+	BlockRootHashResolver  process.BlockRootHashResolver
 }
 
 // NewSCQueryService returns a new instance of SCQueryService
@@ -84,20 +97,32 @@ func NewSCQueryService(
 		bootstrapper:             args.Bootstrapper,
 		gasForQuery:              gasForQuery,
 		allowExternalQueriesChan: args.AllowExternalQueriesChan,
+		stateOverridesHandler:    args.StateOverridesHandler,
+		callTraceBuilder:         args.CallTraceBuilder,
+		historicalStateHandler:   args.HistoricalStateHandler,
+		blockRootHashResolver:    args.BlockRootHashResolver,
 	}, nil
 }
 
-// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract
-func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, error) {
+// ExecuteQuery returns the VMOutput resulted upon running the function on the smart contract. The call trace of the
+// execution is also returned if the query requested it
+func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	if !service.shouldAllowQueriesExecution() {
-		return nil, process.ErrQueriesNotAllowedYet
+		return nil, nil, process.ErrQueriesNotAllowedYet
 	}
 
 	if query.ScAddress == nil {
-		return nil, process.ErrNilScAddress
+		return nil, nil, process.ErrNilScAddress
 	}
 	if len(query.FuncName) == 0 {
-		return nil, process.ErrEmptyFunctionName
+		return nil, nil, process.ErrEmptyFunctionName
+	}
+	if query.WithTrace && check.IfNil(service.callTraceBuilder) {
+		return nil, nil, process.ErrCallTraceNotSupported
+	}
+	err := service.checkBlockCoordinates(query)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	service.mutRunSc.Lock()
@@ -106,6 +131,31 @@ func (service *SCQueryService) ExecuteQuery(query *process.SCQuery) (*vmcommon.V
 	return service.executeScCall(query, 0)
 }
 
+func (service *SCQueryService) checkBlockCoordinates(query *process.SCQuery) error {
+	numBlockCoordinates := 0
+	if query.BlockNonce.HasValue {
+		numBlockCoordinates++
+	}
+	if len(query.BlockHash) > 0 {
+		numBlockCoordinates++
+	}
+	if len(query.BlockRootHash) > 0 {
+		numBlockCoordinates++
+	}
+
+	if numBlockCoordinates == 0 {
+		return nil
+	}
+	if numBlockCoordinates > 1 {
+		return process.ErrTooManyBlockCoordinates
+	}
+	if check.IfNil(service.historicalStateHandler) || check.IfNil(service.blockRootHashResolver) {
+		return process.ErrHistoricalQueriesNotSupported
+	}
+
+	return nil
+}
+
 func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	select {
 	case <-service.allowExternalQueriesChan:
@@ -115,29 +165,62 @@ func (service *SCQueryService) shouldAllowQueriesExecution() bool {
 	}
 }
 
-func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, error) {
+func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice uint64) (*vmcommon.VMOutput, *common.CallTrace, error) {
 	log.Trace("executeScCall", "function", query.FuncName, "numQueries", service.numQueries)
 	service.numQueries++
 
 	shouldEarlyExitBecauseOfSyncState := query.ShouldBeSynced && service.bootstrapper.GetNodeState() == common.NsNotSynchronized
 	if shouldEarlyExitBecauseOfSyncState {
-		return nil, process.ErrNodeIsNotSynced
+		return nil, nil, process.ErrNodeIsNotSynced
 	}
 
-	shouldCheckRootHashChanges := query.SameScState
+	isHistoricalQuery := isHistoricalQuery(query)
+	shouldCheckRootHashChanges := query.SameScState && !isHistoricalQuery
 	rootHashBeforeExecution := make([]byte, 0)
 
 	if shouldCheckRootHashChanges {
 		rootHashBeforeExecution = service.blockChain.GetCurrentBlockRootHash()
 	}
 
-	service.blockChainHook.SetCurrentHeader(service.blockChain.GetCurrentBlockHeader())
+	blockHeader := service.blockChain.GetCurrentBlockHeader()
+	if isHistoricalQuery {
+		historicalHeader, rootHashHolder, err := service.getHistoricalState(query)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = service.historicalStateHandler.StartHistoricalSession(rootHashHolder)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer service.historicalStateHandler.EndHistoricalSession()
+
+		if !check.IfNil(historicalHeader) {
+			blockHeader = historicalHeader
+		}
+	}
+
+	service.blockChainHook.SetCurrentHeader(blockHeader)
+
+	if len(query.StateOverrides) > 0 {
+		if check.IfNil(service.stateOverridesHandler) {
+			return nil, nil, process.ErrStateOverridesNotSupported
+		}
+
+		service.stateOverridesHandler.StartSession()
+		defer service.stateOverridesHandler.EndSession()
+
+		err := service.stateOverridesHandler.ApplyStateOverrides(query.StateOverrides)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
 
 	service.wasmVMChangeLocker.RLock()
 	vm, err := findVMByScAddress(service.vmContainer, query.ScAddress)
 	if err != nil {
 		service.wasmVMChangeLocker.RUnlock()
-		return nil, err
+		return nil, nil, err
 	}
 
 	query = prepareScQuery(query)
@@ -145,7 +228,7 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 	vmOutput, err := vm.RunSmartContractCall(vmInput)
 	service.wasmVMChangeLocker.RUnlock()
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if service.hasRetriableExecutionError(vmOutput) {
@@ -153,18 +236,51 @@ func (service *SCQueryService) executeScCall(query *process.SCQuery, gasPrice ui
 
 		vmOutput, err = vm.RunSmartContractCall(vmInput)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	if query.SameScState {
+	if shouldCheckRootHashChanges {
 		err = service.checkForRootHashChanges(rootHashBeforeExecution)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 	}
 
-	return vmOutput, nil
+	if !query.WithTrace {
+		return vmOutput, nil, nil
+	}
+
+	return vmOutput, service.callTraceBuilder.Build(vmInput, vmOutput), nil
+}
+
+func isHistoricalQuery(query *process.SCQuery) bool {
+	return query.BlockNonce.HasValue || len(query.BlockHash) > 0 || len(query.BlockRootHash) > 0
+}
+
+// getHistoricalState returns the past block header, if known, together with the root hash holder of the state the
+// query should be run against
+func (service *SCQueryService) getHistoricalState(query *process.SCQuery) (data.HeaderHandler, common.RootHashHolder, error) {
+	if len(query.BlockRootHash) > 0 {
+		// the block header (and its epoch) cannot be inferred from the root hash alone
+		return nil, holders.NewRootHashHolder(query.BlockRootHash, core.OptionalUint32{}), nil
+	}
+
+	var header data.HeaderHandler
+	var rootHash []byte
+	var err error
+	if query.BlockNonce.HasValue {
+		header, rootHash, err = service.blockRootHashResolver.GetBlockHeaderAndRootHashByNonce(query.BlockNonce.Value)
+	} else {
+		header, rootHash, err = service.blockRootHashResolver.GetBlockHeaderAndRootHashByHash(query.BlockHash)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	epoch := core.OptionalUint32{Value: header.GetEpoch(), HasValue: true}
+
+	return header, holders.NewRootHashHolder(rootHash, epoch), nil
 }
 
 func (service *SCQueryService) checkForRootHashChanges(rootHashBefore []byte) error {
@@ -231,7 +347,7 @@ func (service *SCQueryService) ComputeScCallGasLimit(tx *transaction.Transaction
 	service.mutRunSc.Lock()
 	defer service.mutRunSc.Unlock()
 
-	vmOutput, err := service.executeScCall(query, 1)
+	vmOutput, _, err := service.executeScCall(query, 1)
 	if err != nil {
 		return 0, err
 	}
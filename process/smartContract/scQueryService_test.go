@@ -10,6 +10,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -18,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/economicsmocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -126,7 +128,7 @@ func TestExecuteQuery_GetNilAddressShouldErr(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	output, err := target.ExecuteQuery(&query)
+	output, _, err := target.ExecuteQuery(&query)
 
 	assert.Nil(t, output)
 	assert.Equal(t, process.ErrNilScAddress, err)
@@ -144,7 +146,7 @@ func TestExecuteQuery_EmptyFunctionShouldErr(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	output, err := target.ExecuteQuery(&query)
+	output, _, err := target.ExecuteQuery(&query)
 
 	assert.Nil(t, output)
 	assert.Equal(t, process.ErrEmptyFunctionName, err)
@@ -164,12 +166,12 @@ func TestExecuteQuery_ShouldPerformActionsInRegardsToAllowanceChannel(t *testing
 		Arguments: [][]byte{},
 	}
 
-	output, err := target.ExecuteQuery(&query)
+	output, _, err := target.ExecuteQuery(&query)
 	assert.Equal(t, process.ErrQueriesNotAllowedYet, err)
 	assert.Nil(t, output)
 
 	close(chanAllowedQueries)
-	_, err = target.ExecuteQuery(&query)
+	_, _, err = target.ExecuteQuery(&query)
 	assert.NoError(t, err)
 }
 
@@ -205,10 +207,10 @@ func TestExecuteQuery_AllowanceChannelShouldWorkUnderConcurrentRequests(t *testi
 		go func(idx int) {
 			select {
 			case <-chanAllowedQueries:
-				_, err := target.ExecuteQuery(&query)
+				_, _, err := target.ExecuteQuery(&query)
 				assert.NoError(t, err)
 			default:
-				output, err := target.ExecuteQuery(&query)
+				output, _, err := target.ExecuteQuery(&query)
 				assert.Equal(t, process.ErrQueriesNotAllowedYet, err)
 				assert.Nil(t, output)
 			}
@@ -264,7 +266,7 @@ func TestExecuteQuery_ShouldReceiveQueryCorrectly(t *testing.T) {
 		Arguments: dataArgs,
 	}
 
-	_, _ = target.ExecuteQuery(&query)
+	_, _, _ = target.ExecuteQuery(&query)
 	assert.True(t, runWasCalled)
 }
 
@@ -302,13 +304,390 @@ func TestExecuteQuery_ReturnsCorrectly(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	vmOutput, err := target.ExecuteQuery(&query)
+	vmOutput, _, err := target.ExecuteQuery(&query)
 
 	assert.Nil(t, err)
 	assert.Equal(t, d[0], vmOutput.ReturnData[0])
 	assert.Equal(t, d[1], vmOutput.ReturnData[1])
 }
 
+func TestExecuteQuery_WithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := common.StateOverrides{
+		"address": &common.AccountOverride{
+			Balance: big.NewInt(100),
+		},
+	}
+	createArgs := func(runCalled *bool) ArgsNewSCQueryService {
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						*runCalled = true
+						return &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}, nil
+					},
+				}, nil
+			},
+		}
+		args.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return uint64(math.MaxUint64)
+			},
+		}
+
+		return args
+	}
+	query := process.SCQuery{
+		ScAddress:      []byte(DummyScAddress),
+		FuncName:       "function",
+		StateOverrides: overrides,
+	}
+
+	t.Run("nil state overrides handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		runCalled := false
+		target, _ := NewSCQueryService(createArgs(&runCalled))
+
+		vmOutput, _, err := target.ExecuteQuery(&query)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, process.ErrStateOverridesNotSupported, err)
+		assert.False(t, runCalled)
+	})
+	t.Run("apply state overrides fails should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		endSessionCalled := false
+		runCalled := false
+		args := createArgs(&runCalled)
+		args.StateOverridesHandler = &stateMock.SimulationAccountsHandlerStub{
+			ApplyStateOverridesCalled: func(_ common.StateOverrides) error {
+				return expectedErr
+			},
+			EndSessionCalled: func() {
+				endSessionCalled = true
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&query)
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, expectedErr, err)
+		assert.False(t, runCalled)
+		assert.True(t, endSessionCalled)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		calls := make([]string, 0)
+		runCalled := false
+		args := createArgs(&runCalled)
+		args.StateOverridesHandler = &stateMock.SimulationAccountsHandlerStub{
+			StartSessionCalled: func() {
+				calls = append(calls, "start")
+			},
+			ApplyStateOverridesCalled: func(providedOverrides common.StateOverrides) error {
+				assert.Equal(t, overrides, providedOverrides)
+				calls = append(calls, "apply")
+				return nil
+			},
+			EndSessionCalled: func() {
+				calls = append(calls, "end")
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&query)
+		assert.Nil(t, err)
+		assert.NotNil(t, vmOutput)
+		assert.True(t, runCalled)
+		assert.Equal(t, []string{"start", "apply", "end"}, calls)
+	})
+}
+
+func TestExecuteQuery_WithTrace(t *testing.T) {
+	t.Parallel()
+
+	expectedVMOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	createArgs := func() ArgsNewSCQueryService {
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						return expectedVMOutput, nil
+					},
+				}, nil
+			},
+		}
+		args.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return uint64(math.MaxUint64)
+			},
+		}
+
+		return args
+	}
+
+	t.Run("nil call trace builder should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createArgs())
+
+		vmOutput, callTrace, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			WithTrace: true,
+		})
+		assert.Nil(t, vmOutput)
+		assert.Nil(t, callTrace)
+		assert.Equal(t, process.ErrCallTraceNotSupported, err)
+	})
+	t.Run("trace not requested should not build it", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.CallTraceBuilder = &testscommon.CallTraceBuilderStub{
+			BuildCalled: func(_ *vmcommon.ContractCallInput, _ *vmcommon.VMOutput) *common.CallTrace {
+				assert.Fail(t, "should have not built the trace")
+				return nil
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, callTrace, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+		assert.Nil(t, callTrace)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		expectedTrace := &common.CallTrace{GasUsed: 10}
+		args := createArgs()
+		args.CallTraceBuilder = &testscommon.CallTraceBuilderStub{
+			BuildCalled: func(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *common.CallTrace {
+				assert.Equal(t, []byte(DummyScAddress), input.RecipientAddr)
+				assert.Equal(t, "function", input.Function)
+				assert.Equal(t, expectedVMOutput, vmOutput)
+				return expectedTrace
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, callTrace, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			WithTrace: true,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+		assert.Equal(t, expectedTrace, callTrace)
+	})
+}
+
+func TestExecuteQuery_OnPastBlocks(t *testing.T) {
+	t.Parallel()
+
+	expectedVMOutput := &vmcommon.VMOutput{ReturnCode: vmcommon.Ok}
+	createArgs := func() ArgsNewSCQueryService {
+		args := createMockArgumentsForSCQuery()
+		args.VmContainer = &mock.VMContainerMock{
+			GetCalled: func(key []byte) (handler vmcommon.VMExecutionHandler, e error) {
+				return &mock.VMExecutionHandlerStub{
+					RunSmartContractCallCalled: func(input *vmcommon.ContractCallInput) (output *vmcommon.VMOutput, e error) {
+						return expectedVMOutput, nil
+					},
+				}, nil
+			},
+		}
+		args.EconomicsFee = &economicsmocks.EconomicsHandlerStub{
+			MaxGasLimitPerBlockCalled: func(_ uint32) uint64 {
+				return uint64(math.MaxUint64)
+			},
+		}
+		args.HistoricalStateHandler = &testscommon.HistoricalStateHandlerStub{}
+		args.BlockRootHashResolver = &testscommon.BlockRootHashResolverStub{}
+
+		return args
+	}
+
+	t.Run("more block coordinates should error", func(t *testing.T) {
+		t.Parallel()
+
+		target, _ := NewSCQueryService(createArgs())
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress:     []byte(DummyScAddress),
+			FuncName:      "function",
+			BlockNonce:    core.OptionalUint64{Value: 7, HasValue: true},
+			BlockRootHash: []byte("root hash"),
+		})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, process.ErrTooManyBlockCoordinates, err)
+	})
+	t.Run("nil historical state handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.HistoricalStateHandler = nil
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress:     []byte(DummyScAddress),
+			FuncName:      "function",
+			BlockRootHash: []byte("root hash"),
+		})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+	})
+	t.Run("nil block root hash resolver should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createArgs()
+		args.BlockRootHashResolver = nil
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockHash: []byte("block hash"),
+		})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, process.ErrHistoricalQueriesNotSupported, err)
+	})
+	t.Run("resolver errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.BlockRootHashResolver = &testscommon.BlockRootHashResolverStub{
+			GetBlockHeaderAndRootHashByHashCalled: func(hash []byte) (data.HeaderHandler, []byte, error) {
+				return nil, nil, expectedErr
+			},
+		}
+		args.HistoricalStateHandler = &testscommon.HistoricalStateHandlerStub{
+			StartHistoricalSessionCalled: func(_ common.RootHashHolder) error {
+				assert.Fail(t, "should have not started the historical session")
+				return nil
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress: []byte(DummyScAddress),
+			FuncName:  "function",
+			BlockHash: []byte("block hash"),
+		})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("start historical session errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createArgs()
+		args.HistoricalStateHandler = &testscommon.HistoricalStateHandlerStub{
+			StartHistoricalSessionCalled: func(_ common.RootHashHolder) error {
+				return expectedErr
+			},
+			EndHistoricalSessionCalled: func() {
+				assert.Fail(t, "should have not ended the historical session")
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress:     []byte(DummyScAddress),
+			FuncName:      "function",
+			BlockRootHash: []byte("root hash"),
+		})
+		assert.Nil(t, vmOutput)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("on root hash should work", func(t *testing.T) {
+		t.Parallel()
+
+		currentHeader := &block.Header{Nonce: 100}
+		calledMethods := make([]string, 0)
+		args := createArgs()
+		args.BlockChain = &testscommon.ChainHandlerStub{
+			GetCurrentBlockHeaderCalled: func() data.HeaderHandler {
+				return currentHeader
+			},
+		}
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				assert.Equal(t, currentHeader, hdr)
+				calledMethods = append(calledMethods, "SetCurrentHeader")
+			},
+		}
+		args.HistoricalStateHandler = &testscommon.HistoricalStateHandlerStub{
+			StartHistoricalSessionCalled: func(options common.RootHashHolder) error {
+				assert.Equal(t, []byte("root hash"), options.GetRootHash())
+				assert.False(t, options.GetEpoch().HasValue)
+				calledMethods = append(calledMethods, "StartHistoricalSession")
+				return nil
+			},
+			EndHistoricalSessionCalled: func() {
+				calledMethods = append(calledMethods, "EndHistoricalSession")
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress:     []byte(DummyScAddress),
+			FuncName:      "function",
+			BlockRootHash: []byte("root hash"),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+		assert.Equal(t, []string{"StartHistoricalSession", "SetCurrentHeader", "EndHistoricalSession"}, calledMethods)
+	})
+	t.Run("on block nonce should work", func(t *testing.T) {
+		t.Parallel()
+
+		historicalHeader := &block.Header{Nonce: 7, Epoch: 2}
+		sessionStarted := false
+		args := createArgs()
+		args.BlockRootHashResolver = &testscommon.BlockRootHashResolverStub{
+			GetBlockHeaderAndRootHashByNonceCalled: func(nonce uint64) (data.HeaderHandler, []byte, error) {
+				assert.Equal(t, uint64(7), nonce)
+				return historicalHeader, []byte("root hash"), nil
+			},
+		}
+		args.BlockChainHook = &testscommon.BlockChainHookStub{
+			SetCurrentHeaderCalled: func(hdr data.HeaderHandler) {
+				assert.Equal(t, historicalHeader, hdr)
+			},
+		}
+		args.HistoricalStateHandler = &testscommon.HistoricalStateHandlerStub{
+			StartHistoricalSessionCalled: func(options common.RootHashHolder) error {
+				assert.Equal(t, []byte("root hash"), options.GetRootHash())
+				assert.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, options.GetEpoch())
+				sessionStarted = true
+				return nil
+			},
+		}
+		target, _ := NewSCQueryService(args)
+
+		vmOutput, _, err := target.ExecuteQuery(&process.SCQuery{
+			ScAddress:  []byte(DummyScAddress),
+			FuncName:   "function",
+			BlockNonce: core.OptionalUint64{Value: 7, HasValue: true},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, expectedVMOutput, vmOutput)
+		assert.True(t, sessionStarted)
+	})
+}
+
 func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 	t.Parallel()
 
@@ -343,7 +722,7 @@ func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 			Arguments: [][]byte{},
 		}
 
-		_, err := target.ExecuteQuery(&query)
+		_, _, err := target.ExecuteQuery(&query)
 		require.Nil(t, err)
 		require.True(t, runSCWasCalled)
 	})
@@ -382,7 +761,7 @@ func TestExecuteQuery_GasProvidedShouldBeApplied(t *testing.T) {
 			Arguments: [][]byte{},
 		}
 
-		_, err := target.ExecuteQuery(&query)
+		_, _, err := target.ExecuteQuery(&query)
 		require.Nil(t, err)
 		require.True(t, runSCWasCalled)
 	})
@@ -419,7 +798,7 @@ func TestExecuteQuery_WhenNotOkCodeShouldNotErr(t *testing.T) {
 		Arguments: [][]byte{},
 	}
 
-	returnedData, err := target.ExecuteQuery(&query)
+	returnedData, _, err := target.ExecuteQuery(&query)
 
 	assert.Nil(t, err)
 	assert.NotNil(t, returnedData)
@@ -471,7 +850,7 @@ func TestExecuteQuery_ShouldCallRunScSequentially(t *testing.T) {
 				Arguments: [][]byte{},
 			}
 
-			_, _ = target.ExecuteQuery(&query)
+			_, _, _ = target.ExecuteQuery(&query)
 			wg.Done()
 		}()
 	}
@@ -514,7 +893,7 @@ func TestSCQueryService_ExecuteQueryShouldNotIncludeCallerAddressAndValue(t *tes
 		Arguments: [][]byte{},
 	}
 
-	_, err := target.ExecuteQuery(&query)
+	_, _, err := target.ExecuteQuery(&query)
 	require.NoError(t, err)
 	require.True(t, callerAddressAndCallValueAreNotSet)
 }
@@ -558,7 +937,7 @@ func TestSCQueryService_ExecuteQueryShouldIncludeCallerAddressAndValue(t *testin
 		Arguments:  [][]byte{},
 	}
 
-	_, err := target.ExecuteQuery(&query)
+	_, _, err := target.ExecuteQuery(&query)
 	require.NoError(t, err)
 	require.True(t, callerAddressAndCallValueAreSet)
 }
@@ -575,7 +954,7 @@ func TestSCQueryService_ShouldFailIfNodeIsNotSynced(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(&process.SCQuery{
 		ShouldBeSynced: true,
 		ScAddress:      []byte(DummyScAddress),
 		FuncName:       "function",
@@ -596,7 +975,7 @@ func TestSCQueryService_ShouldWorkIfNodeIsSynced(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(&process.SCQuery{
 		ShouldBeSynced: true,
 		ScAddress:      []byte(DummyScAddress),
 		FuncName:       "function",
@@ -624,7 +1003,7 @@ func TestSCQueryService_ShouldFailIfStateChanged(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(&process.SCQuery{
 		SameScState: true,
 		ScAddress:   []byte(DummyScAddress),
 		FuncName:    "function",
@@ -649,7 +1028,7 @@ func TestSCQueryService_ShouldWorkIfStateDidntChange(t *testing.T) {
 
 	qs, _ := NewSCQueryService(args)
 
-	res, err := qs.ExecuteQuery(&process.SCQuery{
+	res, _, err := qs.ExecuteQuery(&process.SCQuery{
 		SameScState: true,
 		ScAddress:   []byte(DummyScAddress),
 		FuncName:    "function",
@@ -0,0 +1,265 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+const argumentsSeparator = "@"
+
+// ArgsCallTraceBuilder holds the arguments needed to create a new call trace builder
+type ArgsCallTraceBuilder struct {
+	PubkeyConverter core.PubkeyConverter
+	BlockChainHook  BlockChainHook
+}
+
+type callTraceBuilder struct {
+	pubkeyConverter core.PubkeyConverter
+	blockChainHook  BlockChainHook
+}
+
+type frameInfo struct {
+	sender  []byte
+	address []byte
+	frame   *common.CallFrame
+}
+
+// NewCallTraceBuilder returns a new instance of callTraceBuilder
+func NewCallTraceBuilder(args ArgsCallTraceBuilder) (*callTraceBuilder, error) {
+	if check.IfNil(args.PubkeyConverter) {
+		return nil, process.ErrNilPubkeyConverter
+	}
+	if check.IfNil(args.BlockChainHook) {
+		return nil, process.ErrNilBlockChainHook
+	}
+
+	return &callTraceBuilder{
+		pubkeyConverter: args.PubkeyConverter,
+		blockChainHook:  args.BlockChainHook,
+	}, nil
+}
+
+// Build creates the call trace of the execution described by the provided input and VM output. The nested calls are
+// reconstructed from the output transfers, while the storage accesses, the gas used and the logs are assigned to the
+// first call frame of the account they belong to. Returns nil if there is nothing to trace
+func (builder *callTraceBuilder) Build(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *common.CallTrace {
+	if input == nil || vmOutput == nil {
+		return nil
+	}
+
+	builtInFunctions := builder.blockChainHook.GetBuiltinFunctionNames()
+	root := &frameInfo{
+		sender:  input.CallerAddr,
+		address: input.RecipientAddr,
+		frame:   builder.createFrame(input.CallerAddr, input.RecipientAddr, input.Function, input.CallType, input.CallValue, builtInFunctions),
+	}
+	root.frame.GasLimit = input.GasProvided
+
+	callTrace := &common.CallTrace{
+		ReturnCode:    vmOutput.ReturnCode.String(),
+		ReturnMessage: vmOutput.ReturnMessage,
+		GasProvided:   input.GasProvided,
+		Counters:      builder.blockChainHook.GetCounterValues(),
+		Call:          root.frame,
+	}
+	if input.GasProvided > vmOutput.GasRemaining {
+		callTrace.GasUsed = input.GasProvided - vmOutput.GasRemaining
+	}
+	root.frame.GasUsed = callTrace.GasUsed
+
+	addresses := getSortedOutputAccountsAddresses(vmOutput)
+	children := builder.createChildFrames(vmOutput, addresses, input.RecipientAddr, builtInFunctions)
+	frames := nestFrames(root, children)
+
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		frame := findFrame(frames, []byte(address))
+		if frame != nil && frame != root.frame {
+			frame.GasUsed = outputAccount.GasUsed
+		}
+		if frame == nil {
+			frame = root.frame
+		}
+
+		builder.addStorageAccesses(frame, outputAccount)
+	}
+
+	for _, logEntry := range vmOutput.Logs {
+		frame := findFrame(frames, logEntry.Address)
+		if frame == nil {
+			frame = root.frame
+		}
+
+		frame.Logs = append(frame.Logs, builder.createLogEntry(logEntry))
+	}
+
+	return callTrace
+}
+
+func (builder *callTraceBuilder) createChildFrames(
+	vmOutput *vmcommon.VMOutput,
+	addresses []string,
+	defaultSender []byte,
+	builtInFunctions vmcommon.FunctionNames,
+) []*frameInfo {
+	children := make([]*frameInfo, 0)
+	for _, address := range addresses {
+		outputAccount := vmOutput.OutputAccounts[address]
+		for _, transfer := range outputAccount.OutputTransfers {
+			sender := transfer.SenderAddress
+			if len(sender) == 0 {
+				sender = defaultSender
+			}
+
+			frame := builder.createFrame(sender, []byte(address), extractFunction(transfer.Data), transfer.CallType, transfer.Value, builtInFunctions)
+			frame.GasLimit = transfer.GasLimit
+			frame.GasLocked = transfer.GasLocked
+
+			children = append(children, &frameInfo{
+				sender:  sender,
+				address: []byte(address),
+				frame:   frame,
+			})
+		}
+	}
+
+	return children
+}
+
+func (builder *callTraceBuilder) createFrame(
+	caller []byte,
+	callee []byte,
+	function string,
+	callType vmData.CallType,
+	value *big.Int,
+	builtInFunctions vmcommon.FunctionNames,
+) *common.CallFrame {
+	_, isBuiltInFunction := builtInFunctions[function]
+	frame := &common.CallFrame{
+		Caller:            builder.encodeAddress(caller),
+		Callee:            builder.encodeAddress(callee),
+		Function:          function,
+		CallType:          callType.ToString(),
+		IsBuiltInFunction: isBuiltInFunction,
+	}
+	if value != nil {
+		frame.Value = value.String()
+	}
+
+	return frame
+}
+
+func (builder *callTraceBuilder) addStorageAccesses(frame *common.CallFrame, outputAccount *vmcommon.OutputAccount) {
+	keys := make([]string, 0, len(outputAccount.StorageUpdates))
+	for key := range outputAccount.StorageUpdates {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		update := outputAccount.StorageUpdates[key]
+		storageAccess := &common.StorageAccessTrace{
+			Key:   hex.EncodeToString(update.Offset),
+			Value: hex.EncodeToString(update.Data),
+		}
+
+		if update.Written {
+			frame.StorageWrites = append(frame.StorageWrites, storageAccess)
+			continue
+		}
+
+		frame.StorageReads = append(frame.StorageReads, storageAccess)
+	}
+}
+
+func (builder *callTraceBuilder) createLogEntry(logEntry *vmcommon.LogEntry) *common.LogEntryTrace {
+	topics := make([]string, 0, len(logEntry.Topics))
+	for _, topic := range logEntry.Topics {
+		topics = append(topics, hex.EncodeToString(topic))
+	}
+
+	return &common.LogEntryTrace{
+		Identifier: string(logEntry.Identifier),
+		Address:    builder.encodeAddress(logEntry.Address),
+		Topics:     topics,
+		Data:       hex.EncodeToString(logEntry.Data),
+	}
+}
+
+func (builder *callTraceBuilder) encodeAddress(address []byte) string {
+	if len(address) == 0 {
+		return ""
+	}
+
+	return builder.pubkeyConverter.Encode(address)
+}
+
+// nestFrames attaches each child frame to the first frame, in breadth-first order, whose callee is the child's
+// sender. The frames which cannot be attached this way are attached to the root. Returns all frames, in the order in
+// which they were attached
+func nestFrames(root *frameInfo, children []*frameInfo) []*frameInfo {
+	frames := []*frameInfo{root}
+	attached := make([]bool, len(children))
+	for i := 0; i < len(frames); i++ {
+		parent := frames[i]
+		for j, child := range children {
+			if attached[j] || !bytes.Equal(child.sender, parent.address) {
+				continue
+			}
+
+			attached[j] = true
+			parent.frame.Calls = append(parent.frame.Calls, child.frame)
+			frames = append(frames, child)
+		}
+	}
+
+	for j, child := range children {
+		if attached[j] {
+			continue
+		}
+
+		root.frame.Calls = append(root.frame.Calls, child.frame)
+		frames = append(frames, child)
+	}
+
+	return frames
+}
+
+func findFrame(frames []*frameInfo, address []byte) *common.CallFrame {
+	for _, info := range frames {
+		if bytes.Equal(info.address, address) {
+			return info.frame
+		}
+	}
+
+	return nil
+}
+
+func getSortedOutputAccountsAddresses(vmOutput *vmcommon.VMOutput) []string {
+	addresses := make([]string, 0, len(vmOutput.OutputAccounts))
+	for address := range vmOutput.OutputAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	return addresses
+}
+
+func extractFunction(data []byte) string {
+	return strings.Split(string(data), argumentsSeparator)[0]
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *callTraceBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
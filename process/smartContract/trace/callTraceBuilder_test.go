@@ -0,0 +1,248 @@
+package trace
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	vmData "github.com/multiversx/mx-chain-core-go/data/vm"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	userAddress     = []byte("user")
+	contractAddress = []byte("contractA")
+	calleeAddress   = []byte("contractB")
+	tokenAddress    = []byte("contractC")
+	otherAddress    = []byte("other")
+)
+
+func createMockArgsCallTraceBuilder() ArgsCallTraceBuilder {
+	return ArgsCallTraceBuilder{
+		PubkeyConverter: testscommon.NewPubkeyConverterMock(32),
+		BlockChainHook: &testscommon.BlockChainHookStub{
+			GetBuiltinFunctionNamesCalled: func() vmcommon.FunctionNames {
+				return vmcommon.FunctionNames{"ESDTTransfer": {}}
+			},
+			GetCounterValuesCalled: func() map[string]uint64 {
+				return map[string]uint64{"CrtNumberOfTrieReadsPerTx": 3}
+			},
+		},
+	}
+}
+
+func encode(address []byte) string {
+	return hex.EncodeToString(address)
+}
+
+func TestNewCallTraceBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil pubkey converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCallTraceBuilder()
+		args.PubkeyConverter = nil
+		builder, err := NewCallTraceBuilder(args)
+
+		assert.Nil(t, builder)
+		assert.Equal(t, process.ErrNilPubkeyConverter, err)
+	})
+	t.Run("nil blockchain hook should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCallTraceBuilder()
+		args.BlockChainHook = nil
+		builder, err := NewCallTraceBuilder(args)
+
+		assert.Nil(t, builder)
+		assert.Equal(t, process.ErrNilBlockChainHook, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		builder, err := NewCallTraceBuilder(createMockArgsCallTraceBuilder())
+
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(builder))
+	})
+}
+
+func TestCallTraceBuilder_BuildNilInputsShouldReturnNil(t *testing.T) {
+	t.Parallel()
+
+	builder, _ := NewCallTraceBuilder(createMockArgsCallTraceBuilder())
+
+	assert.Nil(t, builder.Build(nil, &vmcommon.VMOutput{}))
+	assert.Nil(t, builder.Build(&vmcommon.ContractCallInput{}, nil))
+}
+
+func TestCallTraceBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	builder, _ := NewCallTraceBuilder(createMockArgsCallTraceBuilder())
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  userAddress,
+			CallValue:   big.NewInt(0),
+			GasProvided: 1000,
+			CallType:    vmData.DirectCall,
+		},
+		RecipientAddr: contractAddress,
+		Function:      "doSomething",
+	}
+	vmOutput := &vmcommon.VMOutput{
+		ReturnCode:   vmcommon.UserError,
+		GasRemaining: 400,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(tokenAddress): {
+				Address: tokenAddress,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						SenderAddress: calleeAddress,
+						Data:          []byte("ESDTTransfer@544f4b454e@01"),
+						CallType:      vmData.DirectCall,
+						Value:         big.NewInt(0),
+					},
+				},
+			},
+			string(contractAddress): {
+				Address: contractAddress,
+				GasUsed: 100,
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"b": {Offset: []byte("b"), Data: []byte("2"), Written: true},
+					"a": {Offset: []byte("a"), Data: []byte("1")},
+				},
+			},
+			string(calleeAddress): {
+				Address: calleeAddress,
+				GasUsed: 30,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{
+						SenderAddress: contractAddress,
+						Data:          []byte("callMe@01"),
+						CallType:      vmData.AsynchronousCall,
+						GasLimit:      50,
+						GasLocked:     10,
+						Value:         big.NewInt(5),
+					},
+				},
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"c": {Offset: []byte("c"), Data: []byte("3"), Written: true},
+				},
+			},
+		},
+		Logs: []*vmcommon.LogEntry{
+			{Identifier: []byte("event"), Address: calleeAddress, Topics: [][]byte{[]byte("t")}, Data: []byte("d")},
+			{Identifier: []byte("unknown"), Address: otherAddress},
+		},
+	}
+
+	callTrace := builder.Build(input, vmOutput)
+	require.NotNil(t, callTrace)
+
+	expectedTokenFrame := &common.CallFrame{
+		Caller:            encode(calleeAddress),
+		Callee:            encode(tokenAddress),
+		Function:          "ESDTTransfer",
+		CallType:          vmData.DirectCallStr,
+		Value:             "0",
+		IsBuiltInFunction: true,
+	}
+	expectedCalleeFrame := &common.CallFrame{
+		Caller:    encode(contractAddress),
+		Callee:    encode(calleeAddress),
+		Function:  "callMe",
+		CallType:  vmData.AsynchronousCallStr,
+		Value:     "5",
+		GasLimit:  50,
+		GasLocked: 10,
+		GasUsed:   30,
+		StorageWrites: []*common.StorageAccessTrace{
+			{Key: hex.EncodeToString([]byte("c")), Value: hex.EncodeToString([]byte("3"))},
+		},
+		Logs: []*common.LogEntryTrace{
+			{
+				Identifier: "event",
+				Address:    encode(calleeAddress),
+				Topics:     []string{hex.EncodeToString([]byte("t"))},
+				Data:       hex.EncodeToString([]byte("d")),
+			},
+		},
+		Calls: []*common.CallFrame{expectedTokenFrame},
+	}
+	expectedTrace := &common.CallTrace{
+		ReturnCode:  vmcommon.UserError.String(),
+		GasProvided: 1000,
+		GasUsed:     600,
+		Counters:    map[string]uint64{"CrtNumberOfTrieReadsPerTx": 3},
+		Call: &common.CallFrame{
+			Caller:   encode(userAddress),
+			Callee:   encode(contractAddress),
+			Function: "doSomething",
+			CallType: vmData.DirectCallStr,
+			Value:    "0",
+			GasLimit: 1000,
+			GasUsed:  600,
+			StorageReads: []*common.StorageAccessTrace{
+				{Key: hex.EncodeToString([]byte("a")), Value: hex.EncodeToString([]byte("1"))},
+			},
+			StorageWrites: []*common.StorageAccessTrace{
+				{Key: hex.EncodeToString([]byte("b")), Value: hex.EncodeToString([]byte("2"))},
+			},
+			Logs: []*common.LogEntryTrace{
+				{
+					Identifier: "unknown",
+					Address:    encode(otherAddress),
+					Topics:     []string{},
+					Data:       "",
+				},
+			},
+			Calls: []*common.CallFrame{expectedCalleeFrame},
+		},
+	}
+
+	assert.Equal(t, expectedTrace, callTrace)
+}
+
+func TestCallTraceBuilder_BuildUnknownSenderShouldAttachToRoot(t *testing.T) {
+	t.Parallel()
+
+	builder, _ := NewCallTraceBuilder(createMockArgsCallTraceBuilder())
+
+	input := &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  userAddress,
+			GasProvided: 10,
+		},
+		RecipientAddr: contractAddress,
+		Function:      "doSomething",
+	}
+	vmOutput := &vmcommon.VMOutput{
+		GasRemaining: 20,
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			string(calleeAddress): {
+				Address: calleeAddress,
+				OutputTransfers: []vmcommon.OutputTransfer{
+					{SenderAddress: otherAddress},
+					{},
+				},
+			},
+		},
+	}
+
+	callTrace := builder.Build(input, vmOutput)
+	require.NotNil(t, callTrace)
+
+	assert.Equal(t, uint64(0), callTrace.GasUsed)
+	require.Equal(t, 2, len(callTrace.Call.Calls))
+	assert.Equal(t, encode(contractAddress), callTrace.Call.Calls[0].Caller)
+	assert.Equal(t, encode(otherAddress), callTrace.Call.Calls[1].Caller)
+}
@@ -0,0 +1,10 @@
+package trace
+
+import vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+
+// BlockChainHook defines the blockchain hook operations needed when building a call trace
+type BlockChainHook interface {
+	GetBuiltinFunctionNames() vmcommon.FunctionNames
+	GetCounterValues() map[string]uint64
+	IsInterfaceNil() bool
+}
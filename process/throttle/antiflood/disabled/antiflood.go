@@ -42,6 +42,11 @@ func (af *AntiFlood) SetPeerValidatorMapper(_ process.PeerValidatorMapper) error
 	return nil
 }
 
+// SetPeerReputationHandler does nothing
+func (af *AntiFlood) SetPeerReputationHandler(_ process.PeerReputationHandler) error {
+	return nil
+}
+
 // CanProcessMessagesOnTopic will always return nil
 func (af *AntiFlood) CanProcessMessagesOnTopic(_ core.PeerID, _ string, _ uint32, _ uint64, _ []byte) error {
 	return nil
@@ -0,0 +1,55 @@
+package disabled
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+var _ process.PeerReputationHandler = (*PeerReputationHandler)(nil)
+
+// PeerReputationHandler is a disabled implementation of PeerReputationHandler that does not keep any reputation
+// (all peers are considered good peers)
+type PeerReputationHandler struct {
+}
+
+// RecordAntifloodViolation does nothing
+func (prh *PeerReputationHandler) RecordAntifloodViolation(_ core.PeerID) {
+}
+
+// RecordInvalidData does nothing
+func (prh *PeerReputationHandler) RecordInvalidData(_ core.PeerID, _ string) {
+}
+
+// RecordRequestSent does nothing
+func (prh *PeerReputationHandler) RecordRequestSent(_ core.PeerID, _ string) {
+}
+
+// RecordReceivedData does nothing
+func (prh *PeerReputationHandler) RecordReceivedData(_ core.PeerID, _ string, _ bool) {
+}
+
+// GetPeersByReputation returns the provided peers
+func (prh *PeerReputationHandler) GetPeersByReputation(peers []core.PeerID, _ int) []core.PeerID {
+	return peers
+}
+
+// GetPeerReputation returns nil
+func (prh *PeerReputationHandler) GetPeerReputation(_ core.PeerID) *common.PeerReputation {
+	return nil
+}
+
+// SetPeerValidatorMapper does nothing and returns nil
+func (prh *PeerReputationHandler) SetPeerValidatorMapper(_ process.PeerValidatorMapper) error {
+	return nil
+}
+
+// Close does nothing and returns nil
+func (prh *PeerReputationHandler) Close() error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (prh *PeerReputationHandler) IsInterfaceNil() bool {
+	return prh == nil
+}
@@ -0,0 +1,32 @@
+package disabled
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPeerReputationHandler_ShouldNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		assert.Nil(t, r, "this shouldn't panic")
+	}()
+
+	prh := &PeerReputationHandler{}
+	assert.False(t, check.IfNil(prh))
+
+	prh.RecordAntifloodViolation("pid")
+	prh.RecordInvalidData("pid", "topic")
+	prh.RecordRequestSent("pid", "topic")
+	prh.RecordReceivedData("pid", "topic", true)
+
+	peers := []core.PeerID{"pid1", "pid2"}
+	assert.Equal(t, peers, prh.GetPeersByReputation(peers, 1))
+	assert.Nil(t, prh.GetPeerReputation("pid"))
+	assert.Nil(t, prh.SetPeerValidatorMapper(nil))
+	assert.Nil(t, prh.Close())
+}
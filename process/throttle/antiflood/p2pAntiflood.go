@@ -19,14 +19,16 @@ var log = logger.GetOrCreate("process/throttle/antiflood")
 var _ process.P2PAntifloodHandler = (*p2pAntiflood)(nil)
 
 type p2pAntiflood struct {
-	blacklistHandler    process.PeerBlackListCacher
-	floodPreventers     []process.FloodPreventer
-	topicPreventer      process.TopicFloodPreventer
-	mutDebugger         sync.RWMutex
-	debugger            process.AntifloodDebugger
-	peerValidatorMapper process.PeerValidatorMapper
-	mapTopicsFromAll    map[string]struct{}
-	mutTopicCheck       sync.RWMutex
+	blacklistHandler      process.PeerBlackListCacher
+	floodPreventers       []process.FloodPreventer
+	topicPreventer        process.TopicFloodPreventer
+	mutDebugger           sync.RWMutex
+	debugger              process.AntifloodDebugger
+	peerValidatorMapper   process.PeerValidatorMapper
+	mapTopicsFromAll      map[string]struct{}
+	mutTopicCheck         sync.RWMutex
+	mutPeerReputation     sync.RWMutex
+	peerReputationHandler process.PeerReputationHandler
 }
 
 // NewP2PAntiflood creates a new p2p anti flood protection mechanism built on top of a flood preventer implementation.
@@ -48,12 +50,13 @@ func NewP2PAntiflood(
 	}
 
 	return &p2pAntiflood{
-		blacklistHandler:    blacklistHandler,
-		floodPreventers:     floodPreventers,
-		topicPreventer:      topicFloodPreventer,
-		debugger:            &disabled.AntifloodDebugger{},
-		mapTopicsFromAll:    make(map[string]struct{}),
-		peerValidatorMapper: &disabled.PeerValidatorMapper{},
+		blacklistHandler:      blacklistHandler,
+		floodPreventers:       floodPreventers,
+		topicPreventer:        topicFloodPreventer,
+		debugger:              &disabled.AntifloodDebugger{},
+		mapTopicsFromAll:      make(map[string]struct{}),
+		peerValidatorMapper:   &disabled.PeerValidatorMapper{},
+		peerReputationHandler: &disabled.PeerReputationHandler{},
 	}, nil
 }
 
@@ -80,6 +83,7 @@ func (af *p2pAntiflood) CanProcessMessage(message p2p.MessageP2P, fromConnectedP
 			message.SeqNo(),
 			af.blacklistHandler.Has(fromConnectedPeer),
 		)
+		af.recordAntifloodViolation(fromConnectedPeer)
 
 		return lastErrFound
 	}
@@ -145,6 +149,13 @@ func (af *p2pAntiflood) recordDebugEvent(pid core.PeerID, topic string, numRejec
 	af.debugger.AddData(pid, topic, numRejected, sizeRejected, sequence, isBlacklisted)
 }
 
+func (af *p2pAntiflood) recordAntifloodViolation(pid core.PeerID) {
+	af.mutPeerReputation.RLock()
+	defer af.mutPeerReputation.RUnlock()
+
+	af.peerReputationHandler.RecordAntifloodViolation(pid)
+}
+
 func (af *p2pAntiflood) canProcessMessage(fp process.FloodPreventer, message p2p.MessageP2P, fromConnectedPeer core.PeerID) error {
 	//protect from directly connected peer
 	err := fp.IncreaseLoad(fromConnectedPeer, uint64(len(message.Data())))
@@ -190,6 +201,7 @@ func (af *p2pAntiflood) CanProcessMessagesOnTopic(peer core.PeerID, topic string
 		)
 
 		af.recordDebugEvent(peer, topic, numMessages, totalSize, sequence, af.blacklistHandler.Has(peer))
+		af.recordAntifloodViolation(peer)
 
 		return fmt.Errorf("%w in p2pAntiflood for connected peer %s",
 			err,
@@ -231,6 +243,19 @@ func (af *p2pAntiflood) SetDebugger(debugger process.AntifloodDebugger) error {
 	return nil
 }
 
+// SetPeerReputationHandler sets the peer reputation handler, notified each time a peer's messages are rejected
+func (af *p2pAntiflood) SetPeerReputationHandler(handler process.PeerReputationHandler) error {
+	if check.IfNil(handler) {
+		return process.ErrNilPeerReputationHandler
+	}
+
+	af.mutPeerReputation.Lock()
+	af.peerReputationHandler = handler
+	af.mutPeerReputation.Unlock()
+
+	return nil
+}
+
 // BlacklistPeer will add a peer to the black list
 func (af *p2pAntiflood) BlacklistPeer(peer core.PeerID, reason string, duration time.Duration) {
 	peerIsBlacklisted := af.blacklistHandler.Has(peer)
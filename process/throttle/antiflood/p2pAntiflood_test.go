@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood"
 	"github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
+	"github.com/multiversx/mx-chain-go/testscommon/p2pmocks"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -355,6 +356,58 @@ func TestP2pAntiflood_SetDebuggerShouldWork(t *testing.T) {
 	assert.True(t, afm.Debugger() == debugger)
 }
 
+func TestP2pAntiflood_SetPeerReputationHandlerNilHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{},
+		&mock.FloodPreventerStub{},
+	)
+
+	err := afm.SetPeerReputationHandler(nil)
+	assert.Equal(t, process.ErrNilPeerReputationHandler, err)
+}
+
+func TestP2pAntiflood_ViolationsShouldBeRecordedOnPeerReputationHandler(t *testing.T) {
+	t.Parallel()
+
+	identifier := core.PeerID("id")
+	afm, _ := antiflood.NewP2PAntiflood(
+		&mock.PeerBlackListHandlerStub{},
+		&mock.TopicAntiFloodStub{
+			IncreaseLoadCalled: func(pid core.PeerID, topic string, numMessages uint32) error {
+				return process.ErrSystemBusy
+			},
+		},
+		&mock.FloodPreventerStub{
+			IncreaseLoadCalled: func(pid core.PeerID, size uint64) error {
+				return process.ErrSystemBusy
+			},
+		},
+	)
+
+	recordedPeers := make([]core.PeerID, 0)
+	err := afm.SetPeerReputationHandler(&p2pmocks.PeerReputationHandlerStub{
+		RecordAntifloodViolationCalled: func(pid core.PeerID) {
+			recordedPeers = append(recordedPeers, pid)
+		},
+	})
+	assert.Nil(t, err)
+
+	message := &mock.P2PMessageMock{
+		DataField: []byte("data"),
+		PeerField: identifier,
+	}
+	err = afm.CanProcessMessage(message, identifier)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+
+	err = afm.CanProcessMessagesOnTopic(identifier, "topic", 1, 0, nil)
+	assert.True(t, errors.Is(err, process.ErrSystemBusy))
+
+	assert.Equal(t, []core.PeerID{identifier, identifier}, recordedPeers)
+}
+
 func TestP2pAntiflood_Close(t *testing.T) {
 	t.Parallel()
 
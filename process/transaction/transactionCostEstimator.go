@@ -74,8 +74,9 @@ func NewTransactionCostEstimator(
 	return tce, nil
 }
 
-// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume
-func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.Transaction) (*transaction.CostResponse, error) {
+// ComputeTransactionGasLimit will calculate how many gas units a transaction will consume. The optional state overrides
+// replace the fields of the given accounts only for the duration of the estimation
+func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (*transaction.CostResponse, error) {
 	tce.mutExecution.RLock()
 	defer tce.mutExecution.RUnlock()
 
@@ -86,7 +87,7 @@ func (tce *transactionCostEstimator) ComputeTransactionGasLimit(tx *transaction.
 
 	switch txTypeOnSender {
 	case process.SCDeployment, process.SCInvoking, process.BuiltInFunctionCall, process.MoveBalance:
-		return tce.simulateTransactionCost(tx, txTypeOnSender)
+		return tce.simulateTransactionCost(tx, txTypeOnSender, stateOverrides)
 	case process.RelayedTx, process.RelayedTxV2:
 		// TODO implement in the next PR
 		return &transaction.CostResponse{
@@ -110,13 +111,17 @@ func (tce *transactionCostEstimator) computeMoveBalanceCost(tx *transaction.Tran
 	}
 }
 
-func (tce *transactionCostEstimator) simulateTransactionCost(tx *transaction.Transaction, txType process.TransactionType) (*transaction.CostResponse, error) {
-	err := tce.addMissingFieldsIfNeeded(tx)
+func (tce *transactionCostEstimator) simulateTransactionCost(
+	tx *transaction.Transaction,
+	txType process.TransactionType,
+	stateOverrides common.StateOverrides,
+) (*transaction.CostResponse, error) {
+	err := tce.addMissingFieldsIfNeeded(tx, stateOverrides)
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := tce.txSimulator.ProcessTx(tx)
+	res, err := tce.txSimulator.ProcessTx(tx, stateOverrides, false)
 	if err != nil {
 		return &transaction.CostResponse{
 			GasUnits:      0,
@@ -194,7 +199,7 @@ func extractGasRemainedFromMessage(message string, splitString string) uint64 {
 	return gasValue
 }
 
-func (tce *transactionCostEstimator) addMissingFieldsIfNeeded(tx *transaction.Transaction) error {
+func (tce *transactionCostEstimator) addMissingFieldsIfNeeded(tx *transaction.Transaction, stateOverrides common.StateOverrides) error {
 	if tx.GasPrice == 0 {
 		tx.GasPrice = tce.feeHandler.MinGasPrice()
 	}
@@ -203,7 +208,7 @@ func (tce *transactionCostEstimator) addMissingFieldsIfNeeded(tx *transaction.Tr
 	}
 	if tx.GasLimit == 0 {
 		var err error
-		tx.GasLimit, err = tce.getTxGasLimit(tx)
+		tx.GasLimit, err = tce.getTxGasLimit(tx, stateOverrides)
 
 		return err
 	}
@@ -211,7 +216,7 @@ func (tce *transactionCostEstimator) addMissingFieldsIfNeeded(tx *transaction.Tr
 	return nil
 }
 
-func (tce *transactionCostEstimator) getTxGasLimit(tx *transaction.Transaction) (uint64, error) {
+func (tce *transactionCostEstimator) getTxGasLimit(tx *transaction.Transaction, stateOverrides common.StateOverrides) (uint64, error) {
 	selfShardID := tce.shardCoordinator.SelfId()
 	maxGasLimitPerBlock := tce.feeHandler.MaxGasLimitPerBlock(selfShardID) - 1
 
@@ -235,6 +240,10 @@ func (tce *transactionCostEstimator) getTxGasLimit(tx *transaction.Transaction)
 	}
 
 	accountSenderBalance := accountSender.GetBalance()
+	senderOverride, found := stateOverrides[string(tx.SndAddr)]
+	if found && senderOverride != nil && senderOverride.Balance != nil {
+		accountSenderBalance = senderOverride.Balance
+	}
 	tx.GasLimit = maxGasLimitPerBlock
 	txFee := tce.feeHandler.ComputeTxFee(tx)
 	if txFee.Cmp(accountSenderBalance) > 0 && big.NewInt(0).Cmp(accountSenderBalance) != 0 {
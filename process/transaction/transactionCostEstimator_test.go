@@ -10,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/process/txsimulator"
@@ -112,7 +113,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 			return consumedGasUnits
 		},
 	}, &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			return &txSimData.SimulationResults{}, nil
 		},
 	}, &stateMock.AccountsStub{
@@ -123,7 +124,7 @@ func TestComputeTransactionGasLimit_MoveBalance(t *testing.T) {
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -145,7 +146,7 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 			return consumedGasUnits
 		},
 	}, &mock.TransactionSimulatorStub{
-		ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+		ProcessTxCalled: func(tx *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 			return nil, simulationErr
 		},
 	}, &stateMock.AccountsStub{
@@ -156,7 +157,7 @@ func TestComputeTransactionGasLimit_MoveBalanceInvalidNonceShouldStillComputeCos
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -173,7 +174,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{
 					VMOutput: &vmcommon.VMOutput{
 						ReturnCode:   vmcommon.Ok,
@@ -189,7 +190,7 @@ func TestComputeTransactionGasLimit_BuiltInFunction(t *testing.T) {
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, consumedGasUnits, cost.GasUnits)
 }
@@ -206,7 +207,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 				return nil, localErr
 			},
 		}, &stateMock.AccountsStub{
@@ -217,7 +218,7 @@ func TestComputeTransactionGasLimit_BuiltInFunctionShouldErr(t *testing.T) {
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, localErr.Error(), cost.ReturnMessage)
 }
@@ -233,7 +234,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{}, nil
 			},
 		}, &stateMock.AccountsStub{
@@ -244,7 +245,7 @@ func TestComputeTransactionGasLimit_NilVMOutput(t *testing.T) {
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, process.ErrNilVMOutput.Error(), cost.ReturnMessage)
 }
@@ -260,7 +261,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 		},
 	},
 		&mock.TransactionSimulatorStub{
-			ProcessTxCalled: func(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+			ProcessTxCalled: func(tx *transaction.Transaction, _ common.StateOverrides, _ bool) (*txSimData.SimulationResults, error) {
 				return &txSimData.SimulationResults{
 					VMOutput: &vmcommon.VMOutput{
 						ReturnCode: vmcommon.UserError,
@@ -275,7 +276,7 @@ func TestComputeTransactionGasLimit_RetCodeNotOk(t *testing.T) {
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.True(t, strings.Contains(cost.ReturnMessage, vmcommon.UserError.String()))
 }
@@ -296,7 +297,7 @@ func TestTransactionCostEstimator_RelayedTxShouldErr(t *testing.T) {
 		&testscommon.EnableEpochsHandlerStub{})
 
 	tx := &transaction.Transaction{}
-	cost, err := tce.ComputeTransactionGasLimit(tx)
+	cost, err := tce.ComputeTransactionGasLimit(tx, nil)
 	require.Nil(t, err)
 	require.Equal(t, "cannot compute cost of the relayed transaction", cost.ReturnMessage)
 }
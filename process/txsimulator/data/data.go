@@ -2,6 +2,7 @@ package data
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -12,5 +13,22 @@ type SimulationResults struct {
 	ScResults  map[string]*transaction.ApiSmartContractResult `json:"scResults,omitempty"`
 	Receipts   map[string]*transaction.ApiReceipt             `json:"receipts,omitempty"`
 	Hash       string                                         `json:"hash,omitempty"`
+	Trace      *common.CallTrace                              `json:"trace,omitempty"`
 	VMOutput   *vmcommon.VMOutput                             `json:"-"`
 }
+
+// BundleSimulationResults is the data transfer object which will hold the results for simulating the execution of an
+// ordered bundle of transactions
+type BundleSimulationResults struct {
+	Results        []*SimulationResults `json:"results"`
+	AccountChanges []*AccountChange     `json:"accountChanges,omitempty"`
+}
+
+// AccountChange holds the state of an account, as it results after simulating the execution of a bundle of transactions
+type AccountChange struct {
+	Address        string            `json:"address"`
+	Nonce          uint64            `json:"nonce"`
+	Balance        string            `json:"balance,omitempty"`
+	CodeHash       string            `json:"codeHash,omitempty"`
+	StorageUpdates map[string]string `json:"storageUpdates,omitempty"`
+}
@@ -25,3 +25,24 @@ var ErrNilMarshalizer = errors.New("nil marshalizer provided")
 
 // ErrNilHasher signals that a nil hasher has been provided
 var ErrNilHasher = errors.New("nil hasher provided")
+
+// ErrNilSimulationAccountsHandler signals that a nil simulation accounts handler has been provided
+var ErrNilSimulationAccountsHandler = errors.New("nil simulation accounts handler")
+
+// ErrEmptyTransactionsBundle signals that an empty bundle of transactions has been provided
+var ErrEmptyTransactionsBundle = errors.New("empty transactions bundle")
+
+// ErrNoActiveSession signals that an operation requiring an active session has been called outside of one
+var ErrNoActiveSession = errors.New("no active session")
+
+// ErrNilCallTraceBuilder signals that a nil call trace builder has been provided
+var ErrNilCallTraceBuilder = errors.New("nil call trace builder")
+
+// ErrNilAccountsRepository signals that a nil accounts repository has been provided
+var ErrNilAccountsRepository = errors.New("nil accounts repository")
+
+// ErrNilRootHashHolder signals that a nil root hash holder has been provided
+var ErrNilRootHashHolder = errors.New("nil root hash holder")
+
+// ErrHistoricalStateNotSupported signals that the historical state was requested from a component which cannot provide it
+var ErrHistoricalStateNotSupported = errors.New("historical state is not supported")
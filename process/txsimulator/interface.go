@@ -2,6 +2,7 @@ package txsimulator
 
 import (
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
@@ -11,3 +12,15 @@ type TransactionProcessor interface {
 	VerifyTransaction(transaction *transaction.Transaction) error
 	IsInterfaceNil() bool
 }
+
+// SimulationAccountsHandler defines the operations of an accounts wrapper able to keep the saved accounts in memory
+// between consecutive simulations
+type SimulationAccountsHandler interface {
+	StartSession()
+	ApplyStateOverrides(overrides common.StateOverrides) error
+	SessionAccounts() []vmcommon.AccountHandler
+	JournalLen() int
+	RevertToSnapshot(snapshot int) error
+	EndSession()
+	IsInterfaceNil() bool
+}
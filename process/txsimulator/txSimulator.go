@@ -2,6 +2,8 @@ package txsimulator
 
 import (
 	"encoding/hex"
+	"sort"
+	"strings"
 	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -10,11 +12,14 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	txSimData "github.com/multiversx/mx-chain-go/process/txsimulator/data"
 	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-chain-go/state"
 	"github.com/multiversx/mx-chain-go/storage"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
@@ -28,6 +33,8 @@ type ArgsTxSimulator struct {
 	VMOutputCacher            storage.Cacher
 	Hasher                    hashing.Hasher
 	Marshalizer               marshal.Marshalizer
+	SimulationAccounts        SimulationAccountsHandler
+	CallTraceBuilder          process.CallTraceBuilder
 }
 
 type transactionSimulator struct {
@@ -39,6 +46,8 @@ type transactionSimulator struct {
 	vmOutputCacher         storage.Cacher
 	hasher                 hashing.Hasher
 	marshalizer            marshal.Marshalizer
+	simulationAccounts     SimulationAccountsHandler
+	callTraceBuilder       process.CallTraceBuilder
 }
 
 // NewTransactionSimulator returns a new instance of a transactionSimulator
@@ -64,6 +73,12 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 	if check.IfNil(args.Hasher) {
 		return nil, ErrNilHasher
 	}
+	if check.IfNil(args.SimulationAccounts) {
+		return nil, ErrNilSimulationAccountsHandler
+	}
+	if check.IfNil(args.CallTraceBuilder) {
+		return nil, ErrNilCallTraceBuilder
+	}
 
 	return &transactionSimulator{
 		txProcessor:            args.TransactionProcessor,
@@ -73,21 +88,138 @@ func NewTransactionSimulator(args ArgsTxSimulator) (*transactionSimulator, error
 		vmOutputCacher:         args.VMOutputCacher,
 		marshalizer:            args.Marshalizer,
 		hasher:                 args.Hasher,
+		simulationAccounts:     args.SimulationAccounts,
+		callTraceBuilder:       args.CallTraceBuilder,
 	}, nil
 }
 
-// ProcessTx will process the transaction in a special environment, where state-writing is not allowed
-func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimData.SimulationResults, error) {
+// ProcessTx will process the transaction in a special environment, where state-writing is not allowed. The optional
+// state overrides replace the fields of the given accounts only for the duration of this simulation
+func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction, stateOverrides common.StateOverrides, withTrace bool) (*txSimData.SimulationResults, error) {
+	ts.mutOperation.Lock()
+	defer ts.mutOperation.Unlock()
+
+	if len(stateOverrides) == 0 {
+		return ts.processTx(tx, withTrace)
+	}
+
+	ts.simulationAccounts.StartSession()
+	defer ts.simulationAccounts.EndSession()
+
+	err := ts.simulationAccounts.ApplyStateOverrides(stateOverrides)
+	if err != nil {
+		return nil, err
+	}
+
+	return ts.processTx(tx, withTrace)
+}
+
+// ProcessBundle will process the provided transactions one after another in a special environment, where state-writing
+// is not allowed. Each transaction will see the state left by the ones processed before it
+func (ts *transactionSimulator) ProcessBundle(txs []*transaction.Transaction, withTrace bool) (*txSimData.BundleSimulationResults, error) {
+	if len(txs) == 0 {
+		return nil, ErrEmptyTransactionsBundle
+	}
+
 	ts.mutOperation.Lock()
 	defer ts.mutOperation.Unlock()
 
+	ts.simulationAccounts.StartSession()
+	defer ts.simulationAccounts.EndSession()
+
+	bundleResults := &txSimData.BundleSimulationResults{
+		Results: make([]*txSimData.SimulationResults, 0, len(txs)),
+	}
+	storageUpdates := make(map[string]map[string]string)
+	for _, tx := range txs {
+		results, err := ts.processTx(tx, withTrace)
+		if err != nil {
+			return nil, err
+		}
+
+		bundleResults.Results = append(bundleResults.Results, results)
+		ts.addStorageUpdates(storageUpdates, results.VMOutput)
+	}
+
+	bundleResults.AccountChanges = ts.computeAccountChanges(storageUpdates)
+
+	return bundleResults, nil
+}
+
+func (ts *transactionSimulator) addStorageUpdates(storageUpdates map[string]map[string]string, vmOutput *vmcommon.VMOutput) {
+	if vmOutput == nil {
+		return
+	}
+
+	for address, outputAccount := range vmOutput.OutputAccounts {
+		if len(outputAccount.StorageUpdates) == 0 {
+			continue
+		}
+
+		accountUpdates, found := storageUpdates[address]
+		if !found {
+			accountUpdates = make(map[string]string)
+			storageUpdates[address] = accountUpdates
+		}
+
+		for _, update := range outputAccount.StorageUpdates {
+			accountUpdates[hex.EncodeToString(update.Offset)] = hex.EncodeToString(update.Data)
+		}
+	}
+}
+
+func (ts *transactionSimulator) computeAccountChanges(storageUpdates map[string]map[string]string) []*txSimData.AccountChange {
+	accounts := ts.simulationAccounts.SessionAccounts()
+	accountChanges := make([]*txSimData.AccountChange, 0, len(accounts))
+	for _, account := range accounts {
+		address := account.AddressBytes()
+		accountChange := &txSimData.AccountChange{
+			Address:        ts.addressPubKeyConverter.Encode(address),
+			Nonce:          account.GetNonce(),
+			StorageUpdates: storageUpdates[string(address)],
+		}
+		delete(storageUpdates, string(address))
+
+		userAccount, ok := account.(state.UserAccountHandler)
+		if ok {
+			accountChange.Balance = userAccount.GetBalance().String()
+			accountChange.CodeHash = hex.EncodeToString(userAccount.GetCodeHash())
+		}
+
+		accountChanges = append(accountChanges, accountChange)
+	}
+
+	remainingAddresses := make([]string, 0, len(storageUpdates))
+	for address := range storageUpdates {
+		remainingAddresses = append(remainingAddresses, address)
+	}
+	sort.Strings(remainingAddresses)
+
+	for _, address := range remainingAddresses {
+		accountChanges = append(accountChanges, &txSimData.AccountChange{
+			Address:        ts.addressPubKeyConverter.Encode([]byte(address)),
+			StorageUpdates: storageUpdates[address],
+		})
+	}
+
+	return accountChanges
+}
+
+func (ts *transactionSimulator) processTx(tx *transaction.Transaction, withTrace bool) (*txSimData.SimulationResults, error) {
 	txStatus := transaction.TxStatusPending
 	failReason := ""
 
+	snapshot := ts.simulationAccounts.JournalLen()
 	retCode, err := ts.txProcessor.ProcessTransaction(tx)
 	if err != nil {
 		failReason = err.Error()
 		txStatus = transaction.TxStatusFail
+
+		// the partial changes of a failed transaction should not be seen by the next transactions of a bundle
+		errRevert := ts.simulationAccounts.RevertToSnapshot(snapshot)
+		if errRevert != nil {
+			return nil, errRevert
+		}
 	} else {
 		if retCode == vmcommon.Ok {
 			txStatus = transaction.TxStatusSuccess
@@ -107,11 +239,33 @@ func (ts *transactionSimulator) ProcessTx(tx *transaction.Transaction) (*txSimDa
 	vmOutput, ok := ts.getVMOutputOfTx(tx)
 	if ok {
 		results.VMOutput = vmOutput
+		if withTrace {
+			results.Trace = ts.callTraceBuilder.Build(createContractCallInput(tx), vmOutput)
+		}
 	}
 
 	return results, nil
 }
 
+func createContractCallInput(tx *transaction.Transaction) *vmcommon.ContractCallInput {
+	function := strings.Split(string(tx.Data), "@")[0]
+	if core.IsEmptyAddress(tx.RcvAddr) {
+		function = core.SCDeployInitFunctionName
+	}
+
+	return &vmcommon.ContractCallInput{
+		VMInput: vmcommon.VMInput{
+			CallerAddr:  tx.SndAddr,
+			CallValue:   tx.Value,
+			GasPrice:    tx.GasPrice,
+			GasProvided: tx.GasLimit,
+			CallType:    vm.DirectCall,
+		},
+		RecipientAddr: tx.RcvAddr,
+		Function:      function,
+	}
+}
+
 func (ts *transactionSimulator) getVMOutputOfTx(tx *transaction.Transaction) (*vmcommon.VMOutput, bool) {
 	txHash, err := core.CalculateHash(ts.marshalizer, ts.hasher, tx)
 	if err != nil {
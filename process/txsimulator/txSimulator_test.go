@@ -3,6 +3,7 @@ package txsimulator
 import (
 	"encoding/hex"
 	"errors"
+	"math/big"
 	"sync"
 	"testing"
 	"time"
@@ -13,12 +14,14 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/receipt"
 	"github.com/multiversx/mx-chain-core-go/data/smartContractResult"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/process"
 	"github.com/multiversx/mx-chain-go/process/mock"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/storage/txcache"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -93,6 +96,24 @@ func TestNewTransactionSimulator(t *testing.T) {
 			},
 			exError: ErrNilCacher,
 		},
+		{
+			name: "NilSimulationAccounts",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.SimulationAccounts = nil
+				return args
+			},
+			exError: ErrNilSimulationAccountsHandler,
+		},
+		{
+			name: "NilCallTraceBuilder",
+			argsFunc: func() ArgsTxSimulator {
+				args := getTxSimulatorArgs()
+				args.CallTraceBuilder = nil
+				return args
+			},
+			exError: ErrNilCallTraceBuilder,
+		},
 		{
 			name: "Ok",
 			argsFunc: func() ArgsTxSimulator {
@@ -124,7 +145,7 @@ func TestTransactionSimulator_ProcessTxProcessingErrShouldSignal(t *testing.T) {
 	}
 	ts, _ := NewTransactionSimulator(args)
 
-	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37})
+	results, err := ts.ProcessTx(&transaction.Transaction{Nonce: 37}, nil, false)
 	require.NoError(t, err)
 	require.Equal(t, expErr.Error(), results.FailReason)
 }
@@ -206,7 +227,7 @@ func TestTransactionSimulator_ProcessTxShouldIncludeScrsAndReceipts(t *testing.T
 	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
 	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{}, 0)
 
-	results, err := ts.ProcessTx(tx)
+	results, err := ts.ProcessTx(tx, nil, false)
 	require.NoError(t, err)
 	require.Equal(
 		t,
@@ -229,9 +250,87 @@ func getTxSimulatorArgs() ArgsTxSimulator {
 		VMOutputCacher:            txcache.NewDisabledCache(),
 		Marshalizer:               &mock.MarshalizerMock{},
 		Hasher:                    &hashingMocks.HasherMock{},
+		SimulationAccounts:        &stateMock.SimulationAccountsHandlerStub{},
+		CallTraceBuilder:          &testscommon.CallTraceBuilderStub{},
 	}
 }
 
+func TestTransactionSimulator_ProcessTxShouldIncludeTrace(t *testing.T) {
+	t.Parallel()
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageunit.NewCache(storageunit.CacheConfig{
+		Type:     storageunit.LRUCache,
+		Capacity: 100,
+	})
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+
+	tx := &transaction.Transaction{
+		SndAddr:  []byte("sender"),
+		RcvAddr:  []byte("receiver"),
+		Value:    big.NewInt(1),
+		Data:     []byte("function@01@02"),
+		GasLimit: 100,
+	}
+	vmOutput := &vmcommon.VMOutput{GasRemaining: 10}
+	expectedTrace := &common.CallTrace{GasUsed: 90}
+	numBuildCalls := 0
+	args.CallTraceBuilder = &testscommon.CallTraceBuilderStub{
+		BuildCalled: func(input *vmcommon.ContractCallInput, output *vmcommon.VMOutput) *common.CallTrace {
+			numBuildCalls++
+			require.Equal(t, tx.SndAddr, input.CallerAddr)
+			require.Equal(t, tx.RcvAddr, input.RecipientAddr)
+			require.Equal(t, tx.Value, input.CallValue)
+			require.Equal(t, tx.GasLimit, input.GasProvided)
+			require.Equal(t, "function", input.Function)
+			require.True(t, vmOutput == output)
+
+			return expectedTrace
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, tx)
+	args.VMOutputCacher.Put(txHash, vmOutput, 0)
+
+	results, err := ts.ProcessTx(tx, nil, false)
+	require.NoError(t, err)
+	require.Nil(t, results.Trace)
+	require.Equal(t, 0, numBuildCalls)
+
+	args.VMOutputCacher.Put(txHash, vmOutput, 0)
+	results, err = ts.ProcessTx(tx, nil, true)
+	require.NoError(t, err)
+	require.True(t, expectedTrace == results.Trace)
+	require.Equal(t, 1, numBuildCalls)
+}
+
+func TestCreateContractCallInput(t *testing.T) {
+	t.Parallel()
+
+	t.Run("deploy should use the init function", func(t *testing.T) {
+		t.Parallel()
+
+		input := createContractCallInput(&transaction.Transaction{
+			RcvAddr: make([]byte, 32),
+			Data:    []byte("0061736d@0500@0100"),
+		})
+		require.Equal(t, core.SCDeployInitFunctionName, input.Function)
+	})
+	t.Run("no data should have no function", func(t *testing.T) {
+		t.Parallel()
+
+		input := createContractCallInput(&transaction.Transaction{
+			RcvAddr: []byte("receiver"),
+		})
+		require.Empty(t, input.Function)
+	})
+}
+
 func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	t.Parallel()
 
@@ -254,7 +353,7 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	for i := 0; i < numCalls; i++ {
 		go func(idx int) {
 			time.Sleep(time.Millisecond * 10)
-			_, _ = txSimulator.ProcessTx(tx)
+			_, _ = txSimulator.ProcessTx(tx, nil, false)
 			wg.Done()
 		}(i)
 	}
@@ -262,3 +361,198 @@ func TestTransactionSimulator_ProcessTxConcurrentCalls(t *testing.T) {
 	wg.Wait()
 	assert.Equal(t, numCalls, numTransactionProcessorCalls)
 }
+
+func TestTransactionSimulator_ProcessTxWithStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	overrides := common.StateOverrides{"address": {Balance: big.NewInt(10)}}
+
+	t.Run("apply overrides fails should err", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		sessionEnded := false
+		args := getTxSimulatorArgs()
+		args.SimulationAccounts = &stateMock.SimulationAccountsHandlerStub{
+			ApplyStateOverridesCalled: func(_ common.StateOverrides) error {
+				return expectedErr
+			},
+			EndSessionCalled: func() {
+				sessionEnded = true
+			},
+		}
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.Fail(t, "should have not processed the transaction")
+				return vmcommon.Ok, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{}, overrides, false)
+		require.Nil(t, results)
+		require.Equal(t, expectedErr, err)
+		require.True(t, sessionEnded)
+	})
+	t.Run("should process within a session holding the overrides", func(t *testing.T) {
+		t.Parallel()
+
+		isSessionActive := false
+		var appliedOverrides common.StateOverrides
+		args := getTxSimulatorArgs()
+		args.SimulationAccounts = &stateMock.SimulationAccountsHandlerStub{
+			StartSessionCalled: func() {
+				isSessionActive = true
+			},
+			ApplyStateOverridesCalled: func(overrides common.StateOverrides) error {
+				appliedOverrides = overrides
+				return nil
+			},
+			EndSessionCalled: func() {
+				isSessionActive = false
+			},
+		}
+		args.TransactionProcessor = &testscommon.TxProcessorStub{
+			ProcessTransactionCalled: func(_ *transaction.Transaction) (vmcommon.ReturnCode, error) {
+				require.True(t, isSessionActive)
+				return vmcommon.Ok, nil
+			},
+		}
+		args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+			GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+				return &mock.IntermediateTransactionHandlerStub{}, nil
+			},
+		}
+		ts, _ := NewTransactionSimulator(args)
+
+		results, err := ts.ProcessTx(&transaction.Transaction{}, overrides, false)
+		require.NoError(t, err)
+		require.Equal(t, transaction.TxStatusSuccess, results.Status)
+		require.Equal(t, overrides, appliedOverrides)
+		require.False(t, isSessionActive)
+	})
+}
+
+func TestTransactionSimulator_ProcessBundleShouldRevertFailedTransaction(t *testing.T) {
+	t.Parallel()
+
+	journalLen := 0
+	revertedSnapshots := make([]int, 0)
+	args := getTxSimulatorArgs()
+	args.SimulationAccounts = &stateMock.SimulationAccountsHandlerStub{
+		JournalLenCalled: func() int {
+			return journalLen
+		},
+		RevertToSnapshotCalled: func(snapshot int) error {
+			revertedSnapshots = append(revertedSnapshots, snapshot)
+			journalLen = snapshot
+			return nil
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			journalLen += 2
+			if tx.Nonce == 1 {
+				return vmcommon.ExecutionFailed, errors.New("partially processed")
+			}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	results, err := ts.ProcessBundle([]*transaction.Transaction{{Nonce: 0}, {Nonce: 1}, {Nonce: 2}}, false)
+	require.NoError(t, err)
+	require.Equal(t, []int{2}, revertedSnapshots)
+	require.Equal(t, 4, journalLen)
+	require.Equal(t, transaction.TxStatusFail, results.Results[1].Status)
+	require.Equal(t, transaction.TxStatusSuccess, results.Results[2].Status)
+}
+
+func TestTransactionSimulator_ProcessBundleEmptyBundleShouldErr(t *testing.T) {
+	t.Parallel()
+
+	ts, _ := NewTransactionSimulator(getTxSimulatorArgs())
+
+	results, err := ts.ProcessBundle(nil, false)
+	require.Nil(t, results)
+	require.Equal(t, ErrEmptyTransactionsBundle, err)
+}
+
+func TestTransactionSimulator_ProcessBundleShouldProcessInOrderWithinSession(t *testing.T) {
+	t.Parallel()
+
+	sessionStarted := false
+	processedNonces := make([]uint64, 0)
+	sessionAccount := stateMock.NewAccountWrapMock([]byte("sender"))
+	sessionAccount.IncreaseNonce(2)
+	sessionAccount.Balance = big.NewInt(10)
+
+	args := getTxSimulatorArgs()
+	args.VMOutputCacher, _ = storageunit.NewCache(storageunit.CacheConfig{
+		Type:     storageunit.LRUCache,
+		Capacity: 100,
+	})
+	args.SimulationAccounts = &stateMock.SimulationAccountsHandlerStub{
+		StartSessionCalled: func() {
+			sessionStarted = true
+		},
+		SessionAccountsCalled: func() []vmcommon.AccountHandler {
+			return []vmcommon.AccountHandler{sessionAccount}
+		},
+		EndSessionCalled: func() {
+			sessionStarted = false
+		},
+	}
+	args.TransactionProcessor = &testscommon.TxProcessorStub{
+		ProcessTransactionCalled: func(tx *transaction.Transaction) (vmcommon.ReturnCode, error) {
+			require.True(t, sessionStarted)
+			processedNonces = append(processedNonces, tx.Nonce)
+			if tx.Nonce == 1 {
+				return vmcommon.UserError, nil
+			}
+
+			return vmcommon.Ok, nil
+		},
+	}
+	args.IntermediateProcContainer = &mock.IntermProcessorContainerStub{
+		GetCalled: func(key block.Type) (process.IntermediateTransactionHandler, error) {
+			return &mock.IntermediateTransactionHandlerStub{}, nil
+		},
+	}
+	ts, _ := NewTransactionSimulator(args)
+
+	txs := []*transaction.Transaction{{Nonce: 0}, {Nonce: 1}}
+	txHash, _ := core.CalculateHash(args.Marshalizer, args.Hasher, txs[0])
+	args.VMOutputCacher.Put(txHash, &vmcommon.VMOutput{
+		OutputAccounts: map[string]*vmcommon.OutputAccount{
+			"contract": {
+				StorageUpdates: map[string]*vmcommon.StorageUpdate{
+					"key": {Offset: []byte("key"), Data: []byte("value")},
+				},
+			},
+		},
+	}, 0)
+
+	results, err := ts.ProcessBundle(txs, false)
+	require.NoError(t, err)
+	require.False(t, sessionStarted)
+	require.Equal(t, []uint64{0, 1}, processedNonces)
+	require.Len(t, results.Results, 2)
+	require.Equal(t, transaction.TxStatusSuccess, results.Results[0].Status)
+	require.Equal(t, transaction.TxStatusPending, results.Results[1].Status)
+
+	require.Len(t, results.AccountChanges, 2)
+	require.Equal(t, hex.EncodeToString([]byte("sender")), results.AccountChanges[0].Address)
+	require.Equal(t, uint64(2), results.AccountChanges[0].Nonce)
+	require.Equal(t, "10", results.AccountChanges[0].Balance)
+	require.Equal(t, hex.EncodeToString([]byte("contract")), results.AccountChanges[1].Address)
+	require.Equal(t, map[string]string{
+		hex.EncodeToString([]byte("key")): hex.EncodeToString([]byte("value")),
+	}, results.AccountChanges[1].StorageUpdates)
+}
@@ -2,25 +2,243 @@ package txsimulator
 
 import (
 	"context"
+	"errors"
+	"sort"
+	"sync"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 )
 
+// sessionJournalEntry holds the session state of an account before it was saved or removed, so it can be restored
+type sessionJournalEntry struct {
+	address         []byte
+	previousAccount vmcommon.AccountHandler
+	hadPrevious     bool
+}
+
 // readOnlyAccountsDB is a wrapper over an accounts db which works read-only. write operation are disabled
+// While a session is active, the saved accounts are kept in memory so the subsequent loads will see them. The session
+// changes are journaled, so a failed transaction of a bundle can revert its changes through RevertToSnapshot.
+// While a historical session is active, the reads are redirected towards the state of a past block.
 type readOnlyAccountsDB struct {
-	originalAccounts state.AccountsAdapter
+	originalAccounts   state.AccountsAdapter
+	accountsRepository state.AccountsRepository
+	hasher             hashing.Hasher
+
+	mutSession        sync.RWMutex
+	isSessionActive   bool
+	sessionAccounts   map[string]vmcommon.AccountHandler
+	sessionCodes      map[string][]byte
+	sessionSavedOrder [][]byte
+	sessionJournal    []sessionJournalEntry
+	historicalState   common.RootHashHolder
 }
 
 // NewReadOnlyAccountsDB returns a new instance of readOnlyAccountsDB
-func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter) (*readOnlyAccountsDB, error) {
+func NewReadOnlyAccountsDB(accountsDB state.AccountsAdapter, hasher hashing.Hasher) (*readOnlyAccountsDB, error) {
 	if check.IfNil(accountsDB) {
 		return nil, ErrNilAccountsAdapter
 	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &readOnlyAccountsDB{
+		originalAccounts: accountsDB,
+		hasher:           hasher,
+		sessionAccounts:  make(map[string]vmcommon.AccountHandler),
+		sessionCodes:     make(map[string][]byte),
+	}, nil
+}
+
+// NewReadOnlyAccountsDBWithHistory returns a new instance of readOnlyAccountsDB which is also able to read the state of
+// past blocks, through the historical accounts wrapper of the provided accounts repository
+func NewReadOnlyAccountsDBWithHistory(
+	accountsDB state.AccountsAdapter,
+	accountsRepository state.AccountsRepository,
+	hasher hashing.Hasher,
+) (*readOnlyAccountsDB, error) {
+	if check.IfNil(accountsRepository) {
+		return nil, ErrNilAccountsRepository
+	}
+
+	readOnlyAccounts, err := NewReadOnlyAccountsDB(accountsDB, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	readOnlyAccounts.accountsRepository = accountsRepository
+
+	return readOnlyAccounts, nil
+}
+
+// StartHistoricalSession will redirect all the reads towards the state identified by the provided root hash holder,
+// until EndHistoricalSession is called
+func (r *readOnlyAccountsDB) StartHistoricalSession(options common.RootHashHolder) error {
+	if check.IfNil(r.accountsRepository) {
+		return ErrHistoricalStateNotSupported
+	}
+	if check.IfNil(options) {
+		return ErrNilRootHashHolder
+	}
+
+	r.mutSession.Lock()
+	r.historicalState = options
+	r.mutSession.Unlock()
+
+	return nil
+}
+
+// EndHistoricalSession will redirect the reads back towards the original accounts
+func (r *readOnlyAccountsDB) EndHistoricalSession() {
+	r.mutSession.Lock()
+	r.historicalState = nil
+	r.mutSession.Unlock()
+}
+
+func (r *readOnlyAccountsDB) getHistoricalQueryOptions() (api.AccountQueryOptions, bool) {
+	r.mutSession.RLock()
+	defer r.mutSession.RUnlock()
+
+	if check.IfNil(r.historicalState) {
+		return api.AccountQueryOptions{}, false
+	}
+
+	options := api.AccountQueryOptions{
+		BlockRootHash: r.historicalState.GetRootHash(),
+		HintEpoch:     r.historicalState.GetEpoch(),
+	}
+
+	return options, true
+}
+
+func (r *readOnlyAccountsDB) getHistoricalAccount(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, error) {
+	account, _, err := r.accountsRepository.GetAccountWithBlockInfo(address, options)
+	if err != nil {
+		var errAccountNotFound *state.ErrAccountNotFoundAtBlock
+		if errors.As(err, &errAccountNotFound) {
+			return nil, state.ErrAccNotFound
+		}
+
+		return nil, err
+	}
+
+	return account, nil
+}
+
+// StartSession will keep in memory all the accounts saved from now on, until EndSession is called
+func (r *readOnlyAccountsDB) StartSession() {
+	r.mutSession.Lock()
+	r.isSessionActive = true
+	r.sessionAccounts = make(map[string]vmcommon.AccountHandler)
+	r.sessionCodes = make(map[string][]byte)
+	r.sessionSavedOrder = make([][]byte, 0)
+	r.sessionJournal = make([]sessionJournalEntry, 0)
+	r.mutSession.Unlock()
+}
+
+// ApplyStateOverrides will load the overridden accounts, will replace their fields and will keep them in the current
+// session. The original accounts are never written
+func (r *readOnlyAccountsDB) ApplyStateOverrides(overrides common.StateOverrides) error {
+	r.mutSession.RLock()
+	isSessionActive := r.isSessionActive
+	r.mutSession.RUnlock()
+	if !isSessionActive {
+		return ErrNoActiveSession
+	}
+
+	addresses := make([]string, 0, len(overrides))
+	for address := range overrides {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		account, err := r.LoadAccount([]byte(address))
+		if err != nil {
+			return err
+		}
+
+		err = state.ApplyAccountOverride(account, overrides[address])
+		if err != nil {
+			return err
+		}
+
+		err = r.SaveAccount(account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SessionAccounts returns the accounts saved during the current session, in the order they were first saved
+func (r *readOnlyAccountsDB) SessionAccounts() []vmcommon.AccountHandler {
+	r.mutSession.RLock()
+	defer r.mutSession.RUnlock()
+
+	accounts := make([]vmcommon.AccountHandler, 0, len(r.sessionSavedOrder))
+	for _, address := range r.sessionSavedOrder {
+		account, found := r.sessionAccounts[string(address)]
+		if !found || check.IfNil(account) {
+			continue
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts
+}
+
+// EndSession will drop the accounts kept in memory and will stop keeping the saved accounts
+func (r *readOnlyAccountsDB) EndSession() {
+	r.mutSession.Lock()
+	r.isSessionActive = false
+	r.sessionAccounts = make(map[string]vmcommon.AccountHandler)
+	r.sessionCodes = make(map[string][]byte)
+	r.sessionSavedOrder = nil
+	r.sessionJournal = nil
+	r.mutSession.Unlock()
+}
+
+// getSessionAccount returns a copy of the account saved in the current session, so the changes done on it are not
+// visible until it is saved again. A nil account is returned, along with true, for an account removed in the session
+func (r *readOnlyAccountsDB) getSessionAccount(address []byte) (vmcommon.AccountHandler, bool) {
+	r.mutSession.RLock()
+	defer r.mutSession.RUnlock()
+
+	if !r.isSessionActive {
+		return nil, false
+	}
 
-	return &readOnlyAccountsDB{originalAccounts: accountsDB}, nil
+	account, found := r.sessionAccounts[string(address)]
+	if !found || check.IfNil(account) {
+		return nil, found
+	}
+
+	return state.CloneAccount(account), true
+}
+
+// setSessionAccount records the previous session state of the address in the journal and then sets the new one.
+// A nil account marks the address as removed in the current session. Should be called under mutex protection
+func (r *readOnlyAccountsDB) setSessionAccount(address []byte, account vmcommon.AccountHandler) {
+	previousAccount, found := r.sessionAccounts[string(address)]
+	r.sessionJournal = append(r.sessionJournal, sessionJournalEntry{
+		address:         address,
+		previousAccount: previousAccount,
+		hadPrevious:     found,
+	})
+	if !found {
+		r.sessionSavedOrder = append(r.sessionSavedOrder, address)
+	}
+
+	r.sessionAccounts[string(address)] = account
 }
 
 // SetSyncer returns nil for this implementation
@@ -33,13 +251,45 @@ func (r *readOnlyAccountsDB) StartSnapshotIfNeeded() error {
 	return nil
 }
 
-// GetCode returns the code for the given account
+// GetCode returns the code for the given code hash, searching first in the codes saved in the current session
 func (r *readOnlyAccountsDB) GetCode(codeHash []byte) []byte {
+	r.mutSession.RLock()
+	code, found := r.sessionCodes[string(codeHash)]
+	r.mutSession.RUnlock()
+	if found {
+		return code
+	}
+
+	options, isHistorical := r.getHistoricalQueryOptions()
+	if isHistorical {
+		code, _, err := r.accountsRepository.GetCodeWithBlockInfo(codeHash, options)
+		if err != nil {
+			return nil
+		}
+
+		return code
+	}
+
 	return r.originalAccounts.GetCode(codeHash)
 }
 
-// GetExistingAccount will call the original accounts' function with the same name
+// GetExistingAccount will return the account saved in the current session, if any, or will call the original
+// accounts' function with the same name
 func (r *readOnlyAccountsDB) GetExistingAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, found := r.getSessionAccount(address)
+	if found {
+		if check.IfNil(account) {
+			return nil, state.ErrAccNotFound
+		}
+
+		return account, nil
+	}
+
+	options, isHistorical := r.getHistoricalQueryOptions()
+	if isHistorical {
+		return r.getHistoricalAccount(address, options)
+	}
+
 	return r.originalAccounts.GetExistingAccount(address)
 }
 
@@ -48,18 +298,66 @@ func (r *readOnlyAccountsDB) GetAccountFromBytes(address []byte, accountBytes []
 	return r.originalAccounts.GetAccountFromBytes(address, accountBytes)
 }
 
-// LoadAccount will call the original accounts' function with the same name
+// LoadAccount will return the account saved in the current session, if any, or will call the original
+// accounts' function with the same name
 func (r *readOnlyAccountsDB) LoadAccount(address []byte) (vmcommon.AccountHandler, error) {
+	account, found := r.getSessionAccount(address)
+	if found {
+		if check.IfNil(account) {
+			return state.NewUserAccount(address)
+		}
+
+		return account, nil
+	}
+
+	options, isHistorical := r.getHistoricalQueryOptions()
+	if isHistorical {
+		account, err := r.getHistoricalAccount(address, options)
+		if err == state.ErrAccNotFound {
+			return state.NewUserAccount(address)
+		}
+
+		return account, err
+	}
+
 	return r.originalAccounts.LoadAccount(address)
 }
 
-// SaveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) SaveAccount(_ vmcommon.AccountHandler) error {
+// SaveAccount will only keep the account in memory if a session is active, as write operations are disabled on this component
+func (r *readOnlyAccountsDB) SaveAccount(account vmcommon.AccountHandler) error {
+	if check.IfNil(account) {
+		return nil
+	}
+
+	r.mutSession.Lock()
+	defer r.mutSession.Unlock()
+
+	if !r.isSessionActive {
+		return nil
+	}
+
+	newCodeHash, newCode, hasNewCode := state.SetNewCodeHashInMemory(account, r.hasher)
+	if hasNewCode && len(newCode) > 0 {
+		r.sessionCodes[string(newCodeHash)] = newCode
+	}
+
+	r.setSessionAccount(account.AddressBytes(), state.CloneAccount(account))
+
 	return nil
 }
 
-// RemoveAccount won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RemoveAccount(_ []byte) error {
+// RemoveAccount will only mark the account as removed in memory if a session is active, as write operations are disabled
+// on this component
+func (r *readOnlyAccountsDB) RemoveAccount(address []byte) error {
+	r.mutSession.Lock()
+	defer r.mutSession.Unlock()
+
+	if !r.isSessionActive {
+		return nil
+	}
+
+	r.setSessionAccount(address, nil)
+
 	return nil
 }
 
@@ -68,18 +366,56 @@ func (r *readOnlyAccountsDB) Commit() ([]byte, error) {
 	return nil, nil
 }
 
-// JournalLen will call the original accounts' function with the same name
+// JournalLen will return the number of changes done in the current session, if a session is active, or will call the
+// original accounts' function with the same name
 func (r *readOnlyAccountsDB) JournalLen() int {
+	r.mutSession.RLock()
+	defer r.mutSession.RUnlock()
+
+	if r.isSessionActive {
+		return len(r.sessionJournal)
+	}
+
 	return r.originalAccounts.JournalLen()
 }
 
-// RevertToSnapshot won't do anything as write operations are disabled on this component
-func (r *readOnlyAccountsDB) RevertToSnapshot(_ int) error {
+// RevertToSnapshot will revert the changes done in the current session after the provided snapshot, if a session is
+// active. Otherwise, it won't do anything as write operations are disabled on this component
+func (r *readOnlyAccountsDB) RevertToSnapshot(snapshot int) error {
+	r.mutSession.Lock()
+	defer r.mutSession.Unlock()
+
+	if !r.isSessionActive {
+		return nil
+	}
+	if snapshot < 0 || snapshot > len(r.sessionJournal) {
+		return state.ErrSnapshotValueOutOfBounds
+	}
+
+	for idx := len(r.sessionJournal) - 1; idx >= snapshot; idx-- {
+		entry := r.sessionJournal[idx]
+		if entry.hadPrevious {
+			r.sessionAccounts[string(entry.address)] = entry.previousAccount
+			continue
+		}
+
+		// the address was saved for the first time by this entry, so it is the last one in the saved order
+		delete(r.sessionAccounts, string(entry.address))
+		r.sessionSavedOrder = r.sessionSavedOrder[:len(r.sessionSavedOrder)-1]
+	}
+	r.sessionJournal = r.sessionJournal[:snapshot]
+
 	return nil
 }
 
-// RootHash will call the original accounts' function with the same name
+// RootHash will return the root hash of the historical state, if a historical session is active, or will call the
+// original accounts' function with the same name
 func (r *readOnlyAccountsDB) RootHash() ([]byte, error) {
+	options, isHistorical := r.getHistoricalQueryOptions()
+	if isHistorical {
+		return options.BlockRootHash, nil
+	}
+
 	return r.originalAccounts.RootHash()
 }
 
@@ -2,13 +2,17 @@ package txsimulator
 
 import (
 	"context"
+	"math/big"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/common/errChan"
+	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
 	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
 	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
 	"github.com/stretchr/testify/require"
@@ -17,19 +21,53 @@ import (
 func TestNewReadOnlyAccountsDB_NilOriginalAccountsDBShouldErr(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(nil)
+	roAccDb, err := NewReadOnlyAccountsDB(nil, &hashingMocks.HasherMock{})
 	require.True(t, check.IfNil(roAccDb))
 	require.Equal(t, ErrNilAccountsAdapter, err)
 }
 
+func TestNewReadOnlyAccountsDB_NilHasherShouldErr(t *testing.T) {
+	t.Parallel()
+
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, nil)
+	require.True(t, check.IfNil(roAccDb))
+	require.Equal(t, ErrNilHasher, err)
+}
+
 func TestNewReadOnlyAccountsDB(t *testing.T) {
 	t.Parallel()
 
-	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{})
+	roAccDb, err := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &hashingMocks.HasherMock{})
 	require.False(t, check.IfNil(roAccDb))
 	require.NoError(t, err)
 }
 
+func TestNewReadOnlyAccountsDBWithHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, err := NewReadOnlyAccountsDBWithHistory(&stateMock.AccountsStub{}, nil, &hashingMocks.HasherMock{})
+		require.True(t, check.IfNil(roAccDb))
+		require.Equal(t, ErrNilAccountsRepository, err)
+	})
+	t.Run("nil original accounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, err := NewReadOnlyAccountsDBWithHistory(nil, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
+		require.True(t, check.IfNil(roAccDb))
+		require.Equal(t, ErrNilAccountsAdapter, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, err := NewReadOnlyAccountsDBWithHistory(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
+		require.False(t, check.IfNil(roAccDb))
+		require.Nil(t, err)
+	})
+}
+
 func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 	t.Parallel()
 
@@ -73,7 +111,7 @@ func TestReadOnlyAccountsDB_WriteOperationsShouldNotCalled(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &hashingMocks.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	err := roAccDb.SaveAccount(nil)
@@ -128,7 +166,7 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 		},
 	}
 
-	roAccDb, _ := NewReadOnlyAccountsDB(accDb)
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &hashingMocks.HasherMock{})
 	require.NotNil(t, roAccDb)
 
 	actualAcc, err := roAccDb.GetExistingAccount(nil)
@@ -159,3 +197,286 @@ func TestReadOnlyAccountsDB_ReadOperationsShouldWork(t *testing.T) {
 	err = allLeaves.ErrChan.ReadFromChanNonBlocking()
 	require.NoError(t, err)
 }
+
+func TestReadOnlyAccountsDB_SessionShouldKeepSavedAccounts(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("address")
+	originalAccount := stateMock.NewAccountWrapMock(addr)
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return originalAccount, nil
+		},
+		GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+			return originalAccount, nil
+		},
+		SaveAccountCalled: func(_ vmcommon.AccountHandler) error {
+			require.Fail(t, "should have not called SaveAccount on the original accounts")
+			return nil
+		},
+	}
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, &hashingMocks.HasherMock{})
+
+	savedAccount := stateMock.NewAccountWrapMock(addr)
+	savedAccount.IncreaseNonce(5)
+
+	// saving outside a session should not keep the account
+	_ = roAccDb.SaveAccount(savedAccount)
+	acc, _ := roAccDb.LoadAccount(addr)
+	require.True(t, acc == originalAccount)
+	require.Empty(t, roAccDb.SessionAccounts())
+
+	roAccDb.StartSession()
+	_ = roAccDb.SaveAccount(savedAccount)
+
+	acc, _ = roAccDb.LoadAccount(addr)
+	require.True(t, acc == savedAccount)
+	acc, _ = roAccDb.GetExistingAccount(addr)
+	require.True(t, acc == savedAccount)
+	require.Equal(t, []vmcommon.AccountHandler{savedAccount}, roAccDb.SessionAccounts())
+
+	roAccDb.EndSession()
+	acc, _ = roAccDb.LoadAccount(addr)
+	require.True(t, acc == originalAccount)
+	require.Empty(t, roAccDb.SessionAccounts())
+}
+
+func TestReadOnlyAccountsDB_SessionJournal(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("address")
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(address)
+			_ = acc.AddToBalance(big.NewInt(100))
+			return acc, nil
+		},
+		GetExistingAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			acc, _ := state.NewUserAccount(address)
+			_ = acc.AddToBalance(big.NewInt(100))
+			return acc, nil
+		},
+		JournalLenCalled: func() int {
+			require.Fail(t, "should have not called JournalLen on the original accounts during a session")
+			return 0
+		},
+	}
+
+	t.Run("loaded accounts should be copies of the session accounts", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDB(accDb, &hashingMocks.HasherMock{})
+		roAccDb.StartSession()
+
+		acc, _ := roAccDb.LoadAccount(addr)
+		_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+		_ = roAccDb.SaveAccount(acc)
+
+		// changes done on a loaded account without saving it should not be visible
+		acc, _ = roAccDb.LoadAccount(addr)
+		_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(1000))
+		acc, _ = roAccDb.LoadAccount(addr)
+		require.Equal(t, big.NewInt(110), acc.(state.UserAccountHandler).GetBalance())
+	})
+	t.Run("revert to snapshot should restore the session accounts", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDB(accDb, &hashingMocks.HasherMock{})
+		roAccDb.StartSession()
+		require.Equal(t, 0, roAccDb.JournalLen())
+
+		acc, _ := roAccDb.LoadAccount(addr)
+		_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(10))
+		_ = roAccDb.SaveAccount(acc)
+		snapshot := roAccDb.JournalLen()
+		require.Equal(t, 1, snapshot)
+
+		acc, _ = roAccDb.LoadAccount(addr)
+		_ = acc.(state.UserAccountHandler).AddToBalance(big.NewInt(20))
+		_ = roAccDb.SaveAccount(acc)
+		newAcc, _ := state.NewUserAccount([]byte("new address"))
+		_ = roAccDb.SaveAccount(newAcc)
+		require.Equal(t, 3, roAccDb.JournalLen())
+		require.Len(t, roAccDb.SessionAccounts(), 2)
+
+		err := roAccDb.RevertToSnapshot(4)
+		require.Equal(t, state.ErrSnapshotValueOutOfBounds, err)
+
+		err = roAccDb.RevertToSnapshot(snapshot)
+		require.NoError(t, err)
+		require.Equal(t, snapshot, roAccDb.JournalLen())
+		acc, _ = roAccDb.LoadAccount(addr)
+		require.Equal(t, big.NewInt(110), acc.(state.UserAccountHandler).GetBalance())
+		require.Len(t, roAccDb.SessionAccounts(), 1)
+
+		err = roAccDb.RevertToSnapshot(0)
+		require.NoError(t, err)
+		acc, _ = roAccDb.LoadAccount(addr)
+		require.Equal(t, big.NewInt(100), acc.(state.UserAccountHandler).GetBalance())
+		require.Empty(t, roAccDb.SessionAccounts())
+	})
+	t.Run("removed account should not fall back to the original account", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDB(accDb, &hashingMocks.HasherMock{})
+		roAccDb.StartSession()
+
+		acc, _ := roAccDb.LoadAccount(addr)
+		_ = roAccDb.SaveAccount(acc)
+		snapshot := roAccDb.JournalLen()
+
+		err := roAccDb.RemoveAccount(addr)
+		require.NoError(t, err)
+		acc, err = roAccDb.GetExistingAccount(addr)
+		require.Nil(t, acc)
+		require.Equal(t, state.ErrAccNotFound, err)
+		acc, _ = roAccDb.LoadAccount(addr)
+		require.Equal(t, big.NewInt(0), acc.(state.UserAccountHandler).GetBalance())
+		require.Empty(t, roAccDb.SessionAccounts())
+
+		err = roAccDb.RevertToSnapshot(snapshot)
+		require.NoError(t, err)
+		acc, err = roAccDb.GetExistingAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(100), acc.(state.UserAccountHandler).GetBalance())
+	})
+}
+
+func TestReadOnlyAccountsDB_ApplyStateOverrides(t *testing.T) {
+	t.Parallel()
+
+	addr := []byte("address")
+	accDb := &stateMock.AccountsStub{
+		LoadAccountCalled: func(address []byte) (vmcommon.AccountHandler, error) {
+			return state.NewUserAccount(address)
+		},
+		GetCodeCalled: func(_ []byte) []byte {
+			require.Fail(t, "should have not called GetCode on the original accounts")
+			return nil
+		},
+	}
+	hasher := &hashingMocks.HasherMock{}
+	roAccDb, _ := NewReadOnlyAccountsDB(accDb, hasher)
+
+	nonce := uint64(37)
+	code := []byte("overridden code")
+	overrides := common.StateOverrides{
+		string(addr): {
+			Balance: big.NewInt(1000),
+			Nonce:   &nonce,
+			Code:    code,
+			Storage: map[string][]byte{"key": []byte("value")},
+		},
+	}
+
+	err := roAccDb.ApplyStateOverrides(overrides)
+	require.Equal(t, ErrNoActiveSession, err)
+
+	roAccDb.StartSession()
+	err = roAccDb.ApplyStateOverrides(overrides)
+	require.NoError(t, err)
+
+	acc, err := roAccDb.GetExistingAccount(addr)
+	require.NoError(t, err)
+	userAcc := acc.(state.UserAccountHandler)
+	require.Equal(t, big.NewInt(1000), userAcc.GetBalance())
+	require.Equal(t, nonce, userAcc.GetNonce())
+	require.Equal(t, hasher.Compute(string(code)), userAcc.GetCodeHash())
+	require.Equal(t, code, roAccDb.GetCode(userAcc.GetCodeHash()))
+	value, _, err := userAcc.RetrieveValue([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	roAccDb.EndSession()
+	acc, _ = roAccDb.LoadAccount(addr)
+	require.Equal(t, uint64(0), acc.GetNonce())
+}
+
+func TestReadOnlyAccountsDB_HistoricalSession(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without accounts repository should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDB(&stateMock.AccountsStub{}, &hashingMocks.HasherMock{})
+		err := roAccDb.StartHistoricalSession(holders.NewRootHashHolder([]byte("root hash"), core.OptionalUint32{}))
+		require.Equal(t, ErrHistoricalStateNotSupported, err)
+	})
+	t.Run("nil root hash holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		roAccDb, _ := NewReadOnlyAccountsDBWithHistory(&stateMock.AccountsStub{}, &stateMock.AccountsRepositoryStub{}, &hashingMocks.HasherMock{})
+		err := roAccDb.StartHistoricalSession(nil)
+		require.Equal(t, ErrNilRootHashHolder, err)
+	})
+	t.Run("should read from the historical state", func(t *testing.T) {
+		t.Parallel()
+
+		addr := []byte("address")
+		missingAddr := []byte("missing address")
+		originalAccount := stateMock.NewAccountWrapMock(addr)
+		historicalAccount := stateMock.NewAccountWrapMock(addr)
+		rootHashHolder := holders.NewRootHashHolder([]byte("historical root hash"), core.OptionalUint32{Value: 2, HasValue: true})
+		accDb := &stateMock.AccountsStub{
+			LoadAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return originalAccount, nil
+			},
+			GetExistingAccountCalled: func(_ []byte) (vmcommon.AccountHandler, error) {
+				return originalAccount, nil
+			},
+			GetCodeCalled: func(_ []byte) []byte {
+				return []byte("original code")
+			},
+			RootHashCalled: func() ([]byte, error) {
+				return []byte("original root hash"), nil
+			},
+		}
+		checkOptions := func(options api.AccountQueryOptions) {
+			require.Equal(t, []byte("historical root hash"), options.BlockRootHash)
+			require.Equal(t, core.OptionalUint32{Value: 2, HasValue: true}, options.HintEpoch)
+		}
+		accountsRepository := &stateMock.AccountsRepositoryStub{
+			GetAccountWithBlockInfoCalled: func(address []byte, options api.AccountQueryOptions) (vmcommon.AccountHandler, common.BlockInfo, error) {
+				checkOptions(options)
+				if string(address) == string(missingAddr) {
+					return nil, nil, state.NewErrAccountNotFoundAtBlock(holders.NewBlockInfo(nil, 0, options.BlockRootHash))
+				}
+				return historicalAccount, nil, nil
+			},
+			GetCodeWithBlockInfoCalled: func(_ []byte, options api.AccountQueryOptions) ([]byte, common.BlockInfo, error) {
+				checkOptions(options)
+				return []byte("historical code"), nil, nil
+			},
+		}
+		roAccDb, _ := NewReadOnlyAccountsDBWithHistory(accDb, accountsRepository, &hashingMocks.HasherMock{})
+
+		err := roAccDb.StartHistoricalSession(rootHashHolder)
+		require.Nil(t, err)
+
+		acc, err := roAccDb.LoadAccount(addr)
+		require.Nil(t, err)
+		require.True(t, acc == historicalAccount)
+		acc, err = roAccDb.GetExistingAccount(addr)
+		require.Nil(t, err)
+		require.True(t, acc == historicalAccount)
+		acc, err = roAccDb.GetExistingAccount(missingAddr)
+		require.Nil(t, acc)
+		require.Equal(t, state.ErrAccNotFound, err)
+		acc, err = roAccDb.LoadAccount(missingAddr)
+		require.Nil(t, err)
+		require.Equal(t, missingAddr, acc.AddressBytes())
+		require.Equal(t, []byte("historical code"), roAccDb.GetCode([]byte("code hash")))
+		rootHash, _ := roAccDb.RootHash()
+		require.Equal(t, []byte("historical root hash"), rootHash)
+
+		roAccDb.EndHistoricalSession()
+
+		acc, _ = roAccDb.LoadAccount(addr)
+		require.True(t, acc == originalAccount)
+		acc, _ = roAccDb.GetExistingAccount(addr)
+		require.True(t, acc == originalAccount)
+		require.Equal(t, []byte("original code"), roAccDb.GetCode([]byte("code hash")))
+		rootHash, _ = roAccDb.RootHash()
+		require.Equal(t, []byte("original root hash"), rootHash)
+	})
+}
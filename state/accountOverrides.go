@@ -0,0 +1,134 @@
+package state
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-go/common"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// ApplyAccountOverride will replace the fields of the provided account with the ones set in the override. It should only
+// be called on accounts that will never be saved in a real accounts adapter, as the code hash is not updated here
+func ApplyAccountOverride(account vmcommon.AccountHandler, override *common.AccountOverride) error {
+	if check.IfNil(account) {
+		return ErrNilAccountHandler
+	}
+	if override == nil {
+		return nil
+	}
+
+	userAcc, ok := account.(*userAccount)
+	if !ok {
+		return ErrWrongTypeAssertion
+	}
+
+	if override.Balance != nil {
+		if override.Balance.Sign() < 0 {
+			return ErrNegativeValue
+		}
+		userAcc.Balance = big.NewInt(0).Set(override.Balance)
+	}
+	if override.Nonce != nil {
+		userAcc.Nonce = *override.Nonce
+	}
+	if len(override.Code) > 0 {
+		userAcc.SetCode(override.Code)
+	}
+	for key, value := range override.Storage {
+		err := userAcc.SaveKeyValue([]byte(key), value)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SetNewCodeHashInMemory will compute and set the code hash of an account holding new code, without saving the code
+// in any storer. It returns the new code hash, the new code and true if the account was holding new code
+func SetNewCodeHashInMemory(account vmcommon.AccountHandler, hasher hashing.Hasher) ([]byte, []byte, bool) {
+	userAcc, ok := account.(*userAccount)
+	if !ok || check.IfNil(hasher) {
+		return nil, nil, false
+	}
+	if !userAcc.HasNewCode() {
+		return nil, nil, false
+	}
+
+	var newCodeHash []byte
+	if len(userAcc.code) != 0 {
+		newCodeHash = hasher.Compute(string(userAcc.code))
+	}
+	userAcc.SetCodeHash(newCodeHash)
+	userAcc.hasNewCode = false
+
+	return newCodeHash, userAcc.code, true
+}
+
+// CloneAccount returns an in-memory copy of a user account, including the data trie changes not yet saved, so that
+// the copy can be changed without affecting the original account. The data trie itself is shared, as it is never
+// written while the changes are only kept in memory. Other account types are returned as they are
+func CloneAccount(account vmcommon.AccountHandler) vmcommon.AccountHandler {
+	userAcc, ok := account.(*userAccount)
+	if !ok || userAcc == nil {
+		return account
+	}
+
+	clonedAccount := &userAccount{
+		baseAccount: &baseAccount{
+			address:         userAcc.address,
+			code:            cloneBytes(userAcc.code),
+			dataTrieTracker: cloneDataTrieTracker(userAcc.dataTrieTracker),
+			hasNewCode:      userAcc.hasNewCode,
+		},
+		UserAccountData: UserAccountData{
+			Nonce:           userAcc.Nonce,
+			Balance:         cloneBigInt(userAcc.Balance),
+			CodeHash:        cloneBytes(userAcc.CodeHash),
+			RootHash:        cloneBytes(userAcc.RootHash),
+			Address:         userAcc.Address,
+			DeveloperReward: cloneBigInt(userAcc.DeveloperReward),
+			OwnerAddress:    cloneBytes(userAcc.OwnerAddress),
+			UserName:        cloneBytes(userAcc.UserName),
+			CodeMetadata:    cloneBytes(userAcc.CodeMetadata),
+		},
+	}
+
+	return clonedAccount
+}
+
+func cloneDataTrieTracker(tracker DataTrieTracker) DataTrieTracker {
+	tdt, ok := tracker.(*trackableDataTrie)
+	if !ok || tdt == nil {
+		return tracker
+	}
+
+	dirtyData := make(map[string][]byte, len(tdt.dirtyData))
+	for key, value := range tdt.dirtyData {
+		dirtyData[key] = cloneBytes(value)
+	}
+
+	return &trackableDataTrie{
+		dirtyData:  dirtyData,
+		tr:         tdt.tr,
+		identifier: tdt.identifier,
+	}
+}
+
+func cloneBigInt(value *big.Int) *big.Int {
+	if value == nil {
+		return nil
+	}
+
+	return big.NewInt(0).Set(value)
+}
+
+func cloneBytes(value []byte) []byte {
+	if value == nil {
+		return nil
+	}
+
+	return append(make([]byte, 0, len(value)), value...)
+}
@@ -0,0 +1,117 @@
+package state_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon/hashingMocks"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyAccountOverride(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil account should err", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.ApplyAccountOverride(nil, &common.AccountOverride{})
+		assert.Equal(t, state.ErrNilAccountHandler, err)
+	})
+	t.Run("wrong account type should err", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.ApplyAccountOverride(stateMock.NewAccountWrapMock([]byte("address")), &common.AccountOverride{})
+		assert.Equal(t, state.ErrWrongTypeAssertion, err)
+	})
+	t.Run("negative balance should err", func(t *testing.T) {
+		t.Parallel()
+
+		acc, _ := state.NewUserAccount([]byte("address"))
+		err := state.ApplyAccountOverride(acc, &common.AccountOverride{Balance: big.NewInt(-1)})
+		assert.Equal(t, state.ErrNegativeValue, err)
+	})
+	t.Run("should replace the fields", func(t *testing.T) {
+		t.Parallel()
+
+		acc, _ := state.NewUserAccount([]byte("address"))
+		acc.IncreaseNonce(100)
+		_ = acc.AddToBalance(big.NewInt(50))
+
+		nonce := uint64(7)
+		err := state.ApplyAccountOverride(acc, &common.AccountOverride{
+			Balance: big.NewInt(1000),
+			Nonce:   &nonce,
+			Code:    []byte("code"),
+			Storage: map[string][]byte{"key": []byte("value")},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, nonce, acc.GetNonce())
+		assert.Equal(t, big.NewInt(1000), acc.GetBalance())
+		assert.True(t, acc.HasNewCode())
+
+		value, _, err := acc.RetrieveValue([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+	})
+}
+
+func TestSetNewCodeHashInMemory(t *testing.T) {
+	t.Parallel()
+
+	hasher := &hashingMocks.HasherMock{}
+	acc, _ := state.NewUserAccount([]byte("address"))
+
+	codeHash, code, ok := state.SetNewCodeHashInMemory(acc, hasher)
+	assert.False(t, ok)
+	assert.Nil(t, codeHash)
+	assert.Nil(t, code)
+
+	acc.SetCode([]byte("code"))
+	codeHash, code, ok = state.SetNewCodeHashInMemory(acc, hasher)
+	assert.True(t, ok)
+	assert.Equal(t, hasher.Compute("code"), codeHash)
+	assert.Equal(t, codeHash, acc.GetCodeHash())
+	assert.Equal(t, []byte("code"), code)
+	assert.False(t, acc.HasNewCode())
+}
+
+func TestCloneAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("not a user account should return the same account", func(t *testing.T) {
+		t.Parallel()
+
+		acc := stateMock.NewAccountWrapMock([]byte("address"))
+		assert.True(t, acc == state.CloneAccount(acc))
+	})
+	t.Run("should clone user account", func(t *testing.T) {
+		t.Parallel()
+
+		acc, _ := state.NewUserAccount([]byte("address"))
+		_ = acc.AddToBalance(big.NewInt(100))
+		acc.IncreaseNonce(3)
+		_ = acc.SaveKeyValue([]byte("key"), []byte("value"))
+
+		cloned := state.CloneAccount(acc).(state.UserAccountHandler)
+		assert.False(t, cloned == acc)
+		assert.Equal(t, big.NewInt(100), cloned.GetBalance())
+		assert.Equal(t, uint64(3), cloned.GetNonce())
+		value, _, err := cloned.RetrieveValue([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+
+		_ = cloned.AddToBalance(big.NewInt(50))
+		cloned.IncreaseNonce(1)
+		_ = cloned.SaveKeyValue([]byte("key"), []byte("changed"))
+
+		assert.Equal(t, big.NewInt(100), acc.GetBalance())
+		assert.Equal(t, uint64(3), acc.GetNonce())
+		value, _, err = acc.RetrieveValue([]byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte("value"), value)
+	})
+}
@@ -0,0 +1,144 @@
+package state
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// AccountDiff holds the changes of an account between two states of the accounts trie. The old account is nil for
+// a created account, while the new account is nil for a removed one
+type AccountDiff struct {
+	Address         []byte
+	OldAccount      *UserAccountData
+	NewAccount      *UserAccountData
+	DataTrieChanges []*DataTrieChange
+}
+
+// DataTrieChange holds a changed key of an account's data trie. The old value is nil for an added key, while the new
+// value is nil for a removed key
+type DataTrieChange struct {
+	Key      []byte
+	OldValue []byte
+	NewValue []byte
+}
+
+// AccountDiffHandlerFunc is called for each changed account. Returning an error stops the diff
+type AccountDiffHandlerFunc func(diff *AccountDiff) error
+
+// GetAccountsDiff calls the handler for each account changed between the two root hashes of the accounts trie, in
+// the trie's traversal order, starting with the given address. Only the subtries with different hashes are loaded
+// from the storage, for the accounts trie as well as for the data tries
+func GetAccountsDiff(
+	accountsTrie common.Trie,
+	marshaller marshal.Marshalizer,
+	oldRootHash []byte,
+	newRootHash []byte,
+	startAddress []byte,
+	handler AccountDiffHandlerFunc,
+	ctx context.Context,
+) error {
+	if check.IfNil(accountsTrie) {
+		return ErrNilTrie
+	}
+	if check.IfNil(marshaller) {
+		return ErrNilMarshalizer
+	}
+	if handler == nil {
+		return ErrNilAccountDiffHandler
+	}
+
+	return accountsTrie.GetDiff(oldRootHash, newRootHash, startAddress, func(address []byte, oldValue []byte, newValue []byte) error {
+		diff, err := createAccountDiff(accountsTrie, marshaller, address, oldValue, newValue, ctx)
+		if err != nil {
+			return err
+		}
+
+		return handler(diff)
+	}, ctx)
+}
+
+func createAccountDiff(
+	accountsTrie common.Trie,
+	marshaller marshal.Marshalizer,
+	address []byte,
+	oldValue []byte,
+	newValue []byte,
+	ctx context.Context,
+) (*AccountDiff, error) {
+	oldAccount, err := unmarshalAccountData(marshaller, oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newAccount, err := unmarshalAccountData(marshaller, newValue)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &AccountDiff{
+		Address:         address,
+		OldAccount:      oldAccount,
+		NewAccount:      newAccount,
+		DataTrieChanges: make([]*DataTrieChange, 0),
+	}
+
+	oldDataTrieRootHash := getDataTrieRootHash(oldAccount)
+	newDataTrieRootHash := getDataTrieRootHash(newAccount)
+	if bytes.Equal(oldDataTrieRootHash, newDataTrieRootHash) {
+		return diff, nil
+	}
+
+	err = accountsTrie.GetDiff(oldDataTrieRootHash, newDataTrieRootHash, nil, func(key []byte, oldVal []byte, newVal []byte) error {
+		change := &DataTrieChange{Key: key}
+		change.OldValue, err = trimDataTrieValue(oldVal, key, address)
+		if err != nil {
+			return err
+		}
+		change.NewValue, err = trimDataTrieValue(newVal, key, address)
+		if err != nil {
+			return err
+		}
+
+		diff.DataTrieChanges = append(diff.DataTrieChanges, change)
+		return nil
+	}, ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return diff, nil
+}
+
+func unmarshalAccountData(marshaller marshal.Marshalizer, value []byte) (*UserAccountData, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	account := NewEmptyUserAccount()
+	err := marshaller.Unmarshal(account, value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account.UserAccountData, nil
+}
+
+func getDataTrieRootHash(account *UserAccountData) []byte {
+	if account == nil || common.IsEmptyTrie(account.RootHash) {
+		return nil
+	}
+
+	return account.RootHash
+}
+
+// trimDataTrieValue removes the key and the address appended to the values saved in the data tries
+func trimDataTrieValue(value []byte, key []byte, address []byte) ([]byte, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	return trimValue(value, len(key)+len(address))
+}
@@ -0,0 +1,138 @@
+package state_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func loadUserAccount(t *testing.T, adb *state.AccountsDB, address []byte) state.UserAccountHandler {
+	acc, err := adb.LoadAccount(address)
+	require.Nil(t, err)
+
+	return acc.(state.UserAccountHandler)
+}
+
+func TestGetAccountsDiff(t *testing.T) {
+	t.Parallel()
+
+	noopHandler := func(diff *state.AccountDiff) error {
+		return nil
+	}
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.GetAccountsDiff(nil, &testscommon.MarshalizerMock{}, nil, nil, nil, noopHandler, context.Background())
+		assert.Equal(t, state.ErrNilTrie, err)
+	})
+	t.Run("nil marshaller should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.GetAccountsDiff(&trieMock.TrieStub{}, nil, nil, nil, nil, noopHandler, context.Background())
+		assert.Equal(t, state.ErrNilMarshalizer, err)
+	})
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := state.GetAccountsDiff(&trieMock.TrieStub{}, &testscommon.MarshalizerMock{}, nil, nil, nil, nil, context.Background())
+		assert.Equal(t, state.ErrNilAccountDiffHandler, err)
+	})
+	t.Run("should return the changed accounts", func(t *testing.T) {
+		t.Parallel()
+
+		tr, adb := getDefaultTrieAndAccountsDb()
+		unchangedAddress := []byte("unchanged address")
+		modifiedAddress := []byte("modified address")
+		removedAddress := []byte("removed address")
+		createdAddress := []byte("created address")
+
+		for _, address := range [][]byte{unchangedAddress, modifiedAddress, removedAddress} {
+			acc := loadUserAccount(t, adb, address)
+			require.Nil(t, acc.AddToBalance(big.NewInt(10)))
+			require.Nil(t, acc.SaveKeyValue([]byte("key"), []byte("value")))
+			require.Nil(t, adb.SaveAccount(acc))
+		}
+		oldRootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		acc := loadUserAccount(t, adb, modifiedAddress)
+		require.Nil(t, acc.AddToBalance(big.NewInt(5)))
+		acc.IncreaseNonce(1)
+		require.Nil(t, acc.SaveKeyValue([]byte("key"), []byte("new value")))
+		require.Nil(t, acc.SaveKeyValue([]byte("added key"), []byte("added value")))
+		require.Nil(t, adb.SaveAccount(acc))
+
+		require.Nil(t, adb.RemoveAccount(removedAddress))
+
+		acc = loadUserAccount(t, adb, createdAddress)
+		require.Nil(t, acc.AddToBalance(big.NewInt(7)))
+		require.Nil(t, adb.SaveAccount(acc))
+
+		newRootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		diffs := make(map[string]*state.AccountDiff)
+		err = state.GetAccountsDiff(tr, &testscommon.MarshalizerMock{}, oldRootHash, newRootHash, nil, func(diff *state.AccountDiff) error {
+			diffs[string(diff.Address)] = diff
+			return nil
+		}, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, 3, len(diffs))
+
+		modified := diffs[string(modifiedAddress)]
+		require.NotNil(t, modified)
+		assert.Equal(t, big.NewInt(10), modified.OldAccount.Balance)
+		assert.Equal(t, big.NewInt(15), modified.NewAccount.Balance)
+		assert.Equal(t, uint64(0), modified.OldAccount.Nonce)
+		assert.Equal(t, uint64(1), modified.NewAccount.Nonce)
+		changes := make(map[string]*state.DataTrieChange)
+		for _, change := range modified.DataTrieChanges {
+			changes[string(change.Key)] = change
+		}
+		require.Equal(t, 2, len(changes))
+		assert.Equal(t, []byte("value"), changes["key"].OldValue)
+		assert.Equal(t, []byte("new value"), changes["key"].NewValue)
+		assert.Nil(t, changes["added key"].OldValue)
+		assert.Equal(t, []byte("added value"), changes["added key"].NewValue)
+
+		removed := diffs[string(removedAddress)]
+		require.NotNil(t, removed)
+		assert.Nil(t, removed.NewAccount)
+		assert.Equal(t, big.NewInt(10), removed.OldAccount.Balance)
+		require.Equal(t, 1, len(removed.DataTrieChanges))
+		assert.Equal(t, []byte("value"), removed.DataTrieChanges[0].OldValue)
+		assert.Nil(t, removed.DataTrieChanges[0].NewValue)
+
+		created := diffs[string(createdAddress)]
+		require.NotNil(t, created)
+		assert.Nil(t, created.OldAccount)
+		assert.Equal(t, big.NewInt(7), created.NewAccount.Balance)
+		assert.Equal(t, 0, len(created.DataTrieChanges))
+	})
+	t.Run("handler error should stop", func(t *testing.T) {
+		t.Parallel()
+
+		tr, adb := getDefaultTrieAndAccountsDb()
+		oldRootHash, _ := adb.RootHash()
+		_ = generateAccounts(t, 5, adb)
+		newRootHash, err := adb.Commit()
+		require.Nil(t, err)
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err = state.GetAccountsDiff(tr, &testscommon.MarshalizerMock{}, oldRootHash, newRootHash, nil, func(diff *state.AccountDiff) error {
+			numCalls++
+			return expectedErr
+		}, context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+}
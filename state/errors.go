@@ -147,3 +147,6 @@ var ErrNilSyncStatisticsHandler = errors.New("nil sync statistics handler")
 
 // ErrNilAddressConverter signals that a nil address converter was provided
 var ErrNilAddressConverter = errors.New("nil address converter")
+
+// ErrNilAccountDiffHandler signals that a nil account diff handler was provided
+var ErrNilAccountDiffHandler = errors.New("nil account diff handler")
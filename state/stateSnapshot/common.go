@@ -0,0 +1,140 @@
+package stateSnapshot
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/stateSnapshot")
+
+const (
+	// ManifestFileName is the name of the file that describes the snapshot
+	ManifestFileName = "manifest.json"
+	// CurrentVersion is the version of the snapshot format written by the exporter
+	CurrentVersion = 1
+
+	chunkFileNameFormat = "chunk_%06d.dat"
+	recordLengthSize    = 4
+	maxRecordFieldSize  = 64 * 1024 * 1024
+)
+
+// Manifest describes a state snapshot. It is written after all the chunks, so a snapshot without a manifest is
+// incomplete
+type Manifest struct {
+	Version      uint32       `json:"version"`
+	ShardID      uint32       `json:"shardId"`
+	Epoch        uint32       `json:"epoch"`
+	RootHash     string       `json:"rootHash"`
+	NumNodes     uint64       `json:"numNodes"`
+	NumDataTries uint64       `json:"numDataTries"`
+	Chunks       []*ChunkInfo `json:"chunks"`
+}
+
+// ChunkInfo describes a chunk file of a state snapshot. The checksum is the hex encoded sha256 of the file
+type ChunkInfo struct {
+	FileName string `json:"fileName"`
+	NumNodes uint64 `json:"numNodes"`
+	Size     uint64 `json:"size"`
+	Checksum string `json:"checksum"`
+}
+
+// walkStateTries walks the accounts trie and all the data tries referenced by its accounts, calling the handler for
+// each node. It returns the number of distinct data tries
+func walkStateTries(
+	rootHash []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler func(hash []byte, encodedNode []byte) error,
+) (uint64, error) {
+	dataTriesRootHashes := make([][]byte, 0)
+	seenDataTries := make(map[string]struct{})
+	err := trie.WalkTrieNodes(rootHash, db, marshalizer, hasher, func(hash []byte, encodedNode []byte, leafValue []byte) error {
+		if len(leafValue) > 0 {
+			account := state.NewEmptyUserAccount()
+			errUnmarshal := marshalizer.Unmarshal(account, leafValue)
+			_, seen := seenDataTries[string(account.RootHash)]
+			if errUnmarshal == nil && len(account.RootHash) > 0 && !seen {
+				seenDataTries[string(account.RootHash)] = struct{}{}
+				dataTriesRootHashes = append(dataTriesRootHashes, account.RootHash)
+			}
+		}
+
+		return handler(hash, encodedNode)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	log.Debug("accounts trie walked, walking the data tries", "num data tries", len(dataTriesRootHashes))
+
+	noLeafHandler := func(hash []byte, encodedNode []byte, _ []byte) error {
+		return handler(hash, encodedNode)
+	}
+	for _, dataTrieRootHash := range dataTriesRootHashes {
+		err = trie.WalkTrieNodes(dataTrieRootHash, db, marshalizer, hasher, noLeafHandler)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return uint64(len(dataTriesRootHashes)), nil
+}
+
+// a record holds a trie node, as the length prefixed node hash followed by the length prefixed encoded node
+func writeRecord(writer io.Writer, hash []byte, encodedNode []byte) (int, error) {
+	record := make([]byte, 2*recordLengthSize+len(hash)+len(encodedNode))
+	binary.BigEndian.PutUint32(record, uint32(len(hash)))
+	offset := recordLengthSize + copy(record[recordLengthSize:], hash)
+	binary.BigEndian.PutUint32(record[offset:], uint32(len(encodedNode)))
+	copy(record[offset+recordLengthSize:], encodedNode)
+
+	return writer.Write(record)
+}
+
+// readRecord returns io.EOF if the reader holds no more records
+func readRecord(reader io.Reader) ([]byte, []byte, error) {
+	hash, err := readRecordField(reader)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	encodedNode, err := readRecordField(reader)
+	if err == io.EOF {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return hash, encodedNode, nil
+}
+
+func readRecordField(reader io.Reader) ([]byte, error) {
+	lengthBytes := make([]byte, recordLengthSize)
+	_, err := io.ReadFull(reader, lengthBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	length := binary.BigEndian.Uint32(lengthBytes)
+	if length > maxRecordFieldSize {
+		return nil, fmt.Errorf("%w: record field of %d bytes", ErrCorruptedSnapshot, length)
+	}
+
+	field := make([]byte, length)
+	_, err = io.ReadFull(reader, field)
+	if err == io.EOF {
+		return nil, io.ErrUnexpectedEOF
+	}
+
+	return field, err
+}
@@ -0,0 +1,36 @@
+package stateSnapshot
+
+import "errors"
+
+// ErrNilDatabase signals that a nil database was provided
+var ErrNilDatabase = errors.New("nil database")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrEmptyDirectory signals that an empty directory path was provided
+var ErrEmptyDirectory = errors.New("empty directory")
+
+// ErrInvalidChunkSize signals that an invalid chunk size was provided
+var ErrInvalidChunkSize = errors.New("invalid chunk size")
+
+// ErrEmptyRootHash signals that an empty root hash was provided
+var ErrEmptyRootHash = errors.New("empty root hash")
+
+// ErrSnapshotAlreadyExists signals that the output directory already holds a snapshot
+var ErrSnapshotAlreadyExists = errors.New("snapshot already exists")
+
+// ErrUnsupportedVersion signals that the snapshot was written in an unsupported format version
+var ErrUnsupportedVersion = errors.New("unsupported snapshot version")
+
+// ErrShardMismatch signals that the snapshot belongs to another shard
+var ErrShardMismatch = errors.New("snapshot shard mismatch")
+
+// ErrRootHashMismatch signals that the snapshot was exported at another root hash
+var ErrRootHashMismatch = errors.New("snapshot root hash mismatch")
+
+// ErrCorruptedSnapshot signals that the snapshot files are corrupted or incomplete
+var ErrCorruptedSnapshot = errors.New("corrupted snapshot")
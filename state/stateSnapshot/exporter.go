@@ -0,0 +1,226 @@
+package stateSnapshot
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsSnapshotExporter defines the arguments needed for the snapshot exporter creation
+type ArgsSnapshotExporter struct {
+	DB              common.DBWriteCacher
+	Marshalizer     marshal.Marshalizer
+	Hasher          hashing.Hasher
+	OutputDirectory string
+	MaxChunkSize    uint64
+}
+
+type snapshotExporter struct {
+	db              common.DBWriteCacher
+	marshalizer     marshal.Marshalizer
+	hasher          hashing.Hasher
+	outputDirectory string
+	maxChunkSize    uint64
+}
+
+// NewSnapshotExporter creates a component able to export the accounts trie and all the data tries into a set of
+// chunk files described by a manifest
+func NewSnapshotExporter(args ArgsSnapshotExporter) (*snapshotExporter, error) {
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.OutputDirectory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+	if args.MaxChunkSize == 0 {
+		return nil, ErrInvalidChunkSize
+	}
+
+	return &snapshotExporter{
+		db:              args.DB,
+		marshalizer:     args.Marshalizer,
+		hasher:          args.Hasher,
+		outputDirectory: args.OutputDirectory,
+		maxChunkSize:    args.MaxChunkSize,
+	}, nil
+}
+
+// Export writes all the nodes of the state with the given root hash. The shard and epoch are only recorded in the
+// manifest, so the importer can check them
+func (se *snapshotExporter) Export(rootHash []byte, shardID uint32, epoch uint32) (*Manifest, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	manifestPath := filepath.Join(se.outputDirectory, ManifestFileName)
+	_, err := os.Stat(manifestPath)
+	if err == nil {
+		return nil, fmt.Errorf("%w in %s", ErrSnapshotAlreadyExists, se.outputDirectory)
+	}
+
+	err = os.MkdirAll(se.outputDirectory, os.ModePerm)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{
+		Version:  CurrentVersion,
+		ShardID:  shardID,
+		Epoch:    epoch,
+		RootHash: hex.EncodeToString(rootHash),
+		Chunks:   make([]*ChunkInfo, 0),
+	}
+	writer := &chunksWriter{
+		outputDirectory: se.outputDirectory,
+		maxChunkSize:    se.maxChunkSize,
+		manifest:        manifest,
+	}
+
+	manifest.NumDataTries, err = walkStateTries(rootHash, se.db, se.marshalizer, se.hasher, writer.writeNode)
+	if err != nil {
+		writer.abort()
+		return nil, err
+	}
+
+	err = writer.closeChunk()
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeManifest(manifestPath, manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("state snapshot exported",
+		"root hash", manifest.RootHash,
+		"num nodes", manifest.NumNodes,
+		"num data tries", manifest.NumDataTries,
+		"num chunks", len(manifest.Chunks),
+	)
+
+	return manifest, nil
+}
+
+func writeManifest(manifestPath string, manifest *Manifest) error {
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the manifest is renamed into place, so it is never found partially written
+	tmpPath := manifestPath + ".tmp"
+	err = os.WriteFile(tmpPath, manifestBytes, 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmpPath, manifestPath)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (se *snapshotExporter) IsInterfaceNil() bool {
+	return se == nil
+}
+
+type chunksWriter struct {
+	outputDirectory string
+	maxChunkSize    uint64
+	manifest        *Manifest
+
+	file     *os.File
+	buffer   *bufio.Writer
+	checksum hash.Hash
+	chunk    *ChunkInfo
+}
+
+func (cw *chunksWriter) writeNode(hash []byte, encodedNode []byte) error {
+	if cw.chunk != nil && cw.chunk.Size >= cw.maxChunkSize {
+		err := cw.closeChunk()
+		if err != nil {
+			return err
+		}
+	}
+	if cw.chunk == nil {
+		err := cw.openChunk()
+		if err != nil {
+			return err
+		}
+	}
+
+	numBytes, err := writeRecord(io.MultiWriter(cw.buffer, cw.checksum), hash, encodedNode)
+	if err != nil {
+		return err
+	}
+
+	cw.chunk.Size += uint64(numBytes)
+	cw.chunk.NumNodes++
+	cw.manifest.NumNodes++
+
+	return nil
+}
+
+func (cw *chunksWriter) openChunk() error {
+	chunk := &ChunkInfo{
+		FileName: fmt.Sprintf(chunkFileNameFormat, len(cw.manifest.Chunks)),
+	}
+
+	file, err := os.Create(filepath.Join(cw.outputDirectory, chunk.FileName))
+	if err != nil {
+		return err
+	}
+
+	cw.file = file
+	cw.buffer = bufio.NewWriter(file)
+	cw.checksum = sha256.New()
+	cw.chunk = chunk
+
+	return nil
+}
+
+func (cw *chunksWriter) closeChunk() error {
+	if cw.chunk == nil {
+		return nil
+	}
+
+	err := cw.buffer.Flush()
+	if err != nil {
+		_ = cw.file.Close()
+		return err
+	}
+
+	err = cw.file.Close()
+	if err != nil {
+		return err
+	}
+
+	cw.chunk.Checksum = hex.EncodeToString(cw.checksum.Sum(nil))
+	cw.manifest.Chunks = append(cw.manifest.Chunks, cw.chunk)
+	log.Debug("state snapshot chunk written", "file", cw.chunk.FileName, "num nodes", cw.chunk.NumNodes, "size", cw.chunk.Size)
+	cw.chunk = nil
+
+	return nil
+}
+
+func (cw *chunksWriter) abort() {
+	if cw.chunk != nil {
+		_ = cw.file.Close()
+	}
+}
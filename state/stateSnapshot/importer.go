@@ -0,0 +1,177 @@
+package stateSnapshot
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// ArgsSnapshotImporter defines the arguments needed for the snapshot importer creation
+type ArgsSnapshotImporter struct {
+	DB             common.DBWriteCacher
+	Marshalizer    marshal.Marshalizer
+	Hasher         hashing.Hasher
+	InputDirectory string
+}
+
+type snapshotImporter struct {
+	db             common.DBWriteCacher
+	marshalizer    marshal.Marshalizer
+	hasher         hashing.Hasher
+	inputDirectory string
+}
+
+// NewSnapshotImporter creates a component able to load into the trie storage a snapshot written by the exporter
+func NewSnapshotImporter(args ArgsSnapshotImporter) (*snapshotImporter, error) {
+	if check.IfNil(args.DB) {
+		return nil, ErrNilDatabase
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+	if len(args.InputDirectory) == 0 {
+		return nil, ErrEmptyDirectory
+	}
+
+	return &snapshotImporter{
+		db:             args.DB,
+		marshalizer:    args.Marshalizer,
+		hasher:         args.Hasher,
+		inputDirectory: args.InputDirectory,
+	}, nil
+}
+
+// ReadManifest reads the manifest of the snapshot
+func (si *snapshotImporter) ReadManifest() (*Manifest, error) {
+	manifestBytes, err := os.ReadFile(filepath.Join(si.inputDirectory, ManifestFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	manifest := &Manifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorruptedSnapshot, err)
+	}
+	if manifest.Version != CurrentVersion {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, manifest.Version)
+	}
+
+	return manifest, nil
+}
+
+// Import loads the snapshot into the database, after checking that it was exported for the given shard and root
+// hash. Each node is checked against its hash before being saved and, after all the chunks are loaded, the accounts
+// trie and all the data tries are walked to make sure that no node is missing.
+func (si *snapshotImporter) Import(rootHash []byte, shardID uint32) error {
+	manifest, err := si.ReadManifest()
+	if err != nil {
+		return err
+	}
+	if manifest.ShardID != shardID {
+		return fmt.Errorf("%w: snapshot shard %d, expected shard %d", ErrShardMismatch, manifest.ShardID, shardID)
+	}
+	if manifest.RootHash != hex.EncodeToString(rootHash) {
+		return fmt.Errorf("%w: snapshot root hash %s, expected root hash %s",
+			ErrRootHashMismatch, manifest.RootHash, hex.EncodeToString(rootHash))
+	}
+
+	log.Info("importing state snapshot",
+		"epoch", manifest.Epoch,
+		"root hash", manifest.RootHash,
+		"num nodes", manifest.NumNodes,
+		"num chunks", len(manifest.Chunks),
+	)
+
+	numNodes := uint64(0)
+	for _, chunk := range manifest.Chunks {
+		err = si.importChunk(chunk)
+		if err != nil {
+			return err
+		}
+
+		numNodes += chunk.NumNodes
+	}
+	if numNodes != manifest.NumNodes {
+		return fmt.Errorf("%w: the chunks hold %d nodes, the manifest %d", ErrCorruptedSnapshot, numNodes, manifest.NumNodes)
+	}
+
+	return si.verifyState(rootHash)
+}
+
+func (si *snapshotImporter) importChunk(chunk *ChunkInfo) error {
+	file, err := os.Open(filepath.Join(si.inputDirectory, filepath.Base(chunk.FileName)))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	checksum := sha256.New()
+	reader := io.TeeReader(bufio.NewReader(file), checksum)
+	numNodes := uint64(0)
+	for {
+		hash, encodedNode, errRead := readRecord(reader)
+		if errRead == io.EOF {
+			break
+		}
+		if errRead != nil {
+			return fmt.Errorf("%w: %v in chunk %s", ErrCorruptedSnapshot, errRead, chunk.FileName)
+		}
+		if !bytes.Equal(si.hasher.Compute(string(encodedNode)), hash) {
+			return fmt.Errorf("%w: node hash mismatch in chunk %s", ErrCorruptedSnapshot, chunk.FileName)
+		}
+
+		err = si.db.Put(hash, encodedNode)
+		if err != nil {
+			return err
+		}
+		numNodes++
+	}
+
+	if hex.EncodeToString(checksum.Sum(nil)) != chunk.Checksum {
+		return fmt.Errorf("%w: checksum mismatch for chunk %s", ErrCorruptedSnapshot, chunk.FileName)
+	}
+	if numNodes != chunk.NumNodes {
+		return fmt.Errorf("%w: chunk %s holds %d nodes, expected %d", ErrCorruptedSnapshot, chunk.FileName, numNodes, chunk.NumNodes)
+	}
+
+	log.Debug("state snapshot chunk imported", "file", chunk.FileName, "num nodes", numNodes)
+
+	return nil
+}
+
+func (si *snapshotImporter) verifyState(rootHash []byte) error {
+	numNodes := 0
+	numDataTries, err := walkStateTries(rootHash, si.db, si.marshalizer, si.hasher, func(_ []byte, _ []byte) error {
+		numNodes++
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCorruptedSnapshot, err)
+	}
+
+	log.Info("state snapshot imported and verified", "num nodes", numNodes, "num data tries", numDataTries)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (si *snapshotImporter) IsInterfaceNil() bool {
+	return si == nil
+}
@@ -0,0 +1,359 @@
+package stateSnapshot_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/stateSnapshot"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/testscommon/genericMocks"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const shardID = uint32(1)
+
+var (
+	marshalizer = &testscommon.ProtobufMarshalizerMock{}
+	hasher      = &testscommon.KeccakMock{}
+)
+
+type missingNodeDB struct {
+	common.DBWriteCacher
+	missingHash []byte
+}
+
+func (db *missingNodeDB) Get(key []byte) ([]byte, error) {
+	if bytes.Equal(key, db.missingHash) {
+		return nil, errors.New("missing node")
+	}
+
+	return db.DBWriteCacher.Get(key)
+}
+
+// createState creates an accounts trie in which every account has a data trie, except the first one. The last two
+// accounts share the same data trie
+func createState(t *testing.T, numAccounts int) (common.StorageManager, common.Trie) {
+	args, options := storage.GetStorageManagerArgsAndOptions()
+	args.Marshalizer = marshalizer
+	args.Hasher = hasher
+	tsm, err := trie.CreateTrieStorageManager(args, options)
+	require.Nil(t, err)
+
+	mainTrie, _ := trie.NewTrie(tsm, marshalizer, hasher, 5)
+	var dataTrieRootHash []byte
+	for i := 0; i < numAccounts; i++ {
+		address := []byte(fmt.Sprintf("address%d", i))
+		if i > 0 && i < numAccounts-1 {
+			dataTrie, _ := trie.NewTrie(tsm, marshalizer, hasher, 5)
+			for j := 0; j < 10; j++ {
+				_ = dataTrie.Update([]byte(fmt.Sprintf("key%d_%d", i, j)), []byte(fmt.Sprintf("value%d", j)))
+			}
+			require.Nil(t, dataTrie.Commit())
+			dataTrieRootHash, _ = dataTrie.RootHash()
+		}
+
+		accountBytes, _ := marshalizer.Marshal(&state.UserAccountData{
+			Address:  address,
+			Balance:  big.NewInt(int64(i)),
+			RootHash: dataTrieRootHash,
+		})
+		_ = mainTrie.Update(address, accountBytes)
+	}
+	require.Nil(t, mainTrie.Commit())
+
+	return tsm, mainTrie
+}
+
+func createMockArgsSnapshotExporter(t *testing.T, db common.DBWriteCacher) stateSnapshot.ArgsSnapshotExporter {
+	return stateSnapshot.ArgsSnapshotExporter{
+		DB:              db,
+		Marshalizer:     marshalizer,
+		Hasher:          hasher,
+		OutputDirectory: t.TempDir(),
+		MaxChunkSize:    1024 * 1024,
+	}
+}
+
+func createMockArgsSnapshotImporter(directory string) stateSnapshot.ArgsSnapshotImporter {
+	return stateSnapshot.ArgsSnapshotImporter{
+		DB:             genericMocks.NewStorerMock(),
+		Marshalizer:    marshalizer,
+		Hasher:         hasher,
+		InputDirectory: directory,
+	}
+}
+
+func exportState(t *testing.T, maxChunkSize uint64) ([]byte, string, *stateSnapshot.Manifest) {
+	tsm, mainTrie := createState(t, 20)
+	rootHash, _ := mainTrie.RootHash()
+
+	args := createMockArgsSnapshotExporter(t, tsm)
+	args.MaxChunkSize = maxChunkSize
+	exporter, _ := stateSnapshot.NewSnapshotExporter(args)
+	manifest, err := exporter.Export(rootHash, shardID, 7)
+	require.Nil(t, err)
+
+	return rootHash, args.OutputDirectory, manifest
+}
+
+func rewriteManifest(t *testing.T, directory string, manifest *stateSnapshot.Manifest) {
+	manifestBytes, _ := json.Marshal(manifest)
+	require.Nil(t, os.WriteFile(filepath.Join(directory, stateSnapshot.ManifestFileName), manifestBytes, 0644))
+}
+
+func TestNewSnapshotExporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil database should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t, nil)
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Nil(t, exporter)
+		assert.Equal(t, stateSnapshot.ErrNilDatabase, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t, genericMocks.NewStorerMock())
+		args.Marshalizer = nil
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Nil(t, exporter)
+		assert.Equal(t, stateSnapshot.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t, genericMocks.NewStorerMock())
+		args.Hasher = nil
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Nil(t, exporter)
+		assert.Equal(t, stateSnapshot.ErrNilHasher, err)
+	})
+	t.Run("empty output directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t, genericMocks.NewStorerMock())
+		args.OutputDirectory = ""
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Nil(t, exporter)
+		assert.Equal(t, stateSnapshot.ErrEmptyDirectory, err)
+	})
+	t.Run("invalid chunk size should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotExporter(t, genericMocks.NewStorerMock())
+		args.MaxChunkSize = 0
+		exporter, err := stateSnapshot.NewSnapshotExporter(args)
+		assert.Nil(t, exporter)
+		assert.Equal(t, stateSnapshot.ErrInvalidChunkSize, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, err := stateSnapshot.NewSnapshotExporter(createMockArgsSnapshotExporter(t, genericMocks.NewStorerMock()))
+		assert.Nil(t, err)
+		assert.False(t, exporter.IsInterfaceNil())
+	})
+}
+
+func TestSnapshotExporter_Export(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		exporter, _ := stateSnapshot.NewSnapshotExporter(createMockArgsSnapshotExporter(t, genericMocks.NewStorerMock()))
+		manifest, err := exporter.Export(nil, shardID, 0)
+		assert.Nil(t, manifest)
+		assert.Equal(t, stateSnapshot.ErrEmptyRootHash, err)
+	})
+	t.Run("missing node should error and not write the manifest", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, mainTrie := createState(t, 20)
+		rootHash, _ := mainTrie.RootHash()
+		hashes, _ := mainTrie.GetAllHashes()
+
+		args := createMockArgsSnapshotExporter(t, &missingNodeDB{DBWriteCacher: tsm, missingHash: hashes[len(hashes)-1]})
+		exporter, _ := stateSnapshot.NewSnapshotExporter(args)
+		manifest, err := exporter.Export(rootHash, shardID, 0)
+		assert.Nil(t, manifest)
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+
+		_, err = os.Stat(filepath.Join(args.OutputDirectory, stateSnapshot.ManifestFileName))
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("existing snapshot should error", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, mainTrie := createState(t, 5)
+		rootHash, _ := mainTrie.RootHash()
+		exporter, _ := stateSnapshot.NewSnapshotExporter(createMockArgsSnapshotExporter(t, tsm))
+		_, err := exporter.Export(rootHash, shardID, 0)
+		require.Nil(t, err)
+
+		manifest, err := exporter.Export(rootHash, shardID, 0)
+		assert.Nil(t, manifest)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrSnapshotAlreadyExists))
+	})
+	t.Run("should export all the nodes in chunks", func(t *testing.T) {
+		t.Parallel()
+
+		_, directory, manifest := exportState(t, 512)
+		assert.Equal(t, uint32(stateSnapshot.CurrentVersion), manifest.Version)
+		assert.Equal(t, shardID, manifest.ShardID)
+		assert.Equal(t, uint32(7), manifest.Epoch)
+		assert.Equal(t, uint64(18), manifest.NumDataTries)
+		assert.True(t, len(manifest.Chunks) > 1)
+
+		numNodes := uint64(0)
+		for _, chunk := range manifest.Chunks {
+			fileInfo, err := os.Stat(filepath.Join(directory, chunk.FileName))
+			require.Nil(t, err)
+			assert.Equal(t, uint64(fileInfo.Size()), chunk.Size)
+			numNodes += chunk.NumNodes
+		}
+		assert.Equal(t, manifest.NumNodes, numNodes)
+	})
+}
+
+func TestNewSnapshotImporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil database should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsSnapshotImporter(t.TempDir())
+		args.DB = nil
+		importer, err := stateSnapshot.NewSnapshotImporter(args)
+		assert.Nil(t, importer)
+		assert.Equal(t, stateSnapshot.ErrNilDatabase, err)
+	})
+	t.Run("empty input directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(""))
+		assert.Nil(t, importer)
+		assert.Equal(t, stateSnapshot.ErrEmptyDirectory, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		importer, err := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(t.TempDir()))
+		assert.Nil(t, err)
+		assert.False(t, importer.IsInterfaceNil())
+	})
+}
+
+func TestSnapshotImporter_Import(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing manifest should error", func(t *testing.T) {
+		t.Parallel()
+
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(t.TempDir()))
+		err := importer.Import([]byte("root hash"), shardID)
+		assert.True(t, os.IsNotExist(err))
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, directory, manifest := exportState(t, 1024)
+		manifest.Version = stateSnapshot.CurrentVersion + 1
+		rewriteManifest(t, directory, manifest)
+
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(directory))
+		err := importer.Import(rootHash, shardID)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrUnsupportedVersion))
+	})
+	t.Run("other shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, directory, _ := exportState(t, 1024)
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(directory))
+		err := importer.Import(rootHash, shardID+1)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrShardMismatch))
+	})
+	t.Run("other root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		_, directory, _ := exportState(t, 1024)
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(directory))
+		err := importer.Import([]byte("other root hash"), shardID)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrRootHashMismatch))
+	})
+	t.Run("corrupted chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, directory, manifest := exportState(t, 1024)
+		chunkPath := filepath.Join(directory, manifest.Chunks[0].FileName)
+		chunkBytes, _ := os.ReadFile(chunkPath)
+		chunkBytes[len(chunkBytes)-1]++
+		require.Nil(t, os.WriteFile(chunkPath, chunkBytes, 0644))
+
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(directory))
+		err := importer.Import(rootHash, shardID)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrCorruptedSnapshot))
+	})
+	t.Run("truncated chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, directory, manifest := exportState(t, 1024)
+		chunkPath := filepath.Join(directory, manifest.Chunks[0].FileName)
+		chunkBytes, _ := os.ReadFile(chunkPath)
+		require.Nil(t, os.WriteFile(chunkPath, chunkBytes[:len(chunkBytes)-3], 0644))
+
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(directory))
+		err := importer.Import(rootHash, shardID)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrCorruptedSnapshot))
+	})
+	t.Run("missing chunk should error", func(t *testing.T) {
+		t.Parallel()
+
+		rootHash, directory, manifest := exportState(t, 512)
+		lastChunk := manifest.Chunks[len(manifest.Chunks)-1]
+		manifest.Chunks = manifest.Chunks[:len(manifest.Chunks)-1]
+		manifest.NumNodes -= lastChunk.NumNodes
+		rewriteManifest(t, directory, manifest)
+
+		importer, _ := stateSnapshot.NewSnapshotImporter(createMockArgsSnapshotImporter(directory))
+		err := importer.Import(rootHash, shardID)
+		assert.True(t, errors.Is(err, stateSnapshot.ErrCorruptedSnapshot))
+	})
+	t.Run("should import the complete state", func(t *testing.T) {
+		t.Parallel()
+
+		tsm, mainTrie := createState(t, 20)
+		rootHash, _ := mainTrie.RootHash()
+		exporterArgs := createMockArgsSnapshotExporter(t, tsm)
+		exporterArgs.MaxChunkSize = 512
+		exporter, _ := stateSnapshot.NewSnapshotExporter(exporterArgs)
+		_, err := exporter.Export(rootHash, shardID, 7)
+		require.Nil(t, err)
+
+		importerArgs := createMockArgsSnapshotImporter(exporterArgs.OutputDirectory)
+		importer, _ := stateSnapshot.NewSnapshotImporter(importerArgs)
+		err = importer.Import(rootHash, shardID)
+		require.Nil(t, err)
+
+		hashes, _ := mainTrie.GetAllHashes()
+		for _, hash := range hashes {
+			importedNode, errGet := importerArgs.DB.Get(hash)
+			require.Nil(t, errGet)
+			expectedNode, _ := tsm.Get(hash)
+			assert.Equal(t, expectedNode, importedNode)
+		}
+	})
+}
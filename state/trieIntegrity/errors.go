@@ -0,0 +1,36 @@
+package trieIntegrity
+
+import "errors"
+
+// ErrNilTrieStorage signals that a nil trie storage was provided
+var ErrNilTrieStorage = errors.New("nil trie storage")
+
+// ErrNilMarshalizer signals that a nil marshalizer was provided
+var ErrNilMarshalizer = errors.New("nil marshalizer")
+
+// ErrNilHasher signals that a nil hasher was provided
+var ErrNilHasher = errors.New("nil hasher")
+
+// ErrNilNodesFetcher signals that a nil missing nodes fetcher was provided
+var ErrNilNodesFetcher = errors.New("nil missing nodes fetcher")
+
+// ErrNilEpochStorer signals that a nil epoch storer was provided
+var ErrNilEpochStorer = errors.New("nil epoch storer")
+
+// ErrInvalidNumEpochs signals that an invalid number of epochs was provided
+var ErrInvalidNumEpochs = errors.New("invalid number of epochs")
+
+// ErrNilRequestHandler signals that a nil request handler was provided
+var ErrNilRequestHandler = errors.New("nil request handler")
+
+// ErrNilInterceptedNodesCache signals that a nil intercepted nodes cache was provided
+var ErrNilInterceptedNodesCache = errors.New("nil intercepted nodes cache")
+
+// ErrEmptyTopic signals that an empty topic was provided
+var ErrEmptyTopic = errors.New("empty topic")
+
+// ErrInvalidTimeout signals that an invalid timeout was provided
+var ErrInvalidTimeout = errors.New("invalid timeout")
+
+// ErrEmptyRootHash signals that an empty root hash was provided
+var ErrEmptyRootHash = errors.New("empty root hash")
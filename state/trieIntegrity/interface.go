@@ -0,0 +1,18 @@
+package trieIntegrity
+
+// MissingNodesFetcher is able to fetch the trie nodes that are missing from the trie storage. The returned map holds
+// the encoded nodes that were found, by their hash
+type MissingNodesFetcher interface {
+	FetchNodes(hashes [][]byte) map[string][]byte
+	IsInterfaceNil() bool
+}
+
+// EpochStorer is able to read a key from the persister of a given epoch
+type EpochStorer interface {
+	GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+type serializedNodeHandler interface {
+	GetSerialized() []byte
+}
@@ -0,0 +1,60 @@
+package trieIntegrity
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+)
+
+// ArgsOlderEpochsNodesFetcher defines the arguments needed for the older epochs nodes fetcher creation
+type ArgsOlderEpochsNodesFetcher struct {
+	Storer     EpochStorer
+	StartEpoch uint32
+	NumEpochs  uint32
+}
+
+type olderEpochsNodesFetcher struct {
+	storer     EpochStorer
+	startEpoch uint32
+	numEpochs  uint32
+}
+
+// NewOlderEpochsNodesFetcher creates a missing nodes fetcher that searches the nodes in the persisters of the older
+// epochs, starting with the given epoch and going back for the given number of epochs
+func NewOlderEpochsNodesFetcher(args ArgsOlderEpochsNodesFetcher) (*olderEpochsNodesFetcher, error) {
+	if check.IfNil(args.Storer) {
+		return nil, ErrNilEpochStorer
+	}
+	if args.NumEpochs == 0 {
+		return nil, ErrInvalidNumEpochs
+	}
+
+	return &olderEpochsNodesFetcher{
+		storer:     args.Storer,
+		startEpoch: args.StartEpoch,
+		numEpochs:  args.NumEpochs,
+	}, nil
+}
+
+// FetchNodes returns the nodes found in the older epochs, newest epoch first
+func (oenf *olderEpochsNodesFetcher) FetchNodes(hashes [][]byte) map[string][]byte {
+	fetchedNodes := make(map[string][]byte)
+	for _, hash := range hashes {
+		for i := uint32(0); i < oenf.numEpochs && i <= oenf.startEpoch; i++ {
+			epoch := oenf.startEpoch - i
+			encodedNode, err := oenf.storer.GetFromEpoch(hash, epoch)
+			if err != nil || len(encodedNode) == 0 {
+				continue
+			}
+
+			log.Trace("trie node found in an older epoch", "hash", hash, "epoch", epoch)
+			fetchedNodes[string(hash)] = encodedNode
+			break
+		}
+	}
+
+	return fetchedNodes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (oenf *olderEpochsNodesFetcher) IsInterfaceNil() bool {
+	return oenf == nil
+}
@@ -0,0 +1,75 @@
+package trieIntegrity_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewOlderEpochsNodesFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil storer should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := trieIntegrity.NewOlderEpochsNodesFetcher(trieIntegrity.ArgsOlderEpochsNodesFetcher{NumEpochs: 1})
+		assert.Nil(t, fetcher)
+		assert.Equal(t, trieIntegrity.ErrNilEpochStorer, err)
+	})
+	t.Run("zero epochs should error", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := trieIntegrity.NewOlderEpochsNodesFetcher(trieIntegrity.ArgsOlderEpochsNodesFetcher{Storer: &storage.StorerStub{}})
+		assert.Nil(t, fetcher)
+		assert.Equal(t, trieIntegrity.ErrInvalidNumEpochs, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := trieIntegrity.NewOlderEpochsNodesFetcher(trieIntegrity.ArgsOlderEpochsNodesFetcher{
+			Storer:    &storage.StorerStub{},
+			NumEpochs: 1,
+		})
+		assert.Nil(t, err)
+		assert.False(t, fetcher.IsInterfaceNil())
+	})
+}
+
+func TestOlderEpochsNodesFetcher_FetchNodes(t *testing.T) {
+	t.Parallel()
+
+	nodesByEpoch := map[uint32]map[string][]byte{
+		1: {"hash1": []byte("node1 from epoch 1")},
+		3: {"hash1": []byte("node1 from epoch 3"), "hash2": []byte("node2 from epoch 3")},
+		4: {"hash3": []byte("node3 from epoch 4")},
+	}
+	searchedEpochs := make(map[uint32]int)
+	storer := &storage.StorerStub{
+		GetFromEpochCalled: func(key []byte, epoch uint32) ([]byte, error) {
+			searchedEpochs[epoch]++
+			node, found := nodesByEpoch[epoch][string(key)]
+			if !found {
+				return nil, errors.New("key not found")
+			}
+			return node, nil
+		},
+	}
+	fetcher, _ := trieIntegrity.NewOlderEpochsNodesFetcher(trieIntegrity.ArgsOlderEpochsNodesFetcher{
+		Storer:     storer,
+		StartEpoch: 3,
+		NumEpochs:  10,
+	})
+
+	fetchedNodes := fetcher.FetchNodes([][]byte{[]byte("hash1"), []byte("hash2"), []byte("hash3")})
+	expectedNodes := map[string][]byte{
+		"hash1": []byte("node1 from epoch 3"),
+		"hash2": []byte("node2 from epoch 3"),
+	}
+	assert.Equal(t, expectedNodes, fetchedNodes)
+	assert.Equal(t, 0, searchedEpochs[4])
+	assert.Equal(t, 3, searchedEpochs[3])
+	assert.Equal(t, 1, searchedEpochs[0])
+}
@@ -0,0 +1,105 @@
+package trieIntegrity
+
+import (
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+)
+
+const waitTimeBetweenChecks = time.Millisecond * 100
+
+// ArgsPeersNodesFetcher defines the arguments needed for the peers nodes fetcher creation
+type ArgsPeersNodesFetcher struct {
+	RequestHandler   trie.RequestHandler
+	InterceptedNodes storage.Cacher
+	ShardID          uint32
+	Topic            string
+	Timeout          time.Duration
+}
+
+type peersNodesFetcher struct {
+	requestHandler   trie.RequestHandler
+	interceptedNodes storage.Cacher
+	shardID          uint32
+	topic            string
+	timeout          time.Duration
+}
+
+// NewPeersNodesFetcher creates a missing nodes fetcher that requests the nodes from the peers, the same way the trie
+// syncers do. The nodes received by the trie nodes interceptors are read from the intercepted nodes cache.
+func NewPeersNodesFetcher(args ArgsPeersNodesFetcher) (*peersNodesFetcher, error) {
+	if check.IfNil(args.RequestHandler) {
+		return nil, ErrNilRequestHandler
+	}
+	if check.IfNil(args.InterceptedNodes) {
+		return nil, ErrNilInterceptedNodesCache
+	}
+	if len(args.Topic) == 0 {
+		return nil, ErrEmptyTopic
+	}
+	if args.Timeout <= 0 {
+		return nil, ErrInvalidTimeout
+	}
+
+	return &peersNodesFetcher{
+		requestHandler:   args.RequestHandler,
+		interceptedNodes: args.InterceptedNodes,
+		shardID:          args.ShardID,
+		topic:            args.Topic,
+		timeout:          args.Timeout,
+	}, nil
+}
+
+// FetchNodes requests the nodes from the peers and returns the ones received before the timeout. The missing nodes
+// are requested again after each request interval.
+func (pnf *peersNodesFetcher) FetchNodes(hashes [][]byte) map[string][]byte {
+	fetchedNodes := make(map[string][]byte)
+	pendingHashes := hashes
+	deadline := time.Now().Add(pnf.timeout)
+	lastRequest := time.Time{}
+
+	for len(pendingHashes) > 0 && time.Now().Before(deadline) {
+		if time.Since(lastRequest) >= pnf.requestHandler.RequestInterval() {
+			pnf.requestHandler.RequestTrieNodes(pnf.shardID, pendingHashes, pnf.topic)
+			lastRequest = time.Now()
+		}
+
+		time.Sleep(waitTimeBetweenChecks)
+		pendingHashes = pnf.readReceivedNodes(pendingHashes, fetchedNodes)
+	}
+
+	if len(pendingHashes) > 0 {
+		log.Debug("trie nodes not received from the peers", "num nodes", len(pendingHashes))
+	}
+
+	return fetchedNodes
+}
+
+func (pnf *peersNodesFetcher) readReceivedNodes(hashes [][]byte, fetchedNodes map[string][]byte) [][]byte {
+	pendingHashes := make([][]byte, 0, len(hashes))
+	for _, hash := range hashes {
+		value, found := pnf.interceptedNodes.Get(hash)
+		if !found {
+			pendingHashes = append(pendingHashes, hash)
+			continue
+		}
+
+		pnf.interceptedNodes.Remove(hash)
+		receivedNode, ok := value.(serializedNodeHandler)
+		if !ok {
+			pendingHashes = append(pendingHashes, hash)
+			continue
+		}
+
+		fetchedNodes[string(hash)] = receivedNode.GetSerialized()
+	}
+
+	return pendingHashes
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (pnf *peersNodesFetcher) IsInterfaceNil() bool {
+	return pnf == nil
+}
@@ -0,0 +1,123 @@
+package trieIntegrity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const topic = "accountTrieNodes_0"
+
+func createMockArgsPeersNodesFetcher() trieIntegrity.ArgsPeersNodesFetcher {
+	return trieIntegrity.ArgsPeersNodesFetcher{
+		RequestHandler:   &testscommon.RequestHandlerStub{},
+		InterceptedNodes: testscommon.NewCacherMock(),
+		ShardID:          0,
+		Topic:            topic,
+		Timeout:          time.Second,
+	}
+}
+
+func TestNewPeersNodesFetcher(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil request handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersNodesFetcher()
+		args.RequestHandler = nil
+		fetcher, err := trieIntegrity.NewPeersNodesFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.Equal(t, trieIntegrity.ErrNilRequestHandler, err)
+	})
+	t.Run("nil intercepted nodes cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersNodesFetcher()
+		args.InterceptedNodes = nil
+		fetcher, err := trieIntegrity.NewPeersNodesFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.Equal(t, trieIntegrity.ErrNilInterceptedNodesCache, err)
+	})
+	t.Run("empty topic should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersNodesFetcher()
+		args.Topic = ""
+		fetcher, err := trieIntegrity.NewPeersNodesFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.Equal(t, trieIntegrity.ErrEmptyTopic, err)
+	})
+	t.Run("invalid timeout should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersNodesFetcher()
+		args.Timeout = 0
+		fetcher, err := trieIntegrity.NewPeersNodesFetcher(args)
+		assert.Nil(t, fetcher)
+		assert.Equal(t, trieIntegrity.ErrInvalidTimeout, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		fetcher, err := trieIntegrity.NewPeersNodesFetcher(createMockArgsPeersNodesFetcher())
+		assert.Nil(t, err)
+		assert.False(t, fetcher.IsInterfaceNil())
+	})
+}
+
+func TestPeersNodesFetcher_FetchNodes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should return the received nodes", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersNodesFetcher()
+		numRequests := 0
+		args.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestTrieNodesCalled: func(destShardID uint32, hashes [][]byte, requestTopic string) {
+				numRequests++
+				assert.Equal(t, args.ShardID, destShardID)
+				assert.Equal(t, topic, requestTopic)
+				for _, hash := range hashes {
+					receivedNode, _ := trie.NewInterceptedTrieNode(append([]byte("node "), hash...), hasher)
+					args.InterceptedNodes.Put(hash, receivedNode, 0)
+				}
+			},
+		}
+		fetcher, _ := trieIntegrity.NewPeersNodesFetcher(args)
+
+		fetchedNodes := fetcher.FetchNodes([][]byte{[]byte("hash1"), []byte("hash2")})
+		expectedNodes := map[string][]byte{
+			"hash1": []byte("node hash1"),
+			"hash2": []byte("node hash2"),
+		}
+		assert.Equal(t, expectedNodes, fetchedNodes)
+		assert.Equal(t, 1, numRequests)
+		assert.Equal(t, 0, args.InterceptedNodes.Len())
+	})
+	t.Run("should return the nodes received before the timeout", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsPeersNodesFetcher()
+		args.Timeout = time.Millisecond * 300
+		args.RequestHandler = &testscommon.RequestHandlerStub{
+			RequestTrieNodesCalled: func(_ uint32, _ [][]byte, _ string) {
+				receivedNode, _ := trie.NewInterceptedTrieNode([]byte("node1"), hasher)
+				args.InterceptedNodes.Put([]byte("hash1"), receivedNode, 0)
+			},
+		}
+		fetcher, _ := trieIntegrity.NewPeersNodesFetcher(args)
+
+		start := time.Now()
+		fetchedNodes := fetcher.FetchNodes([][]byte{[]byte("hash1"), []byte("hash2")})
+		require.Equal(t, 1, len(fetchedNodes))
+		assert.Equal(t, []byte("node1"), fetchedNodes["hash1"])
+		assert.True(t, time.Since(start) >= args.Timeout)
+	})
+}
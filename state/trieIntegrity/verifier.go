@@ -0,0 +1,255 @@
+package trieIntegrity
+
+import (
+	"bytes"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/trie"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("state/trieIntegrity")
+
+// a repaired node can reveal more missing nodes in its subtrie, so the repair is retried for a limited number of rounds
+const maxRepairRounds = 10
+
+// DamagedNode describes a trie node that is missing from the trie storage, that does not match its hash or that can
+// not be decoded. The path holds the nibbles, as hex digits, leading from the trie root to the node. The data trie
+// root hash and the account address are set only for the nodes of a data trie.
+type DamagedNode struct {
+	Hash             string `json:"hash"`
+	Path             string `json:"path"`
+	DataTrieRootHash string `json:"dataTrieRootHash,omitempty"`
+	AccountAddress   string `json:"accountAddress,omitempty"`
+	Error            string `json:"error"`
+
+	hash []byte
+}
+
+// Report holds the result of a trie integrity verification
+type Report struct {
+	RootHash      string         `json:"rootHash"`
+	NumNodes      uint64         `json:"numNodes"`
+	NumDataTries  uint64         `json:"numDataTries"`
+	DamagedNodes  []*DamagedNode `json:"damagedNodes"`
+	RepairedNodes []*DamagedNode `json:"repairedNodes"`
+}
+
+// ArgsTrieIntegrityVerifier defines the arguments needed for the trie integrity verifier creation
+type ArgsTrieIntegrityVerifier struct {
+	TrieStorage common.DBWriteCacher
+	Marshalizer marshal.Marshalizer
+	Hasher      hashing.Hasher
+}
+
+type trieIntegrityVerifier struct {
+	trieStorage common.DBWriteCacher
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+type dataTrieInfo struct {
+	rootHash       []byte
+	accountAddress []byte
+}
+
+// NewTrieIntegrityVerifier creates a component able to check that the trie storage holds all the nodes of the accounts
+// trie and of the data tries, usually reading them through the trie storage manager
+func NewTrieIntegrityVerifier(args ArgsTrieIntegrityVerifier) (*trieIntegrityVerifier, error) {
+	if check.IfNil(args.TrieStorage) {
+		return nil, ErrNilTrieStorage
+	}
+	if check.IfNil(args.Marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(args.Hasher) {
+		return nil, ErrNilHasher
+	}
+
+	return &trieIntegrityVerifier{
+		trieStorage: args.TrieStorage,
+		marshalizer: args.Marshalizer,
+		hasher:      args.Hasher,
+	}, nil
+}
+
+// Verify walks the accounts trie with the given root hash and all the data tries referenced by its accounts, and
+// reports the damaged nodes. The subtrie of a damaged node can not be reached, so it is not verified.
+func (tiv *trieIntegrityVerifier) Verify(rootHash []byte) (*Report, error) {
+	if len(rootHash) == 0 {
+		return nil, ErrEmptyRootHash
+	}
+
+	log.Info("verifying the trie integrity", "root hash", rootHash)
+
+	report := &Report{
+		RootHash:      hex.EncodeToString(rootHash),
+		DamagedNodes:  make([]*DamagedNode, 0),
+		RepairedNodes: make([]*DamagedNode, 0),
+	}
+
+	dataTries := make([]*dataTrieInfo, 0)
+	seenDataTries := make(map[string]struct{})
+	err := trie.CheckTrieNodes(rootHash, tiv.trieStorage, tiv.marshalizer, tiv.hasher,
+		func(_ []byte, _ []byte, leafValue []byte) error {
+			report.NumNodes++
+			if len(leafValue) == 0 {
+				return nil
+			}
+
+			account := state.NewEmptyUserAccount()
+			errUnmarshal := tiv.marshalizer.Unmarshal(account, leafValue)
+			if errUnmarshal != nil || len(account.RootHash) == 0 {
+				return nil
+			}
+			_, seen := seenDataTries[string(account.RootHash)]
+			if !seen {
+				seenDataTries[string(account.RootHash)] = struct{}{}
+				dataTries = append(dataTries, &dataTrieInfo{rootHash: account.RootHash, accountAddress: account.Address})
+			}
+
+			return nil
+		},
+		tiv.damagedNodeHandler(report, nil),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	report.NumDataTries = uint64(len(dataTries))
+	for _, dataTrie := range dataTries {
+		err = trie.CheckTrieNodes(dataTrie.rootHash, tiv.trieStorage, tiv.marshalizer, tiv.hasher,
+			func(_ []byte, _ []byte, _ []byte) error {
+				report.NumNodes++
+				return nil
+			},
+			tiv.damagedNodeHandler(report, dataTrie),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info("trie integrity verified",
+		"root hash", rootHash,
+		"num nodes", report.NumNodes,
+		"num data tries", report.NumDataTries,
+		"num damaged nodes", len(report.DamagedNodes),
+	)
+
+	return report, nil
+}
+
+func (tiv *trieIntegrityVerifier) damagedNodeHandler(report *Report, dataTrie *dataTrieInfo) trie.DamagedNodeHandlerFunc {
+	return func(hash []byte, path []byte, err error) {
+		damagedNode := &DamagedNode{
+			Hash:  hex.EncodeToString(hash),
+			Path:  nibblesToString(path),
+			Error: err.Error(),
+			hash:  hash,
+		}
+		if dataTrie != nil {
+			damagedNode.DataTrieRootHash = hex.EncodeToString(dataTrie.rootHash)
+			damagedNode.AccountAddress = hex.EncodeToString(dataTrie.accountAddress)
+		}
+
+		log.Warn("damaged trie node",
+			"hash", damagedNode.Hash,
+			"path", damagedNode.Path,
+			"data trie root hash", damagedNode.DataTrieRootHash,
+			"error", damagedNode.Error,
+		)
+		report.DamagedNodes = append(report.DamagedNodes, damagedNode)
+	}
+}
+
+// VerifyAndRepair verifies the trie and saves in the trie storage the damaged nodes provided by the fetcher. The
+// verification is repeated after each repair, as the subtries of the repaired nodes were not verified before.
+func (tiv *trieIntegrityVerifier) VerifyAndRepair(rootHash []byte, fetcher MissingNodesFetcher) (*Report, error) {
+	if check.IfNil(fetcher) {
+		return nil, ErrNilNodesFetcher
+	}
+
+	repairedNodes := make([]*DamagedNode, 0)
+	for round := 0; round < maxRepairRounds; round++ {
+		report, err := tiv.Verify(rootHash)
+		if err != nil {
+			return nil, err
+		}
+		report.RepairedNodes = repairedNodes
+		if len(report.DamagedNodes) == 0 {
+			return report, nil
+		}
+
+		repairedInRound, err := tiv.repair(report.DamagedNodes, fetcher)
+		if err != nil {
+			return nil, err
+		}
+		if len(repairedInRound) == 0 {
+			return report, nil
+		}
+
+		repairedNodes = append(repairedNodes, repairedInRound...)
+	}
+
+	report, err := tiv.Verify(rootHash)
+	if err != nil {
+		return nil, err
+	}
+	report.RepairedNodes = repairedNodes
+
+	return report, nil
+}
+
+func (tiv *trieIntegrityVerifier) repair(damagedNodes []*DamagedNode, fetcher MissingNodesFetcher) ([]*DamagedNode, error) {
+	hashes := make([][]byte, 0, len(damagedNodes))
+	for _, damagedNode := range damagedNodes {
+		hashes = append(hashes, damagedNode.hash)
+	}
+
+	fetchedNodes := fetcher.FetchNodes(hashes)
+	repairedNodes := make([]*DamagedNode, 0, len(fetchedNodes))
+	for _, damagedNode := range damagedNodes {
+		encodedNode, found := fetchedNodes[string(damagedNode.hash)]
+		if !found {
+			continue
+		}
+		if !bytes.Equal(tiv.hasher.Compute(string(encodedNode)), damagedNode.hash) {
+			log.Debug("fetched trie node does not match its hash", "hash", damagedNode.Hash)
+			continue
+		}
+
+		err := tiv.trieStorage.Put(damagedNode.hash, encodedNode)
+		if err != nil {
+			return nil, err
+		}
+
+		log.Debug("trie node repaired", "hash", damagedNode.Hash, "path", damagedNode.Path)
+		repairedNodes = append(repairedNodes, damagedNode)
+	}
+
+	log.Info("trie nodes repaired", "num damaged", len(damagedNodes), "num repaired", len(repairedNodes))
+
+	return repairedNodes, nil
+}
+
+func nibblesToString(nibbles []byte) string {
+	builder := strings.Builder{}
+	for _, nibble := range nibbles {
+		builder.WriteString(strconv.FormatUint(uint64(nibble), 16))
+	}
+
+	return builder.String()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (tiv *trieIntegrityVerifier) IsInterfaceNil() bool {
+	return tiv == nil
+}
@@ -0,0 +1,311 @@
+package trieIntegrity_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/state"
+	"github.com/multiversx/mx-chain-go/state/trieIntegrity"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	stateMock "github.com/multiversx/mx-chain-go/testscommon/state"
+	"github.com/multiversx/mx-chain-go/testscommon/storage"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	marshalizer = &testscommon.ProtobufMarshalizerMock{}
+	hasher      = &testscommon.KeccakMock{}
+)
+
+type testState struct {
+	rootHash         []byte
+	nodes            map[string][]byte
+	mainTrieHashes   [][]byte
+	dataTrieRootHash []byte
+	dataTrieAddress  []byte
+	dataTrieHashes   [][]byte
+}
+
+// createState creates an accounts trie in which every account has a data trie, except the first one, and returns all
+// the nodes, copied in a memory database
+func createState(t *testing.T, numAccounts int) (*testState, common.DBWriteCacher) {
+	args, options := storage.GetStorageManagerArgsAndOptions()
+	args.Marshalizer = marshalizer
+	args.Hasher = hasher
+	tsm, err := trie.CreateTrieStorageManager(args, options)
+	require.Nil(t, err)
+
+	ts := &testState{
+		nodes: make(map[string][]byte),
+	}
+	mainTrie, _ := trie.NewTrie(tsm, marshalizer, hasher, 5)
+	for i := 0; i < numAccounts; i++ {
+		address := []byte(fmt.Sprintf("address%d", i))
+		var dataTrieRootHash []byte
+		if i > 0 {
+			dataTrie, _ := trie.NewTrie(tsm, marshalizer, hasher, 5)
+			for j := 0; j < 20; j++ {
+				_ = dataTrie.Update([]byte(fmt.Sprintf("key%d_%d", i, j)), []byte(fmt.Sprintf("value%d", j)))
+			}
+			require.Nil(t, dataTrie.Commit())
+			dataTrieRootHash, _ = dataTrie.RootHash()
+			ts.dataTrieRootHash, ts.dataTrieAddress = dataTrieRootHash, address
+		}
+
+		accountBytes, _ := marshalizer.Marshal(&state.UserAccountData{
+			Address:  address,
+			Balance:  big.NewInt(int64(i)),
+			RootHash: dataTrieRootHash,
+		})
+		_ = mainTrie.Update(address, accountBytes)
+	}
+	require.Nil(t, mainTrie.Commit())
+	ts.rootHash, _ = mainTrie.RootHash()
+
+	db := testscommon.NewMemDbMock()
+	collectNodes := func(hashes *[][]byte) trie.NodeHandlerFunc {
+		return func(hash []byte, encodedNode []byte, _ []byte) error {
+			ts.nodes[string(hash)] = encodedNode
+			*hashes = append(*hashes, hash)
+			return db.Put(hash, encodedNode)
+		}
+	}
+	require.Nil(t, trie.WalkTrieNodes(ts.rootHash, tsm, marshalizer, hasher, collectNodes(&ts.mainTrieHashes)))
+	for i := 1; i < numAccounts; i++ {
+		account := state.NewEmptyUserAccount()
+		accountBytes, _, _ := mainTrie.Get([]byte(fmt.Sprintf("address%d", i)))
+		require.Nil(t, marshalizer.Unmarshal(account, accountBytes))
+
+		hashes := make([][]byte, 0)
+		require.Nil(t, trie.WalkTrieNodes(account.RootHash, tsm, marshalizer, hasher, collectNodes(&hashes)))
+		if i == numAccounts-1 {
+			ts.dataTrieHashes = hashes
+		}
+	}
+
+	return ts, db
+}
+
+func createMockArgsTrieIntegrityVerifier(db common.DBWriteCacher) trieIntegrity.ArgsTrieIntegrityVerifier {
+	return trieIntegrity.ArgsTrieIntegrityVerifier{
+		TrieStorage: db,
+		Marshalizer: marshalizer,
+		Hasher:      hasher,
+	}
+}
+
+// getBranchWithChild returns a branch node of the main trie, other than the root, and one of its children
+func getBranchWithChild(t *testing.T, ts *testState) ([]byte, []byte) {
+	for _, hash := range ts.mainTrieHashes[1:] {
+		nodeData, err := trie.DecodeNodeData(ts.nodes[string(hash)], marshalizer, hasher)
+		require.Nil(t, err)
+		if nodeData.Type != "branch" {
+			continue
+		}
+
+		for _, child := range nodeData.Children {
+			if len(child) > 0 {
+				return hash, child
+			}
+		}
+	}
+
+	require.Fail(t, "no branch node found")
+	return nil, nil
+}
+
+func TestNewTrieIntegrityVerifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie storage should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieIntegrityVerifier(nil)
+		verifier, err := trieIntegrity.NewTrieIntegrityVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, trieIntegrity.ErrNilTrieStorage, err)
+	})
+	t.Run("nil marshalizer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieIntegrityVerifier(testscommon.NewMemDbMock())
+		args.Marshalizer = nil
+		verifier, err := trieIntegrity.NewTrieIntegrityVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, trieIntegrity.ErrNilMarshalizer, err)
+	})
+	t.Run("nil hasher should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsTrieIntegrityVerifier(testscommon.NewMemDbMock())
+		args.Hasher = nil
+		verifier, err := trieIntegrity.NewTrieIntegrityVerifier(args)
+		assert.Nil(t, verifier)
+		assert.Equal(t, trieIntegrity.ErrNilHasher, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, err := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(testscommon.NewMemDbMock()))
+		assert.Nil(t, err)
+		assert.False(t, verifier.IsInterfaceNil())
+	})
+}
+
+func TestTrieIntegrityVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(testscommon.NewMemDbMock()))
+		report, err := verifier.Verify(nil)
+		assert.Nil(t, report)
+		assert.Equal(t, trieIntegrity.ErrEmptyRootHash, err)
+	})
+	t.Run("complete state should not report damaged nodes", func(t *testing.T) {
+		t.Parallel()
+
+		ts, db := createState(t, 10)
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(db))
+
+		report, err := verifier.Verify(ts.rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, hex.EncodeToString(ts.rootHash), report.RootHash)
+		assert.Equal(t, uint64(len(ts.nodes)), report.NumNodes)
+		assert.Equal(t, uint64(9), report.NumDataTries)
+		assert.Empty(t, report.DamagedNodes)
+		assert.Empty(t, report.RepairedNodes)
+	})
+	t.Run("missing root should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		ts, db := createState(t, 10)
+		_ = db.Remove(ts.rootHash)
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(db))
+
+		report, err := verifier.Verify(ts.rootHash)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(0), report.NumNodes)
+		require.Equal(t, 1, len(report.DamagedNodes))
+		assert.Equal(t, hex.EncodeToString(ts.rootHash), report.DamagedNodes[0].Hash)
+		assert.Equal(t, "", report.DamagedNodes[0].Path)
+	})
+	t.Run("damaged main trie and data trie nodes should be reported", func(t *testing.T) {
+		t.Parallel()
+
+		ts, db := createState(t, 50)
+		_, missingMainTrieNode := getBranchWithChild(t, ts)
+		_ = db.Remove(missingMainTrieNode)
+		corruptedDataTrieNode := ts.dataTrieHashes[len(ts.dataTrieHashes)-1]
+		_ = db.Put(corruptedDataTrieNode, []byte("corrupted"))
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(db))
+
+		report, err := verifier.Verify(ts.rootHash)
+		require.Nil(t, err)
+		require.Equal(t, 2, len(report.DamagedNodes))
+
+		mainTrieDamage := report.DamagedNodes[0]
+		assert.Equal(t, hex.EncodeToString(missingMainTrieNode), mainTrieDamage.Hash)
+		assert.NotEmpty(t, mainTrieDamage.Path)
+		assert.Empty(t, mainTrieDamage.DataTrieRootHash)
+		assert.Empty(t, mainTrieDamage.AccountAddress)
+		assert.Contains(t, mainTrieDamage.Error, trie.ErrNodeNotFound.Error())
+
+		dataTrieDamage := report.DamagedNodes[1]
+		assert.Equal(t, hex.EncodeToString(corruptedDataTrieNode), dataTrieDamage.Hash)
+		assert.NotEmpty(t, dataTrieDamage.Path)
+		assert.Equal(t, hex.EncodeToString(ts.dataTrieRootHash), dataTrieDamage.DataTrieRootHash)
+		assert.Equal(t, hex.EncodeToString(ts.dataTrieAddress), dataTrieDamage.AccountAddress)
+		assert.Contains(t, dataTrieDamage.Error, trie.ErrNodeHashMismatch.Error())
+	})
+}
+
+func TestTrieIntegrityVerifier_VerifyAndRepair(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil fetcher should error", func(t *testing.T) {
+		t.Parallel()
+
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(testscommon.NewMemDbMock()))
+		report, err := verifier.VerifyAndRepair([]byte("root hash"), nil)
+		assert.Nil(t, report)
+		assert.Equal(t, trieIntegrity.ErrNilNodesFetcher, err)
+	})
+	t.Run("complete state should not fetch nodes", func(t *testing.T) {
+		t.Parallel()
+
+		ts, db := createState(t, 5)
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(db))
+		fetcher := &stateMock.MissingNodesFetcherStub{
+			FetchNodesCalled: func(hashes [][]byte) map[string][]byte {
+				require.Fail(t, "should have not been called")
+				return nil
+			},
+		}
+
+		report, err := verifier.VerifyAndRepair(ts.rootHash, fetcher)
+		require.Nil(t, err)
+		assert.Empty(t, report.DamagedNodes)
+		assert.Empty(t, report.RepairedNodes)
+	})
+	t.Run("should repair the nodes revealed by the previous repairs", func(t *testing.T) {
+		t.Parallel()
+
+		ts, db := createState(t, 50)
+		branch, child := getBranchWithChild(t, ts)
+		_ = db.Remove(branch)
+		_ = db.Remove(child)
+		_ = db.Put(ts.dataTrieRootHash, []byte("corrupted"))
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(db))
+
+		numFetches := 0
+		fetcher := &stateMock.MissingNodesFetcherStub{
+			FetchNodesCalled: func(hashes [][]byte) map[string][]byte {
+				numFetches++
+				fetched := make(map[string][]byte)
+				for _, hash := range hashes {
+					fetched[string(hash)] = ts.nodes[string(hash)]
+				}
+				return fetched
+			},
+		}
+
+		report, err := verifier.VerifyAndRepair(ts.rootHash, fetcher)
+		require.Nil(t, err)
+		assert.Equal(t, 2, numFetches)
+		assert.Empty(t, report.DamagedNodes)
+		assert.Equal(t, uint64(len(ts.nodes)), report.NumNodes)
+		require.Equal(t, 3, len(report.RepairedNodes))
+		assert.Equal(t, hex.EncodeToString(branch), report.RepairedNodes[0].Hash)
+		assert.Equal(t, hex.EncodeToString(ts.dataTrieRootHash), report.RepairedNodes[1].Hash)
+		assert.Equal(t, hex.EncodeToString(child), report.RepairedNodes[2].Hash)
+	})
+	t.Run("fetched nodes not matching their hash should not be saved", func(t *testing.T) {
+		t.Parallel()
+
+		ts, db := createState(t, 50)
+		_, child := getBranchWithChild(t, ts)
+		_ = db.Remove(child)
+		verifier, _ := trieIntegrity.NewTrieIntegrityVerifier(createMockArgsTrieIntegrityVerifier(db))
+		fetcher := &stateMock.MissingNodesFetcherStub{
+			FetchNodesCalled: func(hashes [][]byte) map[string][]byte {
+				return map[string][]byte{string(child): []byte("wrong node")}
+			},
+		}
+
+		report, err := verifier.VerifyAndRepair(ts.rootHash, fetcher)
+		require.Nil(t, err)
+		require.Equal(t, 1, len(report.DamagedNodes))
+		assert.Equal(t, hex.EncodeToString(child), report.DamagedNodes[0].Hash)
+		assert.Empty(t, report.RepairedNodes)
+
+		_, err = db.Get(child)
+		assert.NotNil(t, err)
+	})
+}
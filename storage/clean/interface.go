@@ -31,3 +31,9 @@ type NodeTypeProviderHandler interface {
 	GetType() core.NodeType
 	IsInterfaceNil() bool
 }
+
+// UnitIdentifierHandler defines a storer able to return its identifier, which is also the name of the unit's directory
+// inside each epoch directory
+type UnitIdentifierHandler interface {
+	GetIdentifier() string
+}
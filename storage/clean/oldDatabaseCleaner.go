@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
@@ -24,20 +25,44 @@ var log = logger.GetOrCreate("storage/clean")
 // ArgsOldDatabaseCleaner holds the arguments needed for creating an oldDatabaseCleaner
 type ArgsOldDatabaseCleaner struct {
 	DatabasePath           string
+	ColdDatabasePath       string
 	StorageListProvider    StorageListProviderHandler
 	EpochStartNotifier     EpochStartNotifier
 	OldDataCleanerProvider OldDataCleanerProvider
+	UnitsRetention         []config.StorageUnitRetentionConfig
+}
+
+// epochsToKeep holds the oldest epoch to keep for the units following the default policy and, separately, for each
+// unit having a finite retention policy
+type epochsToKeep struct {
+	oldestEpoch       uint32
+	oldestEpochByUnit map[string]uint32
+}
+
+func (etk *epochsToKeep) hasDataToRemove(epoch uint32) bool {
+	if epoch < etk.oldestEpoch {
+		return true
+	}
+	for _, oldestEpoch := range etk.oldestEpochByUnit {
+		if epoch < oldestEpoch {
+			return true
+		}
+	}
+
+	return false
 }
 
 type oldDatabaseCleaner struct {
 	sync.RWMutex
 
 	databasePath           string
+	coldDatabasePath       string
 	storageListProvider    StorageListProviderHandler
 	pathRemover            func(file string) error
 	directoryReader        storage.DirectoryReaderHandler
 	oldDataCleanerProvider OldDataCleanerProvider
-	oldestEpochsToKeep     map[uint32]uint32
+	unitsRetention         map[string]config.StorageUnitRetentionConfig
+	oldestEpochsToKeep     map[uint32]*epochsToKeep
 }
 
 // NewOldDatabaseCleaner returns a new instance of oldDatabaseCleaner
@@ -57,13 +82,20 @@ func NewOldDatabaseCleaner(args ArgsOldDatabaseCleaner) (*oldDatabaseCleaner, er
 	}
 	directoryReader := directoryhandler.NewDirectoryReader()
 
+	unitsRetention := make(map[string]config.StorageUnitRetentionConfig)
+	for _, retention := range args.UnitsRetention {
+		unitsRetention[retention.Unit] = retention
+	}
+
 	odc := &oldDatabaseCleaner{
 		databasePath:           args.DatabasePath,
+		coldDatabasePath:       args.ColdDatabasePath,
 		storageListProvider:    args.StorageListProvider,
 		pathRemover:            pathRemoverFunc,
 		directoryReader:        directoryReader,
 		oldDataCleanerProvider: args.OldDataCleanerProvider,
-		oldestEpochsToKeep:     make(map[uint32]uint32),
+		unitsRetention:         unitsRetention,
+		oldestEpochsToKeep:     make(map[uint32]*epochsToKeep),
 	}
 
 	odc.registerHandler(args.EpochStartNotifier)
@@ -92,43 +124,67 @@ func (odc *oldDatabaseCleaner) epochChangePrepareHandler(_ data.HeaderHandler) {
 }
 
 func (odc *oldDatabaseCleaner) handleEpochChangeAction(epoch uint32) error {
-	newOldestEpoch, err := odc.computeOldestEpochToKeep()
+	newEpochsToKeep, err := odc.computeOldestEpochsToKeep()
 	if err != nil {
 		return err
 	}
 
 	odc.Lock()
-	odc.oldestEpochsToKeep[epoch] = newOldestEpoch
+	odc.oldestEpochsToKeep[epoch] = newEpochsToKeep
 	odc.Unlock()
 
-	shouldClean := odc.shouldCleanOldData(epoch, newOldestEpoch)
-	log.Debug("old database cleaner", "epoch", epoch, "should clean", shouldClean, "oldest epoch", newOldestEpoch, "inner map", odc.oldestEpochsToKeep)
+	epochsToDeleteTo, shouldClean := odc.computeEpochsToDeleteTo(epoch, newEpochsToKeep)
+	log.Debug("old database cleaner", "epoch", epoch, "should clean", shouldClean,
+		"oldest epoch", newEpochsToKeep.oldestEpoch, "oldest epochs by unit", newEpochsToKeep.oldestEpochByUnit)
 	if !shouldClean {
 		return nil
 	}
 
-	err = odc.cleanOldEpochs(epoch)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return odc.cleanOldEpochs(epoch, epochsToDeleteTo)
 }
 
-func (odc *oldDatabaseCleaner) shouldCleanOldData(currentEpoch uint32, newOldestEpoch uint32) bool {
+// computeEpochsToDeleteTo returns the epochs before which the data can be removed, based on the oldest epochs to keep
+// saved on the previous epoch change
+func (odc *oldDatabaseCleaner) computeEpochsToDeleteTo(currentEpoch uint32, newEpochsToKeep *epochsToKeep) (*epochsToKeep, bool) {
 	odc.RLock()
 	defer odc.RUnlock()
 
-	if !odc.oldDataCleanerProvider.ShouldClean() {
-		return false
-	}
-
 	epochForDeletion := currentEpoch - 1
-	previousOldestEpoch, epochExists := odc.oldestEpochsToKeep[epochForDeletion]
+	previousEpochsToKeep, epochExists := odc.oldestEpochsToKeep[epochForDeletion]
 	if !epochExists {
 		log.Debug("cannot delete old databases as the previous epoch does not exist in configuration",
-			"epoch", epochForDeletion,
-			"epochs configuration", odc.oldestEpochsToKeep)
+			"epoch", epochForDeletion)
+		return nil, false
+	}
+
+	epochsToDeleteTo := &epochsToKeep{
+		oldestEpochByUnit: make(map[string]uint32),
+	}
+	if odc.shouldCleanOldData(previousEpochsToKeep.oldestEpoch, newEpochsToKeep.oldestEpoch) {
+		epochsToDeleteTo.oldestEpoch = previousEpochsToKeep.oldestEpoch
+	}
+
+	// the units with a finite retention policy are cleaned regardless of the node type
+	for unit, previousOldestEpoch := range previousEpochsToKeep.oldestEpochByUnit {
+		newOldestEpoch, found := newEpochsToKeep.oldestEpochByUnit[unit]
+		if !found || previousOldestEpoch > newOldestEpoch {
+			continue
+		}
+
+		epochsToDeleteTo.oldestEpochByUnit[unit] = previousOldestEpoch
+	}
+
+	shouldClean := epochsToDeleteTo.oldestEpoch > 0 || len(epochsToDeleteTo.oldestEpochByUnit) > 0
+
+	return epochsToDeleteTo, shouldClean
+}
+
+// shouldCleanOldData returns true if the data of the units following the default policy can be removed
+func (odc *oldDatabaseCleaner) shouldCleanOldData(previousOldestEpoch uint32, newOldestEpoch uint32) bool {
+	if !odc.oldDataCleanerProvider.ShouldClean() {
+		return false
+	}
+	if previousOldestEpoch == math.MaxUint32 {
 		return false
 	}
 	if previousOldestEpoch > newOldestEpoch {
@@ -141,11 +197,15 @@ func (odc *oldDatabaseCleaner) shouldCleanOldData(currentEpoch uint32, newOldest
 	return true
 }
 
-func (odc *oldDatabaseCleaner) computeOldestEpochToKeep() (uint32, error) {
+func (odc *oldDatabaseCleaner) computeOldestEpochsToKeep() (*epochsToKeep, error) {
 	odc.RLock()
 	defer odc.RUnlock()
 
-	oldestEpoch := uint32(math.MaxUint32)
+	newEpochsToKeep := &epochsToKeep{
+		oldestEpoch:       math.MaxUint32,
+		oldestEpochByUnit: make(map[string]uint32),
+	}
+	numStorersWithOldestEpoch := 0
 	storers := odc.storageListProvider.GetAllStorers()
 	for _, storer := range storers {
 		localEpoch, err := storer.GetOldestEpoch()
@@ -153,17 +213,38 @@ func (odc *oldDatabaseCleaner) computeOldestEpochToKeep() (uint32, error) {
 			logOldestEpochCompute(err)
 			continue
 		}
+		numStorersWithOldestEpoch++
 
-		if localEpoch < oldestEpoch {
-			oldestEpoch = localEpoch
+		retention, hasRetention := odc.getUnitRetention(storer)
+		if !hasRetention {
+			if localEpoch < newEpochsToKeep.oldestEpoch {
+				newEpochsToKeep.oldestEpoch = localEpoch
+			}
+			continue
+		}
+		if retention.KeepForever {
+			continue
 		}
+
+		newEpochsToKeep.oldestEpochByUnit[retention.Unit] = localEpoch
 	}
 
-	if oldestEpoch == uint32(math.MaxUint32) {
-		return 0, storage.ErrCannotComputeStorageOldestEpoch
+	if numStorersWithOldestEpoch == 0 {
+		return nil, storage.ErrCannotComputeStorageOldestEpoch
 	}
 
-	return oldestEpoch, nil
+	return newEpochsToKeep, nil
+}
+
+func (odc *oldDatabaseCleaner) getUnitRetention(storer storage.Storer) (config.StorageUnitRetentionConfig, bool) {
+	identifierHandler, ok := storer.(UnitIdentifierHandler)
+	if !ok {
+		return config.StorageUnitRetentionConfig{}, false
+	}
+
+	retention, found := odc.unitsRetention[identifierHandler.GetIdentifier()]
+
+	return retention, found
 }
 
 func logOldestEpochCompute(err error) {
@@ -172,20 +253,34 @@ func logOldestEpochCompute(err error) {
 	}
 }
 
-func (odc *oldDatabaseCleaner) cleanOldEpochs(currentEpoch uint32) error {
+func (odc *oldDatabaseCleaner) cleanOldEpochs(currentEpoch uint32, epochsToDeleteTo *epochsToKeep) error {
 	odc.Lock()
 	defer odc.Unlock()
 
-	epochForDeletion := currentEpoch - 1
-	epochToDeleteTo := odc.oldestEpochsToKeep[epochForDeletion]
-
-	epochDirectories, err := odc.directoryReader.ListDirectoriesAsString(odc.databasePath)
+	err := odc.cleanDatabaseDirectory(odc.databasePath, epochsToDeleteTo)
 	if err != nil {
 		return err
 	}
 
-	if len(epochDirectories) == 0 {
-		return nil
+	// the persisters moved in the cold storage follow the same retention as the ones in the database directory
+	if len(odc.coldDatabasePath) > 0 {
+		err = odc.cleanDatabaseDirectory(odc.coldDatabasePath, epochsToDeleteTo)
+		if err != nil {
+			log.Debug("cannot clean the cold storage", "path", odc.coldDatabasePath, "error", err)
+		}
+	}
+
+	odc.cleanMap(currentEpoch)
+
+	return nil
+}
+
+// cleanDatabaseDirectory removes the old epochs data from the provided database directory.
+// Should be called under mutex protection
+func (odc *oldDatabaseCleaner) cleanDatabaseDirectory(databasePath string, epochsToDeleteTo *epochsToKeep) error {
+	epochDirectories, err := odc.directoryReader.ListDirectoriesAsString(databasePath)
+	if err != nil {
+		return err
 	}
 
 	sortedEpochDirectories, sortedEpochs, found := getSortedEpochDirectories(epochDirectories)
@@ -194,21 +289,87 @@ func (odc *oldDatabaseCleaner) cleanOldEpochs(currentEpoch uint32) error {
 	}
 
 	for idx, epoch := range sortedEpochs {
-		if epoch >= epochToDeleteTo {
-			break
+		fullDirectoryPath := path.Join(databasePath, sortedEpochDirectories[idx])
+		if len(odc.unitsRetention) == 0 {
+			// all the units follow the same policy, so the epoch directories are removed as a whole
+			if epoch >= epochsToDeleteTo.oldestEpoch {
+				break
+			}
+
+			odc.removePath(fullDirectoryPath)
+			continue
 		}
 
-		fullDirectoryPath := path.Join(odc.databasePath, sortedEpochDirectories[idx])
-		log.Debug("removing old database", "db path", fullDirectoryPath)
-		err := odc.pathRemover(fullDirectoryPath)
-		if err != nil {
-			log.Warn("cannot remove old DB", "path", fullDirectoryPath, "error", err)
+		odc.cleanUnitsDirectories(fullDirectoryPath, epoch, epochsToDeleteTo)
+	}
+
+	return nil
+}
+
+// cleanUnitsDirectories removes, from each shard directory of the epoch directory, the directories of the units whose
+// data for that epoch is no longer needed. The epoch directory is removed as well if no unit directory is left.
+// Should be called under mutex protection
+func (odc *oldDatabaseCleaner) cleanUnitsDirectories(epochDirectoryPath string, epoch uint32, epochsToDeleteTo *epochsToKeep) {
+	if !epochsToDeleteTo.hasDataToRemove(epoch) {
+		return
+	}
+
+	shardDirectories, err := odc.directoryReader.ListDirectoriesAsString(epochDirectoryPath)
+	if err != nil {
+		log.Warn("cannot list old epoch directory", "path", epochDirectoryPath, "error", err)
+		return
+	}
+
+	numUnitsLeft := 0
+	for _, shardDirectory := range shardDirectories {
+		shardDirectoryPath := path.Join(epochDirectoryPath, shardDirectory)
+		unitDirectories, errList := odc.directoryReader.ListDirectoriesAsString(shardDirectoryPath)
+		if errList != nil {
+			log.Warn("cannot list old epoch directory", "path", shardDirectoryPath, "error", errList)
+			numUnitsLeft++
+			continue
+		}
+
+		for _, unitDirectory := range unitDirectories {
+			if !odc.shouldRemoveUnit(unitDirectory, epoch, epochsToDeleteTo) {
+				numUnitsLeft++
+				continue
+			}
+
+			if !odc.removePath(path.Join(shardDirectoryPath, unitDirectory)) {
+				numUnitsLeft++
+			}
 		}
 	}
 
-	odc.cleanMap(currentEpoch)
+	if numUnitsLeft == 0 {
+		odc.removePath(epochDirectoryPath)
+	}
+}
 
-	return nil
+func (odc *oldDatabaseCleaner) shouldRemoveUnit(unit string, epoch uint32, epochsToDeleteTo *epochsToKeep) bool {
+	retention, hasRetention := odc.unitsRetention[unit]
+	if !hasRetention {
+		return epoch < epochsToDeleteTo.oldestEpoch
+	}
+	if retention.KeepForever {
+		return false
+	}
+
+	oldestEpoch, found := epochsToDeleteTo.oldestEpochByUnit[unit]
+
+	return found && epoch < oldestEpoch
+}
+
+func (odc *oldDatabaseCleaner) removePath(fullPath string) bool {
+	log.Debug("removing old database", "db path", fullPath)
+	err := odc.pathRemover(fullPath)
+	if err != nil {
+		log.Warn("cannot remove old DB", "path", fullPath, "error", err)
+		return false
+	}
+
+	return true
 }
 
 // cleanMap will remove all the entries from the map that aren't for current epoch.
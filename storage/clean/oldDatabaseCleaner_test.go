@@ -2,9 +2,13 @@ package clean
 
 import (
 	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/data/block"
+	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/storage"
@@ -249,6 +253,176 @@ func TestOldDatabaseCleaner_EpochChange(t *testing.T) {
 	)
 }
 
+func TestOldDatabaseCleaner_EpochChangeShouldCleanColdStorage(t *testing.T) {
+	t.Parallel()
+
+	var handlerFunc epochStart.ActionHandler
+	args := createMockArgs()
+	args.ColdDatabasePath = "cold/D"
+	args.EpochStartNotifier = &mock.EpochStartNotifierStub{
+		RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+			handlerFunc = handler
+		},
+	}
+	args.OldDataCleanerProvider = &testscommon.OldDataCleanerProviderStub{
+		ShouldCleanCalled: func() bool {
+			return true
+		},
+	}
+	directoryReader := &mock.DirectoryReaderStub{
+		ListDirectoriesAsStringCalled: func(directoryPath string) ([]string, error) {
+			if directoryPath == args.ColdDatabasePath {
+				return []string{"Epoch_0", "Epoch_1"}, nil
+			}
+
+			return []string{"Epoch_2", "Epoch_3"}, nil
+		},
+	}
+
+	removedFiles := make([]string, 0)
+	fileRemover := func(file string) error {
+		removedFiles = append(removedFiles, file)
+		return nil
+	}
+
+	args.StorageListProvider = getStorageListProviderWithOldEpoch(3)
+	odc, _ := NewOldDatabaseCleaner(args)
+	odc.pathRemover = fileRemover
+	odc.directoryReader = directoryReader
+
+	handlerFunc.EpochStartAction(&block.Header{Epoch: 5})
+	require.Empty(t, removedFiles)
+	handlerFunc.EpochStartAction(&block.Header{Epoch: 6})
+	require.Equal(t,
+		[]string{"db/D/Epoch_2", "cold/D/Epoch_0", "cold/D/Epoch_1"},
+		removedFiles,
+	)
+}
+
+type identifiedStorerStub struct {
+	*storageStubs.StorerStub
+	identifier string
+}
+
+func (stub *identifiedStorerStub) GetIdentifier() string {
+	return stub.identifier
+}
+
+func createIdentifiedStorer(identifier string, oldestEpoch uint32) storage.Storer {
+	return &identifiedStorerStub{
+		StorerStub: &storageStubs.StorerStub{
+			GetOldestEpochCalled: func() (uint32, error) {
+				return oldestEpoch, nil
+			},
+		},
+		identifier: identifier,
+	}
+}
+
+func TestOldDatabaseCleaner_EpochChangeWithUnitsRetention(t *testing.T) {
+	t.Parallel()
+
+	units := []string{"Transactions", "BlockHeaders", "MiniBlocks"}
+	createDatabase := func(t *testing.T) string {
+		databasePath := t.TempDir()
+		for epoch := 0; epoch < 4; epoch++ {
+			for _, unit := range units {
+				unitPath := filepath.Join(databasePath, fmt.Sprintf("Epoch_%d", epoch), "Shard_0", unit)
+				require.Nil(t, os.MkdirAll(unitPath, os.ModePerm))
+			}
+		}
+
+		return databasePath
+	}
+	runEpochChanges := func(
+		t *testing.T,
+		databasePath string,
+		unitsRetention []config.StorageUnitRetentionConfig,
+		storers map[dataRetriever.UnitType]storage.Storer,
+	) {
+		var handlerFunc epochStart.ActionHandler
+		args := createMockArgs()
+		args.DatabasePath = databasePath
+		args.EpochStartNotifier = &mock.EpochStartNotifierStub{
+			RegisterHandlerCalled: func(handler epochStart.ActionHandler) {
+				handlerFunc = handler
+			},
+		}
+		args.OldDataCleanerProvider = &testscommon.OldDataCleanerProviderStub{
+			ShouldCleanCalled: func() bool {
+				return true
+			},
+		}
+		args.UnitsRetention = unitsRetention
+		args.StorageListProvider = &mock.StorageListProviderStub{
+			GetAllStorersCalled: func() map[dataRetriever.UnitType]storage.Storer {
+				return storers
+			},
+		}
+		_, err := NewOldDatabaseCleaner(args)
+		require.Nil(t, err)
+
+		handlerFunc.EpochStartAction(&block.Header{Epoch: 5})
+		handlerFunc.EpochStartAction(&block.Header{Epoch: 6})
+	}
+	unitExists := func(databasePath string, epoch int, unit string) bool {
+		_, errStat := os.Stat(filepath.Join(databasePath, fmt.Sprintf("Epoch_%d", epoch), "Shard_0", unit))
+		return errStat == nil
+	}
+
+	t.Run("unit kept forever should not be removed", func(t *testing.T) {
+		t.Parallel()
+
+		databasePath := createDatabase(t)
+		runEpochChanges(t,
+			databasePath,
+			[]config.StorageUnitRetentionConfig{
+				{Unit: "Transactions", KeepForever: true},
+				{Unit: "BlockHeaders", NumEpochsToKeep: 2},
+			},
+			map[dataRetriever.UnitType]storage.Storer{
+				dataRetriever.TransactionUnit: createIdentifiedStorer("Transactions", 0),
+				dataRetriever.BlockHeaderUnit: createIdentifiedStorer("BlockHeaders", 2),
+				dataRetriever.MiniBlockUnit:   createIdentifiedStorer("MiniBlocks", 1),
+			},
+		)
+
+		// the transactions are kept forever, the headers for the epochs older than 2 and the miniblocks for the ones
+		// older than 1 are removed
+		for epoch := 0; epoch < 4; epoch++ {
+			assert.True(t, unitExists(databasePath, epoch, "Transactions"))
+			assert.Equal(t, epoch >= 2, unitExists(databasePath, epoch, "BlockHeaders"))
+			assert.Equal(t, epoch >= 1, unitExists(databasePath, epoch, "MiniBlocks"))
+		}
+	})
+	t.Run("units with a number of epochs to keep should be removed by their own oldest epoch", func(t *testing.T) {
+		t.Parallel()
+
+		databasePath := createDatabase(t)
+		runEpochChanges(t,
+			databasePath,
+			[]config.StorageUnitRetentionConfig{
+				{Unit: "Transactions", NumEpochsToKeep: 1},
+				{Unit: "BlockHeaders", NumEpochsToKeep: 2},
+			},
+			map[dataRetriever.UnitType]storage.Storer{
+				dataRetriever.TransactionUnit: createIdentifiedStorer("Transactions", 3),
+				dataRetriever.BlockHeaderUnit: createIdentifiedStorer("BlockHeaders", 2),
+				dataRetriever.MiniBlockUnit:   createIdentifiedStorer("MiniBlocks", 1),
+			},
+		)
+
+		// the epoch directories left without units are removed as a whole
+		_, err := os.Stat(filepath.Join(databasePath, "Epoch_0"))
+		assert.True(t, os.IsNotExist(err))
+		for epoch := 1; epoch < 4; epoch++ {
+			assert.Equal(t, epoch >= 3, unitExists(databasePath, epoch, "Transactions"))
+			assert.Equal(t, epoch >= 2, unitExists(databasePath, epoch, "BlockHeaders"))
+			assert.True(t, unitExists(databasePath, epoch, "MiniBlocks"))
+		}
+	})
+}
+
 func getStorageListProviderWithOldEpoch(epoch uint32) StorageListProviderHandler {
 	return &mock.StorageListProviderStub{
 		GetAllStorersCalled: func() map[dataRetriever.UnitType]storage.Storer {
@@ -0,0 +1,28 @@
+package clean
+
+import (
+	"github.com/multiversx/mx-chain-go/config"
+)
+
+// retentionOldDataCleanerProvider decides the old data cleaning of a storage unit having its own retention policy,
+// regardless of the node type
+type retentionOldDataCleanerProvider struct {
+	shouldClean bool
+}
+
+// NewRetentionOldDataCleanerProvider returns a new instance of retentionOldDataCleanerProvider
+func NewRetentionOldDataCleanerProvider(retention config.StorageUnitRetentionConfig) *retentionOldDataCleanerProvider {
+	return &retentionOldDataCleanerProvider{
+		shouldClean: !retention.KeepForever,
+	}
+}
+
+// ShouldClean returns false if the unit is kept forever
+func (rodcp *retentionOldDataCleanerProvider) ShouldClean() bool {
+	return rodcp.shouldClean
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (rodcp *retentionOldDataCleanerProvider) IsInterfaceNil() bool {
+	return rodcp == nil
+}
@@ -0,0 +1,19 @@
+package clean
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/config"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRetentionOldDataCleanerProvider_ShouldClean(t *testing.T) {
+	t.Parallel()
+
+	rodcp := NewRetentionOldDataCleanerProvider(config.StorageUnitRetentionConfig{Unit: "Transactions", KeepForever: true})
+	require.False(t, rodcp.IsInterfaceNil())
+	require.False(t, rodcp.ShouldClean())
+
+	rodcp = NewRetentionOldDataCleanerProvider(config.StorageUnitRetentionConfig{Unit: "BlockHeaders", NumEpochsToKeep: 10})
+	require.True(t, rodcp.ShouldClean())
+}
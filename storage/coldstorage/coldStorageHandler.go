@@ -0,0 +1,286 @@
+package coldstorage
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var log = logger.GetOrCreate("storage/coldstorage")
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+// movingSuffix marks the partial copy of a directory being moved on a different device
+const movingSuffix = ".moving"
+
+// ArgsColdStorageHandler holds the arguments needed for creating a new cold storage handler
+type ArgsColdStorageHandler struct {
+	DatabasePath     string
+	ColdDatabasePath string
+}
+
+type coldStorageHandler struct {
+	databasePath     string
+	coldDatabasePath string
+	mutMove          sync.Mutex
+}
+
+// NewColdStorageHandler creates a new cold storage handler which moves the closed persisters from the database
+// directory to the cold database directory, keeping their path relative to the database directory
+func NewColdStorageHandler(args ArgsColdStorageHandler) (*coldStorageHandler, error) {
+	if len(args.DatabasePath) == 0 {
+		return nil, storage.ErrEmptyDatabasePath
+	}
+	if len(args.ColdDatabasePath) == 0 {
+		return nil, storage.ErrEmptyColdStoragePath
+	}
+
+	databasePath, err := filepath.Abs(args.DatabasePath)
+	if err != nil {
+		return nil, err
+	}
+	coldDatabasePath, err := filepath.Abs(args.ColdDatabasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	if isSameOrSubPath(databasePath, coldDatabasePath) || isSameOrSubPath(coldDatabasePath, databasePath) {
+		return nil, fmt.Errorf("%w: database path %s, cold storage path %s",
+			storage.ErrInvalidColdStoragePath, databasePath, coldDatabasePath)
+	}
+
+	return &coldStorageHandler{
+		databasePath:     databasePath,
+		coldDatabasePath: coldDatabasePath,
+	}, nil
+}
+
+// MoveToColdStorage schedules the move of the directory of a closed persister in the cold storage. The directory is
+// copied by a background go routine, after which switchPath is called with the cold path. The source directory is
+// removed only if switchPath returns true, otherwise the copy is discarded. A path which is already in the cold storage
+// or which does not exist on disk is ignored. switchPath is never called from the caller's go routine
+func (csh *coldStorageHandler) MoveToColdStorage(path string, switchPath func(coldPath string) bool) {
+	if csh.isColdStoragePath(path) || !directoryExists(path) {
+		return
+	}
+
+	coldPath, err := csh.computeColdPath(path)
+	if err != nil {
+		log.Warn("cannot move persister to cold storage", "path", path, "error", err.Error())
+		return
+	}
+
+	go csh.moveInBackground(path, coldPath, switchPath)
+}
+
+// moveInBackground copies the persister directory in the cold storage and removes the source once the persister was
+// switched to the cold path. The moves are done one at a time, so the disks are not overloaded
+func (csh *coldStorageHandler) moveInBackground(path string, coldPath string, switchPath func(coldPath string) bool) {
+	csh.mutMove.Lock()
+	defer csh.mutMove.Unlock()
+
+	err := os.MkdirAll(filepath.Dir(coldPath), rwxOwner)
+	if err != nil {
+		log.Warn("cannot create cold storage directory", "path", coldPath, "error", err.Error())
+		return
+	}
+
+	// a previous move might have been interrupted after the copy was completed, before removing the source
+	err = os.RemoveAll(coldPath)
+	if err != nil {
+		log.Warn("cannot remove stale persister copy from cold storage", "path", coldPath, "error", err.Error())
+		return
+	}
+
+	err = copyToColdStorage(path, coldPath)
+	if err != nil {
+		log.Warn("cannot copy persister to cold storage", "path", path, "cold path", coldPath, "error", err.Error())
+		return
+	}
+
+	if !switchPath(coldPath) {
+		log.Debug("persister was reopened or removed while being moved, discarding the cold storage copy",
+			"path", path, "cold path", coldPath)
+		err = os.RemoveAll(coldPath)
+		if err != nil {
+			log.Warn("cannot remove discarded persister copy from cold storage", "path", coldPath, "error", err.Error())
+		}
+		return
+	}
+
+	err = os.RemoveAll(path)
+	if err != nil {
+		log.Warn("cannot remove persister directory moved to cold storage", "path", path, "error", err.Error())
+	}
+	csh.removeEmptyParentDirectories(path)
+
+	log.Debug("moved persister to cold storage", "path", path, "cold path", coldPath)
+}
+
+// GetPersisterPath returns the path of the persister in the cold storage if it was moved there. Otherwise, the
+// provided path is returned
+func (csh *coldStorageHandler) GetPersisterPath(path string) string {
+	if csh.isColdStoragePath(path) || directoryExists(path) {
+		return path
+	}
+
+	coldPath, err := csh.computeColdPath(path)
+	if err != nil {
+		return path
+	}
+	if !directoryExists(coldPath) {
+		return path
+	}
+
+	return coldPath
+}
+
+// RemoveFromColdStorage removes the directory of a persister moved in the cold storage. Paths outside the cold
+// storage are ignored
+func (csh *coldStorageHandler) RemoveFromColdStorage(path string) error {
+	if !csh.isColdStoragePath(path) {
+		return nil
+	}
+
+	return os.RemoveAll(path)
+}
+
+// removeEmptyParentDirectories removes the epoch and shard directories left empty after their persisters were moved
+func (csh *coldStorageHandler) removeEmptyParentDirectories(path string) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return
+	}
+
+	for dir := filepath.Dir(absolutePath); dir != csh.databasePath && isSameOrSubPath(csh.databasePath, dir); dir = filepath.Dir(dir) {
+		// os.Remove fails for a directory which is not empty
+		err = os.Remove(dir)
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (csh *coldStorageHandler) isColdStoragePath(path string) bool {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+
+	return isSameOrSubPath(csh.coldDatabasePath, absolutePath)
+}
+
+func (csh *coldStorageHandler) computeColdPath(path string) (string, error) {
+	absolutePath, err := filepath.Abs(path)
+	if err != nil {
+		return "", err
+	}
+	if !isSameOrSubPath(csh.databasePath, absolutePath) || absolutePath == csh.databasePath {
+		return "", fmt.Errorf("%w: %s", storage.ErrPathNotInDatabaseDirectory, path)
+	}
+
+	relativePath, err := filepath.Rel(csh.databasePath, absolutePath)
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(csh.coldDatabasePath, relativePath), nil
+}
+
+// copyToColdStorage copies the directory next to its destination first, so an interrupted copy never leaves an
+// incomplete persister in the cold storage. The files are hard linked when the cold storage is on the same device
+func copyToColdStorage(source string, destination string) error {
+	movingPath := destination + movingSuffix
+	err := os.RemoveAll(movingPath)
+	if err != nil {
+		return err
+	}
+
+	err = copyDirectory(source, movingPath)
+	if err != nil {
+		_ = os.RemoveAll(movingPath)
+		return err
+	}
+
+	return os.Rename(movingPath, destination)
+}
+
+func copyDirectory(source string, destination string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relativePath, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		destinationPath := filepath.Join(destination, relativePath)
+
+		if info.IsDir() {
+			return os.MkdirAll(destinationPath, rwxOwner)
+		}
+
+		return copyFile(path, destinationPath, info.Mode())
+	})
+}
+
+func copyFile(source string, destination string, mode os.FileMode) error {
+	// the source persister is closed, so its files are not modified while linked in the cold storage
+	err := os.Link(source, destination)
+	if err == nil {
+		return nil
+	}
+
+	sourceFile, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = sourceFile.Close()
+	}()
+
+	destinationFile, err := os.OpenFile(destination, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(destinationFile, sourceFile)
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	err = destinationFile.Sync()
+	if err != nil {
+		_ = destinationFile.Close()
+		return err
+	}
+
+	return destinationFile.Close()
+}
+
+func directoryExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+
+	return info.IsDir()
+}
+
+func isSameOrSubPath(parent string, path string) bool {
+	return path == parent || strings.HasPrefix(path, parent+string(filepath.Separator))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csh *coldStorageHandler) IsInterfaceNil() bool {
+	return csh == nil
+}
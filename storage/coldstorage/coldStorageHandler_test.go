@@ -0,0 +1,230 @@
+package coldstorage
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPersisterDirectory(t *testing.T, path string) {
+	require.Nil(t, os.MkdirAll(path, rwxOwner))
+	require.Nil(t, os.WriteFile(filepath.Join(path, "000001.log"), []byte("data"), 0600))
+}
+
+func createHandler(t *testing.T) (*coldStorageHandler, string, string) {
+	root := t.TempDir()
+	databasePath := filepath.Join(root, "db", "chain")
+	coldDatabasePath := filepath.Join(root, "cold", "chain")
+
+	csh, err := NewColdStorageHandler(ArgsColdStorageHandler{
+		DatabasePath:     databasePath,
+		ColdDatabasePath: coldDatabasePath,
+	})
+	require.Nil(t, err)
+
+	return csh, databasePath, coldDatabasePath
+}
+
+func TestNewColdStorageHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty database path should error", func(t *testing.T) {
+		t.Parallel()
+
+		csh, err := NewColdStorageHandler(ArgsColdStorageHandler{ColdDatabasePath: "cold"})
+		assert.Nil(t, csh)
+		assert.Equal(t, storage.ErrEmptyDatabasePath, err)
+	})
+	t.Run("empty cold database path should error", func(t *testing.T) {
+		t.Parallel()
+
+		csh, err := NewColdStorageHandler(ArgsColdStorageHandler{DatabasePath: "db"})
+		assert.Nil(t, csh)
+		assert.Equal(t, storage.ErrEmptyColdStoragePath, err)
+	})
+	t.Run("overlapping paths should error", func(t *testing.T) {
+		t.Parallel()
+
+		csh, err := NewColdStorageHandler(ArgsColdStorageHandler{DatabasePath: "db", ColdDatabasePath: "db/cold"})
+		assert.Nil(t, csh)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdStoragePath))
+
+		csh, err = NewColdStorageHandler(ArgsColdStorageHandler{DatabasePath: "cold/db", ColdDatabasePath: "cold"})
+		assert.Nil(t, csh)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdStoragePath))
+
+		csh, err = NewColdStorageHandler(ArgsColdStorageHandler{DatabasePath: "db", ColdDatabasePath: "./db"})
+		assert.Nil(t, csh)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdStoragePath))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		csh, err := NewColdStorageHandler(ArgsColdStorageHandler{DatabasePath: "db", ColdDatabasePath: "dbcold"})
+		assert.Nil(t, err)
+		assert.False(t, csh.IsInterfaceNil())
+	})
+}
+
+func moveAndWait(t *testing.T, csh *coldStorageHandler, path string, shouldSwitch bool) string {
+	chSwitched := make(chan string, 1)
+	csh.MoveToColdStorage(path, func(coldPath string) bool {
+		// the switch function is called after the copy is complete, before the source directory is removed
+		assert.True(t, directoryExists(path))
+		assert.True(t, directoryExists(coldPath))
+
+		chSwitched <- coldPath
+		return shouldSwitch
+	})
+
+	select {
+	case coldPath := <-chSwitched:
+		// wait for the background move to finish
+		csh.mutMove.Lock()
+		csh.mutMove.Unlock()
+
+		return coldPath
+	case <-time.After(time.Second * 5):
+		require.Fail(t, "timeout while waiting for the move")
+		return ""
+	}
+}
+
+func TestColdStorageHandler_MoveToColdStorage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("path outside the database directory should not move", func(t *testing.T) {
+		t.Parallel()
+
+		csh, _, _ := createHandler(t)
+		path := filepath.Join(t.TempDir(), "Transactions")
+		createPersisterDirectory(t, path)
+
+		csh.MoveToColdStorage(path, func(_ string) bool {
+			assert.Fail(t, "should have not switched the path")
+			return true
+		})
+		csh.mutMove.Lock()
+		csh.mutMove.Unlock()
+		assert.True(t, directoryExists(path))
+	})
+	t.Run("missing or cold paths should not move", func(t *testing.T) {
+		t.Parallel()
+
+		csh, databasePath, coldDatabasePath := createHandler(t)
+		switchPath := func(_ string) bool {
+			assert.Fail(t, "should have not switched the path")
+			return true
+		}
+
+		path := filepath.Join(databasePath, "Epoch_0", "Shard_0", "Transactions")
+		csh.MoveToColdStorage(path, switchPath)
+
+		coldPath := filepath.Join(coldDatabasePath, "Epoch_0", "Shard_0", "Transactions")
+		createPersisterDirectory(t, coldPath)
+		csh.MoveToColdStorage(coldPath, switchPath)
+		assert.True(t, directoryExists(coldPath))
+	})
+	t.Run("should move the directory", func(t *testing.T) {
+		t.Parallel()
+
+		csh, databasePath, coldDatabasePath := createHandler(t)
+		path := filepath.Join(databasePath, "Epoch_0", "Shard_0", "Transactions")
+		createPersisterDirectory(t, path)
+
+		// leftover of a previous interrupted move
+		expectedColdPath := filepath.Join(coldDatabasePath, "Epoch_0", "Shard_0", "Transactions")
+		createPersisterDirectory(t, expectedColdPath)
+
+		otherPath := filepath.Join(databasePath, "Epoch_1", "Shard_0", "Transactions")
+		createPersisterDirectory(t, otherPath)
+
+		movedPath := moveAndWait(t, csh, path, true)
+		assert.Equal(t, expectedColdPath, movedPath)
+		assert.False(t, directoryExists(path))
+		// the emptied epoch directory is removed, the database directory is kept
+		assert.False(t, directoryExists(filepath.Join(databasePath, "Epoch_0")))
+		assert.True(t, directoryExists(otherPath))
+
+		content, err := os.ReadFile(filepath.Join(expectedColdPath, "000001.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("data"), content)
+	})
+	t.Run("refused switch should discard the copy", func(t *testing.T) {
+		t.Parallel()
+
+		csh, databasePath, coldDatabasePath := createHandler(t)
+		path := filepath.Join(databasePath, "Epoch_0", "Shard_0", "Transactions")
+		createPersisterDirectory(t, path)
+
+		movedPath := moveAndWait(t, csh, path, false)
+		assert.Equal(t, filepath.Join(coldDatabasePath, "Epoch_0", "Shard_0", "Transactions"), movedPath)
+		assert.False(t, directoryExists(movedPath))
+
+		content, err := os.ReadFile(filepath.Join(path, "000001.log"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("data"), content)
+	})
+}
+
+func TestColdStorageHandler_CopyToColdStorage(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	source := filepath.Join(root, "source")
+	createPersisterDirectory(t, filepath.Join(source, "sub"))
+	require.Nil(t, os.WriteFile(filepath.Join(source, "MANIFEST"), []byte("manifest"), 0600))
+
+	destination := filepath.Join(root, "destination")
+	err := copyToColdStorage(source, destination)
+	assert.Nil(t, err)
+	assert.False(t, directoryExists(destination+movingSuffix))
+
+	content, err := os.ReadFile(filepath.Join(destination, "MANIFEST"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("manifest"), content)
+	content, err = os.ReadFile(filepath.Join(destination, "sub", "000001.log"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("data"), content)
+}
+
+func TestColdStorageHandler_GetPersisterPath(t *testing.T) {
+	t.Parallel()
+
+	csh, databasePath, coldDatabasePath := createHandler(t)
+	hotPath := filepath.Join(databasePath, "Epoch_0", "Shard_0", "Transactions")
+	coldPath := filepath.Join(coldDatabasePath, "Epoch_0", "Shard_0", "Transactions")
+
+	// neither exists, a new persister will be created in the database directory
+	assert.Equal(t, hotPath, csh.GetPersisterPath(hotPath))
+
+	createPersisterDirectory(t, coldPath)
+	assert.Equal(t, coldPath, csh.GetPersisterPath(hotPath))
+
+	createPersisterDirectory(t, hotPath)
+	assert.Equal(t, hotPath, csh.GetPersisterPath(hotPath))
+
+	assert.Equal(t, "outside", csh.GetPersisterPath("outside"))
+}
+
+func TestColdStorageHandler_RemoveFromColdStorage(t *testing.T) {
+	t.Parallel()
+
+	csh, databasePath, coldDatabasePath := createHandler(t)
+	hotPath := filepath.Join(databasePath, "Epoch_0", "Shard_0", "Transactions")
+	coldPath := filepath.Join(coldDatabasePath, "Epoch_0", "Shard_0", "Transactions")
+	createPersisterDirectory(t, hotPath)
+	createPersisterDirectory(t, coldPath)
+
+	assert.Nil(t, csh.RemoveFromColdStorage(hotPath))
+	assert.True(t, directoryExists(hotPath))
+
+	assert.Nil(t, csh.RemoveFromColdStorage(coldPath))
+	assert.False(t, directoryExists(coldPath))
+}
@@ -2,6 +2,7 @@ package database
 
 import (
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
 	"github.com/multiversx/mx-chain-storage-go/leveldb"
 	"github.com/multiversx/mx-chain-storage-go/memorydb"
 )
@@ -31,3 +32,9 @@ func NewLevelDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFil
 func NewSerialDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (s *leveldb.SerialDB, err error) {
 	return leveldb.NewSerialDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
 }
+
+// NewPebbleDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewPebbleDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*pebbledb.DB, error) {
+	return pebbledb.NewDB(path, batchDelaySeconds, maxBatchSize, maxOpenFiles)
+}
@@ -0,0 +1,204 @@
+package database
+
+import (
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+var log = logger.GetOrCreate("storage/database")
+
+var _ storage.Persister = (*levelDBAdapter)(nil)
+var _ storage.EngineStatsProvider = (*levelDBAdapter)(nil)
+var _ storage.Compactor = (*levelDBAdapter)(nil)
+
+const (
+	levelDBTableExtension        = ".ldb"
+	levelDBLegacyTableExtension  = ".sst"
+	levelDBJournalExtension      = ".log"
+	levelDBJournalNamePrefixChar = '0'
+)
+
+// levelDBAdapter wraps a level db persister, adding the engine statistics and the manual compaction. The wrapped
+// persister does not expose its level db handle, so the statistics are read from the database files and the
+// compaction closes the persister, compacts the database files and opens a new persister on them
+type levelDBAdapter struct {
+	mutPersister  sync.RWMutex
+	persister     storage.Persister
+	path          string
+	openPersister func() (storage.Persister, error)
+	isClosed      bool
+}
+
+// NewLevelDBAdapter opens a level db persister through the provided function and wraps it, so it can report its engine
+// statistics and it can be manually compacted
+func NewLevelDBAdapter(path string, openPersister func() (storage.Persister, error)) (*levelDBAdapter, error) {
+	if openPersister == nil {
+		return nil, storage.ErrNilPersisterFactory
+	}
+
+	persister, err := openPersister()
+	if err != nil {
+		return nil, err
+	}
+
+	return &levelDBAdapter{
+		persister:     persister,
+		path:          path,
+		openPersister: openPersister,
+	}, nil
+}
+
+func (adapter *levelDBAdapter) getPersister() storage.Persister {
+	adapter.mutPersister.RLock()
+	defer adapter.mutPersister.RUnlock()
+
+	return adapter.persister
+}
+
+// Put adds the value to the (key, val) storage medium
+func (adapter *levelDBAdapter) Put(key, val []byte) error {
+	adapter.mutPersister.RLock()
+	defer adapter.mutPersister.RUnlock()
+
+	return adapter.persister.Put(key, val)
+}
+
+// Get returns the value associated to the key
+func (adapter *levelDBAdapter) Get(key []byte) ([]byte, error) {
+	adapter.mutPersister.RLock()
+	defer adapter.mutPersister.RUnlock()
+
+	return adapter.persister.Get(key)
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (adapter *levelDBAdapter) Has(key []byte) error {
+	adapter.mutPersister.RLock()
+	defer adapter.mutPersister.RUnlock()
+
+	return adapter.persister.Has(key)
+}
+
+// Remove removes the data associated to the given key
+func (adapter *levelDBAdapter) Remove(key []byte) error {
+	adapter.mutPersister.RLock()
+	defer adapter.mutPersister.RUnlock()
+
+	return adapter.persister.Remove(key)
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+func (adapter *levelDBAdapter) RangeKeys(handler func(key []byte, val []byte) bool) {
+	adapter.mutPersister.RLock()
+	defer adapter.mutPersister.RUnlock()
+
+	adapter.persister.RangeKeys(handler)
+}
+
+// Close closes the files/resources associated to the storage medium
+func (adapter *levelDBAdapter) Close() error {
+	adapter.mutPersister.Lock()
+	defer adapter.mutPersister.Unlock()
+
+	adapter.isClosed = true
+
+	return adapter.persister.Close()
+}
+
+// Destroy removes the storage medium stored data
+func (adapter *levelDBAdapter) Destroy() error {
+	adapter.mutPersister.Lock()
+	defer adapter.mutPersister.Unlock()
+
+	adapter.isClosed = true
+
+	return adapter.persister.Destroy()
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (adapter *levelDBAdapter) DestroyClosed() error {
+	return adapter.getPersister().DestroyClosed()
+}
+
+// GetEngineStats returns the number of tables and the size of the journal, read from the database files. The journal
+// holds the writes not yet flushed from the memory table
+func (adapter *levelDBAdapter) GetEngineStats() *common.StorageEngineStats {
+	stats := &common.StorageEngineStats{}
+
+	entries, err := os.ReadDir(adapter.path)
+	if err != nil {
+		return stats
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		switch {
+		case strings.HasSuffix(name, levelDBTableExtension), strings.HasSuffix(name, levelDBLegacyTableExtension):
+			stats.NumTables++
+		case strings.HasSuffix(name, levelDBJournalExtension) && name[0] == levelDBJournalNamePrefixChar:
+			info, errInfo := entry.Info()
+			if errInfo == nil {
+				stats.MemTableSizeInBytes += uint64(info.Size())
+			}
+		}
+	}
+
+	return stats
+}
+
+// Compact compacts the whole key range of the database. The persister is closed while the database files are compacted,
+// so the calls made meanwhile wait for the compaction to end
+func (adapter *levelDBAdapter) Compact() error {
+	adapter.mutPersister.Lock()
+	defer adapter.mutPersister.Unlock()
+
+	if adapter.isClosed {
+		return storage.ErrDBIsClosed
+	}
+
+	err := adapter.persister.Close()
+	if err != nil {
+		return err
+	}
+
+	errCompact := compactLevelDB(adapter.path)
+	if errCompact != nil {
+		log.Warn("error compacting level db", "path", adapter.path, "error", errCompact)
+	}
+
+	persister, err := adapter.openPersister()
+	if err != nil {
+		adapter.isClosed = true
+		return err
+	}
+	adapter.persister = persister
+
+	return errCompact
+}
+
+func compactLevelDB(path string) error {
+	db, err := leveldb.OpenFile(path, nil)
+	if err != nil {
+		return err
+	}
+
+	errCompact := db.CompactRange(util.Range{})
+	errClose := db.Close()
+	if errCompact != nil {
+		return errCompact
+	}
+
+	return errClose
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (adapter *levelDBAdapter) IsInterfaceNil() bool {
+	return adapter == nil
+}
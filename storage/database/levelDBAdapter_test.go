@@ -0,0 +1,106 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createLevelDBAdapter(t *testing.T, path string) *levelDBAdapter {
+	adapter, err := NewLevelDBAdapter(path, func() (storage.Persister, error) {
+		return NewSerialDB(path, 1, 10, 10)
+	})
+	require.Nil(t, err)
+
+	return adapter
+}
+
+func TestNewLevelDBAdapter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil open function should error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter, err := NewLevelDBAdapter(t.TempDir(), nil)
+		assert.Equal(t, storage.ErrNilPersisterFactory, err)
+		assert.True(t, check.IfNil(adapter))
+	})
+	t.Run("open error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		adapter, err := NewLevelDBAdapter(t.TempDir(), func() (storage.Persister, error) {
+			return nil, expectedErr
+		})
+		assert.Equal(t, expectedErr, err)
+		assert.True(t, check.IfNil(adapter))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := createLevelDBAdapter(t, t.TempDir())
+		assert.False(t, check.IfNil(adapter))
+		_ = adapter.Close()
+	})
+}
+
+func TestLevelDBAdapter_CompactAndGetEngineStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("compact after close should error", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := createLevelDBAdapter(t, t.TempDir())
+		require.Nil(t, adapter.Close())
+
+		assert.Equal(t, storage.ErrDBIsClosed, adapter.Compact())
+	})
+	t.Run("compaction should keep the data and reopen the persister", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := createLevelDBAdapter(t, t.TempDir())
+		numKeys := 100
+		for i := 0; i < numKeys; i++ {
+			require.Nil(t, adapter.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+		}
+
+		wg := sync.WaitGroup{}
+		wg.Add(2)
+		go func() {
+			assert.Nil(t, adapter.Compact())
+			wg.Done()
+		}()
+		go func() {
+			for i := 0; i < numKeys; i++ {
+				_, err := adapter.Get([]byte(fmt.Sprintf("key%d", i)))
+				assert.Nil(t, err)
+			}
+			wg.Done()
+		}()
+		wg.Wait()
+
+		stats := adapter.GetEngineStats()
+		assert.True(t, stats.NumTables > 0)
+
+		value, err := adapter.Get([]byte("key7"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value7"), value)
+		assert.Nil(t, adapter.Put([]byte("new key"), []byte("new value")))
+
+		_ = adapter.Close()
+	})
+	t.Run("missing directory should return empty stats", func(t *testing.T) {
+		t.Parallel()
+
+		adapter := &levelDBAdapter{path: "missing directory"}
+		stats := adapter.GetEngineStats()
+		assert.Zero(t, stats.NumTables)
+		assert.Zero(t, stats.MemTableSizeInBytes)
+	})
+}
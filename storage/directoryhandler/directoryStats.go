@@ -0,0 +1,26 @@
+package directoryhandler
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// GetDirectoryStats returns the number of files and their cumulated size in bytes from the given directory and its
+// subdirectories. The entries which cannot be read are skipped
+func GetDirectoryStats(path string) (uint64, uint64) {
+	numFiles := uint64(0)
+	sizeInBytes := uint64(0)
+	_ = filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if !info.IsDir() {
+			numFiles++
+			sizeInBytes += uint64(info.Size())
+		}
+
+		return nil
+	})
+
+	return numFiles, sizeInBytes
+}
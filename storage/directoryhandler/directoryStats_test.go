@@ -0,0 +1,34 @@
+package directoryhandler
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDirectoryStats(t *testing.T) {
+	t.Parallel()
+
+	t.Run("missing directory should return 0", func(t *testing.T) {
+		t.Parallel()
+
+		numFiles, sizeInBytes := GetDirectoryStats(filepath.Join(t.TempDir(), "missing"))
+		assert.Equal(t, uint64(0), numFiles)
+		assert.Equal(t, uint64(0), sizeInBytes)
+	})
+	t.Run("should count the files from the subdirectories", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		require.Nil(t, os.MkdirAll(filepath.Join(dir, "sub"), os.ModePerm))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "file1"), []byte("data"), 0600))
+		require.Nil(t, os.WriteFile(filepath.Join(dir, "sub", "file2"), []byte("more data"), 0600))
+
+		numFiles, sizeInBytes := GetDirectoryStats(dir)
+		assert.Equal(t, uint64(2), numFiles)
+		assert.Equal(t, uint64(13), sizeInBytes)
+	})
+}
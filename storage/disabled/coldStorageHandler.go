@@ -0,0 +1,27 @@
+package disabled
+
+type coldStorageHandler struct{}
+
+// NewColdStorageHandler returns a new instance of this disabled cold storage handler
+func NewColdStorageHandler() *coldStorageHandler {
+	return &coldStorageHandler{}
+}
+
+// MoveToColdStorage does nothing
+func (csh *coldStorageHandler) MoveToColdStorage(_ string, _ func(coldPath string) bool) {
+}
+
+// GetPersisterPath returns the provided path
+func (csh *coldStorageHandler) GetPersisterPath(path string) string {
+	return path
+}
+
+// RemoveFromColdStorage returns nil
+func (csh *coldStorageHandler) RemoveFromColdStorage(_ string) error {
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (csh *coldStorageHandler) IsInterfaceNil() bool {
+	return csh == nil
+}
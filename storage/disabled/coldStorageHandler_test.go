@@ -0,0 +1,29 @@
+package disabled
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestColdStorageHandler_MethodsDoNotPanic(t *testing.T) {
+	t.Parallel()
+
+	defer func() {
+		r := recover()
+		if r != nil {
+			assert.Fail(t, fmt.Sprintf("should have not panicked: %v", r))
+		}
+	}()
+
+	csh := NewColdStorageHandler()
+	assert.False(t, csh.IsInterfaceNil())
+	assert.Equal(t, "path", csh.GetPersisterPath("path"))
+	assert.Nil(t, csh.RemoveFromColdStorage("path"))
+
+	csh.MoveToColdStorage("path", func(_ string) bool {
+		assert.Fail(t, "should have not switched the path")
+		return false
+	})
+}
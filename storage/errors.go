@@ -82,12 +82,24 @@ var ErrNotSupportedCacheType = storageErrors.ErrNotSupportedCacheType
 // ErrDBIsClosed is raised when the DB is closed
 var ErrDBIsClosed = storageErrors.ErrDBIsClosed
 
+// ErrInvalidNumOpenFiles signals that an invalid number of maximum open files has been provided
+var ErrInvalidNumOpenFiles = storageErrors.ErrInvalidNumOpenFiles
+
 // ErrEpochKeepIsLowerThanNumActive signals that num epochs to keep is lower than num active epochs
 var ErrEpochKeepIsLowerThanNumActive = errors.New("num epochs to keep is lower than num active epochs")
 
 // ErrNilPersistersTracker signals that a nil persisters tracker has been provided
 var ErrNilPersistersTracker = errors.New("nil persisters tracker provided")
 
+// ErrCompactionNotSupported signals that the persisters do not support the manual compaction
+var ErrCompactionNotSupported = errors.New("compaction is not supported by the persisters")
+
+// ErrInvalidUnitRetention signals that an invalid storage unit retention policy has been provided
+var ErrInvalidUnitRetention = errors.New("invalid storage unit retention policy")
+
+// ErrEpochNotRetained signals that the data of the requested epoch was removed due to the unit's retention policy
+var ErrEpochNotRetained = errors.New("epoch is outside the unit's retention window")
+
 // IsNotFoundInStorageErr returns whether an error is a "not found in storage" error.
 // Currently, "item not found" storage errors are untyped (thus not distinguishable from others). E.g. see "pruningStorer.go".
 // As a workaround, we test the error message for a match.
@@ -98,3 +110,18 @@ func IsNotFoundInStorageErr(err error) bool {
 
 	return strings.Contains(err.Error(), "not found")
 }
+
+// ErrNilColdStorageHandler signals that a nil cold storage handler has been provided
+var ErrNilColdStorageHandler = errors.New("nil cold storage handler")
+
+// ErrEmptyColdStoragePath signals that an empty cold storage path has been provided
+var ErrEmptyColdStoragePath = errors.New("empty cold storage path")
+
+// ErrInvalidColdStoragePath signals that the cold storage path overlaps the database path
+var ErrInvalidColdStoragePath = errors.New("the cold storage path should not overlap the database path")
+
+// ErrPathNotInDatabaseDirectory signals that the provided path is not located in the database directory
+var ErrPathNotInDatabaseDirectory = errors.New("path is not located in the database directory")
+
+// ErrEmptyDatabasePath signals that an empty database path has been provided
+var ErrEmptyDatabasePath = errors.New("empty database path")
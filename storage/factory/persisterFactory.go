@@ -36,11 +36,17 @@ func (pf *PersisterFactory) Create(path string) (storage.Persister, error) {
 
 	switch storageunit.DBType(pf.dbType) {
 	case storageunit.LvlDB:
-		return database.NewLevelDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+		return database.NewLevelDBAdapter(path, func() (storage.Persister, error) {
+			return database.NewLevelDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+		})
 	case storageunit.LvlDBSerial:
-		return database.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+		return database.NewLevelDBAdapter(path, func() (storage.Persister, error) {
+			return database.NewSerialDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
+		})
 	case storageunit.MemoryDB:
 		return database.NewMemDB(), nil
+	case storageunit.PebbleDB:
+		return database.NewPebbleDB(path, pf.batchDelaySeconds, pf.maxBatchSize, pf.maxOpenFiles)
 	default:
 		return nil, storage.ErrNotSupportedDBType
 	}
@@ -56,7 +56,7 @@ func TestPersisterFactory_Create(t *testing.T) {
 		persisterInstance, err := factoryInstance.Create(t.TempDir())
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(persisterInstance))
-		assert.Equal(t, "*leveldb.DB", fmt.Sprintf("%T", persisterInstance))
+		assert.Equal(t, "*database.levelDBAdapter", fmt.Sprintf("%T", persisterInstance))
 		_ = persisterInstance.Close()
 	})
 	t.Run("for LvlDBSerial should work", func(t *testing.T) {
@@ -66,7 +66,17 @@ func TestPersisterFactory_Create(t *testing.T) {
 		persisterInstance, err := factoryInstance.Create(t.TempDir())
 		assert.Nil(t, err)
 		assert.False(t, check.IfNil(persisterInstance))
-		assert.Equal(t, "*leveldb.SerialDB", fmt.Sprintf("%T", persisterInstance))
+		assert.Equal(t, "*database.levelDBAdapter", fmt.Sprintf("%T", persisterInstance))
+		_ = persisterInstance.Close()
+	})
+	t.Run("for PebbleDB should work", func(t *testing.T) {
+		t.Parallel()
+
+		factoryInstance := NewPersisterFactory(createDBConfig("PebbleDB"))
+		persisterInstance, err := factoryInstance.Create(t.TempDir())
+		assert.Nil(t, err)
+		assert.False(t, check.IfNil(persisterInstance))
+		assert.Equal(t, "*pebbledb.DB", fmt.Sprintf("%T", persisterInstance))
 		_ = persisterInstance.Close()
 	})
 	t.Run("for MemoryDB should work", func(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-go/epochStart"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/clean"
+	"github.com/multiversx/mx-chain-go/storage/coldstorage"
 	"github.com/multiversx/mx-chain-go/storage/databaseremover/disabled"
 	"github.com/multiversx/mx-chain-go/storage/databaseremover/factory"
 	storageDisabled "github.com/multiversx/mx-chain-go/storage/disabled"
@@ -46,6 +47,9 @@ type StorageServiceFactory struct {
 	pathManager                   storage.PathManagerHandler
 	epochStartNotifier            epochStart.EpochStartNotifier
 	oldDataCleanerProvider        clean.OldDataCleanerProvider
+	unitsRetention                map[string]config.StorageUnitRetentionConfig
+	coldStorageHandler            pruning.ColdStorageHandler
+	coldDatabasePath              string
 	createTrieEpochRootHashStorer bool
 	currentEpoch                  uint32
 	storageType                   StorageServiceType
@@ -84,6 +88,16 @@ func NewStorageServiceFactory(args StorageServiceFactoryArgs) (*StorageServiceFa
 		return nil, storage.ErrInvalidNumberOfEpochsToSave
 	}
 
+	unitsRetention, err := createUnitsRetention(args.Config.StoragePruning)
+	if err != nil {
+		return nil, err
+	}
+
+	coldStorageHandler, coldDatabasePath, err := createColdStorageHandler(args.Config.StoragePruning.ColdStorage, args.PathManager)
+	if err != nil {
+		return nil, err
+	}
+
 	return &StorageServiceFactory{
 		generalConfig:                 args.Config,
 		prefsConfig:                   args.PrefsConfig,
@@ -93,11 +107,79 @@ func NewStorageServiceFactory(args StorageServiceFactoryArgs) (*StorageServiceFa
 		currentEpoch:                  args.CurrentEpoch,
 		createTrieEpochRootHashStorer: args.CreateTrieEpochRootHashStorer,
 		oldDataCleanerProvider:        oldDataCleanProvider,
+		unitsRetention:                unitsRetention,
+		coldStorageHandler:            coldStorageHandler,
+		coldDatabasePath:              coldDatabasePath,
 		storageType:                   args.StorageType,
 		nodeProcessingMode:            args.NodeProcessingMode,
 	}, nil
 }
 
+func createUnitsRetention(pruningConfig config.StoragePruningConfig) (map[string]config.StorageUnitRetentionConfig, error) {
+	unitsRetention := make(map[string]config.StorageUnitRetentionConfig)
+	for _, retention := range pruningConfig.UnitsRetention {
+		if len(retention.Unit) == 0 {
+			return nil, fmt.Errorf("%w: empty unit", storage.ErrInvalidUnitRetention)
+		}
+		_, exists := unitsRetention[retention.Unit]
+		if exists {
+			return nil, fmt.Errorf("%w: duplicated unit %s", storage.ErrInvalidUnitRetention, retention.Unit)
+		}
+
+		isFinite := retention.NumEpochsToKeep > 0
+		if retention.KeepForever == isFinite {
+			return nil, fmt.Errorf("%w: exactly one of KeepForever and NumEpochsToKeep should be set for %s",
+				storage.ErrInvalidUnitRetention, retention.Unit)
+		}
+		isBelowMinimum := retention.NumEpochsToKeep < minimumNumberOfEpochsToKeep ||
+			retention.NumEpochsToKeep < pruningConfig.NumActivePersisters
+		if isFinite && isBelowMinimum {
+			return nil, fmt.Errorf("%w: NumEpochsToKeep for %s should be at least %d and not lower than NumActivePersisters",
+				storage.ErrInvalidUnitRetention, retention.Unit, minimumNumberOfEpochsToKeep)
+		}
+
+		unitsRetention[retention.Unit] = retention
+	}
+
+	return unitsRetention, nil
+}
+
+func createColdStorageHandler(
+	coldStorageConfig config.ColdStorageConfig,
+	pathManager storage.PathManagerHandler,
+) (pruning.ColdStorageHandler, string, error) {
+	if !coldStorageConfig.Enabled {
+		return storageDisabled.NewColdStorageHandler(), "", nil
+	}
+	if len(coldStorageConfig.Path) == 0 {
+		return nil, "", storage.ErrEmptyColdStoragePath
+	}
+
+	// the cold database directory mirrors the database directory, which is named after the chain ID
+	coldDatabasePath := filepath.Join(coldStorageConfig.Path, filepath.Base(pathManager.DatabasePath()))
+	coldStorageHandler, err := coldstorage.NewColdStorageHandler(coldstorage.ArgsColdStorageHandler{
+		DatabasePath:     pathManager.DatabasePath(),
+		ColdDatabasePath: coldDatabasePath,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	log.Debug("cold storage enabled", "path", coldDatabasePath)
+
+	return coldStorageHandler, coldDatabasePath, nil
+}
+
+func (psf *StorageServiceFactory) hasFiniteUnitsRetention() bool {
+	for _, retention := range psf.unitsRetention {
+		if !retention.KeepForever {
+			return true
+		}
+	}
+
+	return false
+}
+
 func checkArgs(args StorageServiceFactoryArgs) error {
 	if args.Config.StoragePruning.NumActivePersisters < minimumNumberOfActivePersisters {
 		return storage.ErrInvalidNumberOfActivePersisters
@@ -182,7 +264,7 @@ func (psf *StorageServiceFactory) createAndAddBaseStorageUnits(
 	metaHdrHashNonceUnitConfig := GetDBFromConfig(psf.generalConfig.MetaHdrNonceHashStorage.DB)
 	dbPath := psf.pathManager.PathForStatic(shardID, psf.generalConfig.MetaHdrNonceHashStorage.DB.FilePath)
 	metaHdrHashNonceUnitConfig.FilePath = dbPath
-	metaHdrHashNonceUnit, err := storageunit.NewStorageUnitFromConf(
+	metaHdrHashNonceUnit, err := storageunit.NewStorageUnitWithStatsFromConf(
 		GetCacherFromConfig(psf.generalConfig.MetaHdrNonceHashStorage.Cache),
 		metaHdrHashNonceUnitConfig)
 	if err != nil {
@@ -221,7 +303,7 @@ func (psf *StorageServiceFactory) createAndAddBaseStorageUnits(
 	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath = psf.pathManager.PathForStatic(shardId, psf.generalConfig.StatusMetricsStorage.DB.FilePath)
 	statusMetricsDbConfig.FilePath = dbPath
-	statusMetricsStorageUnit, err := storageunit.NewStorageUnitFromConf(
+	statusMetricsStorageUnit, err := storageunit.NewStorageUnitWithStatsFromConf(
 		GetCacherFromConfig(psf.generalConfig.StatusMetricsStorage.Cache),
 		statusMetricsDbConfig)
 	if err != nil {
@@ -255,7 +337,7 @@ func (psf *StorageServiceFactory) CreateForShard() (dataRetriever.StorageService
 	shardHdrHashNonceConfig := GetDBFromConfig(psf.generalConfig.ShardHdrNonceHashStorage.DB)
 	dbPath := psf.pathManager.PathForStatic(shardID, psf.generalConfig.ShardHdrNonceHashStorage.DB.FilePath) + shardID
 	shardHdrHashNonceConfig.FilePath = dbPath
-	shardHdrHashNonceUnit, err := storageunit.NewStorageUnitFromConf(
+	shardHdrHashNonceUnit, err := storageunit.NewStorageUnitWithStatsFromConf(
 		GetCacherFromConfig(psf.generalConfig.ShardHdrNonceHashStorage.Cache),
 		shardHdrHashNonceConfig)
 	if err != nil {
@@ -314,13 +396,13 @@ func (psf *StorageServiceFactory) CreateForMeta() (dataRetriever.StorageService,
 	}
 	shardID := core.GetShardIDString(core.MetachainShardId)
 
-	shardHdrHashNonceUnits := make([]*storageunit.Unit, psf.shardCoordinator.NumberOfShards())
+	shardHdrHashNonceUnits := make([]storage.Storer, psf.shardCoordinator.NumberOfShards())
 	for i := uint32(0); i < psf.shardCoordinator.NumberOfShards(); i++ {
 		shardHdrHashNonceConfig := GetDBFromConfig(psf.generalConfig.ShardHdrNonceHashStorage.DB)
 		shardID = core.GetShardIDString(core.MetachainShardId)
 		dbPath := psf.pathManager.PathForStatic(shardID, psf.generalConfig.ShardHdrNonceHashStorage.DB.FilePath) + fmt.Sprintf("%d", i)
 		shardHdrHashNonceConfig.FilePath = dbPath
-		shardHdrHashNonceUnits[i], err = storageunit.NewStorageUnitFromConf(
+		shardHdrHashNonceUnits[i], err = storageunit.NewStorageUnitWithStatsFromConf(
 			GetCacherFromConfig(psf.generalConfig.ShardHdrNonceHashStorage.Cache),
 			shardHdrHashNonceConfig)
 		if err != nil {
@@ -440,7 +522,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	miniblockHashByTxHashDbConfig := GetDBFromConfig(miniblockHashByTxHashConfig.DB)
 	miniblockHashByTxHashDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, miniblockHashByTxHashConfig.DB.FilePath)
 	miniblockHashByTxHashCacherConfig := GetCacherFromConfig(miniblockHashByTxHashConfig.Cache)
-	miniblockHashByTxHashUnit, err := storageunit.NewStorageUnitFromConf(miniblockHashByTxHashCacherConfig, miniblockHashByTxHashDbConfig)
+	miniblockHashByTxHashUnit, err := storageunit.NewStorageUnitWithStatsFromConf(miniblockHashByTxHashCacherConfig, miniblockHashByTxHashDbConfig)
 	if err != nil {
 		return fmt.Errorf("%w for DbLookupExtensions.MiniblockHashByTxHashStorageConfig", err)
 	}
@@ -452,7 +534,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	blockHashByRoundDBConfig := GetDBFromConfig(blockHashByRoundConfig.DB)
 	blockHashByRoundDBConfig.FilePath = psf.pathManager.PathForStatic(shardID, blockHashByRoundConfig.DB.FilePath)
 	blockHashByRoundCacherConfig := GetCacherFromConfig(blockHashByRoundConfig.Cache)
-	blockHashByRoundUnit, err := storageunit.NewStorageUnitFromConf(blockHashByRoundCacherConfig, blockHashByRoundDBConfig)
+	blockHashByRoundUnit, err := storageunit.NewStorageUnitWithStatsFromConf(blockHashByRoundCacherConfig, blockHashByRoundDBConfig)
 	if err != nil {
 		return fmt.Errorf("%w for DbLookupExtensions.RoundHashStorageConfig", err)
 	}
@@ -464,7 +546,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	epochByHashDbConfig := GetDBFromConfig(epochByHashConfig.DB)
 	epochByHashDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, epochByHashConfig.DB.FilePath)
 	epochByHashCacherConfig := GetCacherFromConfig(epochByHashConfig.Cache)
-	epochByHashUnit, err := storageunit.NewStorageUnitFromConf(epochByHashCacherConfig, epochByHashDbConfig)
+	epochByHashUnit, err := storageunit.NewStorageUnitWithStatsFromConf(epochByHashCacherConfig, epochByHashDbConfig)
 	if err != nil {
 		return fmt.Errorf("%w for DbLookupExtensions.EpochByHashStorageConfig", err)
 	}
@@ -475,7 +557,7 @@ func (psf *StorageServiceFactory) setupDbLookupExtensions(chainStorer *dataRetri
 	esdtSuppliesDbConfig := GetDBFromConfig(esdtSuppliesConfig.DB)
 	esdtSuppliesDbConfig.FilePath = psf.pathManager.PathForStatic(shardID, esdtSuppliesConfig.DB.FilePath)
 	esdtSuppliesCacherConfig := GetCacherFromConfig(esdtSuppliesConfig.Cache)
-	esdtSuppliesUnit, err := storageunit.NewStorageUnitFromConf(esdtSuppliesCacherConfig, esdtSuppliesDbConfig)
+	esdtSuppliesUnit, err := storageunit.NewStorageUnitWithStatsFromConf(esdtSuppliesCacherConfig, esdtSuppliesDbConfig)
 	if err != nil {
 		return fmt.Errorf("%w for DbLookupExtensions.ESDTSuppliesStorageConfig", err)
 	}
@@ -513,9 +595,26 @@ func (psf *StorageServiceFactory) createPruningStorerArgs(
 		MaxBatchSize:              storageConfig.DB.MaxBatchSize,
 		EnabledDbLookupExtensions: psf.generalConfig.DbLookupExtensions.Enabled,
 		PersistersTracker:         pruning.NewPersistersTracker(epochsData),
+		ColdStorageHandler:        psf.coldStorageHandler,
 		EpochsData:                epochsData,
 	}
 
+	retention, hasRetention := psf.unitsRetention[storageConfig.DB.FilePath]
+	if !hasRetention {
+		return args
+	}
+
+	// the unit's own retention policy overrides the node type based old data cleaning
+	args.OldDataCleanerProvider = clean.NewRetentionOldDataCleanerProvider(retention)
+	if retention.KeepForever {
+		args.CustomDatabaseRemover = disabled.NewDisabledCustomDatabaseRemover()
+		return args
+	}
+
+	args.EpochsData.NumOfEpochsToKeep = uint32(retention.NumEpochsToKeep)
+	args.PersistersTracker = pruning.NewPersistersTracker(args.EpochsData)
+	args.RetainedEpochsOnly = true
+
 	return args
 }
 
@@ -528,7 +627,7 @@ func (psf *StorageServiceFactory) createTrieEpochRootHashStorerIfNeeded() (stora
 	shardId := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath := psf.pathManager.PathForStatic(shardId, psf.generalConfig.TrieEpochRootHashStorage.DB.FilePath)
 	trieEpochRootHashDbConfig.FilePath = dbPath
-	trieEpochRootHashStorageUnit, err := storageunit.NewStorageUnitFromConf(
+	trieEpochRootHashStorageUnit, err := storageunit.NewStorageUnitWithStatsFromConf(
 		GetCacherFromConfig(psf.generalConfig.TrieEpochRootHashStorage.Cache),
 		trieEpochRootHashDbConfig)
 	if err != nil {
@@ -545,7 +644,7 @@ func (psf *StorageServiceFactory) createTriePersister(
 	shardID := core.GetShardIDString(psf.shardCoordinator.SelfId())
 	dbPath := psf.pathManager.PathForStatic(shardID, storageConfig.DB.FilePath)
 	trieDBConfig.FilePath = dbPath
-	trieUnit, err := storageunit.NewStorageUnitFromConf(
+	trieUnit, err := storageunit.NewStorageUnitWithStatsFromConf(
 		GetCacherFromConfig(storageConfig.Cache),
 		trieDBConfig)
 	if err != nil {
@@ -605,15 +704,29 @@ func (psf *StorageServiceFactory) getNumActivePersistersForFullHistoryStorer(isF
 }
 
 func (psf *StorageServiceFactory) initOldDatabasesCleaningIfNeeded(store dataRetriever.StorageService) error {
+	oldDataCleanerProvider := psf.oldDataCleanerProvider
 	isFullArchive := psf.prefsConfig.FullArchive
 	if isFullArchive {
-		return nil
+		if !psf.hasFiniteUnitsRetention() {
+			return nil
+		}
+
+		// a full archive node only cleans the units having their own finite retention policy
+		oldDataCleanerProvider = clean.NewRetentionOldDataCleanerProvider(config.StorageUnitRetentionConfig{KeepForever: true})
 	}
+
+	unitsRetention := make([]config.StorageUnitRetentionConfig, 0, len(psf.unitsRetention))
+	for _, retention := range psf.unitsRetention {
+		unitsRetention = append(unitsRetention, retention)
+	}
+
 	_, err := clean.NewOldDatabaseCleaner(clean.ArgsOldDatabaseCleaner{
 		DatabasePath:           psf.pathManager.DatabasePath(),
+		ColdDatabasePath:       psf.coldDatabasePath,
 		StorageListProvider:    store,
 		EpochStartNotifier:     psf.epochStartNotifier,
-		OldDataCleanerProvider: psf.oldDataCleanerProvider,
+		OldDataCleanerProvider: oldDataCleanerProvider,
+		UnitsRetention:         unitsRetention,
 	})
 
 	return err
@@ -1,6 +1,8 @@
 package factory
 
 import (
+	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core"
@@ -8,6 +10,7 @@ import (
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/mock"
+	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-chain-go/testscommon/nodeTypeProviderMock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -142,6 +145,41 @@ func TestNewStorageServiceFactory(t *testing.T) {
 		assert.Equal(t, storage.ErrInvalidNumberOfEpochsToSave, err)
 		assert.Nil(t, storageServiceFactory)
 	})
+	t.Run("invalid units retention should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidRetentions := [][]config.StorageUnitRetentionConfig{
+			{{NumEpochsToKeep: 5}},
+			{{Unit: "TxStorage", KeepForever: true}, {Unit: "TxStorage", NumEpochsToKeep: 5}},
+			{{Unit: "TxStorage", KeepForever: true, NumEpochsToKeep: 5}},
+			{{Unit: "TxStorage"}},
+			{{Unit: "TxStorage", NumEpochsToKeep: 1}},
+			{{Unit: "TxStorage", NumEpochsToKeep: 2}},
+		}
+		for _, unitsRetention := range invalidRetentions {
+			args := createMockArgument(t)
+			args.Config.StoragePruning.UnitsRetention = unitsRetention
+			storageServiceFactory, err := NewStorageServiceFactory(args)
+			assert.True(t, errors.Is(err, storage.ErrInvalidUnitRetention))
+			assert.Nil(t, storageServiceFactory)
+		}
+	})
+	t.Run("invalid cold storage path should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		args.Config.StoragePruning.ColdStorage = config.ColdStorageConfig{
+			Enabled: true,
+		}
+		storageServiceFactory, err := NewStorageServiceFactory(args)
+		assert.Equal(t, storage.ErrEmptyColdStoragePath, err)
+		assert.Nil(t, storageServiceFactory)
+
+		args.Config.StoragePruning.ColdStorage.Path = args.PathManager.DatabasePath()
+		storageServiceFactory, err = NewStorageServiceFactory(args)
+		assert.True(t, errors.Is(err, storage.ErrInvalidColdStoragePath))
+		assert.Nil(t, storageServiceFactory)
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -149,7 +187,52 @@ func TestNewStorageServiceFactory(t *testing.T) {
 		storageServiceFactory, err := NewStorageServiceFactory(args)
 		assert.Nil(t, err)
 		assert.NotNil(t, storageServiceFactory)
+		assert.Empty(t, storageServiceFactory.coldDatabasePath)
 	})
+	t.Run("should work with cold storage", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgument(t)
+		coldPath := t.TempDir()
+		args.Config.StoragePruning.ColdStorage = config.ColdStorageConfig{
+			Enabled: true,
+			Path:    coldPath,
+		}
+		storageServiceFactory, err := NewStorageServiceFactory(args)
+		assert.Nil(t, err)
+		assert.Equal(t, filepath.Join(coldPath, filepath.Base(args.PathManager.DatabasePath())), storageServiceFactory.coldDatabasePath)
+
+		pruningArgs := storageServiceFactory.createPruningStorerArgs(args.Config.TxStorage, &testscommon.CustomDatabaseRemoverStub{})
+		assert.True(t, pruningArgs.ColdStorageHandler == storageServiceFactory.coldStorageHandler)
+	})
+}
+
+func TestStorageServiceFactory_CreatePruningStorerArgsWithUnitsRetention(t *testing.T) {
+	t.Parallel()
+
+	args := createMockArgument(t)
+	args.Config.StoragePruning.UnitsRetention = []config.StorageUnitRetentionConfig{
+		{Unit: "TxStorage", KeepForever: true},
+		{Unit: "BlockHeaderStorage", NumEpochsToKeep: 10},
+	}
+	storageServiceFactory, err := NewStorageServiceFactory(args)
+	require.Nil(t, err)
+
+	customDatabaseRemover := &testscommon.CustomDatabaseRemoverStub{}
+	defaultArgs := storageServiceFactory.createPruningStorerArgs(args.Config.MiniBlocksStorage, customDatabaseRemover)
+	assert.Equal(t, uint32(4), defaultArgs.EpochsData.NumOfEpochsToKeep)
+	assert.True(t, defaultArgs.OldDataCleanerProvider == storageServiceFactory.oldDataCleanerProvider)
+	assert.False(t, defaultArgs.RetainedEpochsOnly)
+
+	keptForeverArgs := storageServiceFactory.createPruningStorerArgs(args.Config.TxStorage, customDatabaseRemover)
+	assert.False(t, keptForeverArgs.OldDataCleanerProvider.ShouldClean())
+	assert.False(t, keptForeverArgs.CustomDatabaseRemover == customDatabaseRemover)
+	assert.False(t, keptForeverArgs.RetainedEpochsOnly)
+
+	retainedArgs := storageServiceFactory.createPruningStorerArgs(args.Config.BlockHeaderStorage, customDatabaseRemover)
+	assert.Equal(t, uint32(10), retainedArgs.EpochsData.NumOfEpochsToKeep)
+	assert.True(t, retainedArgs.OldDataCleanerProvider.ShouldClean())
+	assert.True(t, retainedArgs.RetainedEpochsOnly)
 }
 
 func TestStorageServiceFactory_CreateForShard(t *testing.T) {
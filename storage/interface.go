@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/multiversx/mx-chain-core-go/storage"
+	"github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-storage-go/types"
 )
@@ -201,3 +202,18 @@ type AdaptedSizedLRUCache interface {
 	AddSizedAndReturnEvicted(key, value interface{}, sizeInBytes int64) map[interface{}]interface{}
 	IsInterfaceNil() bool
 }
+
+// EngineStatsProvider defines a persister able to report the internal statistics of its storage engine
+type EngineStatsProvider interface {
+	GetEngineStats() *common.StorageEngineStats
+}
+
+// PersistersStatsProvider defines a storer able to report the statistics of its active persisters
+type PersistersStatsProvider interface {
+	GetPersistersStats() []*common.PersisterStats
+}
+
+// Compactor defines a persister or a storer able to compact its data on demand
+type Compactor interface {
+	Compact() error
+}
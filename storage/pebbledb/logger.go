@@ -0,0 +1,20 @@
+package pebbledb
+
+import "fmt"
+
+// pebbleLogger redirects the messages of the pebble engine to the node logger
+type pebbleLogger struct {
+	path string
+}
+
+// Infof logs the engine information messages, such as the flushes and the compactions
+func (pl *pebbleLogger) Infof(format string, args ...interface{}) {
+	log.Trace(fmt.Sprintf(format, args...), "path", pl.path)
+}
+
+// Fatalf logs the engine unrecoverable errors. The engine expects this call to stop the execution
+func (pl *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	message := fmt.Sprintf(format, args...)
+	log.Error(message, "path", pl.path)
+	panic(message)
+}
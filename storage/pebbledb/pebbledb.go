@@ -0,0 +1,377 @@
+package pebbledb
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/storage"
+	logger "github.com/multiversx/mx-chain-logger-go"
+)
+
+var _ storage.Persister = (*DB)(nil)
+var _ storage.EngineStatsProvider = (*DB)(nil)
+var _ storage.Compactor = (*DB)(nil)
+
+// read + write + execute for owner only
+const rwxOwner = 0700
+
+var log = logger.GetOrCreate("storage/pebbledb")
+
+// DB holds a pointer to the pebble database and the path to where it is stored. The writes are accumulated in an
+// indexed batch, so they can be read before being committed, and the batch is committed when it reaches the maximum
+// size or when the batch delay expires, the same way the level db persister does.
+type DB struct {
+	// mutClose keeps the database open while a manual compaction or a keys iteration runs, without blocking the other
+	// operations
+	mutClose          sync.RWMutex
+	mutDb             sync.RWMutex
+	path              string
+	db                *pebble.DB
+	batch             *pebble.Batch
+	maxBatchSize      int
+	batchDelaySeconds int
+	cancel            context.CancelFunc
+}
+
+// NewDB is a constructor for the pebble persister
+// It creates the files in the location given as parameter
+func NewDB(path string, batchDelaySeconds int, maxBatchSize int, maxOpenFiles int) (*DB, error) {
+	if maxOpenFiles < 1 {
+		return nil, storage.ErrInvalidNumOpenFiles
+	}
+
+	err := os.MkdirAll(path, rwxOwner)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &pebble.Options{
+		MaxOpenFiles: maxOpenFiles,
+		Logger:       &pebbleLogger{path: path},
+	}
+	db, err := pebble.Open(path, options)
+	if err != nil {
+		return nil, fmt.Errorf("%w for path %s", err, path)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	dbStore := &DB{
+		path:              path,
+		db:                db,
+		batch:             db.NewIndexedBatch(),
+		maxBatchSize:      maxBatchSize,
+		batchDelaySeconds: batchDelaySeconds,
+		cancel:            cancel,
+	}
+
+	go dbStore.batchTimeoutHandle(ctx)
+
+	runtime.SetFinalizer(dbStore, func(db *DB) {
+		_ = db.Close()
+	})
+
+	log.Debug("opened pebble db persister", "path", path)
+
+	return dbStore, nil
+}
+
+func (s *DB) batchTimeoutHandle(ctx context.Context) {
+	interval := time.Duration(s.batchDelaySeconds) * time.Second
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		timer.Reset(interval)
+
+		select {
+		case <-timer.C:
+			s.mutDb.Lock()
+			err := s.commitBatch()
+			s.mutDb.Unlock()
+			if err != nil {
+				log.Warn("pebble db commitBatch", "path", s.path, "error", err.Error())
+			}
+		case <-ctx.Done():
+			log.Debug("closing the timed batch handler", "path", s.path)
+			return
+		}
+	}
+}
+
+// commitBatch writes the batch into the database and creates a new one. It should be called under the db mutex
+func (s *DB) commitBatch() error {
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+	if s.batch.Empty() {
+		return nil
+	}
+
+	err := s.batch.Commit(pebble.Sync)
+	if err != nil {
+		return err
+	}
+
+	_ = s.batch.Close()
+	s.batch = s.db.NewIndexedBatch()
+
+	return nil
+}
+
+// commitBatchIfFull should be called under the db mutex
+func (s *DB) commitBatchIfFull() error {
+	if int(s.batch.Count()) < s.maxBatchSize {
+		return nil
+	}
+
+	err := s.commitBatch()
+	if err != nil {
+		log.Warn("pebble db commitBatch", "path", s.path, "error", err.Error())
+	}
+
+	return err
+}
+
+// Put adds the value to the (key, val) storage medium
+func (s *DB) Put(key, val []byte) error {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := s.batch.Set(key, val, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.commitBatchIfFull()
+}
+
+// Get returns the value associated to the key
+func (s *DB) Get(key []byte) ([]byte, error) {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil, storage.ErrDBIsClosed
+	}
+
+	data, closer, err := s.batch.Get(key)
+	if err == pebble.ErrNotFound {
+		return nil, storage.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	value := make([]byte, len(data))
+	copy(value, data)
+	_ = closer.Close()
+
+	return value, nil
+}
+
+// Has returns nil if the given key is present in the persistence medium
+func (s *DB) Has(key []byte) error {
+	_, err := s.Get(key)
+
+	return err
+}
+
+// Remove removes the data associated to the given key
+func (s *DB) Remove(key []byte) error {
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db == nil {
+		return storage.ErrDBIsClosed
+	}
+
+	err := s.batch.Delete(key, nil)
+	if err != nil {
+		return err
+	}
+
+	return s.commitBatchIfFull()
+}
+
+// RangeKeys will call the handler function for each (key, value) pair
+// If the handler returns true, the iteration will continue, otherwise will stop
+func (s *DB) RangeKeys(handler func(key []byte, value []byte) bool) {
+	if handler == nil {
+		return
+	}
+
+	s.mutClose.RLock()
+	defer s.mutClose.RUnlock()
+
+	s.mutDb.RLock()
+	db := s.db
+	s.mutDb.RUnlock()
+	if db == nil {
+		return
+	}
+
+	iterator := db.NewIter(nil)
+	for valid := iterator.First(); valid; valid = iterator.Next() {
+		key := iterator.Key()
+		clonedKey := make([]byte, len(key))
+		copy(clonedKey, key)
+
+		val := iterator.Value()
+		clonedVal := make([]byte, len(val))
+		copy(clonedVal, val)
+
+		shouldContinue := handler(clonedKey, clonedVal)
+		if !shouldContinue {
+			break
+		}
+	}
+
+	_ = iterator.Close()
+}
+
+// GetEngineStats returns the internal statistics of the pebble engine
+func (s *DB) GetEngineStats() *common.StorageEngineStats {
+	s.mutDb.RLock()
+	defer s.mutDb.RUnlock()
+
+	if s.db == nil {
+		return nil
+	}
+
+	metrics := s.db.Metrics()
+	numTables := int64(0)
+	for _, level := range metrics.Levels {
+		numTables += level.NumFiles
+	}
+
+	return &common.StorageEngineStats{
+		NumCompactions:           uint64(metrics.Compact.Count),
+		NumCompactionsInProgress: uint64(metrics.Compact.NumInProgress),
+		CompactionDebtInBytes:    metrics.Compact.EstimatedDebt,
+		NumFlushes:               uint64(metrics.Flush.Count),
+		NumTables:                uint64(numTables),
+		MemTableSizeInBytes:      metrics.MemTable.Size,
+		BlockCacheHits:           uint64(metrics.BlockCache.Hits),
+		BlockCacheMisses:         uint64(metrics.BlockCache.Misses),
+	}
+}
+
+// Compact commits the current batch and compacts the whole key range of the database
+func (s *DB) Compact() error {
+	s.mutClose.RLock()
+	defer s.mutClose.RUnlock()
+
+	s.mutDb.Lock()
+	err := s.commitBatch()
+	db := s.db
+	s.mutDb.Unlock()
+	if err != nil {
+		return err
+	}
+
+	firstKey, lastKey := getKeysRange(db)
+	if bytes.Compare(firstKey, lastKey) >= 0 {
+		// the database holds at most one key, only the memtable has to be written
+		return db.Flush()
+	}
+
+	log.Debug("compacting pebble db", "path", s.path)
+	startTime := time.Now()
+	err = db.Compact(firstKey, lastKey)
+	if err != nil {
+		return err
+	}
+	log.Debug("pebble db compacted", "path", s.path, "duration", time.Since(startTime))
+
+	return nil
+}
+
+func getKeysRange(db *pebble.DB) ([]byte, []byte) {
+	iterator := db.NewIter(nil)
+	defer func() {
+		_ = iterator.Close()
+	}()
+
+	if !iterator.First() {
+		return nil, nil
+	}
+	firstKey := append([]byte{}, iterator.Key()...)
+	_ = iterator.Last()
+	lastKey := append([]byte{}, iterator.Key()...)
+
+	return firstKey, lastKey
+}
+
+// Close closes the files/resources associated to the storage medium
+func (s *DB) Close() error {
+	s.mutClose.Lock()
+	defer s.mutClose.Unlock()
+
+	s.mutDb.Lock()
+	defer s.mutDb.Unlock()
+
+	if s.db == nil {
+		return nil
+	}
+
+	err := s.commitBatch()
+	if err != nil {
+		log.Warn("pebble db commitBatch on close", "path", s.path, "error", err.Error())
+	}
+
+	return s.closeDB()
+}
+
+// closeDB should be called under the db mutex
+func (s *DB) closeDB() error {
+	s.cancel()
+	_ = s.batch.Close()
+
+	db := s.db
+	s.db = nil
+	log.Debug("closed pebble db persister", "path", s.path)
+
+	return db.Close()
+}
+
+// Destroy removes the storage medium stored data
+func (s *DB) Destroy() error {
+	s.mutClose.Lock()
+	defer s.mutClose.Unlock()
+
+	s.mutDb.Lock()
+	if s.db != nil {
+		err := s.closeDB()
+		if err != nil {
+			s.mutDb.Unlock()
+			return err
+		}
+	}
+	s.mutDb.Unlock()
+
+	return os.RemoveAll(s.path)
+}
+
+// DestroyClosed removes the already closed storage medium stored data
+func (s *DB) DestroyClosed() error {
+	s.mutClose.Lock()
+	defer s.mutClose.Unlock()
+
+	return os.RemoveAll(s.path)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (s *DB) IsInterfaceNil() bool {
+	return s == nil
+}
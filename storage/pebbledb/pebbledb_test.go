@@ -0,0 +1,277 @@
+package pebbledb_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPebbleDB(t *testing.T, batchDelaySeconds int, maxBatchSize int) *pebbledb.DB {
+	db, err := pebbledb.NewDB(t.TempDir(), batchDelaySeconds, maxBatchSize, 10)
+	require.Nil(t, err)
+
+	return db
+}
+
+func TestNewDB(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max open files should error", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := pebbledb.NewDB(t.TempDir(), 10, 1, 0)
+		assert.Nil(t, db)
+		assert.Equal(t, storage.ErrInvalidNumOpenFiles, err)
+	})
+	t.Run("double open should error", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		db, err := pebbledb.NewDB(dir, 10, 1, 10)
+		require.Nil(t, err)
+		defer func() {
+			_ = db.Close()
+		}()
+
+		_, err = pebbledb.NewDB(dir, 10, 1, 10)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		db, err := pebbledb.NewDB(t.TempDir(), 10, 1, 10)
+		assert.Nil(t, err)
+		assert.False(t, db.IsInterfaceNil())
+		_ = db.Close()
+	})
+}
+
+func TestDB_PutGetHasRemove(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db := createPebbleDB(t, 10, 100)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	_, err := db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+
+	// the value is read from the batch, before being committed
+	assert.Nil(t, db.Put(key, val))
+	v, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+	assert.Nil(t, db.Has(key))
+
+	assert.Nil(t, db.Remove(key))
+	_, err = db.Get(key)
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+	assert.Equal(t, storage.ErrKeyNotFound, db.Has(key))
+}
+
+func TestDB_GetAfterBatchTimeout(t *testing.T) {
+	t.Parallel()
+
+	key, val := []byte("key"), []byte("value")
+	db := createPebbleDB(t, 1, 100)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	assert.Nil(t, db.Put(key, val))
+	time.Sleep(time.Second * 2)
+
+	v, err := db.Get(key)
+	assert.Nil(t, err)
+	assert.Equal(t, val, v)
+
+	// the committed values are visible to the iterator
+	numKeys := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numKeys++
+		return true
+	})
+	assert.Equal(t, 1, numKeys)
+}
+
+func TestDB_ValuesArePersistedOnClose(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := pebbledb.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i))))
+	}
+	assert.Nil(t, db.Close())
+	assert.Nil(t, db.Close())
+
+	_, err = db.Get([]byte("key0"))
+	assert.Equal(t, storage.ErrDBIsClosed, err)
+	assert.Equal(t, storage.ErrDBIsClosed, db.Put([]byte("key"), []byte("value")))
+
+	db, err = pebbledb.NewDB(dir, 10, 100, 10)
+	require.Nil(t, err)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	values := make(map[string]string)
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		values[string(key)] = string(value)
+		return true
+	})
+	assert.Equal(t, 10, len(values))
+	assert.Equal(t, "value3", values["key3"])
+}
+
+func TestDB_RangeKeysShouldStopWhenHandlerReturnsFalse(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 10, 1)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	for i := 0; i < 10; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+
+	numKeys := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numKeys++
+		return numKeys < 3
+	})
+	assert.Equal(t, 3, numKeys)
+
+	db.RangeKeys(nil)
+}
+
+func TestDB_CloseShouldWaitForRangeKeys(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 10, 1)
+	numKeys := 10
+	for i := 0; i < numKeys; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+	}
+
+	rangeStarted := make(chan struct{})
+	closeDone := make(chan error)
+	go func() {
+		<-rangeStarted
+		closeDone <- db.Close()
+	}()
+
+	numRangedKeys := 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		if numRangedKeys == 0 {
+			close(rangeStarted)
+		}
+		numRangedKeys++
+		time.Sleep(10 * time.Millisecond)
+
+		select {
+		case <-closeDone:
+			assert.Fail(t, "close should wait for the keys iteration")
+		default:
+		}
+
+		return true
+	})
+	assert.Equal(t, numKeys, numRangedKeys)
+	assert.Nil(t, <-closeDone)
+
+	numRangedKeys = 0
+	db.RangeKeys(func(key []byte, value []byte) bool {
+		numRangedKeys++
+		return true
+	})
+	assert.Zero(t, numRangedKeys)
+}
+
+func TestDB_Destroy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	db, err := pebbledb.NewDB(dir, 10, 1, 10)
+	require.Nil(t, err)
+	_ = db.Put([]byte("key"), []byte("value"))
+
+	assert.Nil(t, db.Destroy())
+	_, err = os.Stat(dir)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestDB_ConcurrentOperations(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 1, 7)
+	defer func() {
+		_ = db.Close()
+	}()
+
+	numOperations := 1000
+	wg := sync.WaitGroup{}
+	wg.Add(numOperations)
+	for i := 0; i < numOperations; i++ {
+		go func(idx int) {
+			key := []byte(fmt.Sprintf("key%d", idx%50))
+			switch idx % 4 {
+			case 0:
+				_ = db.Put(key, []byte("value"))
+			case 1:
+				_, _ = db.Get(key)
+			case 2:
+				_ = db.Remove(key)
+			default:
+				_ = db.Has(key)
+			}
+			wg.Done()
+		}(i)
+	}
+	wg.Wait()
+}
+
+func TestDB_CompactAndGetEngineStats(t *testing.T) {
+	t.Parallel()
+
+	db := createPebbleDB(t, 10, 10)
+
+	// an empty database is only flushed
+	assert.Nil(t, db.Compact())
+
+	for i := 0; i < 100; i++ {
+		_ = db.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+	}
+	for i := 0; i < 50; i++ {
+		_ = db.Remove([]byte(fmt.Sprintf("key%d", i)))
+	}
+
+	assert.Nil(t, db.Compact())
+	stats := db.GetEngineStats()
+	require.NotNil(t, stats)
+	assert.True(t, stats.NumFlushes > 0)
+	assert.True(t, stats.NumTables > 0)
+
+	v, err := db.Get([]byte("key70"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("value70"), v)
+	_, err = db.Get([]byte("key10"))
+	assert.Equal(t, storage.ErrKeyNotFound, err)
+
+	_ = db.Close()
+	assert.Nil(t, db.GetEngineStats())
+	assert.Equal(t, storage.ErrDBIsClosed, db.Compact())
+}
@@ -160,6 +160,10 @@ func (fhps *FullHistoryPruningStorer) getFromOldEpoch(key []byte, epoch uint32)
 }
 
 func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.Persister, error) {
+	if !fhps.isEpochRetained(epoch) {
+		return nil, fmt.Errorf("%w: epoch %d for %s", storage.ErrEpochNotRetained, epoch, fhps.identifier)
+	}
+
 	epochString := fmt.Sprintf("%d", epoch)
 
 	fhps.lock.RLock()
@@ -201,6 +205,21 @@ func (fhps *FullHistoryPruningStorer) getOrOpenPersister(epoch uint32) (storage.
 	return persister, nil
 }
 
+// isEpochRetained returns false if the epoch is older than the retention window of a unit with its own retention policy
+func (fhps *FullHistoryPruningStorer) isEpochRetained(epoch uint32) bool {
+	if !fhps.args.RetainedEpochsOnly {
+		return true
+	}
+
+	fhps.lock.RLock()
+	newestEpoch := fhps.activePersisters[0].epoch
+	fhps.lock.RUnlock()
+
+	oldestRetainedEpoch := int64(newestEpoch) - int64(fhps.numOfEpochsToKeep) + 1
+
+	return int64(epoch) >= oldestRetainedEpoch
+}
+
 func (fhps *FullHistoryPruningStorer) getPersisterData(epochString string, epoch uint32) (*persisterData, bool) {
 	pdata, exists := fhps.oldEpochsActivePersistersCache.Get([]byte(epochString))
 	if exists {
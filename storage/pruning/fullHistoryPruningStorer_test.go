@@ -3,6 +3,7 @@ package pruning_test
 import (
 	"context"
 	"crypto/rand"
+	"errors"
 	"fmt"
 	"math"
 	"path/filepath"
@@ -231,6 +232,33 @@ func TestNewFullHistoryPruningStorer_GetBulkFromEpochShouldNotLoadFromCache(t *t
 	assert.Equal(t, expected, res)
 }
 
+func TestFullHistoryPruningStorer_RetainedEpochsOnly(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.EpochsData.StartingEpoch = 5
+	args.PersistersTracker = pruning.NewPersistersTracker(args.EpochsData)
+	args.RetainedEpochsOnly = true
+	fhArgs := pruning.FullHistoryStorerArgs{
+		StorerArgs:               args,
+		NumOfOldActivePersisters: 2,
+	}
+	fhps, err := pruning.NewFullHistoryPruningStorer(fhArgs)
+	require.Nil(t, err)
+
+	testKey, testVal := []byte("key"), []byte("value")
+	assert.Nil(t, fhps.PutInEpoch(testKey, testVal, 4))
+	res, err := fhps.GetFromEpoch(testKey, 4)
+	assert.Nil(t, err)
+	assert.Equal(t, testVal, res)
+
+	// epoch 3 is outside the window of the 2 epochs to keep, so its persister is not created again
+	err = fhps.PutInEpoch(testKey, testVal, 3)
+	assert.True(t, errors.Is(err, storage.ErrEpochNotRetained))
+	_, err = fhps.GetBulkFromEpoch([][]byte{testKey}, 2)
+	assert.True(t, errors.Is(err, storage.ErrEpochNotRetained))
+}
+
 func TestFullHistoryPruningStorer_IsEpochActive(t *testing.T) {
 	t.Parallel()
 
@@ -27,6 +27,14 @@ type PersistersTracker interface {
 	IsInterfaceNil() bool
 }
 
+// ColdStorageHandler defines what a component moving the closed persisters to a slower storage should do
+type ColdStorageHandler interface {
+	MoveToColdStorage(path string, switchPath func(coldPath string) bool)
+	GetPersisterPath(path string) string
+	RemoveFromColdStorage(path string) error
+	IsInterfaceNil() bool
+}
+
 type storerWithEpochOperations interface {
 	GetFromEpoch(key []byte, epoch uint32) ([]byte, error)
 	GetBulkFromEpoch(keys [][]byte, epoch uint32) ([]storageCore.KeyValuePair, error)
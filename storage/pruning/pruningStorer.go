@@ -17,11 +17,14 @@ import (
 	"github.com/multiversx/mx-chain-go/epochStart/notifier"
 	"github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/clean"
+	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	logger "github.com/multiversx/mx-chain-logger-go"
 )
 
 var _ storage.Storer = (*PruningStorer)(nil)
+var _ storage.PersistersStatsProvider = (*PruningStorer)(nil)
+var _ storage.Compactor = (*PruningStorer)(nil)
 
 var log = logger.GetOrCreate("storage/pruning")
 
@@ -39,6 +42,8 @@ type persisterData struct {
 	path      string
 	epoch     uint32
 	isClosed  bool
+	// generation changes each time the persister is reopened or destroyed, so a pending cold storage move can detect it
+	generation uint64
 	sync.RWMutex
 }
 
@@ -62,6 +67,19 @@ func (pd *persisterData) Close() error {
 	return err
 }
 
+func (pd *persisterData) getPath() string {
+	pd.RLock()
+	defer pd.RUnlock()
+
+	return pd.path
+}
+
+func (pd *persisterData) setPath(path string) {
+	pd.Lock()
+	pd.path = path
+	pd.Unlock()
+}
+
 func (pd *persisterData) getPersister() storage.Persister {
 	pd.RLock()
 	defer pd.RUnlock()
@@ -73,6 +91,22 @@ func (pd *persisterData) setPersisterAndIsClosed(persister storage.Persister, is
 	pd.Lock()
 	pd.persister = persister
 	pd.isClosed = isClosed
+	if !isClosed {
+		pd.generation++
+	}
+	pd.Unlock()
+}
+
+func (pd *persisterData) getGeneration() uint64 {
+	pd.RLock()
+	defer pd.RUnlock()
+
+	return pd.generation
+}
+
+func (pd *persisterData) incrementGeneration() {
+	pd.Lock()
+	pd.generation++
 	pd.Unlock()
 }
 
@@ -94,6 +128,7 @@ type PruningStorer struct {
 	epochPrepareHdr        data.HeaderHandler
 	oldDataCleanerProvider clean.OldDataCleanerProvider
 	customDatabaseRemover  storage.CustomDatabaseRemoverHandler
+	coldStorageHandler     ColdStorageHandler
 	identifier             string
 	numOfEpochsToKeep      uint32
 	numOfActivePersisters  uint32
@@ -155,10 +190,15 @@ func initPruningStorer(
 	pdb.numOfActivePersisters = args.EpochsData.NumOfActivePersisters
 	pdb.oldDataCleanerProvider = args.OldDataCleanerProvider
 	pdb.customDatabaseRemover = args.CustomDatabaseRemover
+	pdb.coldStorageHandler = args.ColdStorageHandler
 	pdb.persistersMapByEpoch = persistersMapByEpoch
 	pdb.activePersisters = activePersisters
 	pdb.lastEpochNeededHandler = pdb.lastEpochNeeded
 
+	for _, pd := range persistersMapByEpoch {
+		pdb.moveToColdStorage(pd)
+	}
+
 	return pdb, nil
 }
 
@@ -193,6 +233,9 @@ func checkArgs(args StorerArgs) error {
 	if check.IfNil(args.PersistersTracker) {
 		return storage.ErrNilPersistersTracker
 	}
+	if check.IfNil(args.ColdStorageHandler) {
+		return storage.ErrNilColdStorageHandler
+	}
 
 	return nil
 }
@@ -307,6 +350,7 @@ func (ps *PruningStorer) onEvicted(key interface{}, value interface{}) {
 		if err != nil {
 			log.Warn("initFullHistoryPruningStorer - onEvicted", "key", key, "err", err.Error())
 		}
+		ps.moveToColdStorage(pd)
 	}
 }
 
@@ -656,6 +700,11 @@ func (ps *PruningStorer) ClearCache() {
 	ps.cacher.Clear()
 }
 
+// GetIdentifier returns the identifier of the storer, which is also the name of its directory inside each epoch directory
+func (ps *PruningStorer) GetIdentifier() string {
+	return ps.identifier
+}
+
 // GetOldestEpoch returns the oldest epoch from current configuration
 func (ps *PruningStorer) GetOldestEpoch() (uint32, error) {
 	ps.lock.RLock()
@@ -699,6 +748,7 @@ func (ps *PruningStorer) DestroyUnit() error {
 				"error", err.Error())
 			continue
 		}
+		pd.incrementGeneration()
 		numOfPersistersRemoved++
 	}
 
@@ -956,6 +1006,7 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 		if err != nil {
 			log.Warn("error closing persister", "error", err.Error(), "id", ps.identifier)
 		}
+		ps.moveToColdStorage(pd)
 	}
 
 	shouldRemoveFromMapDueToOldData := ps.oldDataCleanerProvider.ShouldClean()
@@ -968,6 +1019,11 @@ func (ps *PruningStorer) closeAndDestroyPersisters(epoch uint32) error {
 			if err != nil {
 				return err
 			}
+			persisterToRemove.incrementGeneration()
+			err = ps.coldStorageHandler.RemoveFromColdStorage(persisterToRemove.getPath())
+			if err != nil {
+				return err
+			}
 
 			// destroyed persisters have to be removed from the map, regardless on the shouldRemoveFromMapDueToOldData value
 			delete(ps.persistersMapByEpoch, epochToRemove)
@@ -1033,11 +1089,100 @@ func (ps *PruningStorer) RangeKeys(_ func(key []byte, val []byte) bool) {
 	debug.PrintStack()
 }
 
+// GetPersistersStats returns the statistics of the active persisters. The number of files and the size are read from
+// the persisters directories, while the engine statistics are set only for the persisters able to report them
+func (ps *PruningStorer) GetPersistersStats() []*common.PersisterStats {
+	activePersisters := ps.getActivePersistersCopy()
+
+	allStats := make([]*common.PersisterStats, 0, len(activePersisters))
+	for _, pd := range activePersisters {
+		numFiles, sizeInBytes := directoryhandler.GetDirectoryStats(pd.getPath())
+		stats := &common.PersisterStats{
+			Path:        pd.getPath(),
+			Epoch:       pd.epoch,
+			NumFiles:    numFiles,
+			SizeInBytes: sizeInBytes,
+		}
+
+		statsProvider, ok := pd.getPersister().(storage.EngineStatsProvider)
+		if ok && !pd.getIsClosed() {
+			stats.Engine = statsProvider.GetEngineStats()
+		}
+
+		allStats = append(allStats, stats)
+	}
+
+	return allStats
+}
+
+// Compact compacts the open active persisters. It errors if none of them supports the manual compaction
+func (ps *PruningStorer) Compact() error {
+	activePersisters := ps.getActivePersistersCopy()
+
+	numCompacted := 0
+	for _, pd := range activePersisters {
+		if pd.getIsClosed() {
+			continue
+		}
+		compactor, ok := pd.getPersister().(storage.Compactor)
+		if !ok {
+			continue
+		}
+
+		err := compactor.Compact()
+		if err != nil {
+			return fmt.Errorf("%w while compacting %s", err, pd.getPath())
+		}
+		numCompacted++
+	}
+
+	if numCompacted == 0 {
+		return fmt.Errorf("%w for %s", storage.ErrCompactionNotSupported, ps.identifier)
+	}
+
+	log.Debug("storer compacted", "identifier", ps.identifier, "num persisters", numCompacted)
+
+	return nil
+}
+
+// the compaction and the statistics computation should not hold the storer lock, as they can take a while
+func (ps *PruningStorer) getActivePersistersCopy() []*persisterData {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	activePersisters := make([]*persisterData, len(ps.activePersisters))
+	copy(activePersisters, ps.activePersisters)
+
+	return activePersisters
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (ps *PruningStorer) IsInterfaceNil() bool {
 	return ps == nil
 }
 
+// moveToColdStorage schedules the move of a closed persister in the cold storage. The persister is switched to the
+// cold path only after its directory was completely copied and only if it was neither reopened nor destroyed meanwhile
+func (ps *PruningStorer) moveToColdStorage(pd *persisterData) {
+	if !pd.getIsClosed() {
+		return
+	}
+
+	generation := pd.getGeneration()
+	ps.coldStorageHandler.MoveToColdStorage(pd.getPath(), func(coldPath string) bool {
+		ps.lock.Lock()
+		defer ps.lock.Unlock()
+
+		if !pd.getIsClosed() || pd.getGeneration() != generation {
+			return false
+		}
+
+		pd.setPath(coldPath)
+
+		return true
+	})
+}
+
 func createPersisterPathForEpoch(args StorerArgs, epoch uint32, shard string) string {
 	filePath := args.PathManager.PathForEpoch(core.GetShardIDString(args.ShardCoordinator.SelfId()), epoch, args.Identifier)
 	if len(shard) > 0 {
@@ -1051,6 +1196,8 @@ func createPersisterDataForEpoch(args StorerArgs, epoch uint32, shard string) (*
 	// TODO: if booting from storage in an epoch > 0, shardId needs to be taken from somewhere else
 	// e.g. determined from directories in persister path or taken from boot storer
 	filePath := createPersisterPathForEpoch(args, epoch, shard)
+	// the persisters of the epochs out of the active window might have been moved in the cold storage
+	filePath = args.ColdStorageHandler.GetPersisterPath(filePath)
 
 	db, err := args.PersisterFactory.Create(filePath)
 	if err != nil {
@@ -22,6 +22,10 @@ type StorerArgs struct {
 	PruningEnabled            bool
 	EnabledDbLookupExtensions bool
 	PersistersTracker         PersistersTracker
+	ColdStorageHandler        ColdStorageHandler
+	// RetainedEpochsOnly is set for the units having their own finite retention policy, so the persisters of the
+	// epochs older than NumOfEpochsToKeep are never opened again
+	RetainedEpochsOnly bool
 }
 
 // EpochArgs will hold the arguments needed for persistersTracker
@@ -19,6 +19,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/data/block"
 	"github.com/multiversx/mx-chain-go/config"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/coldstorage"
 	"github.com/multiversx/mx-chain-go/storage/database"
 	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
 	"github.com/multiversx/mx-chain-go/storage/factory"
@@ -88,6 +89,7 @@ func getDefaultArgs() pruning.StorerArgs {
 		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
 		MaxBatchSize:           10,
 		PersistersTracker:      pruning.NewPersistersTracker(epochsData),
+		ColdStorageHandler:     &testscommon.ColdStorageHandlerStub{},
 	}
 }
 
@@ -120,6 +122,7 @@ func getDefaultArgsSerialDB() pruning.StorerArgs {
 		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
 		MaxBatchSize:           20,
 		PersistersTracker:      pruning.NewPersistersTracker(epochData),
+		ColdStorageHandler:     &testscommon.ColdStorageHandlerStub{},
 	}
 }
 
@@ -147,6 +150,18 @@ func TestNewPruningStorer_NilPersistersTrackerShouldErr(t *testing.T) {
 	assert.Equal(t, storage.ErrNilPersistersTracker, err)
 }
 
+func TestNewPruningStorer_NilColdStorageHandlerShouldErr(t *testing.T) {
+	t.Parallel()
+
+	args := getDefaultArgs()
+	args.ColdStorageHandler = nil
+
+	ps, err := pruning.NewPruningStorer(args)
+
+	assert.Nil(t, ps)
+	assert.Equal(t, storage.ErrNilColdStorageHandler, err)
+}
+
 func TestNewPruningStorer_NumEpochKeepLowerThanNumActiveShouldErr(t *testing.T) {
 	t.Parallel()
 
@@ -1312,6 +1327,184 @@ func TestPruningStorer_RemoveFromCurrentEpoch(t *testing.T) {
 	assert.Equal(t, value, recovered)
 }
 
+func TestPruningStorer_GetPersistersStatsAndCompact(t *testing.T) {
+	t.Parallel()
+
+	t.Run("persisters without engine statistics and compaction", func(t *testing.T) {
+		t.Parallel()
+
+		ps, _ := pruning.NewPruningStorer(getDefaultArgs())
+
+		allStats := ps.GetPersistersStats()
+		require.Equal(t, 1, len(allStats))
+		assert.Nil(t, allStats[0].Engine)
+
+		err := ps.Compact()
+		assert.True(t, errors.Is(err, storage.ErrCompactionNotSupported))
+	})
+	t.Run("pebble persisters should report statistics and compact", func(t *testing.T) {
+		t.Parallel()
+
+		dbPath := t.TempDir()
+		args := getDefaultArgs()
+		args.PathManager = &testscommon.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+			return filepath.Join(dbPath, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+		}}
+		args.PersisterFactory = factory.NewPersisterFactory(config.DBConfig{
+			Type:              string(storageunit.PebbleDB),
+			BatchDelaySeconds: 1,
+			MaxBatchSize:      1,
+			MaxOpenFiles:      10,
+		})
+		ps, _ := pruning.NewPruningStorer(args)
+		defer func() {
+			_ = ps.Close()
+		}()
+
+		for i := 0; i < 10; i++ {
+			_ = ps.Put([]byte(fmt.Sprintf("key%d", i)), []byte("value"))
+		}
+		require.Nil(t, ps.Compact())
+
+		allStats := ps.GetPersistersStats()
+		require.Equal(t, 1, len(allStats))
+		assert.Equal(t, filepath.Join(dbPath, "Epoch_0", "Shard_0", "id"), allStats[0].Path)
+		assert.Equal(t, uint32(0), allStats[0].Epoch)
+		assert.True(t, allStats[0].NumFiles > 0)
+		assert.True(t, allStats[0].SizeInBytes > 0)
+		require.NotNil(t, allStats[0].Engine)
+		assert.True(t, allStats[0].Engine.NumTables > 0)
+	})
+}
+
+func waitForDirectory(t *testing.T, path string, shouldExist bool) {
+	for i := 0; i < 500; i++ {
+		_, err := os.Stat(path)
+		if shouldExist == (err == nil) {
+			return
+		}
+
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	require.Fail(t, fmt.Sprintf("timeout while waiting for %s, should exist: %v", path, shouldExist))
+}
+
+func TestPruningStorer_ColdStorage(t *testing.T) {
+	t.Parallel()
+
+	rootPath := t.TempDir()
+	dbPath := filepath.Join(rootPath, "db")
+	coldDbPath := filepath.Join(rootPath, "cold")
+	hotPathForEpoch := func(epoch uint32) string {
+		return filepath.Join(dbPath, fmt.Sprintf("Epoch_%d", epoch), "Shard_0", "id")
+	}
+	coldPathForEpoch := func(epoch uint32) string {
+		return filepath.Join(coldDbPath, fmt.Sprintf("Epoch_%d", epoch), "Shard_0", "id")
+	}
+
+	coldStorageHandler, err := coldstorage.NewColdStorageHandler(coldstorage.ArgsColdStorageHandler{
+		DatabasePath:     dbPath,
+		ColdDatabasePath: coldDbPath,
+	})
+	require.Nil(t, err)
+
+	args := getDefaultArgs()
+	args.EpochsData.NumOfEpochsToKeep = 3
+	args.PersistersTracker = pruning.NewPersistersTracker(args.EpochsData)
+	args.ColdStorageHandler = coldStorageHandler
+	args.PathManager = &testscommon.PathManagerStub{PathForEpochCalled: func(shardId string, epoch uint32, identifier string) string {
+		return filepath.Join(dbPath, fmt.Sprintf("Epoch_%d", epoch), fmt.Sprintf("Shard_%s", shardId), identifier)
+	}}
+	args.PersisterFactory = factory.NewPersisterFactory(config.DBConfig{
+		Type:              string(storageunit.LvlDBSerial),
+		BatchDelaySeconds: 1,
+		MaxBatchSize:      1,
+		MaxOpenFiles:      10,
+	})
+
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	key, value := []byte("key"), []byte("value")
+	require.Nil(t, ps.Put(key, value))
+	require.Nil(t, ps.ChangeEpochSimple(1))
+	require.Nil(t, ps.ChangeEpochSimple(2))
+
+	// epoch 0 left the active window, so it is moved in the cold storage in background
+	waitForDirectory(t, hotPathForEpoch(0), false)
+	_, err = os.Stat(coldPathForEpoch(0))
+	assert.Nil(t, err)
+	_, err = os.Stat(hotPathForEpoch(1))
+	assert.Nil(t, err)
+
+	ps.ClearCache()
+	recovered, err := ps.GetFromEpoch(key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+	require.Nil(t, ps.Close())
+
+	// after a restart, the persister of epoch 0 is opened from the cold storage
+	args.EpochsData.StartingEpoch = 2
+	args.PersistersTracker = pruning.NewPersistersTracker(args.EpochsData)
+	ps, err = pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	recovered, err = ps.GetFromEpoch(key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+	_, err = os.Stat(hotPathForEpoch(0))
+	assert.True(t, os.IsNotExist(err))
+
+	// epoch 0 falls out of the epochs to keep, so it is removed from the cold storage as well
+	args.CustomDatabaseRemover = &testscommon.CustomDatabaseRemoverStub{
+		ShouldRemoveCalled: func(_ string, _ uint32) bool {
+			return true
+		},
+	}
+	require.Nil(t, ps.Close())
+	ps, err = pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+	require.Nil(t, ps.ChangeEpochSimple(3))
+
+	_, err = os.Stat(coldPathForEpoch(0))
+	assert.True(t, os.IsNotExist(err))
+	waitForDirectory(t, coldPathForEpoch(1), true)
+	require.Nil(t, ps.Close())
+}
+
+func TestPruningStorer_ColdStorageMoveOfReopenedPersisterShouldBeDiscarded(t *testing.T) {
+	t.Parallel()
+
+	var switchPath func(coldPath string) bool
+	args := getDefaultArgs()
+	args.ColdStorageHandler = &testscommon.ColdStorageHandlerStub{
+		MoveToColdStorageCalled: func(_ string, switchPathFunc func(coldPath string) bool) {
+			switchPath = switchPathFunc
+		},
+	}
+
+	ps, err := pruning.NewPruningStorer(args)
+	require.Nil(t, err)
+
+	key, value := []byte("key"), []byte("value")
+	require.Nil(t, ps.Put(key, value))
+	require.Nil(t, ps.ChangeEpochSimple(1))
+	require.Nil(t, ps.ChangeEpochSimple(2))
+	require.NotNil(t, switchPath)
+
+	// the persister of epoch 0 is reopened while its directory is copied
+	ps.ClearCache()
+	recovered, err := ps.GetFromEpoch(key, 0)
+	require.Nil(t, err)
+	assert.Equal(t, value, recovered)
+
+	assert.False(t, switchPath("cold path"))
+	recovered, err = ps.GetFromEpoch(key, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, value, recovered)
+}
+
 func TestPruningStorer_IsInterfaceNil(t *testing.T) {
 	t.Parallel()
 
@@ -9,8 +9,7 @@ const (
 	SizeLRUCache = storageUnit.SizeLRUCache
 )
 
-// LvlDB currently the only supported DBs
-// More to be added
+// LvlDB, LvlDBSerial and PebbleDB are the persistent supported DBs
 const (
 	// LvlDB represents a levelDB storage identifier
 	LvlDB = storageUnit.LvlDB
@@ -18,4 +17,6 @@ const (
 	LvlDBSerial = storageUnit.LvlDBSerial
 	// MemoryDB represents an in memory storage identifier
 	MemoryDB = storageUnit.MemoryDB
+	// PebbleDB represents a pebble storage identifier
+	PebbleDB DBType = "PebbleDB"
 )
@@ -1,12 +1,22 @@
 package storageunit
 
 import (
+	"fmt"
+
 	"github.com/multiversx/mx-chain-core-go/marshal"
+	chainCommon "github.com/multiversx/mx-chain-go/common"
 	"github.com/multiversx/mx-chain-go/storage"
+	"github.com/multiversx/mx-chain-go/storage/database"
+	"github.com/multiversx/mx-chain-go/storage/directoryhandler"
+	"github.com/multiversx/mx-chain-go/storage/pebbledb"
+	"github.com/multiversx/mx-chain-storage-go/common"
 	"github.com/multiversx/mx-chain-storage-go/storageCacherAdapter"
 	"github.com/multiversx/mx-chain-storage-go/storageUnit"
 )
 
+var _ storage.PersistersStatsProvider = (*unitWithStats)(nil)
+var _ storage.Compactor = (*unitWithStats)(nil)
+
 // Unit represents a storer's data bank
 // holding the cache and persistence unit
 type Unit = storageUnit.Unit
@@ -42,12 +52,120 @@ func NewCache(config CacheConfig) (storage.Cacher, error) {
 
 // NewDB creates a new database from database config
 func NewDB(argDB ArgDB) (storage.Persister, error) {
-	return storageUnit.NewDB(argDB)
+	switch argDB.DBType {
+	case PebbleDB:
+		return pebbledb.NewDB(argDB.Path, argDB.BatchDelaySeconds, argDB.MaxBatchSize, argDB.MaxOpenFiles)
+	case LvlDB, LvlDBSerial:
+		return database.NewLevelDBAdapter(argDB.Path, func() (storage.Persister, error) {
+			return storageUnit.NewDB(argDB)
+		})
+	default:
+		return storageUnit.NewDB(argDB)
+	}
 }
 
 // NewStorageUnitFromConf creates a new storage unit from a storage unit config
 func NewStorageUnitFromConf(cacheConf CacheConfig, dbConf DBConfig) (*Unit, error) {
-	return storageUnit.NewStorageUnitFromConf(cacheConf, dbConf)
+	if dbConf.Type != PebbleDB {
+		return storageUnit.NewStorageUnitFromConf(cacheConf, dbConf)
+	}
+
+	if dbConf.MaxBatchSize > int(cacheConf.Capacity) {
+		return nil, common.ErrCacheSizeIsLowerThanBatchSize
+	}
+
+	cache, err := NewCache(cacheConf)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := NewDB(ArgDB{
+		DBType:            dbConf.Type,
+		Path:              dbConf.FilePath,
+		BatchDelaySeconds: dbConf.BatchDelaySeconds,
+		MaxBatchSize:      dbConf.MaxBatchSize,
+		MaxOpenFiles:      dbConf.MaxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return NewStorageUnit(cache, db)
+}
+
+// unitWithStats is a storage unit able to report the statistics of its persister and to compact it
+type unitWithStats struct {
+	*Unit
+	persister storage.Persister
+	path      string
+}
+
+// NewStorageUnitWithStatsFromConf creates a new storage unit from a storage unit config. The returned storer also
+// implements the storage.PersistersStatsProvider and storage.Compactor interfaces
+func NewStorageUnitWithStatsFromConf(cacheConf CacheConfig, dbConf DBConfig) (storage.Storer, error) {
+	if dbConf.MaxBatchSize > int(cacheConf.Capacity) {
+		return nil, common.ErrCacheSizeIsLowerThanBatchSize
+	}
+
+	cache, err := NewCache(cacheConf)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := NewDB(ArgDB{
+		DBType:            dbConf.Type,
+		Path:              dbConf.FilePath,
+		BatchDelaySeconds: dbConf.BatchDelaySeconds,
+		MaxBatchSize:      dbConf.MaxBatchSize,
+		MaxOpenFiles:      dbConf.MaxOpenFiles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unit, err := NewStorageUnit(cache, db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &unitWithStats{
+		Unit:      unit,
+		persister: db,
+		path:      dbConf.FilePath,
+	}, nil
+}
+
+// GetPersistersStats returns the statistics of the unit's persister. The engine statistics are set only if the
+// persister is able to report them
+func (uws *unitWithStats) GetPersistersStats() []*chainCommon.PersisterStats {
+	numFiles, sizeInBytes := directoryhandler.GetDirectoryStats(uws.path)
+	stats := &chainCommon.PersisterStats{
+		Path:        uws.path,
+		NumFiles:    numFiles,
+		SizeInBytes: sizeInBytes,
+	}
+
+	statsProvider, ok := uws.persister.(storage.EngineStatsProvider)
+	if ok {
+		stats.Engine = statsProvider.GetEngineStats()
+	}
+
+	return []*chainCommon.PersisterStats{stats}
+}
+
+// Compact compacts the unit's persister. It errors if the persister does not support the manual compaction
+func (uws *unitWithStats) Compact() error {
+	compactor, ok := uws.persister.(storage.Compactor)
+	if !ok {
+		return fmt.Errorf("%w for %s", storage.ErrCompactionNotSupported, uws.path)
+	}
+
+	return compactor.Compact()
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (uws *unitWithStats) IsInterfaceNil() bool {
+	return uws == nil
 }
 
 // NewNilStorer will return a nil storer
@@ -1,10 +1,13 @@
 package storageunit_test
 
 import (
+	"errors"
+	"fmt"
 	"path"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	chainStorage "github.com/multiversx/mx-chain-go/storage"
 	"github.com/multiversx/mx-chain-go/storage/mock"
 	"github.com/multiversx/mx-chain-go/storage/storageunit"
 	"github.com/multiversx/mx-chain-go/testscommon/storage"
@@ -12,6 +15,7 @@ import (
 
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewStorageUnit(t *testing.T) {
@@ -102,6 +106,21 @@ func TestNewDB(t *testing.T) {
 		assert.Nil(t, err)
 		_ = db.Close()
 	})
+	t.Run("pebble db should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := storageunit.ArgDB{
+			DBType:            storageunit.PebbleDB,
+			Path:              path.Join(t.TempDir(), "TEST"),
+			BatchDelaySeconds: 5,
+			MaxBatchSize:      10,
+			MaxOpenFiles:      10,
+		}
+		db, err := storageunit.NewDB(args)
+		assert.False(t, check.IfNil(db))
+		assert.Nil(t, err)
+		_ = db.Close()
+	})
 }
 
 func TestNewStorageUnitFromConf(t *testing.T) {
@@ -140,6 +159,118 @@ func TestNewStorageUnitFromConf(t *testing.T) {
 		assert.Nil(t, err)
 		_ = unit.Close()
 	})
+	t.Run("pebble db with batch size larger than the cache should error", func(t *testing.T) {
+		t.Parallel()
+
+		cacheConfig := storageunit.CacheConfig{
+			Type:     "LRU",
+			Capacity: 5,
+		}
+		pebbleDBConfig := dbConfig
+		pebbleDBConfig.Type = storageunit.PebbleDB
+		pebbleDBConfig.FilePath = path.Join(t.TempDir(), "TEST")
+
+		unit, err := storageunit.NewStorageUnitFromConf(cacheConfig, pebbleDBConfig)
+		assert.Nil(t, unit)
+		assert.Equal(t, common.ErrCacheSizeIsLowerThanBatchSize, err)
+	})
+	t.Run("pebble db should work", func(t *testing.T) {
+		t.Parallel()
+
+		cacheConfig := storageunit.CacheConfig{
+			Type:     "LRU",
+			Capacity: 100,
+		}
+		pebbleDBConfig := dbConfig
+		pebbleDBConfig.Type = storageunit.PebbleDB
+		pebbleDBConfig.FilePath = path.Join(t.TempDir(), "TEST")
+
+		unit, err := storageunit.NewStorageUnitFromConf(cacheConfig, pebbleDBConfig)
+		assert.NotNil(t, unit)
+		assert.Nil(t, err)
+
+		assert.Nil(t, unit.Put([]byte("key"), []byte("value")))
+		unit.ClearCache()
+		value, err := unit.Get([]byte("key"))
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("value"), value)
+		_ = unit.Close()
+	})
+}
+
+func TestNewStorageUnitWithStatsFromConf(t *testing.T) {
+	t.Parallel()
+
+	cacheConfig := storageunit.CacheConfig{
+		Type:     "LRU",
+		Capacity: 100,
+	}
+
+	t.Run("invalid batch size should error", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := storageunit.DBConfig{
+			FilePath:     path.Join(t.TempDir(), "TEST"),
+			Type:         storageunit.LvlDBSerial,
+			MaxBatchSize: 101,
+		}
+		unit, err := storageunit.NewStorageUnitWithStatsFromConf(cacheConfig, dbConfig)
+		assert.Equal(t, common.ErrCacheSizeIsLowerThanBatchSize, err)
+		assert.True(t, check.IfNil(unit))
+	})
+	t.Run("persister without engine stats and compaction", func(t *testing.T) {
+		t.Parallel()
+
+		dbConfig := storageunit.DBConfig{
+			FilePath:     path.Join(t.TempDir(), "TEST"),
+			Type:         storageunit.MemoryDB,
+			MaxBatchSize: 10,
+		}
+		unit, err := storageunit.NewStorageUnitWithStatsFromConf(cacheConfig, dbConfig)
+		require.Nil(t, err)
+
+		stats := unit.(chainStorage.PersistersStatsProvider).GetPersistersStats()
+		require.Equal(t, 1, len(stats))
+		assert.Nil(t, stats[0].Engine)
+		assert.True(t, errors.Is(unit.(chainStorage.Compactor).Compact(), chainStorage.ErrCompactionNotSupported))
+	})
+
+	for _, dbType := range []storageunit.DBType{storageunit.LvlDB, storageunit.LvlDBSerial, storageunit.PebbleDB} {
+		dbConfig := storageunit.DBConfig{
+			FilePath:          path.Join(t.TempDir(), "TEST"),
+			Type:              dbType,
+			BatchDelaySeconds: 5,
+			MaxBatchSize:      10,
+			MaxOpenFiles:      10,
+		}
+
+		t.Run(string(dbType), func(t *testing.T) {
+			t.Parallel()
+
+			unit, err := storageunit.NewStorageUnitWithStatsFromConf(cacheConfig, dbConfig)
+			require.Nil(t, err)
+
+			for i := 0; i < 100; i++ {
+				_ = unit.Put([]byte(fmt.Sprintf("key%d", i)), []byte(fmt.Sprintf("value%d", i)))
+			}
+			assert.Nil(t, unit.(chainStorage.Compactor).Compact())
+
+			stats := unit.(chainStorage.PersistersStatsProvider).GetPersistersStats()
+			require.Equal(t, 1, len(stats))
+			assert.Equal(t, dbConfig.FilePath, stats[0].Path)
+			assert.True(t, stats[0].NumFiles > 0)
+			assert.True(t, stats[0].SizeInBytes > 0)
+			require.NotNil(t, stats[0].Engine)
+			assert.True(t, stats[0].Engine.NumTables > 0)
+
+			unit.ClearCache()
+			value, err := unit.Get([]byte("key7"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("value7"), value)
+
+			_ = unit.Close()
+		})
+	}
 }
 
 func TestNewNilStorer(t *testing.T) {
@@ -0,0 +1,32 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-core-go/data"
+
+// BlockRootHashResolverStub -
+type BlockRootHashResolverStub struct {
+	GetBlockHeaderAndRootHashByNonceCalled func(nonce uint64) (data.HeaderHandler, []byte, error)
+	GetBlockHeaderAndRootHashByHashCalled  func(hash []byte) (data.HeaderHandler, []byte, error)
+}
+
+// GetBlockHeaderAndRootHashByNonce -
+func (stub *BlockRootHashResolverStub) GetBlockHeaderAndRootHashByNonce(nonce uint64) (data.HeaderHandler, []byte, error) {
+	if stub.GetBlockHeaderAndRootHashByNonceCalled != nil {
+		return stub.GetBlockHeaderAndRootHashByNonceCalled(nonce)
+	}
+
+	return nil, nil, nil
+}
+
+// GetBlockHeaderAndRootHashByHash -
+func (stub *BlockRootHashResolverStub) GetBlockHeaderAndRootHashByHash(hash []byte) (data.HeaderHandler, []byte, error) {
+	if stub.GetBlockHeaderAndRootHashByHashCalled != nil {
+		return stub.GetBlockHeaderAndRootHashByHashCalled(hash)
+	}
+
+	return nil, nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *BlockRootHashResolverStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,25 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// CallTraceBuilderStub -
+type CallTraceBuilderStub struct {
+	BuildCalled func(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *common.CallTrace
+}
+
+// Build -
+func (stub *CallTraceBuilderStub) Build(input *vmcommon.ContractCallInput, vmOutput *vmcommon.VMOutput) *common.CallTrace {
+	if stub.BuildCalled != nil {
+		return stub.BuildCalled(input, vmOutput)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *CallTraceBuilderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,38 @@
+package testscommon
+
+// ColdStorageHandlerStub -
+type ColdStorageHandlerStub struct {
+	MoveToColdStorageCalled     func(path string, switchPath func(coldPath string) bool)
+	GetPersisterPathCalled      func(path string) string
+	RemoveFromColdStorageCalled func(path string) error
+}
+
+// MoveToColdStorage -
+func (stub *ColdStorageHandlerStub) MoveToColdStorage(path string, switchPath func(coldPath string) bool) {
+	if stub.MoveToColdStorageCalled != nil {
+		stub.MoveToColdStorageCalled(path, switchPath)
+	}
+}
+
+// GetPersisterPath -
+func (stub *ColdStorageHandlerStub) GetPersisterPath(path string) string {
+	if stub.GetPersisterPathCalled != nil {
+		return stub.GetPersisterPathCalled(path)
+	}
+
+	return path
+}
+
+// RemoveFromColdStorage -
+func (stub *ColdStorageHandlerStub) RemoveFromColdStorage(path string) error {
+	if stub.RemoveFromColdStorageCalled != nil {
+		return stub.RemoveFromColdStorageCalled(path)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *ColdStorageHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -77,10 +77,12 @@ func GetDefaultCryptoComponents() *mock.CryptoComponentsMock {
 // GetDefaultNetworkComponents -
 func GetDefaultNetworkComponents() *mock.NetworkComponentsMock {
 	return &mock.NetworkComponentsMock{
-		Messenger:       &p2pmocks.MessengerStub{},
-		InputAntiFlood:  &mock.P2PAntifloodHandlerStub{},
-		OutputAntiFlood: &mock.P2PAntifloodHandlerStub{},
-		PeerBlackList:   &mock.PeerBlackListHandlerStub{},
+		Messenger:                 &p2pmocks.MessengerStub{},
+		InputAntiFlood:            &mock.P2PAntifloodHandlerStub{},
+		OutputAntiFlood:           &mock.P2PAntifloodHandlerStub{},
+		PeerBlackList:             &mock.PeerBlackListHandlerStub{},
+		PeerReputation:            &p2pmocks.PeerReputationHandlerStub{},
+		PeersResponseTrackerField: &testscommon.PeersResponseTrackerStub{},
 	}
 }
 
@@ -0,0 +1,30 @@
+package testscommon
+
+import "github.com/multiversx/mx-chain-go/common"
+
+// HistoricalStateHandlerStub -
+type HistoricalStateHandlerStub struct {
+	StartHistoricalSessionCalled func(options common.RootHashHolder) error
+	EndHistoricalSessionCalled   func()
+}
+
+// StartHistoricalSession -
+func (stub *HistoricalStateHandlerStub) StartHistoricalSession(options common.RootHashHolder) error {
+	if stub.StartHistoricalSessionCalled != nil {
+		return stub.StartHistoricalSessionCalled(options)
+	}
+
+	return nil
+}
+
+// EndHistoricalSession -
+func (stub *HistoricalStateHandlerStub) EndHistoricalSession() {
+	if stub.EndHistoricalSessionCalled != nil {
+		stub.EndHistoricalSessionCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *HistoricalStateHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
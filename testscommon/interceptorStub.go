@@ -10,6 +10,8 @@ import (
 type InterceptorStub struct {
 	ProcessReceivedMessageCalled     func(message p2p.MessageP2P) error
 	SetInterceptedDebugHandlerCalled func(debugger process.InterceptedDebugger) error
+	SetPeerReputationHandlerCalled   func(handler process.PeerReputationHandler) error
+	SetPeersResponseTrackerCalled    func(tracker process.PeersResponseTracker) error
 	RegisterHandlerCalled            func(handler func(topic string, hash []byte, data interface{}))
 	CloseCalled                      func() error
 }
@@ -32,6 +34,24 @@ func (is *InterceptorStub) SetInterceptedDebugHandler(debugger process.Intercept
 	return nil
 }
 
+// SetPeerReputationHandler -
+func (is *InterceptorStub) SetPeerReputationHandler(handler process.PeerReputationHandler) error {
+	if is.SetPeerReputationHandlerCalled != nil {
+		return is.SetPeerReputationHandlerCalled(handler)
+	}
+
+	return nil
+}
+
+// SetPeersResponseTracker -
+func (is *InterceptorStub) SetPeersResponseTracker(tracker process.PeersResponseTracker) error {
+	if is.SetPeersResponseTrackerCalled != nil {
+		return is.SetPeersResponseTrackerCalled(tracker)
+	}
+
+	return nil
+}
+
 // RegisterHandler -
 func (is *InterceptorStub) RegisterHandler(handler func(topic string, hash []byte, data interface{})) {
 	if is.RegisterHandlerCalled != nil {
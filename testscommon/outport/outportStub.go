@@ -12,6 +12,7 @@ type OutportStub struct {
 	SaveValidatorsRatingCalled  func(index string, validatorsInfo []*outportcore.ValidatorRatingInfo)
 	SaveValidatorsPubKeysCalled func(shardPubKeys map[uint32][][]byte, epoch uint32)
 	HasDriversCalled            func() bool
+	FinalizedBlockCalled        func(headerHash []byte)
 }
 
 // SaveBlock -
@@ -74,5 +75,8 @@ func (as *OutportStub) SubscribeDriver(_ outport.Driver) error {
 }
 
 // FinalizedBlock -
-func (as *OutportStub) FinalizedBlock(_ []byte) {
+func (as *OutportStub) FinalizedBlock(headerHash []byte) {
+	if as.FinalizedBlockCalled != nil {
+		as.FinalizedBlockCalled(headerHash)
+	}
 }
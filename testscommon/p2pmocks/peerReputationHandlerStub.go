@@ -0,0 +1,83 @@
+package p2pmocks
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/process"
+)
+
+// PeerReputationHandlerStub -
+type PeerReputationHandlerStub struct {
+	RecordAntifloodViolationCalled func(pid core.PeerID)
+	RecordInvalidDataCalled        func(pid core.PeerID, topic string)
+	RecordRequestSentCalled        func(pid core.PeerID, topic string)
+	RecordReceivedDataCalled       func(pid core.PeerID, topic string, isUseful bool)
+	GetPeersByReputationCalled     func(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID
+	GetPeerReputationCalled        func(pid core.PeerID) *common.PeerReputation
+	SetPeerValidatorMapperCalled   func(validatorMapper process.PeerValidatorMapper) error
+}
+
+// RecordAntifloodViolation -
+func (stub *PeerReputationHandlerStub) RecordAntifloodViolation(pid core.PeerID) {
+	if stub.RecordAntifloodViolationCalled != nil {
+		stub.RecordAntifloodViolationCalled(pid)
+	}
+}
+
+// RecordInvalidData -
+func (stub *PeerReputationHandlerStub) RecordInvalidData(pid core.PeerID, topic string) {
+	if stub.RecordInvalidDataCalled != nil {
+		stub.RecordInvalidDataCalled(pid, topic)
+	}
+}
+
+// RecordRequestSent -
+func (stub *PeerReputationHandlerStub) RecordRequestSent(pid core.PeerID, topic string) {
+	if stub.RecordRequestSentCalled != nil {
+		stub.RecordRequestSentCalled(pid, topic)
+	}
+}
+
+// RecordReceivedData -
+func (stub *PeerReputationHandlerStub) RecordReceivedData(pid core.PeerID, topic string, isUseful bool) {
+	if stub.RecordReceivedDataCalled != nil {
+		stub.RecordReceivedDataCalled(pid, topic, isUseful)
+	}
+}
+
+// GetPeersByReputation -
+func (stub *PeerReputationHandlerStub) GetPeersByReputation(peers []core.PeerID, minNumOfPeersExpected int) []core.PeerID {
+	if stub.GetPeersByReputationCalled != nil {
+		return stub.GetPeersByReputationCalled(peers, minNumOfPeersExpected)
+	}
+
+	return peers
+}
+
+// GetPeerReputation -
+func (stub *PeerReputationHandlerStub) GetPeerReputation(pid core.PeerID) *common.PeerReputation {
+	if stub.GetPeerReputationCalled != nil {
+		return stub.GetPeerReputationCalled(pid)
+	}
+
+	return nil
+}
+
+// SetPeerValidatorMapper -
+func (stub *PeerReputationHandlerStub) SetPeerValidatorMapper(validatorMapper process.PeerValidatorMapper) error {
+	if stub.SetPeerValidatorMapperCalled != nil {
+		return stub.SetPeerValidatorMapperCalled(validatorMapper)
+	}
+
+	return nil
+}
+
+// Close -
+func (stub *PeerReputationHandlerStub) Close() error {
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeerReputationHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,60 @@
+package testscommon
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+)
+
+// PeersResponseTrackerStub -
+type PeersResponseTrackerStub struct {
+	RequestSentCalled      func(pid core.PeerID, topic string)
+	ResponseReceivedCalled func(pid core.PeerID, topic string, isRequested bool)
+	SortPeersCalled        func(peers []core.PeerID, topic string) []core.PeerID
+	QueryCalled            func(search string) []string
+	CloseCalled            func() error
+}
+
+// RequestSent -
+func (stub *PeersResponseTrackerStub) RequestSent(pid core.PeerID, topic string) {
+	if stub.RequestSentCalled != nil {
+		stub.RequestSentCalled(pid, topic)
+	}
+}
+
+// ResponseReceived -
+func (stub *PeersResponseTrackerStub) ResponseReceived(pid core.PeerID, topic string, isRequested bool) {
+	if stub.ResponseReceivedCalled != nil {
+		stub.ResponseReceivedCalled(pid, topic, isRequested)
+	}
+}
+
+// SortPeers -
+func (stub *PeersResponseTrackerStub) SortPeers(peers []core.PeerID, topic string) []core.PeerID {
+	if stub.SortPeersCalled != nil {
+		return stub.SortPeersCalled(peers, topic)
+	}
+
+	return peers
+}
+
+// Query -
+func (stub *PeersResponseTrackerStub) Query(search string) []string {
+	if stub.QueryCalled != nil {
+		return stub.QueryCalled(search)
+	}
+
+	return make([]string, 0)
+}
+
+// Close -
+func (stub *PeersResponseTrackerStub) Close() error {
+	if stub.CloseCalled != nil {
+		return stub.CloseCalled()
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *PeersResponseTrackerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package state
+
+// MissingNodesFetcherStub -
+type MissingNodesFetcherStub struct {
+	FetchNodesCalled func(hashes [][]byte) map[string][]byte
+}
+
+// FetchNodes -
+func (stub *MissingNodesFetcherStub) FetchNodes(hashes [][]byte) map[string][]byte {
+	if stub.FetchNodesCalled != nil {
+		return stub.FetchNodesCalled(hashes)
+	}
+
+	return make(map[string][]byte)
+}
+
+// IsInterfaceNil -
+func (stub *MissingNodesFetcherStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,71 @@
+package state
+
+import (
+	"github.com/multiversx/mx-chain-go/common"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+)
+
+// SimulationAccountsHandlerStub -
+type SimulationAccountsHandlerStub struct {
+	StartSessionCalled        func()
+	ApplyStateOverridesCalled func(overrides common.StateOverrides) error
+	SessionAccountsCalled     func() []vmcommon.AccountHandler
+	JournalLenCalled          func() int
+	RevertToSnapshotCalled    func(snapshot int) error
+	EndSessionCalled          func()
+}
+
+// StartSession -
+func (stub *SimulationAccountsHandlerStub) StartSession() {
+	if stub.StartSessionCalled != nil {
+		stub.StartSessionCalled()
+	}
+}
+
+// ApplyStateOverrides -
+func (stub *SimulationAccountsHandlerStub) ApplyStateOverrides(overrides common.StateOverrides) error {
+	if stub.ApplyStateOverridesCalled != nil {
+		return stub.ApplyStateOverridesCalled(overrides)
+	}
+
+	return nil
+}
+
+// SessionAccounts -
+func (stub *SimulationAccountsHandlerStub) SessionAccounts() []vmcommon.AccountHandler {
+	if stub.SessionAccountsCalled != nil {
+		return stub.SessionAccountsCalled()
+	}
+
+	return nil
+}
+
+// JournalLen -
+func (stub *SimulationAccountsHandlerStub) JournalLen() int {
+	if stub.JournalLenCalled != nil {
+		return stub.JournalLenCalled()
+	}
+
+	return 0
+}
+
+// RevertToSnapshot -
+func (stub *SimulationAccountsHandlerStub) RevertToSnapshot(snapshot int) error {
+	if stub.RevertToSnapshotCalled != nil {
+		return stub.RevertToSnapshotCalled(snapshot)
+	}
+
+	return nil
+}
+
+// EndSession -
+func (stub *SimulationAccountsHandlerStub) EndSession() {
+	if stub.EndSessionCalled != nil {
+		stub.EndSessionCalled()
+	}
+}
+
+// IsInterfaceNil -
+func (stub *SimulationAccountsHandlerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
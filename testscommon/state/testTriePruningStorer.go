@@ -51,6 +51,7 @@ func CreateTestingTriePruningStorer(coordinator sharding.Coordinator, notifier p
 		CustomDatabaseRemover:  &testscommon.CustomDatabaseRemoverStub{},
 		MaxBatchSize:           10,
 		PersistersTracker:      pruning.NewPersistersTracker(epochsData),
+		ColdStorageHandler:     &testscommon.ColdStorageHandlerStub{},
 	}
 
 	tps, err := pruning.NewTriePruningStorer(args)
@@ -6,6 +6,7 @@ import (
 
 // SyncTimerStub -
 type SyncTimerStub struct {
+	CurrentTimeCalled func() time.Time
 }
 
 // StartSyncingTime -
@@ -24,6 +25,10 @@ func (sts *SyncTimerStub) FormattedCurrentTime() string {
 
 // CurrentTime -
 func (sts *SyncTimerStub) CurrentTime() time.Time {
+	if sts.CurrentTimeCalled != nil {
+		return sts.CurrentTimeCalled()
+	}
+
 	return time.Now()
 }
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/common"
 )
 
@@ -23,7 +24,11 @@ type TrieStub struct {
 	GetSerializedNodesCalled    func([]byte, uint64) ([][]byte, uint64, error)
 	GetAllHashesCalled          func() ([][]byte, error)
 	GetAllLeavesOnChannelCalled func(leavesChannels *common.TrieIteratorChannels, ctx context.Context, rootHash []byte, keyBuilder common.KeyBuilder) error
+	GetLeavesPageCalled         func(rootHash []byte, startKey []byte, maxLeaves int, ctx context.Context) ([]core.KeyValueHolder, []byte, error)
+	GetDiffCalled               func(oldRootHash []byte, newRootHash []byte, startKey []byte, handler common.TrieDiffHandlerFunc, ctx context.Context) error
 	GetProofCalled              func(key []byte) ([][]byte, []byte, error)
+	GetMultiProofCalled         func(keys [][]byte) ([][]byte, [][]byte, error)
+	GetRangeProofCalled         func(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, []byte, error)
 	VerifyProofCalled           func(rootHash []byte, key []byte, proof [][]byte) (bool, error)
 	GetStorageManagerCalled     func() common.StorageManager
 	GetSerializedNodeCalled     func(bytes []byte) ([]byte, error)
@@ -49,6 +54,24 @@ func (ts *TrieStub) GetProof(key []byte) ([][]byte, []byte, error) {
 	return nil, nil, nil
 }
 
+// GetMultiProof -
+func (ts *TrieStub) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if ts.GetMultiProofCalled != nil {
+		return ts.GetMultiProofCalled(keys)
+	}
+
+	return nil, nil, nil
+}
+
+// GetRangeProof -
+func (ts *TrieStub) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, []byte, error) {
+	if ts.GetRangeProofCalled != nil {
+		return ts.GetRangeProofCalled(startKey, endKey, maxLeaves)
+	}
+
+	return nil, nil, nil, nil
+}
+
 // VerifyProof -
 func (ts *TrieStub) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	if ts.VerifyProofCalled != nil {
@@ -67,6 +90,24 @@ func (ts *TrieStub) GetAllLeavesOnChannel(leavesChannels *common.TrieIteratorCha
 	return nil
 }
 
+// GetLeavesPage -
+func (ts *TrieStub) GetLeavesPage(rootHash []byte, startKey []byte, maxLeaves int, ctx context.Context) ([]core.KeyValueHolder, []byte, error) {
+	if ts.GetLeavesPageCalled != nil {
+		return ts.GetLeavesPageCalled(rootHash, startKey, maxLeaves, ctx)
+	}
+
+	return nil, nil, nil
+}
+
+// GetDiff -
+func (ts *TrieStub) GetDiff(oldRootHash []byte, newRootHash []byte, startKey []byte, handler common.TrieDiffHandlerFunc, ctx context.Context) error {
+	if ts.GetDiffCalled != nil {
+		return ts.GetDiffCalled(oldRootHash, newRootHash, startKey, handler, ctx)
+	}
+
+	return nil
+}
+
 // Get -
 func (ts *TrieStub) Get(key []byte) ([]byte, uint32, error) {
 	if ts.GetCalled != nil {
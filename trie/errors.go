@@ -73,6 +73,9 @@ var ErrNilPathManager = errors.New("nil path manager")
 // ErrInvalidTrieTopic signals that invalid trie topic has been provided
 var ErrInvalidTrieTopic = errors.New("invalid trie topic")
 
+// ErrInvalidMaxLeaves signals that an invalid maximum number of leaves has been provided
+var ErrInvalidMaxLeaves = errors.New("invalid maximum number of leaves")
+
 // ErrNilContext signals that nil context has been provided
 var ErrNilContext = errors.New("nil context")
 
@@ -114,3 +117,27 @@ var ErrNilTrieIteratorLeavesChannel = errors.New("nil trie iterator leaves chann
 
 // ErrNilTrieIteratorErrChannel signals that a nil trie iterator error channel has been provided
 var ErrNilTrieIteratorErrChannel = errors.New("nil trie iterator error channel")
+
+// ErrEmptyKeysList signals that an empty list of keys was provided
+var ErrEmptyKeysList = errors.New("empty keys list")
+
+// ErrInvalidRangeBounds signals that the start key of a range is placed after its end key
+var ErrInvalidRangeBounds = errors.New("invalid range bounds")
+
+// ErrInvalidProof signals that a Merkle proof does not match the provided root hash
+var ErrInvalidProof = errors.New("invalid proof")
+
+// ErrNilNodeHandlerFunc signals that a nil node handler function has been provided
+var ErrNilNodeHandlerFunc = errors.New("nil node handler function")
+
+// ErrNodeHashMismatch signals that the hash of an encoded node differs from the key it was stored under
+var ErrNodeHashMismatch = errors.New("node hash mismatch")
+
+// ErrNilDamagedNodeHandlerFunc signals that a nil damaged node handler function has been provided
+var ErrNilDamagedNodeHandlerFunc = errors.New("nil damaged node handler function")
+
+// ErrNilTrieDiffHandlerFunc signals that a nil trie diff handler function has been provided
+var ErrNilTrieDiffHandlerFunc = errors.New("nil trie diff handler function")
+
+// ErrSnapshotCanceled signals that a snapshot or checkpoint was canceled
+var ErrSnapshotCanceled = errors.New("snapshot canceled")
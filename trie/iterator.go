@@ -1,8 +1,13 @@
 package trie
 
 import (
+	"bytes"
+
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
 )
 
 type iterator struct {
@@ -79,3 +84,167 @@ func (it *iterator) GetHash() ([]byte, error) {
 
 	return it.currentNode.getHash(), nil
 }
+
+type leavesIteratorEntry struct {
+	node          node
+	path          []byte
+	isOnStartPath bool
+}
+
+type leavesIterator struct {
+	startPath []byte
+	stack     []leavesIteratorEntry
+	nextLeaf  core.KeyValueHolder
+	db        common.DBWriteCacher
+}
+
+// NewLeavesIteratorFromKey creates a trie iterator that returns the leaves in the trie's traversal order, starting
+// with the leaf that has the given key, or with the first leaf placed after it. The subtries placed before the start
+// key are not loaded from the storage. An empty start key will iterate over all the trie leaves.
+func NewLeavesIteratorFromKey(trie common.Trie, startKey []byte) (*leavesIterator, error) {
+	if check.IfNil(trie) {
+		return nil, ErrNilTrie
+	}
+
+	pmt, ok := trie.(*patriciaMerkleTrie)
+	if !ok {
+		return nil, ErrWrongTypeAssertion
+	}
+
+	it := &leavesIterator{
+		stack: make([]leavesIteratorEntry, 0),
+		db:    trie.GetStorageManager(),
+	}
+	if len(startKey) > 0 {
+		it.startPath = keyBytesToHex(startKey)
+	}
+
+	if pmt.root == nil {
+		return it, nil
+	}
+
+	it.pushNode(pmt.root, make([]byte, 0), len(it.startPath) > 0)
+	err := it.moveToNextLeaf()
+	if err != nil {
+		return nil, err
+	}
+
+	return it, nil
+}
+
+// HasNext returns true if there is a next leaf
+func (it *leavesIterator) HasNext() bool {
+	return it.nextLeaf != nil
+}
+
+// Next returns the current leaf and moves the iterator to the following one
+func (it *leavesIterator) Next() (core.KeyValueHolder, error) {
+	if it.nextLeaf == nil {
+		return nil, ErrNilNode
+	}
+
+	leaf := it.nextLeaf
+	err := it.moveToNextLeaf()
+	if err != nil {
+		return nil, err
+	}
+
+	return leaf, nil
+}
+
+func (it *leavesIterator) moveToNextLeaf() error {
+	it.nextLeaf = nil
+	for len(it.stack) > 0 {
+		entry := it.stack[len(it.stack)-1]
+		it.stack = it.stack[:len(it.stack)-1]
+
+		switch n := entry.node.(type) {
+		case *leafNode:
+			kb := keyBuilder.NewKeyBuilder()
+			kb.BuildKey(entry.path)
+			key, err := kb.GetKey()
+			if err != nil {
+				return err
+			}
+
+			it.nextLeaf = keyValStorage.NewKeyValStorage(key, n.Value)
+			return nil
+		case *extensionNode:
+			err := resolveIfCollapsed(n, 0, it.db)
+			if err != nil {
+				return err
+			}
+
+			it.pushNode(n.child, entry.path, entry.isOnStartPath)
+		case *branchNode:
+			err := it.pushBranchChildren(n, entry)
+			if err != nil {
+				return err
+			}
+		default:
+			return ErrWrongTypeAssertion
+		}
+	}
+
+	return nil
+}
+
+// pushBranchChildren adds the children in reverse order, so that they are processed from the first to the last one
+func (it *leavesIterator) pushBranchChildren(bn *branchNode, entry leavesIteratorEntry) error {
+	for i := nrOfChildren - 1; i >= 0; i-- {
+		if bn.children[i] == nil && !bn.isPosCollapsed(i) {
+			continue
+		}
+
+		childPath := concat(entry.path, byte(i))
+		if entry.isOnStartPath && comparePathWithStartPath(childPath, it.startPath) < 0 {
+			continue
+		}
+
+		err := resolveIfCollapsed(bn, byte(i), it.db)
+		if err != nil {
+			return err
+		}
+
+		it.pushNode(bn.children[i], childPath, entry.isOnStartPath)
+	}
+
+	return nil
+}
+
+// pushNode adds the node on the stack, unless all the leaves under it are placed before the start path
+func (it *leavesIterator) pushNode(n node, parentPath []byte, isParentOnStartPath bool) {
+	path := parentPath
+	switch typedNode := n.(type) {
+	case *leafNode:
+		path = concat(parentPath, typedNode.Key...)
+	case *extensionNode:
+		path = concat(parentPath, typedNode.Key...)
+	}
+
+	isOnStartPath := false
+	if isParentOnStartPath {
+		comparison := comparePathWithStartPath(path, it.startPath)
+		if comparison < 0 {
+			return
+		}
+		isOnStartPath = comparison == 0
+	}
+
+	it.stack = append(it.stack, leavesIteratorEntry{
+		node:          n,
+		path:          path,
+		isOnStartPath: isOnStartPath,
+	})
+}
+
+// comparePathWithStartPath compares the common length prefix of the given paths. It returns 0 if one path is the
+// prefix of the other, meaning that the start path can still be reached from the given path
+func comparePathWithStartPath(path []byte, startPath []byte) int {
+	length := len(path)
+	if len(startPath) < length {
+		length = len(startPath)
+	}
+
+	return bytes.Compare(path[:length], startPath[:length])
+}
@@ -1,11 +1,17 @@
 package trie_test
 
 import (
+	"context"
 	"testing"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/common/errChan"
+	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewIterator(t *testing.T) {
@@ -79,3 +85,125 @@ func TestIterator_GetHash(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, rootHash, hash)
 }
+
+func getAllLeavesKeysInTraversalOrder(t *testing.T, tr common.Trie) [][]byte {
+	rootHash, _ := tr.RootHash()
+	leavesChannel := &common.TrieIteratorChannels{
+		LeavesChan: make(chan core.KeyValueHolder, common.TrieLeavesChannelDefaultCapacity),
+		ErrChan:    errChan.NewErrChanWrapper(),
+	}
+	err := tr.GetAllLeavesOnChannel(leavesChannel, context.Background(), rootHash, keyBuilder.NewKeyBuilder())
+	require.Nil(t, err)
+
+	keys := make([][]byte, 0)
+	for leaf := range leavesChannel.LeavesChan {
+		keys = append(keys, leaf.Key())
+	}
+	require.Nil(t, leavesChannel.ErrChan.ReadFromChanNonBlocking())
+
+	return keys
+}
+
+func getLeavesKeysFromIterator(t *testing.T, tr common.Trie, startKey []byte) [][]byte {
+	it, err := trie.NewLeavesIteratorFromKey(tr, startKey)
+	require.Nil(t, err)
+
+	keys := make([][]byte, 0)
+	for it.HasNext() {
+		leaf, errNext := it.Next()
+		require.Nil(t, errNext)
+		keys = append(keys, leaf.Key())
+	}
+
+	return keys
+}
+
+func TestNewLeavesIteratorFromKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		var tr common.Trie
+		it, err := trie.NewLeavesIteratorFromKey(tr, nil)
+		assert.Nil(t, it)
+		assert.Equal(t, trie.ErrNilTrie, err)
+	})
+	t.Run("wrong trie type should error", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewLeavesIteratorFromKey(&trieMock.TrieStub{}, nil)
+		assert.Nil(t, it)
+		assert.Equal(t, trie.ErrWrongTypeAssertion, err)
+	})
+	t.Run("empty trie should not have leaves", func(t *testing.T) {
+		t.Parallel()
+
+		it, err := trie.NewLeavesIteratorFromKey(emptyTrie(), []byte("dog"))
+		assert.Nil(t, err)
+		assert.False(t, it.HasNext())
+
+		leaf, err := it.Next()
+		assert.Nil(t, leaf)
+		assert.Equal(t, trie.ErrNilNode, err)
+	})
+}
+
+func TestLeavesIterator_Next(t *testing.T) {
+	t.Parallel()
+
+	t.Run("without start key should return all the leaves in traversal order", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+
+		expectedKeys := getAllLeavesKeysInTraversalOrder(t, tr)
+		require.Len(t, expectedKeys, 100)
+		assert.Equal(t, expectedKeys, getLeavesKeysFromIterator(t, tr, nil))
+	})
+	t.Run("should return the leaves starting with the given key", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(100)
+		_ = tr.Commit()
+
+		allKeys := getAllLeavesKeysInTraversalOrder(t, tr)
+		for i, key := range allKeys {
+			assert.Equal(t, allKeys[i:], getLeavesKeysFromIterator(t, tr, key))
+		}
+	})
+	t.Run("missing start key should return the leaves placed after it", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Update([]byte("doge"), []byte("coin"))
+		_ = tr.Update([]byte("horse"), []byte("stallion"))
+		_ = tr.Commit()
+		allKeys := getAllLeavesKeysInTraversalOrder(t, tr)
+
+		for i, key := range allKeys {
+			trieWithoutKey := initTrie()
+			_ = trieWithoutKey.Update([]byte("doge"), []byte("coin"))
+			_ = trieWithoutKey.Update([]byte("horse"), []byte("stallion"))
+			_ = trieWithoutKey.Delete(key)
+			_ = trieWithoutKey.Commit()
+
+			assert.Equal(t, allKeys[i+1:], getLeavesKeysFromIterator(t, trieWithoutKey, key))
+		}
+	})
+	t.Run("should return the leaves values", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		_ = tr.Update([]byte("dog"), []byte("puppy"))
+
+		it, _ := trie.NewLeavesIteratorFromKey(tr, nil)
+		require.True(t, it.HasNext())
+		leaf, err := it.Next()
+		assert.Nil(t, err)
+		assert.Equal(t, []byte("dog"), leaf.Key())
+		assert.Equal(t, []byte("puppy"), leaf.Value())
+		assert.False(t, it.HasNext())
+	})
+}
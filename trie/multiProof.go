@@ -0,0 +1,408 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// GetMultiProof computes a single Merkle proof for all the given keys. The proof holds each needed trie node only once,
+// in the order they were reached while descending from the root. The returned values are the leaves values of the
+// given keys, in the same order. A missing key has a nil value, and the proof holds the nodes that show its absence.
+func (tr *patriciaMerkleTrie) GetMultiProof(keys [][]byte) ([][]byte, [][]byte, error) {
+	if len(keys) == 0 {
+		return nil, nil, ErrEmptyKeysList
+	}
+
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, ErrNilNode
+	}
+
+	err := tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	collector := newProofNodesCollector()
+	values := make([][]byte, 0, len(keys))
+	for _, key := range keys {
+		value, errCollect := tr.collectKeyProof(collector, key)
+		if errCollect != nil {
+			return nil, nil, errCollect
+		}
+
+		values = append(values, value)
+	}
+
+	return collector.proof, values, nil
+}
+
+func (tr *patriciaMerkleTrie) collectKeyProof(collector *proofNodesCollector, key []byte) ([]byte, error) {
+	hexKey := keyBytesToHex(key)
+	currentNode := tr.root
+	for {
+		err := collector.add(currentNode)
+		if err != nil {
+			return nil, err
+		}
+
+		nextNode, nextKey, err := currentNode.getNext(hexKey, tr.trieStorage)
+		if errors.Is(err, ErrNodeNotFound) {
+			// the nodes collected so far prove that the key is not in the trie
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if nextNode == nil {
+			return currentNode.getValue(), nil
+		}
+
+		currentNode = nextNode
+		hexKey = nextKey
+	}
+}
+
+// GetRangeProof computes a single Merkle proof for all the leaves that have the keys between the given bounds, in the
+// trie's traversal order. Both bounds are included, and an empty end key means that there is no upper bound. If more
+// than maxLeaves leaves are found, the range is shortened so that it ends with the last returned leaf. The proven end
+// key, which should be used when verifying the proof, is also returned.
+func (tr *patriciaMerkleTrie) GetRangeProof(startKey []byte, endKey []byte, maxLeaves int) ([][]byte, []core.KeyValueHolder, []byte, error) {
+	if maxLeaves <= 0 {
+		return nil, nil, nil, ErrInvalidMaxLeaves
+	}
+
+	bounds, err := newRangeBounds(startKey, endKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	tr.mutOperation.Lock()
+	defer tr.mutOperation.Unlock()
+
+	if tr.root == nil {
+		return nil, nil, nil, ErrNilNode
+	}
+
+	err = tr.root.setRootHash()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	collector, err := tr.collectRangeProof(bounds, maxLeaves+1)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if len(collector.leaves) <= maxLeaves {
+		return collector.proof, collector.leaves, endKey, nil
+	}
+
+	// the range is shortened, and the proof is computed again, so it does not hold the nodes after the last leaf
+	provenEndKey := collector.leaves[maxLeaves-1].Key()
+	bounds.endPath = keyBytesToHex(provenEndKey)
+	collector, err = tr.collectRangeProof(bounds, maxLeaves)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return collector.proof, collector.leaves, provenEndKey, nil
+}
+
+func (tr *patriciaMerkleTrie) collectRangeProof(bounds *rangeBounds, maxLeaves int) (*rangeProofCollector, error) {
+	collector := &rangeProofCollector{
+		proofNodesCollector: newProofNodesCollector(),
+		bounds:              bounds,
+		db:                  tr.trieStorage,
+		maxLeaves:           maxLeaves,
+		leaves:              make([]core.KeyValueHolder, 0),
+	}
+
+	err := collector.collect(tr.root, make([]byte, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	return collector, nil
+}
+
+type proofNodesCollector struct {
+	proof     [][]byte
+	seenNodes map[string]struct{}
+}
+
+func newProofNodesCollector() *proofNodesCollector {
+	return &proofNodesCollector{
+		proof:     make([][]byte, 0),
+		seenNodes: make(map[string]struct{}),
+	}
+}
+
+func (collector *proofNodesCollector) add(n node) error {
+	encodedNode, err := n.getEncodedNode()
+	if err != nil {
+		return err
+	}
+
+	_, seen := collector.seenNodes[string(encodedNode)]
+	if seen {
+		return nil
+	}
+
+	collector.seenNodes[string(encodedNode)] = struct{}{}
+	collector.proof = append(collector.proof, encodedNode)
+
+	return nil
+}
+
+type rangeProofCollector struct {
+	*proofNodesCollector
+	bounds    *rangeBounds
+	db        common.DBWriteCacher
+	maxLeaves int
+	leaves    []core.KeyValueHolder
+}
+
+// collect descends only in the subtries that might hold keys in range. The verifier walks the proof in the same way,
+// so all the nodes it needs are found in the proof.
+func (collector *rangeProofCollector) collect(n node, path []byte) error {
+	if len(collector.leaves) == collector.maxLeaves {
+		return nil
+	}
+
+	err := collector.add(n)
+	if err != nil {
+		return err
+	}
+
+	switch typedNode := n.(type) {
+	case *leafNode:
+		leafPath := concat(path, typedNode.Key...)
+		if !collector.bounds.containsPath(leafPath) {
+			return nil
+		}
+
+		leaf, errLeaf := createLeaf(leafPath, typedNode.Value)
+		if errLeaf != nil {
+			return errLeaf
+		}
+		collector.leaves = append(collector.leaves, leaf)
+
+		return nil
+	case *extensionNode:
+		childPath := concat(path, typedNode.Key...)
+		if !collector.bounds.intersectsPath(childPath) {
+			return nil
+		}
+
+		err = resolveIfCollapsed(typedNode, 0, collector.db)
+		if err != nil {
+			return err
+		}
+
+		return collector.collect(typedNode.child, childPath)
+	case *branchNode:
+		for i := 0; i < nrOfChildren; i++ {
+			if typedNode.children[i] == nil && !typedNode.isPosCollapsed(i) {
+				continue
+			}
+
+			childPath := concat(path, byte(i))
+			if !collector.bounds.intersectsPath(childPath) {
+				continue
+			}
+
+			err = resolveIfCollapsed(typedNode, byte(i), collector.db)
+			if err != nil {
+				return err
+			}
+
+			err = collector.collect(typedNode.children[i], childPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrWrongTypeAssertion
+	}
+}
+
+// rangeBounds holds the hex paths of the range limits. An empty end path means that there is no upper bound
+type rangeBounds struct {
+	startPath []byte
+	endPath   []byte
+}
+
+func newRangeBounds(startKey []byte, endKey []byte) (*rangeBounds, error) {
+	bounds := &rangeBounds{}
+	if len(startKey) > 0 {
+		bounds.startPath = keyBytesToHex(startKey)
+	}
+	if len(endKey) > 0 {
+		bounds.endPath = keyBytesToHex(endKey)
+	}
+
+	if len(bounds.endPath) > 0 && bytes.Compare(bounds.startPath, bounds.endPath) > 0 {
+		return nil, ErrInvalidRangeBounds
+	}
+
+	return bounds, nil
+}
+
+func (bounds *rangeBounds) containsPath(leafPath []byte) bool {
+	if bytes.Compare(leafPath, bounds.startPath) < 0 {
+		return false
+	}
+
+	return len(bounds.endPath) == 0 || bytes.Compare(leafPath, bounds.endPath) <= 0
+}
+
+// intersectsPath returns true if the subtrie placed at the given path might hold leaves in range
+func (bounds *rangeBounds) intersectsPath(path []byte) bool {
+	if comparePathWithStartPath(path, bounds.startPath) < 0 {
+		return false
+	}
+
+	return len(bounds.endPath) == 0 || comparePathWithStartPath(path, bounds.endPath) <= 0
+}
+
+func createLeaf(leafPath []byte, value []byte) (core.KeyValueHolder, error) {
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(leafPath)
+	key, err := kb.GetKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return keyValStorage.NewKeyValStorage(key, value), nil
+}
+
+// proofNodes holds the nodes of a multiproof or range proof, indexed by their hashes
+type proofNodes struct {
+	nodes       map[string][]byte
+	marshalizer marshal.Marshalizer
+	hasher      hashing.Hasher
+}
+
+func newProofNodes(proof [][]byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) *proofNodes {
+	nodes := make(map[string][]byte, len(proof))
+	for _, encodedNode := range proof {
+		nodes[string(hasher.Compute(string(encodedNode)))] = encodedNode
+	}
+
+	return &proofNodes{
+		nodes:       nodes,
+		marshalizer: marshalizer,
+		hasher:      hasher,
+	}
+}
+
+func (pn *proofNodes) getNode(hash []byte) (node, error) {
+	encodedNode, found := pn.nodes[string(hash)]
+	if !found {
+		return nil, fmt.Errorf("%w: missing node with hash %s", ErrInvalidProof, hex.EncodeToString(hash))
+	}
+
+	return decodeNode(encodedNode, pn.marshalizer, pn.hasher)
+}
+
+func (pn *proofNodes) verifyKey(rootHash []byte, key []byte) ([]byte, error) {
+	hexKey := keyBytesToHex(key)
+	wantHash := rootHash
+	for {
+		n, err := pn.getNode(wantHash)
+		if err != nil {
+			return nil, err
+		}
+
+		switch typedNode := n.(type) {
+		case *leafNode:
+			if !bytes.Equal(hexKey, typedNode.Key) {
+				return nil, nil
+			}
+
+			return typedNode.Value, nil
+		case *extensionNode:
+			if !bytes.HasPrefix(hexKey, typedNode.Key) {
+				return nil, nil
+			}
+
+			wantHash = typedNode.EncodedChild
+			hexKey = hexKey[len(typedNode.Key):]
+		case *branchNode:
+			if len(hexKey) == 0 || int(hexKey[0]) >= len(typedNode.EncodedChildren) {
+				return nil, fmt.Errorf("%w: key %s is too short", ErrInvalidProof, hex.EncodeToString(key))
+			}
+
+			wantHash = typedNode.EncodedChildren[hexKey[0]]
+			if len(wantHash) == 0 {
+				return nil, nil
+			}
+			hexKey = hexKey[1:]
+		default:
+			return nil, ErrWrongTypeAssertion
+		}
+	}
+}
+
+func (pn *proofNodes) verifyRange(hash []byte, path []byte, bounds *rangeBounds, leaves []core.KeyValueHolder) ([]core.KeyValueHolder, error) {
+	n, err := pn.getNode(hash)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typedNode := n.(type) {
+	case *leafNode:
+		leafPath := concat(path, typedNode.Key...)
+		if !bounds.containsPath(leafPath) {
+			return leaves, nil
+		}
+
+		leaf, errLeaf := createLeaf(leafPath, typedNode.Value)
+		if errLeaf != nil {
+			return nil, errLeaf
+		}
+
+		return append(leaves, leaf), nil
+	case *extensionNode:
+		childPath := concat(path, typedNode.Key...)
+		if !bounds.intersectsPath(childPath) {
+			return leaves, nil
+		}
+
+		return pn.verifyRange(typedNode.EncodedChild, childPath, bounds, leaves)
+	case *branchNode:
+		for i := 0; i < len(typedNode.EncodedChildren); i++ {
+			if len(typedNode.EncodedChildren[i]) == 0 {
+				continue
+			}
+
+			childPath := concat(path, byte(i))
+			if !bounds.intersectsPath(childPath) {
+				continue
+			}
+
+			leaves, err = pn.verifyRange(typedNode.EncodedChildren[i], childPath, bounds, leaves)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return leaves, nil
+	default:
+		return nil, ErrWrongTypeAssertion
+	}
+}
@@ -0,0 +1,266 @@
+package trie_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockProofVerifier() common.MerkleProofVerifier {
+	mpv, _ := trie.NewMerkleProofVerifier(&testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{})
+
+	return mpv
+}
+
+func getAllLeaves(t *testing.T, tr common.Trie) []core.KeyValueHolder {
+	rootHash, _ := tr.RootHash()
+	leaves, _, err := tr.GetLeavesPage(rootHash, nil, 1000, context.Background())
+	require.Nil(t, err)
+
+	return leaves
+}
+
+func removeProofNode(proof [][]byte, index int) [][]byte {
+	tamperedProof := make([][]byte, 0, len(proof)-1)
+	tamperedProof = append(tamperedProof, proof[:index]...)
+
+	return append(tamperedProof, proof[index+1:]...)
+}
+
+func TestPatriciaMerkleTrie_GetMultiProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		proof, values, err := tr.GetMultiProof(nil)
+		assert.Equal(t, trie.ErrEmptyKeysList, err)
+		assert.Nil(t, proof)
+		assert.Nil(t, values)
+	})
+	t.Run("empty trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		proof, values, err := tr.GetMultiProof([][]byte{[]byte("dog")})
+		assert.Equal(t, trie.ErrNilNode, err)
+		assert.Nil(t, proof)
+		assert.Nil(t, values)
+	})
+	t.Run("should return the values and a proof without duplicated nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		collapsedTrie, _ := tr.Recreate(rootHash)
+
+		absentKey := []byte("absent key")
+		proof, values, err := collapsedTrie.GetMultiProof(append(keys, absentKey))
+		require.Nil(t, err)
+		require.Equal(t, len(keys)+1, len(values))
+		for i := range keys {
+			assert.Equal(t, keys[i], values[i])
+		}
+		assert.Nil(t, values[len(keys)])
+
+		numNodesInSeparateProofs := 0
+		for _, key := range keys {
+			singleProof, _, _ := collapsedTrie.GetProof(key)
+			numNodesInSeparateProofs += len(singleProof)
+		}
+		assert.Less(t, len(proof), numNodesInSeparateProofs)
+
+		provenValues, err := createMockProofVerifier().VerifyMultiProof(rootHash, append(keys, absentKey), proof)
+		require.Nil(t, err)
+		for i := range keys {
+			assert.Equal(t, keys[i], provenValues[i].Key())
+			assert.Equal(t, keys[i], provenValues[i].Value())
+		}
+		assert.Equal(t, absentKey, provenValues[len(keys)].Key())
+		assert.Nil(t, provenValues[len(keys)].Value())
+	})
+}
+
+func TestMerkleProofVerifier_VerifyMultiProof(t *testing.T) {
+	t.Parallel()
+
+	tr, keys := initTrieMultipleValues(20)
+	rootHash, _ := tr.RootHash()
+	proof, _, _ := tr.GetMultiProof(keys)
+	mpv := createMockProofVerifier()
+
+	t.Run("empty keys should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := mpv.VerifyMultiProof(rootHash, nil, proof)
+		assert.Equal(t, trie.ErrEmptyKeysList, err)
+		assert.Nil(t, provenValues)
+	})
+	t.Run("different root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		otherTrie, _ := initTrieMultipleValues(21)
+		otherRootHash, _ := otherTrie.RootHash()
+
+		provenValues, err := mpv.VerifyMultiProof(otherRootHash, keys, proof)
+		assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+		assert.Nil(t, provenValues)
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenValues, err := mpv.VerifyMultiProof(rootHash, keys, removeProofNode(proof, len(proof)-1))
+		assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+		assert.Nil(t, provenValues)
+	})
+	t.Run("key not covered by the proof should error", func(t *testing.T) {
+		t.Parallel()
+
+		partialProof, _, _ := tr.GetMultiProof(keys[:1])
+		provenValues, err := mpv.VerifyMultiProof(rootHash, keys, partialProof)
+		assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+		assert.Nil(t, provenValues)
+	})
+}
+
+func TestPatriciaMerkleTrie_GetRangeProof(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		proof, leaves, endKey, err := tr.GetRangeProof(nil, nil, 0)
+		assert.Equal(t, trie.ErrInvalidMaxLeaves, err)
+		assert.Nil(t, proof)
+		assert.Nil(t, leaves)
+		assert.Nil(t, endKey)
+	})
+	t.Run("start key after end key should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(10)
+		_ = tr.Commit()
+		allLeaves := getAllLeaves(t, tr)
+
+		_, _, _, err := tr.GetRangeProof(allLeaves[5].Key(), allLeaves[4].Key(), 10)
+		assert.Equal(t, trie.ErrInvalidRangeBounds, err)
+	})
+	t.Run("empty trie should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		_, _, _, err := tr.GetRangeProof(nil, nil, 10)
+		assert.Equal(t, trie.ErrNilNode, err)
+	})
+	t.Run("should return all the leaves in range", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		allLeaves := getAllLeaves(t, tr)
+		startKey := allLeaves[10].Key()
+		endKey := allLeaves[30].Key()
+
+		proof, leaves, provenEndKey, err := tr.GetRangeProof(startKey, endKey, 100)
+		require.Nil(t, err)
+		assert.Equal(t, allLeaves[10:31], leaves)
+		assert.Equal(t, endKey, provenEndKey)
+
+		provenLeaves, err := createMockProofVerifier().VerifyRangeProof(rootHash, startKey, provenEndKey, proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, provenLeaves)
+	})
+	t.Run("bounds that are not in the trie should work", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		proof, leaves, _, err := tr.GetRangeProof(nil, nil, 100)
+		require.Nil(t, err)
+		assert.Equal(t, getAllLeaves(t, tr), leaves)
+
+		provenLeaves, err := createMockProofVerifier().VerifyRangeProof(rootHash, nil, nil, proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, provenLeaves)
+	})
+	t.Run("too many leaves should shorten the range", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		allLeaves := getAllLeaves(t, tr)
+		startKey := allLeaves[10].Key()
+
+		proof, leaves, provenEndKey, err := tr.GetRangeProof(startKey, nil, 5)
+		require.Nil(t, err)
+		assert.Equal(t, allLeaves[10:15], leaves)
+		assert.Equal(t, allLeaves[14].Key(), provenEndKey)
+
+		provenLeaves, err := createMockProofVerifier().VerifyRangeProof(rootHash, startKey, provenEndKey, proof)
+		require.Nil(t, err)
+		assert.Equal(t, leaves, provenLeaves)
+
+		// the shortened proof can not be used to claim that there are no more leaves after the proven end key
+		_, err = createMockProofVerifier().VerifyRangeProof(rootHash, startKey, nil, proof)
+		assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+	})
+}
+
+func TestMerkleProofVerifier_VerifyRangeProof(t *testing.T) {
+	t.Parallel()
+
+	tr, _ := initTrieMultipleValues(50)
+	_ = tr.Commit()
+	rootHash, _ := tr.RootHash()
+	allLeaves := getAllLeaves(t, tr)
+	startKey := allLeaves[5].Key()
+	endKey := allLeaves[25].Key()
+	proof, _, _, _ := tr.GetRangeProof(startKey, endKey, 100)
+	mpv := createMockProofVerifier()
+
+	t.Run("invalid bounds should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenLeaves, err := mpv.VerifyRangeProof(rootHash, endKey, startKey, proof)
+		assert.Equal(t, trie.ErrInvalidRangeBounds, err)
+		assert.Nil(t, provenLeaves)
+	})
+	t.Run("any missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		for i := range proof {
+			provenLeaves, err := mpv.VerifyRangeProof(rootHash, startKey, endKey, removeProofNode(proof, i))
+			assert.True(t, errors.Is(err, trie.ErrInvalidProof), "removed node "+strconv.Itoa(i))
+			assert.Nil(t, provenLeaves)
+		}
+	})
+	t.Run("wider range than the proven one should error", func(t *testing.T) {
+		t.Parallel()
+
+		provenLeaves, err := mpv.VerifyRangeProof(rootHash, allLeaves[4].Key(), endKey, proof)
+		assert.True(t, errors.Is(err, trie.ErrInvalidProof))
+		assert.Nil(t, provenLeaves)
+	})
+	t.Run("narrower range should return the leaves in the narrower range", func(t *testing.T) {
+		t.Parallel()
+
+		provenLeaves, err := mpv.VerifyRangeProof(rootHash, allLeaves[6].Key(), allLeaves[7].Key(), proof)
+		require.Nil(t, err)
+		assert.Equal(t, allLeaves[6:8], provenLeaves)
+	})
+}
@@ -0,0 +1,203 @@
+package trie
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/hashing"
+	"github.com/multiversx/mx-chain-core-go/marshal"
+	"github.com/multiversx/mx-chain-go/common"
+)
+
+// NodeHandlerFunc is called for each node reached while walking a trie. The leaf value is nil for intermediate nodes
+type NodeHandlerFunc func(hash []byte, encodedNode []byte, leafValue []byte) error
+
+// DamagedNodeHandlerFunc is called for each node that is missing from the storer, that does not match its hash or that
+// can not be decoded. The path holds the nibbles of the key prefix leading from the root to the node
+type DamagedNodeHandlerFunc func(hash []byte, path []byte, err error)
+
+// WalkTrieNodes reads all the nodes of the trie with the given root hash from the provided storer, depth first, and
+// calls the handler for each one of them. Each node is checked against its hash, so a nil error means that the storer
+// holds the complete trie.
+func WalkTrieNodes(
+	rootHash []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler NodeHandlerFunc,
+) error {
+	err := checkWalkArguments(db, marshalizer, hasher, handler)
+	if err != nil {
+		return err
+	}
+
+	return walkTrie(rootHash, db, marshalizer, hasher, handler, func(_ []byte, _ []byte, err error) error {
+		return err
+	})
+}
+
+// CheckTrieNodes walks the trie the same way WalkTrieNodes does, but it does not stop at the damaged nodes. Each one
+// of them is reported to the damaged node handler and its subtrie is skipped.
+func CheckTrieNodes(
+	rootHash []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler NodeHandlerFunc,
+	damagedNodeHandler DamagedNodeHandlerFunc,
+) error {
+	err := checkWalkArguments(db, marshalizer, hasher, handler)
+	if err != nil {
+		return err
+	}
+	if damagedNodeHandler == nil {
+		return ErrNilDamagedNodeHandlerFunc
+	}
+
+	return walkTrie(rootHash, db, marshalizer, hasher, handler, func(hash []byte, path []byte, err error) error {
+		damagedNodeHandler(hash, path, err)
+		return nil
+	})
+}
+
+func checkWalkArguments(
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler NodeHandlerFunc,
+) error {
+	if check.IfNil(db) {
+		return ErrNilDatabase
+	}
+	if check.IfNil(marshalizer) {
+		return ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return ErrNilHasher
+	}
+	if handler == nil {
+		return ErrNilNodeHandlerFunc
+	}
+
+	return nil
+}
+
+type nodeToWalk struct {
+	hash []byte
+	path []byte
+}
+
+func walkTrie(
+	rootHash []byte,
+	db common.DBWriteCacher,
+	marshalizer marshal.Marshalizer,
+	hasher hashing.Hasher,
+	handler NodeHandlerFunc,
+	damagedNodeHandler func(hash []byte, path []byte, err error) error,
+) error {
+	nodesToWalk := []*nodeToWalk{{hash: rootHash, path: make([]byte, 0)}}
+	for len(nodesToWalk) > 0 {
+		lastIndex := len(nodesToWalk) - 1
+		current := nodesToWalk[lastIndex]
+		nodesToWalk = nodesToWalk[:lastIndex]
+
+		encodedNode, err := db.Get(current.hash)
+		if err != nil {
+			err = fmt.Errorf("%w: %v for node %s", ErrNodeNotFound, err, hex.EncodeToString(current.hash))
+		} else if !bytes.Equal(hasher.Compute(string(encodedNode)), current.hash) {
+			err = fmt.Errorf("%w for node %s", ErrNodeHashMismatch, hex.EncodeToString(current.hash))
+		}
+		if err != nil {
+			errDamaged := damagedNodeHandler(current.hash, current.path, err)
+			if errDamaged != nil {
+				return errDamaged
+			}
+			continue
+		}
+
+		n, err := decodeNode(encodedNode, marshalizer, hasher)
+		if err != nil {
+			errDamaged := damagedNodeHandler(current.hash, current.path, err)
+			if errDamaged != nil {
+				return errDamaged
+			}
+			continue
+		}
+
+		var leafValue []byte
+		switch typedNode := n.(type) {
+		case *leafNode:
+			leafValue = typedNode.Value
+		case *extensionNode:
+			nodesToWalk = append(nodesToWalk, &nodeToWalk{
+				hash: typedNode.EncodedChild,
+				path: concatNibbles(current.path, typedNode.Key...),
+			})
+		case *branchNode:
+			// the children are pushed in reverse order, so they are walked in the trie's traversal order
+			for i := len(typedNode.EncodedChildren) - 1; i >= 0; i-- {
+				if len(typedNode.EncodedChildren[i]) == 0 {
+					continue
+				}
+
+				nodesToWalk = append(nodesToWalk, &nodeToWalk{
+					hash: typedNode.EncodedChildren[i],
+					path: concatNibbles(current.path, byte(i)),
+				})
+			}
+		default:
+			return ErrWrongTypeAssertion
+		}
+
+		err = handler(current.hash, encodedNode, leafValue)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func concatNibbles(path []byte, nibbles ...byte) []byte {
+	newPath := make([]byte, 0, len(path)+len(nibbles))
+	newPath = append(newPath, path...)
+
+	return append(newPath, nibbles...)
+}
+
+// NodeData holds the content of a decoded trie node. The key is the partial key, as held by the extension and the
+// leaf nodes, and the children are the hashes of the child nodes, empty for the missing ones
+type NodeData struct {
+	Type     string
+	Key      []byte
+	Value    []byte
+	Children [][]byte
+}
+
+// DecodeNodeData decodes a trie node as it is saved in the storage
+func DecodeNodeData(encodedNode []byte, marshalizer marshal.Marshalizer, hasher hashing.Hasher) (*NodeData, error) {
+	if check.IfNil(marshalizer) {
+		return nil, ErrNilMarshalizer
+	}
+	if check.IfNil(hasher) {
+		return nil, ErrNilHasher
+	}
+
+	n, err := decodeNode(encodedNode, marshalizer, hasher)
+	if err != nil {
+		return nil, err
+	}
+
+	switch typedNode := n.(type) {
+	case *leafNode:
+		return &NodeData{Type: "leaf", Key: typedNode.Key, Value: typedNode.Value}, nil
+	case *extensionNode:
+		return &NodeData{Type: "extension", Key: typedNode.Key, Children: [][]byte{typedNode.EncodedChild}}, nil
+	case *branchNode:
+		return &NodeData{Type: "branch", Children: typedNode.EncodedChildren}, nil
+	default:
+		return nil, ErrWrongTypeAssertion
+	}
+}
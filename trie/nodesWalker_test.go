@@ -0,0 +1,246 @@
+package trie_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/multiversx/mx-chain-go/trie"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWalkTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	noOpHandler := func(_ []byte, _ []byte, _ []byte) error {
+		return nil
+	}
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		marshalizer := &testscommon.ProtobufMarshalizerMock{}
+		hasher := &testscommon.KeccakMock{}
+		db := testscommon.NewMemDbMock()
+
+		assert.Equal(t, trie.ErrNilDatabase, trie.WalkTrieNodes(nil, nil, marshalizer, hasher, noOpHandler))
+		assert.Equal(t, trie.ErrNilMarshalizer, trie.WalkTrieNodes(nil, db, nil, hasher, noOpHandler))
+		assert.Equal(t, trie.ErrNilHasher, trie.WalkTrieNodes(nil, db, marshalizer, nil, noOpHandler))
+		assert.Equal(t, trie.ErrNilNodeHandlerFunc, trie.WalkTrieNodes(nil, db, marshalizer, hasher, nil))
+	})
+	t.Run("missing node should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		marshalizer, hasher := &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{}
+
+		err := trie.WalkTrieNodes(rootHash, testscommon.NewMemDbMock(), marshalizer, hasher, noOpHandler)
+		assert.True(t, errors.Is(err, trie.ErrNodeNotFound))
+	})
+	t.Run("node not matching its hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		db := testscommon.NewMemDbMock()
+		encodedRoot, _ := tr.GetStorageManager().Get(rootHash)
+		_ = db.Put(rootHash, append(encodedRoot, 0))
+
+		err := trie.WalkTrieNodes(rootHash, db, &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{}, noOpHandler)
+		assert.True(t, errors.Is(err, trie.ErrNodeHashMismatch))
+	})
+	t.Run("handler error should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(20)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		expectedErr := errors.New("expected error")
+
+		err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{},
+			func(_ []byte, _ []byte, _ []byte) error {
+				return expectedErr
+			})
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should walk all the nodes", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+		expectedHashes, _ := tr.GetAllHashes()
+
+		walkedHashes := make(map[string]struct{})
+		leafValues := make([][]byte, 0)
+		err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{},
+			func(hash []byte, encodedNode []byte, leafValue []byte) error {
+				storedNode, errGet := tr.GetStorageManager().Get(hash)
+				require.Nil(t, errGet)
+				assert.Equal(t, storedNode, encodedNode)
+
+				walkedHashes[string(hash)] = struct{}{}
+				if leafValue != nil {
+					leafValues = append(leafValues, leafValue)
+				}
+
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, len(expectedHashes), len(walkedHashes))
+		for _, hash := range expectedHashes {
+			_, found := walkedHashes[string(hash)]
+			assert.True(t, found)
+		}
+
+		allLeaves := getAllLeaves(t, tr)
+		require.Equal(t, len(keys), len(leafValues))
+		for i := range allLeaves {
+			assert.Equal(t, allLeaves[i].Value(), leafValues[i])
+		}
+	})
+}
+
+func TestDecodeNodeData(t *testing.T) {
+	t.Parallel()
+
+	marshalizer, hasher := &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{}
+
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeData, err := trie.DecodeNodeData([]byte("node"), nil, hasher)
+		assert.Nil(t, nodeData)
+		assert.Equal(t, trie.ErrNilMarshalizer, err)
+
+		nodeData, err = trie.DecodeNodeData([]byte("node"), marshalizer, nil)
+		assert.Nil(t, nodeData)
+		assert.Equal(t, trie.ErrNilHasher, err)
+	})
+	t.Run("invalid encoding should error", func(t *testing.T) {
+		t.Parallel()
+
+		nodeData, err := trie.DecodeNodeData(nil, marshalizer, hasher)
+		assert.Nil(t, nodeData)
+		assert.Equal(t, trie.ErrInvalidEncoding, err)
+	})
+	t.Run("should decode all the node types", func(t *testing.T) {
+		t.Parallel()
+
+		tr, _ := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		numNodesByType := make(map[string]int)
+		err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), marshalizer, hasher,
+			func(_ []byte, encodedNode []byte, leafValue []byte) error {
+				nodeData, errDecode := trie.DecodeNodeData(encodedNode, marshalizer, hasher)
+				require.Nil(t, errDecode)
+				numNodesByType[nodeData.Type]++
+
+				switch nodeData.Type {
+				case "leaf":
+					assert.Equal(t, leafValue, nodeData.Value)
+					assert.NotEmpty(t, nodeData.Key)
+				case "extension":
+					assert.Equal(t, 1, len(nodeData.Children))
+				case "branch":
+					assert.Equal(t, 17, len(nodeData.Children))
+				}
+
+				return nil
+			})
+		require.Nil(t, err)
+		assert.Equal(t, 50, numNodesByType["leaf"])
+		assert.True(t, numNodesByType["branch"] > 0)
+	})
+}
+
+func TestCheckTrieNodes(t *testing.T) {
+	t.Parallel()
+
+	marshalizer, hasher := &testscommon.ProtobufMarshalizerMock{}, &testscommon.KeccakMock{}
+	noOpHandler := func(_ []byte, _ []byte, _ []byte) error {
+		return nil
+	}
+	noOpDamagedHandler := func(_ []byte, _ []byte, _ error) {}
+
+	t.Run("nil damaged node handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.CheckTrieNodes(nil, testscommon.NewMemDbMock(), marshalizer, hasher, noOpHandler, nil)
+		assert.Equal(t, trie.ErrNilDamagedNodeHandlerFunc, err)
+	})
+	t.Run("nil arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := trie.CheckTrieNodes(nil, nil, marshalizer, hasher, noOpHandler, noOpDamagedHandler)
+		assert.Equal(t, trie.ErrNilDatabase, err)
+	})
+	t.Run("damaged nodes should be reported with their paths and the walk should continue", func(t *testing.T) {
+		t.Parallel()
+
+		tr, keys := initTrieMultipleValues(50)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		db := testscommon.NewMemDbMock()
+		var missingLeafHash, missingLeafValue, corruptedLeafHash, corruptedLeafValue []byte
+		err := trie.WalkTrieNodes(rootHash, tr.GetStorageManager(), marshalizer, hasher,
+			func(hash []byte, encodedNode []byte, leafValue []byte) error {
+				switch {
+				case leafValue != nil && missingLeafHash == nil:
+					missingLeafHash, missingLeafValue = hash, leafValue
+				case leafValue != nil && corruptedLeafHash == nil:
+					corruptedLeafHash, corruptedLeafValue = hash, leafValue
+					_ = db.Put(hash, append(encodedNode, 0))
+				default:
+					_ = db.Put(hash, encodedNode)
+				}
+
+				return nil
+			})
+		require.Nil(t, err)
+
+		numLeaves := 0
+		damagedPaths := make(map[string][]byte)
+		damagedErrors := make(map[string]error)
+		err = trie.CheckTrieNodes(rootHash, db, marshalizer, hasher,
+			func(_ []byte, _ []byte, leafValue []byte) error {
+				if leafValue != nil {
+					numLeaves++
+				}
+				return nil
+			},
+			func(hash []byte, path []byte, err error) {
+				damagedPaths[string(hash)] = path
+				damagedErrors[string(hash)] = err
+			})
+		require.Nil(t, err)
+
+		assert.Equal(t, len(keys)-2, numLeaves)
+		require.Equal(t, 2, len(damagedPaths))
+		assert.True(t, errors.Is(damagedErrors[string(missingLeafHash)], trie.ErrNodeNotFound))
+		assert.True(t, errors.Is(damagedErrors[string(corruptedLeafHash)], trie.ErrNodeHashMismatch))
+		assert.True(t, isPrefixOf(damagedPaths[string(missingLeafHash)], keyToNibbles(missingLeafValue)))
+		assert.True(t, isPrefixOf(damagedPaths[string(corruptedLeafHash)], keyToNibbles(corruptedLeafValue)))
+	})
+}
+
+// keyToNibbles returns the nibbles in the order in which the trie consumes them, starting with the last key byte
+func keyToNibbles(key []byte) []byte {
+	nibbles := make([]byte, 0, 2*len(key))
+	for i := len(key) - 1; i >= 0; i-- {
+		nibbles = append(nibbles, key[i]&0x0f, key[i]>>4)
+	}
+
+	return nibbles
+}
+
+func isPrefixOf(prefix []byte, nibbles []byte) bool {
+	return len(prefix) > 0 && len(prefix) <= len(nibbles) && string(nibbles[:len(prefix)]) == string(prefix)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
@@ -498,6 +499,111 @@ func (tr *patriciaMerkleTrie) GetAllLeavesOnChannel(
 	return nil
 }
 
+// GetLeavesPage returns at most maxLeaves leaves, in the trie's traversal order, starting with the leaf that has the
+// given key or with the first leaf placed after it. It also returns the key of the leaf that follows the returned
+// ones, which is nil if there are no more leaves to be iterated
+func (tr *patriciaMerkleTrie) GetLeavesPage(
+	rootHash []byte,
+	startKey []byte,
+	maxLeaves int,
+	ctx context.Context,
+) ([]core.KeyValueHolder, []byte, error) {
+	if maxLeaves <= 0 {
+		return nil, nil, ErrInvalidMaxLeaves
+	}
+	if ctx == nil {
+		return nil, nil, ErrNilContext
+	}
+
+	tr.mutOperation.RLock()
+	newTrie, err := tr.recreate(rootHash, tr.trieStorage)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return nil, nil, err
+	}
+
+	if check.IfNil(newTrie) || newTrie.root == nil {
+		tr.mutOperation.RUnlock()
+		return make([]core.KeyValueHolder, 0), nil, nil
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	it, err := NewLeavesIteratorFromKey(newTrie, startKey)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	leaves := make([]core.KeyValueHolder, 0, maxLeaves)
+	for it.HasNext() {
+		if common.IsContextDone(ctx) {
+			return nil, nil, errors.ErrContextClosing
+		}
+
+		leaf, errNext := it.Next()
+		if errNext != nil {
+			return nil, nil, errNext
+		}
+
+		if len(leaves) == maxLeaves {
+			return leaves, leaf.Key(), nil
+		}
+
+		leaves = append(leaves, leaf)
+	}
+
+	return leaves, nil, nil
+}
+
+// GetDiff calls the handler for each key with a different value in the tries with the given root hashes, in the
+// trie's traversal order, starting with the leaf that has the given key or with the first leaf placed after it. Only
+// the subtries with different hashes are walked, so the cost depends on the size of the change, not of the trie
+func (tr *patriciaMerkleTrie) GetDiff(
+	oldRootHash []byte,
+	newRootHash []byte,
+	startKey []byte,
+	handler common.TrieDiffHandlerFunc,
+	ctx context.Context,
+) error {
+	if handler == nil {
+		return ErrNilTrieDiffHandlerFunc
+	}
+	if ctx == nil {
+		return ErrNilContext
+	}
+
+	tr.mutOperation.RLock()
+	oldTrie, err := tr.recreate(oldRootHash, tr.trieStorage)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	newTrie, err := tr.recreate(newRootHash, tr.trieStorage)
+	if err != nil {
+		tr.mutOperation.RUnlock()
+		return err
+	}
+
+	tr.trieStorage.EnterPruningBufferingMode()
+	tr.mutOperation.RUnlock()
+
+	defer func() {
+		tr.mutOperation.Lock()
+		tr.trieStorage.ExitPruningBufferingMode()
+		tr.mutOperation.Unlock()
+	}()
+
+	return diffTries(oldTrie, newTrie, startKey, handler, ctx)
+}
+
 // GetAllHashes returns all the hashes from the trie
 func (tr *patriciaMerkleTrie) GetAllHashes() ([][]byte, error) {
 	tr.mutOperation.Lock()
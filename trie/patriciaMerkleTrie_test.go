@@ -18,6 +18,7 @@ import (
 	"github.com/multiversx/mx-chain-go/common/errChan"
 	"github.com/multiversx/mx-chain-go/common/holders"
 	"github.com/multiversx/mx-chain-go/config"
+	chainErrors "github.com/multiversx/mx-chain-go/errors"
 	"github.com/multiversx/mx-chain-go/testscommon"
 	trieMock "github.com/multiversx/mx-chain-go/testscommon/trie"
 	"github.com/multiversx/mx-chain-go/trie"
@@ -545,6 +546,274 @@ func TestPatriciaMerkleTrie_GetAllHashesEmtyTrie(t *testing.T) {
 	assert.Equal(t, 0, len(hashes))
 }
 
+func TestPatriciaMerkleTrie_GetLeavesPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid max leaves should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		leaves, nextKey, err := tr.GetLeavesPage([]byte{}, nil, 0, context.Background())
+		assert.Equal(t, trie.ErrInvalidMaxLeaves, err)
+		assert.Nil(t, leaves)
+		assert.Nil(t, nextKey)
+	})
+	t.Run("nil context should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		leaves, nextKey, err := tr.GetLeavesPage([]byte{}, nil, 1, nil)
+		assert.Equal(t, trie.ErrNilContext, err)
+		assert.Nil(t, leaves)
+		assert.Nil(t, nextKey)
+	})
+	t.Run("empty trie should return no leaves", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		leaves, nextKey, err := tr.GetLeavesPage([]byte{}, nil, 10, context.Background())
+		assert.Nil(t, err)
+		assert.Empty(t, leaves)
+		assert.Nil(t, nextKey)
+	})
+	t.Run("missing root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		leaves, nextKey, err := tr.GetLeavesPage([]byte("missing root hash"), nil, 10, context.Background())
+		assert.NotNil(t, err)
+		assert.Nil(t, leaves)
+		assert.Nil(t, nextKey)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		leaves, nextKey, err := tr.GetLeavesPage(rootHash, nil, 10, ctx)
+		assert.Equal(t, chainErrors.ErrContextClosing, err)
+		assert.Nil(t, leaves)
+		assert.Nil(t, nextKey)
+	})
+	t.Run("should iterate the whole trie in pages", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 100
+		tr, values := initTrieMultipleValues(numLeaves)
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		// the trie is changed after the commit, the pages should still be read from the committed root hash
+		_ = tr.Update([]byte("new key"), []byte("new value"))
+
+		expectedValues := make(map[string]struct{})
+		for _, value := range values {
+			expectedValues[string(value)] = struct{}{}
+		}
+
+		numPages := 0
+		var startKey []byte
+		for {
+			leaves, nextKey, err := tr.GetLeavesPage(rootHash, startKey, 7, context.Background())
+			require.Nil(t, err)
+			numPages++
+
+			for _, leaf := range leaves {
+				_, found := expectedValues[string(leaf.Key())]
+				require.True(t, found)
+				delete(expectedValues, string(leaf.Key()))
+			}
+
+			if len(nextKey) == 0 {
+				break
+			}
+			require.Len(t, leaves, 7)
+			startKey = nextKey
+		}
+
+		assert.Empty(t, expectedValues)
+		assert.Equal(t, 15, numPages)
+	})
+}
+
+type trieDiffEntry struct {
+	key      string
+	oldValue []byte
+	newValue []byte
+}
+
+func getTrieDiff(t *testing.T, tr common.Trie, oldRootHash []byte, newRootHash []byte, startKey []byte) []trieDiffEntry {
+	diff := make([]trieDiffEntry, 0)
+	err := tr.GetDiff(oldRootHash, newRootHash, startKey, func(key []byte, oldValue []byte, newValue []byte) error {
+		diff = append(diff, trieDiffEntry{key: string(key), oldValue: oldValue, newValue: newValue})
+		return nil
+	}, context.Background())
+	require.Nil(t, err)
+
+	return diff
+}
+
+func TestPatriciaMerkleTrie_GetDiff(t *testing.T) {
+	t.Parallel()
+
+	noOpHandler := func(_ []byte, _ []byte, _ []byte) error {
+		return nil
+	}
+
+	t.Run("nil handler should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		err := tr.GetDiff(nil, nil, nil, nil, context.Background())
+		assert.Equal(t, trie.ErrNilTrieDiffHandlerFunc, err)
+	})
+	t.Run("nil context should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		err := tr.GetDiff(nil, nil, nil, noOpHandler, nil)
+		assert.Equal(t, trie.ErrNilContext, err)
+	})
+	t.Run("missing root hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		err := tr.GetDiff(rootHash, []byte("missing root hash"), nil, noOpHandler, context.Background())
+		assert.NotNil(t, err)
+	})
+	t.Run("context done should error", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := tr.GetDiff(emptyTrieHash, rootHash, nil, noOpHandler, ctx)
+		assert.Equal(t, chainErrors.ErrContextClosing, err)
+	})
+	t.Run("handler error should stop", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		expectedErr := errors.New("expected error")
+		numCalls := 0
+		err := tr.GetDiff(emptyTrieHash, rootHash, nil, func(_ []byte, _ []byte, _ []byte) error {
+			numCalls++
+			return expectedErr
+		}, context.Background())
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, 1, numCalls)
+	})
+	t.Run("same root hash should not report changes", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		assert.Empty(t, getTrieDiff(t, tr, rootHash, rootHash, nil))
+	})
+	t.Run("empty old trie should report all the leaves as added", func(t *testing.T) {
+		t.Parallel()
+
+		tr := initTrie()
+		_ = tr.Commit()
+		rootHash, _ := tr.RootHash()
+
+		diff := getTrieDiff(t, tr, emptyTrieHash, rootHash, nil)
+		leaves, _, err := tr.GetLeavesPage(rootHash, nil, 10, context.Background())
+		require.Nil(t, err)
+		require.Equal(t, len(leaves), len(diff))
+		for i, leaf := range leaves {
+			assert.Equal(t, trieDiffEntry{key: string(leaf.Key()), newValue: leaf.Value()}, diff[i])
+		}
+
+		diff = getTrieDiff(t, tr, rootHash, emptyTrieHash, nil)
+		require.Equal(t, len(leaves), len(diff))
+		for i, leaf := range leaves {
+			assert.Equal(t, trieDiffEntry{key: string(leaf.Key()), oldValue: leaf.Value()}, diff[i])
+		}
+	})
+	t.Run("should report the changed keys", func(t *testing.T) {
+		t.Parallel()
+
+		numLeaves := 200
+		tr, values := initTrieMultipleValues(numLeaves)
+		_ = tr.Update([]byte("doe"), []byte("reindeer"))
+		_ = tr.Update([]byte("dog"), []byte("puppy"))
+		_ = tr.Commit()
+		oldRootHash, _ := tr.RootHash()
+
+		expectedDiff := make(map[string]trieDiffEntry)
+		for i := 0; i < numLeaves; i += 7 {
+			newValue := []byte(fmt.Sprintf("new value %d", i))
+			_ = tr.Update(values[i], newValue)
+			expectedDiff[string(values[i])] = trieDiffEntry{key: string(values[i]), oldValue: values[i], newValue: newValue}
+		}
+		for i := 3; i < numLeaves; i += 11 {
+			_ = tr.Delete(values[i])
+			expectedDiff[string(values[i])] = trieDiffEntry{key: string(values[i]), oldValue: values[i]}
+		}
+		_ = tr.Delete([]byte("dog"))
+		expectedDiff["dog"] = trieDiffEntry{key: "dog", oldValue: []byte("puppy")}
+		_ = tr.Update([]byte("doge"), []byte("coin"))
+		expectedDiff["doge"] = trieDiffEntry{key: "doge", newValue: []byte("coin")}
+		_ = tr.Commit()
+		newRootHash, _ := tr.RootHash()
+
+		diff := getTrieDiff(t, tr, oldRootHash, newRootHash, nil)
+		require.Equal(t, len(expectedDiff), len(diff))
+		for _, entry := range diff {
+			assert.Equal(t, expectedDiff[entry.key], entry)
+		}
+
+		reversedDiff := getTrieDiff(t, tr, newRootHash, oldRootHash, nil)
+		require.Equal(t, len(diff), len(reversedDiff))
+		for i := range diff {
+			assert.Equal(t, trieDiffEntry{key: diff[i].key, oldValue: diff[i].newValue, newValue: diff[i].oldValue}, reversedDiff[i])
+		}
+
+		// continuing from a reported key returns the rest of the changes
+		startIndex := len(diff) / 2
+		partialDiff := getTrieDiff(t, tr, oldRootHash, newRootHash, []byte(diff[startIndex].key))
+		assert.Equal(t, diff[startIndex:], partialDiff)
+	})
+	t.Run("key which is a suffix of another key should be compared with its old value", func(t *testing.T) {
+		t.Parallel()
+
+		tr := emptyTrie()
+		_ = tr.Update([]byte("key"), []byte("value"))
+		_ = tr.Commit()
+		oldRootHash, _ := tr.RootHash()
+
+		// the nibbles are stored in reverse order, so the leaf of "key" is placed on the terminator position
+		_ = tr.Update([]byte("key"), []byte("new value"))
+		_ = tr.Update([]byte("added key"), []byte("added value"))
+		_ = tr.Commit()
+		newRootHash, _ := tr.RootHash()
+
+		expectedDiff := []trieDiffEntry{
+			{key: "added key", newValue: []byte("added value")},
+			{key: "key", oldValue: []byte("value"), newValue: []byte("new value")},
+		}
+		assert.Equal(t, expectedDiff, getTrieDiff(t, tr, oldRootHash, newRootHash, nil))
+	})
+}
+
 func TestPatriciaMerkleTrie_GetAllLeavesOnChannel(t *testing.T) {
 	t.Parallel()
 
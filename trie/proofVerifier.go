@@ -1,7 +1,9 @@
 package trie
 
 import (
+	"github.com/multiversx/mx-chain-core-go/core"
 	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/core/keyValStorage"
 	"github.com/multiversx/mx-chain-core-go/hashing"
 	"github.com/multiversx/mx-chain-core-go/marshal"
 )
@@ -31,3 +33,37 @@ func NewMerkleProofVerifier(marshalizer marshal.Marshalizer, hasher hashing.Hash
 func (mpv *merkleProofVerifier) VerifyProof(rootHash []byte, key []byte, proof [][]byte) (bool, error) {
 	return mpv.trie.VerifyProof(rootHash, key, proof)
 }
+
+// VerifyMultiProof verifies the given multiproof and returns the values it binds to the given keys, in the same order.
+// A key that the proof shows to be absent from the trie has a nil value
+func (mpv *merkleProofVerifier) VerifyMultiProof(rootHash []byte, keys [][]byte, proof [][]byte) ([]core.KeyValueHolder, error) {
+	if len(keys) == 0 {
+		return nil, ErrEmptyKeysList
+	}
+
+	nodes := newProofNodes(proof, mpv.trie.marshalizer, mpv.trie.hasher)
+	provenValues := make([]core.KeyValueHolder, 0, len(keys))
+	for _, key := range keys {
+		value, err := nodes.verifyKey(rootHash, key)
+		if err != nil {
+			return nil, err
+		}
+
+		provenValues = append(provenValues, keyValStorage.NewKeyValStorage(key, value))
+	}
+
+	return provenValues, nil
+}
+
+// VerifyRangeProof verifies the given range proof and returns all the leaves that have the keys between the given
+// bounds, in the trie's traversal order. An empty end key means that there is no upper bound
+func (mpv *merkleProofVerifier) VerifyRangeProof(rootHash []byte, startKey []byte, endKey []byte, proof [][]byte) ([]core.KeyValueHolder, error) {
+	bounds, err := newRangeBounds(startKey, endKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := newProofNodes(proof, mpv.trie.marshalizer, mpv.trie.hasher)
+
+	return nodes.verifyRange(rootHash, make([]byte, 0), bounds, make([]core.KeyValueHolder, 0))
+}
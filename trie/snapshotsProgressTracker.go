@@ -0,0 +1,342 @@
+package trie
+
+import (
+	"context"
+	"encoding/hex"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+)
+
+const (
+	snapshotOperationType   = "snapshot"
+	checkpointOperationType = "checkpoint"
+)
+
+// snapshotOperation holds the progress of a queued or running snapshot or checkpoint
+type snapshotOperation struct {
+	operationType    string
+	address          string
+	rootHash         []byte
+	mainTrieRootHash []byte
+	epoch            uint32
+	generation       uint64
+
+	mutOperation   sync.RWMutex
+	isRunning      bool
+	isCanceled     bool
+	startTimestamp time.Time
+	cancel         context.CancelFunc
+	err            error
+
+	numNodesCopied uint64
+	numBytesCopied uint64
+}
+
+func (op *snapshotOperation) addCopiedNode(size uint64) {
+	atomic.AddUint64(&op.numNodesCopied, 1)
+	atomic.AddUint64(&op.numBytesCopied, size)
+}
+
+func (op *snapshotOperation) setError(err error) {
+	op.mutOperation.Lock()
+	op.err = err
+	op.mutOperation.Unlock()
+}
+
+func (op *snapshotOperation) wasCanceled() bool {
+	op.mutOperation.RLock()
+	defer op.mutOperation.RUnlock()
+
+	return op.isCanceled
+}
+
+func (op *snapshotOperation) toDTO() *common.TrieSnapshotOperation {
+	op.mutOperation.RLock()
+	defer op.mutOperation.RUnlock()
+
+	return &common.TrieSnapshotOperation{
+		Type:             op.operationType,
+		Address:          hex.EncodeToString([]byte(op.address)),
+		RootHash:         hex.EncodeToString(op.rootHash),
+		MainTrieRootHash: hex.EncodeToString(op.mainTrieRootHash),
+		Epoch:            op.epoch,
+		StartTimestamp:   op.startTimestamp.Unix(),
+		NumNodesCopied:   atomic.LoadUint64(&op.numNodesCopied),
+		NumBytesCopied:   atomic.LoadUint64(&op.numBytesCopied),
+	}
+}
+
+// snapshotsProgressTracker keeps track of the snapshots and checkpoints of a trie storage manager. A run starts when
+// the first operation is queued and ends when there are no more queued or running operations
+type snapshotsProgressTracker struct {
+	mut                  sync.RWMutex
+	isPaused             bool
+	generation           uint64
+	numQueuedSnapshots   uint64
+	numQueuedCheckpoints uint64
+	runningOperations    map[*snapshotOperation]struct{}
+
+	runStartTimestamp time.Time
+	runNumNodesCopied uint64
+	runNumBytesCopied uint64
+	runHasErrors      bool
+
+	lastRunNumNodes    uint64
+	lastRunDuration    time.Duration
+	lastError          error
+	lastErrorTimestamp time.Time
+}
+
+func newSnapshotsProgressTracker() *snapshotsProgressTracker {
+	return &snapshotsProgressTracker{
+		runningOperations: make(map[*snapshotOperation]struct{}),
+	}
+}
+
+// enqueue registers a new operation, before it is added in the queue
+func (spt *snapshotsProgressTracker) enqueue(op *snapshotOperation) {
+	spt.mut.Lock()
+	defer spt.mut.Unlock()
+
+	if spt.isIdle() {
+		spt.runStartTimestamp = time.Now()
+		spt.runNumNodesCopied = 0
+		spt.runNumBytesCopied = 0
+		spt.runHasErrors = false
+	}
+
+	op.generation = spt.generation
+	spt.changeNumQueued(op.operationType, 1)
+}
+
+// start marks the operation as running and returns the context it should run with. It returns false if the operation
+// was canceled while queued
+func (spt *snapshotsProgressTracker) start(ctx context.Context, op *snapshotOperation) (context.Context, bool) {
+	spt.mut.Lock()
+	defer spt.mut.Unlock()
+
+	spt.changeNumQueued(op.operationType, -1)
+
+	op.mutOperation.Lock()
+	defer op.mutOperation.Unlock()
+
+	op.isRunning = true
+	op.startTimestamp = time.Now()
+	spt.runningOperations[op] = struct{}{}
+
+	if op.generation < spt.generation {
+		op.isCanceled = true
+		return ctx, false
+	}
+
+	operationCtx, cancel := context.WithCancel(ctx)
+	op.cancel = cancel
+
+	return operationCtx, true
+}
+
+// finish removes the operation, queued or running, and records its error, if any
+func (spt *snapshotsProgressTracker) finish(op *snapshotOperation) {
+	spt.mut.Lock()
+	defer spt.mut.Unlock()
+
+	op.mutOperation.Lock()
+	isRunning := op.isRunning
+	op.isRunning = false
+	if op.cancel != nil {
+		op.cancel()
+	}
+	err := op.err
+	op.mutOperation.Unlock()
+
+	if isRunning {
+		delete(spt.runningOperations, op)
+	} else {
+		spt.changeNumQueued(op.operationType, -1)
+	}
+
+	spt.runNumNodesCopied += atomic.LoadUint64(&op.numNodesCopied)
+	spt.runNumBytesCopied += atomic.LoadUint64(&op.numBytesCopied)
+	if err != nil {
+		spt.runHasErrors = true
+		spt.lastError = err
+		spt.lastErrorTimestamp = time.Now()
+	}
+
+	if !spt.isIdle() {
+		return
+	}
+
+	spt.lastRunDuration = time.Since(spt.runStartTimestamp)
+	if !spt.runHasErrors {
+		spt.lastRunNumNodes = spt.runNumNodesCopied
+	}
+	log.Debug("trie snapshots run finished",
+		"duration", spt.lastRunDuration.Truncate(time.Second),
+		"num nodes copied", spt.runNumNodesCopied,
+		"num bytes copied", spt.runNumBytesCopied,
+		"errors", spt.runHasErrors)
+}
+
+// should be called under mutex protection
+func (spt *snapshotsProgressTracker) changeNumQueued(operationType string, delta int) {
+	if operationType == checkpointOperationType {
+		spt.numQueuedCheckpoints = uint64(int64(spt.numQueuedCheckpoints) + int64(delta))
+		return
+	}
+
+	spt.numQueuedSnapshots = uint64(int64(spt.numQueuedSnapshots) + int64(delta))
+}
+
+// should be called under mutex protection
+func (spt *snapshotsProgressTracker) isIdle() bool {
+	return spt.numQueuedSnapshots == 0 && spt.numQueuedCheckpoints == 0 && len(spt.runningOperations) == 0
+}
+
+func (spt *snapshotsProgressTracker) isSnapshottingPaused() bool {
+	spt.mut.RLock()
+	defer spt.mut.RUnlock()
+
+	return spt.isPaused
+}
+
+func (spt *snapshotsProgressTracker) pause() {
+	spt.mut.Lock()
+	spt.isPaused = true
+	spt.mut.Unlock()
+
+	log.Info("trie snapshots paused")
+}
+
+func (spt *snapshotsProgressTracker) resume() {
+	spt.mut.Lock()
+	spt.isPaused = false
+	spt.mut.Unlock()
+
+	log.Info("trie snapshots resumed")
+}
+
+// cancelAll stops the running operations and marks the queued ones as canceled, so they are skipped when dequeued
+func (spt *snapshotsProgressTracker) cancelAll() {
+	spt.mut.Lock()
+	defer spt.mut.Unlock()
+
+	spt.generation++
+	for op := range spt.runningOperations {
+		op.mutOperation.Lock()
+		op.isCanceled = true
+		if op.cancel != nil {
+			op.cancel()
+		}
+		op.mutOperation.Unlock()
+	}
+
+	log.Info("trie snapshots canceled",
+		"num running", len(spt.runningOperations),
+		"num queued snapshots", spt.numQueuedSnapshots,
+		"num queued checkpoints", spt.numQueuedCheckpoints)
+}
+
+func (spt *snapshotsProgressTracker) getStatus() *common.TrieSnapshotsStatus {
+	spt.mut.RLock()
+	defer spt.mut.RUnlock()
+
+	status := &common.TrieSnapshotsStatus{
+		Paused:               spt.isPaused,
+		NumQueuedSnapshots:   spt.numQueuedSnapshots,
+		NumQueuedCheckpoints: spt.numQueuedCheckpoints,
+		LastDurationSec:      int64(spt.lastRunDuration.Seconds()),
+		RunningOperations:    make([]*common.TrieSnapshotOperation, 0, len(spt.runningOperations)),
+	}
+	if spt.lastError != nil {
+		status.LastError = spt.lastError.Error()
+		status.LastErrorTimestamp = spt.lastErrorTimestamp.Unix()
+	}
+	if spt.isIdle() {
+		return status
+	}
+
+	status.StartTimestamp = spt.runStartTimestamp.Unix()
+	status.NumNodesCopied = spt.runNumNodesCopied
+	status.NumBytesCopied = spt.runNumBytesCopied
+	for op := range spt.runningOperations {
+		operation := op.toDTO()
+		status.RunningOperations = append(status.RunningOperations, operation)
+		status.NumNodesCopied += operation.NumNodesCopied
+		status.NumBytesCopied += operation.NumBytesCopied
+		if operation.Type == checkpointOperationType {
+			status.NumRunningCheckpoints++
+		} else {
+			status.NumRunningSnapshots++
+		}
+	}
+
+	status.EstimatedNumNodes = spt.lastRunNumNodes
+	status.EstimatedCompletionSec = estimateCompletion(time.Since(spt.runStartTimestamp), status.NumNodesCopied, spt.lastRunNumNodes)
+
+	return status
+}
+
+// estimateCompletion returns the estimated number of seconds until the current run ends, assuming a constant copy rate
+func estimateCompletion(elapsed time.Duration, numNodesCopied uint64, estimatedNumNodes uint64) int64 {
+	if numNodesCopied == 0 || estimatedNumNodes <= numNodesCopied {
+		return 0
+	}
+
+	remainingNodes := float64(estimatedNumNodes - numNodesCopied)
+
+	return int64(elapsed.Seconds() * remainingNodes / float64(numNodesCopied))
+}
+
+// pausableIdleProvider reports the node as busy while the snapshots are paused, so the running snapshots and
+// checkpoints wait before copying the next trie node
+type pausableIdleProvider struct {
+	IdleNodeProvider
+	tracker *snapshotsProgressTracker
+}
+
+// IsIdle returns true if the node is idle and the snapshots are not paused
+func (pip *pausableIdleProvider) IsIdle() bool {
+	return !pip.tracker.isSnapshottingPaused() && pip.IdleNodeProvider.IsIdle()
+}
+
+// progressTrieStatistics counts the nodes copied by a snapshot or checkpoint while they are saved
+type progressTrieStatistics struct {
+	common.TrieStatisticsHandler
+	operation *snapshotOperation
+}
+
+// AddBranchNode will add the given level and size to the branch nodes statistics
+func (pts *progressTrieStatistics) AddBranchNode(level int, size uint64) {
+	pts.operation.addCopiedNode(size)
+	pts.TrieStatisticsHandler.AddBranchNode(level, size)
+}
+
+// AddExtensionNode will add the given level and size to the extension nodes statistics
+func (pts *progressTrieStatistics) AddExtensionNode(level int, size uint64) {
+	pts.operation.addCopiedNode(size)
+	pts.TrieStatisticsHandler.AddExtensionNode(level, size)
+}
+
+// AddLeafNode will add the given level and size to the leaf nodes statistics
+func (pts *progressTrieStatistics) AddLeafNode(level int, size uint64) {
+	pts.operation.addCopiedNode(size)
+	pts.TrieStatisticsHandler.AddLeafNode(level, size)
+}
+
+// operationError returns the error to be reported for a failed operation. A canceled operation reports
+// ErrSnapshotCanceled instead of the context closing error, while a closing node reports no error
+func operationError(op *snapshotOperation, err error) error {
+	if op.wasCanceled() {
+		return ErrSnapshotCanceled
+	}
+	if errors.IsClosingError(err) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,145 @@
+package trie
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	mxErrors "github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/testscommon"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSnapshotsProgressTracker_QueuedAndRunningOperations(t *testing.T) {
+	t.Parallel()
+
+	spt := newSnapshotsProgressTracker()
+	snapshot := &snapshotOperation{operationType: snapshotOperationType, address: "addr", rootHash: []byte("root")}
+	checkpoint := &snapshotOperation{operationType: checkpointOperationType, rootHash: []byte("root")}
+	spt.enqueue(snapshot)
+	spt.enqueue(checkpoint)
+
+	status := spt.getStatus()
+	assert.Equal(t, uint64(1), status.NumQueuedSnapshots)
+	assert.Equal(t, uint64(1), status.NumQueuedCheckpoints)
+	assert.NotZero(t, status.StartTimestamp)
+
+	_, shouldStart := spt.start(context.Background(), snapshot)
+	assert.True(t, shouldStart)
+	snapshot.addCopiedNode(10)
+	snapshot.addCopiedNode(20)
+
+	status = spt.getStatus()
+	assert.Zero(t, status.NumQueuedSnapshots)
+	assert.Equal(t, uint64(1), status.NumRunningSnapshots)
+	assert.Equal(t, uint64(2), status.NumNodesCopied)
+	assert.Equal(t, uint64(30), status.NumBytesCopied)
+	assert.Equal(t, 1, len(status.RunningOperations))
+	assert.Equal(t, "61646472", status.RunningOperations[0].Address)
+
+	spt.finish(snapshot)
+	status = spt.getStatus()
+	assert.Zero(t, status.NumRunningSnapshots)
+	assert.Equal(t, uint64(2), status.NumNodesCopied)
+
+	// the checkpoint is dropped from the queue without being started
+	spt.finish(checkpoint)
+	status = spt.getStatus()
+	assert.Zero(t, status.NumQueuedCheckpoints)
+	assert.Zero(t, status.NumNodesCopied)
+	assert.Zero(t, status.StartTimestamp)
+	assert.Equal(t, uint64(2), spt.lastRunNumNodes)
+
+	// the next run uses the previous one for the estimation
+	next := &snapshotOperation{operationType: snapshotOperationType}
+	spt.enqueue(next)
+	_, _ = spt.start(context.Background(), next)
+	assert.Equal(t, uint64(2), spt.getStatus().EstimatedNumNodes)
+}
+
+func TestSnapshotsProgressTracker_Errors(t *testing.T) {
+	t.Parallel()
+
+	spt := newSnapshotsProgressTracker()
+	op := &snapshotOperation{operationType: snapshotOperationType}
+	spt.enqueue(op)
+	_, _ = spt.start(context.Background(), op)
+	op.addCopiedNode(1)
+	expectedErr := errors.New("expected error")
+	op.setError(expectedErr)
+	spt.finish(op)
+
+	status := spt.getStatus()
+	assert.Equal(t, expectedErr.Error(), status.LastError)
+	assert.NotZero(t, status.LastErrorTimestamp)
+	// a failed run is not used for estimations
+	assert.Zero(t, spt.lastRunNumNodes)
+}
+
+func TestSnapshotsProgressTracker_CancelAll(t *testing.T) {
+	t.Parallel()
+
+	spt := newSnapshotsProgressTracker()
+	running := &snapshotOperation{operationType: snapshotOperationType}
+	queued := &snapshotOperation{operationType: checkpointOperationType}
+	spt.enqueue(running)
+	spt.enqueue(queued)
+	ctx, shouldStart := spt.start(context.Background(), running)
+	assert.True(t, shouldStart)
+
+	spt.cancelAll()
+
+	select {
+	case <-ctx.Done():
+	case <-time.After(time.Second):
+		assert.Fail(t, "running operation was not canceled")
+	}
+	assert.Equal(t, ErrSnapshotCanceled, operationError(running, mxErrors.ErrContextClosing))
+
+	_, shouldStart = spt.start(context.Background(), queued)
+	assert.False(t, shouldStart)
+	assert.Equal(t, ErrSnapshotCanceled, operationError(queued, nil))
+
+	// operations queued after the cancel are not affected
+	next := &snapshotOperation{operationType: snapshotOperationType}
+	spt.enqueue(next)
+	_, shouldStart = spt.start(context.Background(), next)
+	assert.True(t, shouldStart)
+	assert.Nil(t, operationError(next, mxErrors.ErrContextClosing))
+}
+
+func TestSnapshotsProgressTracker_PausableIdleProvider(t *testing.T) {
+	t.Parallel()
+
+	spt := newSnapshotsProgressTracker()
+	isIdle := true
+	pip := &pausableIdleProvider{
+		IdleNodeProvider: &testscommon.ProcessStatusHandlerStub{
+			IsIdleCalled: func() bool {
+				return isIdle
+			},
+		},
+		tracker: spt,
+	}
+	assert.True(t, pip.IsIdle())
+
+	spt.pause()
+	assert.False(t, pip.IsIdle())
+	assert.True(t, spt.getStatus().Paused)
+
+	spt.resume()
+	assert.True(t, pip.IsIdle())
+
+	isIdle = false
+	assert.False(t, pip.IsIdle())
+}
+
+func TestEstimateCompletion(t *testing.T) {
+	t.Parallel()
+
+	assert.Zero(t, estimateCompletion(time.Minute, 0, 100))
+	assert.Zero(t, estimateCompletion(time.Minute, 100, 0))
+	assert.Zero(t, estimateCompletion(time.Minute, 150, 100))
+	assert.Equal(t, int64(180), estimateCompletion(time.Minute, 25, 100))
+}
@@ -0,0 +1,315 @@
+package trie
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/multiversx/mx-chain-go/common"
+	"github.com/multiversx/mx-chain-go/errors"
+	"github.com/multiversx/mx-chain-go/trie/keyBuilder"
+)
+
+// diffEntry holds a node of one of the compared tries, placed at the current path. The hash is empty for the nodes
+// derived while aligning the two tries, as they are not saved in the storage
+type diffEntry struct {
+	hash    []byte
+	node    node
+	resolve func() (node, error)
+}
+
+func (de *diffEntry) getNode() (node, error) {
+	if de == nil {
+		return nil, nil
+	}
+	if de.node != nil || de.resolve == nil {
+		return de.node, nil
+	}
+
+	n, err := de.resolve()
+	if err != nil {
+		return nil, err
+	}
+	de.node = n
+
+	return n, nil
+}
+
+type trieDiffer struct {
+	db        common.DBWriteCacher
+	startPath []byte
+	handler   common.TrieDiffHandlerFunc
+	ctx       context.Context
+}
+
+// diffTries calls the handler for each key with a different value in the two tries, in the trie's traversal order,
+// starting with the given key. Only the subtries with different hashes are loaded from the storage
+func diffTries(
+	oldTrie *patriciaMerkleTrie,
+	newTrie *patriciaMerkleTrie,
+	startKey []byte,
+	handler common.TrieDiffHandlerFunc,
+	ctx context.Context,
+) error {
+	td := &trieDiffer{
+		db:      newTrie.GetStorageManager(),
+		handler: handler,
+		ctx:     ctx,
+	}
+	if len(startKey) > 0 {
+		td.startPath = keyBytesToHex(startKey)
+	}
+
+	return td.diffEntries(newDiffEntry(oldTrie.root), newDiffEntry(newTrie.root), make([]byte, 0))
+}
+
+func newDiffEntry(n node) *diffEntry {
+	if n == nil || n.isEmptyOrNil() != nil {
+		return nil
+	}
+
+	return &diffEntry{
+		hash: n.getHash(),
+		node: n,
+	}
+}
+
+func (td *trieDiffer) diffEntries(oldEntry *diffEntry, newEntry *diffEntry, path []byte) error {
+	if common.IsContextDone(td.ctx) {
+		return errors.ErrContextClosing
+	}
+	if oldEntry == nil && newEntry == nil {
+		return nil
+	}
+	if oldEntry != nil && newEntry != nil && len(oldEntry.hash) > 0 && bytes.Equal(oldEntry.hash, newEntry.hash) {
+		return nil
+	}
+	if td.isBeforeStartPath(path) {
+		return nil
+	}
+
+	oldNode, err := oldEntry.getNode()
+	if err != nil {
+		return err
+	}
+	newNode, err := newEntry.getNode()
+	if err != nil {
+		return err
+	}
+
+	if oldNode == nil {
+		return td.reportAll(newNode, path, false)
+	}
+	if newNode == nil {
+		return td.reportAll(oldNode, path, true)
+	}
+
+	oldLeaf, isOldLeaf := oldNode.(*leafNode)
+	newLeaf, isNewLeaf := newNode.(*leafNode)
+	if isOldLeaf && isNewLeaf {
+		return td.diffLeaves(oldLeaf, newLeaf, path)
+	}
+
+	oldSlots, err := td.expand(oldNode)
+	if err != nil {
+		return err
+	}
+	newSlots, err := td.expand(newNode)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < nrOfChildren; i++ {
+		err = td.diffEntries(oldSlots[i], newSlots[i], concat(path, byte(i)))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (td *trieDiffer) diffLeaves(oldLeaf *leafNode, newLeaf *leafNode, path []byte) error {
+	oldPath := concat(path, oldLeaf.Key...)
+	newPath := concat(path, newLeaf.Key...)
+
+	comparison := bytes.Compare(oldPath, newPath)
+	if comparison == 0 {
+		if bytes.Equal(oldLeaf.Value, newLeaf.Value) {
+			return nil
+		}
+
+		return td.report(oldPath, oldLeaf.Value, newLeaf.Value)
+	}
+
+	if comparison > 0 {
+		err := td.report(newPath, nil, newLeaf.Value)
+		if err != nil {
+			return err
+		}
+
+		return td.report(oldPath, oldLeaf.Value, nil)
+	}
+
+	err := td.report(oldPath, oldLeaf.Value, nil)
+	if err != nil {
+		return err
+	}
+
+	return td.report(newPath, nil, newLeaf.Value)
+}
+
+// expand returns the nodes placed under the given node, on each of the next nibble positions. The extension and the
+// leaf nodes are shortened by one nibble, so they can be compared with the children of a branch node
+func (td *trieDiffer) expand(n node) ([]*diffEntry, error) {
+	slots := make([]*diffEntry, nrOfChildren)
+
+	switch typedNode := n.(type) {
+	case *branchNode:
+		for i := 0; i < nrOfChildren; i++ {
+			if typedNode.children[i] == nil && len(typedNode.EncodedChildren[i]) == 0 {
+				continue
+			}
+
+			pos := byte(i)
+			slots[i] = &diffEntry{
+				hash: typedNode.EncodedChildren[i],
+				node: typedNode.children[i],
+				resolve: func() (node, error) {
+					err := resolveIfCollapsed(typedNode, pos, td.db)
+					if err != nil {
+						return nil, err
+					}
+
+					return typedNode.children[pos], nil
+				},
+			}
+		}
+	case *extensionNode:
+		if len(typedNode.Key) == 0 {
+			return nil, ErrInvalidNode
+		}
+
+		pos := typedNode.Key[0]
+		if len(typedNode.Key) > 1 {
+			slots[pos] = &diffEntry{
+				node: &extensionNode{
+					CollapsedEn: CollapsedEn{
+						Key:          typedNode.Key[1:],
+						EncodedChild: typedNode.EncodedChild,
+					},
+					child: typedNode.child,
+					baseNode: &baseNode{
+						marsh:  typedNode.marsh,
+						hasher: typedNode.hasher,
+					},
+				},
+			}
+			break
+		}
+
+		slots[pos] = &diffEntry{
+			hash: typedNode.EncodedChild,
+			node: typedNode.child,
+			resolve: func() (node, error) {
+				err := resolveIfCollapsed(typedNode, 0, td.db)
+				if err != nil {
+					return nil, err
+				}
+
+				return typedNode.child, nil
+			},
+		}
+	case *leafNode:
+		if len(typedNode.Key) == 0 {
+			return nil, ErrInvalidNode
+		}
+
+		// a leaf whose key ends here is placed on the terminator position with an empty key, as in a branch node
+		slots[typedNode.Key[0]] = &diffEntry{
+			node: &leafNode{
+				CollapsedLn: CollapsedLn{
+					Key:   typedNode.Key[1:],
+					Value: typedNode.Value,
+				},
+				baseNode: &baseNode{},
+			},
+		}
+	default:
+		return nil, ErrWrongTypeAssertion
+	}
+
+	return slots, nil
+}
+
+// reportAll reports all the leaves under the given node as removed, or as added
+func (td *trieDiffer) reportAll(n node, path []byte, isRemoved bool) error {
+	if common.IsContextDone(td.ctx) {
+		return errors.ErrContextClosing
+	}
+
+	switch typedNode := n.(type) {
+	case *leafNode:
+		leafPath := concat(path, typedNode.Key...)
+		if isRemoved {
+			return td.report(leafPath, typedNode.Value, nil)
+		}
+
+		return td.report(leafPath, nil, typedNode.Value)
+	case *extensionNode:
+		childPath := concat(path, typedNode.Key...)
+		if td.isBeforeStartPath(childPath) {
+			return nil
+		}
+
+		err := resolveIfCollapsed(typedNode, 0, td.db)
+		if err != nil {
+			return err
+		}
+
+		return td.reportAll(typedNode.child, childPath, isRemoved)
+	case *branchNode:
+		for i := 0; i < nrOfChildren; i++ {
+			if typedNode.children[i] == nil && !typedNode.isPosCollapsed(i) {
+				continue
+			}
+
+			childPath := concat(path, byte(i))
+			if td.isBeforeStartPath(childPath) {
+				continue
+			}
+
+			err := resolveIfCollapsed(typedNode, byte(i), td.db)
+			if err != nil {
+				return err
+			}
+
+			err = td.reportAll(typedNode.children[i], childPath, isRemoved)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	default:
+		return ErrWrongTypeAssertion
+	}
+}
+
+func (td *trieDiffer) report(path []byte, oldValue []byte, newValue []byte) error {
+	if td.isBeforeStartPath(path) {
+		return nil
+	}
+
+	kb := keyBuilder.NewKeyBuilder()
+	kb.BuildKey(path)
+	key, err := kb.GetKey()
+	if err != nil {
+		return err
+	}
+
+	return td.handler(key, oldValue, newValue)
+}
+
+func (td *trieDiffer) isBeforeStartPath(path []byte) bool {
+	return len(td.startPath) > 0 && comparePathWithStartPath(path, td.startPath) < 0
+}
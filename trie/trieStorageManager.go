@@ -20,6 +20,8 @@ import (
 	"github.com/multiversx/mx-chain-go/trie/statistics"
 )
 
+var _ common.TrieSnapshotsController = (*trieStorageManager)(nil)
+
 // trieStorageManager manages all the storage operations of the trie (commit, snapshot, checkpoint, pruning)
 type trieStorageManager struct {
 	mainStorer             common.DBWriteCacher
@@ -33,6 +35,7 @@ type trieStorageManager struct {
 	closer                 core.SafeCloser
 	closed                 bool
 	idleProvider           IdleNodeProvider
+	snapshotsTracker       *snapshotsProgressTracker
 }
 
 type snapshotsQueueEntry struct {
@@ -43,6 +46,7 @@ type snapshotsQueueEntry struct {
 	missingNodesChan chan []byte
 	stats            common.SnapshotStatisticsHandler
 	epoch            uint32
+	operation        *snapshotOperation
 }
 
 // NewTrieStorageManagerArgs holds the arguments needed for creating a new trieStorageManager
@@ -78,6 +82,7 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
+	snapshotsTracker := newSnapshotsProgressTracker()
 
 	tsm := &trieStorageManager{
 		mainStorer:             args.MainStorer,
@@ -88,7 +93,11 @@ func NewTrieStorageManager(args NewTrieStorageManagerArgs) (*trieStorageManager,
 		cancelFunc:             cancelFunc,
 		checkpointHashesHolder: args.CheckpointHashesHolder,
 		closer:                 closing.NewSafeChanCloser(),
-		idleProvider:           args.IdleProvider,
+		idleProvider: &pausableIdleProvider{
+			IdleNodeProvider: args.IdleProvider,
+			tracker:          snapshotsTracker,
+		},
+		snapshotsTracker: snapshotsTracker,
 	}
 	goRoutinesThrottler, err := throttler.NewNumGoRoutinesThrottler(int32(args.GeneralConfig.SnapshotsGoroutineNum))
 	if err != nil {
@@ -358,10 +367,19 @@ func (tsm *trieStorageManager) TakeSnapshot(
 		missingNodesChan: missingNodesChan,
 		stats:            stats,
 		epoch:            epoch,
-	}
+		operation: &snapshotOperation{
+			operationType:    snapshotOperationType,
+			address:          address,
+			rootHash:         rootHash,
+			mainTrieRootHash: mainTrieRootHash,
+			epoch:            epoch,
+		},
+	}
+	tsm.snapshotsTracker.enqueue(snapshotEntry.operation)
 	select {
 	case tsm.snapshotReq <- snapshotEntry:
 	case <-tsm.closer.ChanClose():
+		tsm.snapshotsTracker.finish(snapshotEntry.operation)
 		tsm.ExitPruningBufferingMode()
 		safelyCloseChan(iteratorChannels.LeavesChan)
 		stats.SnapshotFinished()
@@ -405,10 +423,17 @@ func (tsm *trieStorageManager) SetCheckpoint(
 		iteratorChannels: iteratorChannels,
 		missingNodesChan: missingNodesChan,
 		stats:            stats,
+		operation: &snapshotOperation{
+			operationType:    checkpointOperationType,
+			rootHash:         rootHash,
+			mainTrieRootHash: mainTrieRootHash,
+		},
 	}
+	tsm.snapshotsTracker.enqueue(checkpointEntry.operation)
 	select {
 	case tsm.checkpointReq <- checkpointEntry:
 	case <-tsm.closer.ChanClose():
+		tsm.snapshotsTracker.finish(checkpointEntry.operation)
 		tsm.ExitPruningBufferingMode()
 		safelyCloseChan(iteratorChannels.LeavesChan)
 		stats.SnapshotFinished()
@@ -422,6 +447,7 @@ func safelyCloseChan(ch chan core.KeyValueHolder) {
 }
 
 func (tsm *trieStorageManager) finishOperation(snapshotEntry *snapshotsQueueEntry, message string) {
+	tsm.snapshotsTracker.finish(snapshotEntry.operation)
 	tsm.ExitPruningBufferingMode()
 	log.Trace(message, "rootHash", snapshotEntry.rootHash)
 	safelyCloseChan(snapshotEntry.iteratorChannels.LeavesChan)
@@ -436,9 +462,16 @@ func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry,
 
 	log.Trace("trie snapshot started", "rootHash", snapshotEntry.rootHash)
 
+	ctx, shouldStart := tsm.snapshotsTracker.start(ctx, snapshotEntry.operation)
+	if !shouldStart {
+		tsm.treatOperationError(snapshotEntry, ErrSnapshotCanceled, "trie storage manager: takeSnapshot")
+		return
+	}
+
 	stsm, err := newSnapshotTrieStorageManager(tsm, snapshotEntry.epoch)
 	if err != nil {
 		snapshotEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
+		snapshotEntry.operation.setError(err)
 		log.Error("takeSnapshot: trie storage manager: newSnapshotTrieStorageManager",
 			"rootHash", snapshotEntry.rootHash,
 			"main trie rootHash", snapshotEntry.mainTrieRootHash,
@@ -448,24 +481,18 @@ func (tsm *trieStorageManager) takeSnapshot(snapshotEntry *snapshotsQueueEntry,
 
 	newRoot, err := newSnapshotNode(stsm, msh, hsh, snapshotEntry.rootHash, snapshotEntry.missingNodesChan)
 	if err != nil {
-		snapshotEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
-		treatSnapshotError(err,
-			"trie storage manager: newSnapshotNode takeSnapshot",
-			snapshotEntry.rootHash,
-			snapshotEntry.mainTrieRootHash,
-		)
+		tsm.treatOperationError(snapshotEntry, err, "trie storage manager: newSnapshotNode takeSnapshot")
 		return
 	}
 
 	stats := statistics.NewTrieStatistics()
-	err = newRoot.commitSnapshot(stsm, snapshotEntry.iteratorChannels.LeavesChan, snapshotEntry.missingNodesChan, ctx, stats, tsm.idleProvider, rootDepthLevel)
+	progressStats := &progressTrieStatistics{
+		TrieStatisticsHandler: stats,
+		operation:             snapshotEntry.operation,
+	}
+	err = newRoot.commitSnapshot(stsm, snapshotEntry.iteratorChannels.LeavesChan, snapshotEntry.missingNodesChan, ctx, progressStats, tsm.idleProvider, rootDepthLevel)
 	if err != nil {
-		snapshotEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
-		treatSnapshotError(err,
-			"trie storage manager: takeSnapshot commit",
-			snapshotEntry.rootHash,
-			snapshotEntry.mainTrieRootHash,
-		)
+		tsm.treatOperationError(snapshotEntry, err, "trie storage manager: takeSnapshot commit")
 		return
 	}
 
@@ -481,26 +508,26 @@ func (tsm *trieStorageManager) takeCheckpoint(checkpointEntry *snapshotsQueueEnt
 
 	log.Trace("trie checkpoint started", "rootHash", checkpointEntry.rootHash)
 
+	ctx, shouldStart := tsm.snapshotsTracker.start(ctx, checkpointEntry.operation)
+	if !shouldStart {
+		tsm.treatOperationError(checkpointEntry, ErrSnapshotCanceled, "trie storage manager: takeCheckpoint")
+		return
+	}
+
 	newRoot, err := newSnapshotNode(tsm, msh, hsh, checkpointEntry.rootHash, checkpointEntry.missingNodesChan)
 	if err != nil {
-		checkpointEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
-		treatSnapshotError(err,
-			"trie storage manager: newSnapshotNode takeCheckpoint",
-			checkpointEntry.rootHash,
-			checkpointEntry.mainTrieRootHash,
-		)
+		tsm.treatOperationError(checkpointEntry, err, "trie storage manager: newSnapshotNode takeCheckpoint")
 		return
 	}
 
 	stats := statistics.NewTrieStatistics()
-	err = newRoot.commitCheckpoint(tsm, tsm.checkpointsStorer, tsm.checkpointHashesHolder, checkpointEntry.iteratorChannels.LeavesChan, ctx, stats, tsm.idleProvider, rootDepthLevel)
+	progressStats := &progressTrieStatistics{
+		TrieStatisticsHandler: stats,
+		operation:             checkpointEntry.operation,
+	}
+	err = newRoot.commitCheckpoint(tsm, tsm.checkpointsStorer, tsm.checkpointHashesHolder, checkpointEntry.iteratorChannels.LeavesChan, ctx, progressStats, tsm.idleProvider, rootDepthLevel)
 	if err != nil {
-		checkpointEntry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
-		treatSnapshotError(err,
-			"trie storage manager: takeCheckpoint commit",
-			checkpointEntry.rootHash,
-			checkpointEntry.mainTrieRootHash,
-		)
+		tsm.treatOperationError(checkpointEntry, err, "trie storage manager: takeCheckpoint commit")
 		return
 	}
 
@@ -508,11 +535,28 @@ func (tsm *trieStorageManager) takeCheckpoint(checkpointEntry *snapshotsQueueEnt
 	checkpointEntry.stats.AddTrieStats(stats.GetTrieStats())
 }
 
+// treatOperationError reports the error of a snapshot or checkpoint on the iterator error channel. The operations
+// canceled through CancelSnapshots report ErrSnapshotCanceled instead of the context closing error
+func (tsm *trieStorageManager) treatOperationError(entry *snapshotsQueueEntry, err error, message string) {
+	operationErr := operationError(entry.operation, err)
+	if operationErr == ErrSnapshotCanceled {
+		err = operationErr
+	}
+
+	entry.iteratorChannels.ErrChan.WriteInChanNonBlocking(err)
+	entry.operation.setError(operationErr)
+	treatSnapshotError(err, message, entry.rootHash, entry.mainTrieRootHash)
+}
+
 func treatSnapshotError(err error, message string, rootHash []byte, mainTrieRootHash []byte) {
 	if errors.IsClosingError(err) {
 		log.Debug("context closing", "message", message, "rootHash", rootHash, "mainTrieRootHash", mainTrieRootHash)
 		return
 	}
+	if err == ErrSnapshotCanceled {
+		log.Debug("operation canceled", "message", message, "rootHash", rootHash, "mainTrieRootHash", mainTrieRootHash)
+		return
+	}
 
 	log.Error(message, "rootHash", rootHash, "mainTrieRootHash", mainTrieRootHash, "err", err.Error())
 }
@@ -675,6 +719,27 @@ func isTrieSynced(stsm *snapshotTrieStorageManager) bool {
 	return false
 }
 
+// GetSnapshotsStatus returns the status of the queued and running snapshots and checkpoints
+func (tsm *trieStorageManager) GetSnapshotsStatus() *common.TrieSnapshotsStatus {
+	return tsm.snapshotsTracker.getStatus()
+}
+
+// PauseSnapshots pauses the running snapshots and checkpoints before copying their next trie node
+func (tsm *trieStorageManager) PauseSnapshots() {
+	tsm.snapshotsTracker.pause()
+}
+
+// ResumeSnapshots resumes the paused snapshots and checkpoints
+func (tsm *trieStorageManager) ResumeSnapshots() {
+	tsm.snapshotsTracker.resume()
+}
+
+// CancelSnapshots stops the running snapshots and checkpoints and discards the queued ones. A canceled snapshot
+// does not mark the database as active, so it will be retaken
+func (tsm *trieStorageManager) CancelSnapshots() {
+	tsm.snapshotsTracker.cancelAll()
+}
+
 // GetBaseTrieStorageManager returns the trie storage manager
 func (tsm *trieStorageManager) GetBaseTrieStorageManager() common.StorageManager {
 	return tsm
@@ -541,3 +541,75 @@ func TestWriteInChanNonBlocking(t *testing.T) {
 		assert.Equal(t, err2, recovered)
 	})
 }
+
+func TestTrieStorageManager_PauseAndCancelSnapshots(t *testing.T) {
+	t.Parallel()
+
+	t.Run("paused checkpoint should finish after resume", func(t *testing.T) {
+		t.Parallel()
+
+		tr, trieStorage := trie.CreateSmallTestTrieAndStorageManager()
+		rootHash, _ := tr.RootHash()
+		trieStorage.AddDirtyCheckpointHashes(rootHash, trie.GetDirtyHashes(tr))
+
+		trieStorage.PauseSnapshots()
+		iteratorChannels := &common.TrieIteratorChannels{
+			LeavesChan: nil,
+			ErrChan:    errChan.NewErrChanWrapper(),
+		}
+		trieStorage.SetCheckpoint(rootHash, []byte{}, iteratorChannels, nil, &trieMock.MockStatistics{})
+
+		require.Eventually(t, func() bool {
+			return trieStorage.GetSnapshotsStatus().NumRunningCheckpoints == 1
+		}, time.Second, time.Millisecond*10)
+		status := trieStorage.GetSnapshotsStatus()
+		assert.True(t, status.Paused)
+		assert.Zero(t, status.NumNodesCopied)
+		require.Equal(t, 1, len(status.RunningOperations))
+		assert.Equal(t, "checkpoint", status.RunningOperations[0].Type)
+
+		trieStorage.ResumeSnapshots()
+		trie.WaitForOperationToComplete(trieStorage)
+
+		val, err := trieStorage.GetFromCheckpoint(rootHash)
+		assert.Nil(t, err)
+		assert.NotNil(t, val)
+		assert.Nil(t, iteratorChannels.ErrChan.ReadFromChanNonBlocking())
+
+		status = trieStorage.GetSnapshotsStatus()
+		assert.False(t, status.Paused)
+		assert.Zero(t, status.NumRunningCheckpoints)
+		assert.Empty(t, status.LastError)
+	})
+	t.Run("canceled checkpoint should report the error", func(t *testing.T) {
+		t.Parallel()
+
+		tr, trieStorage := trie.CreateSmallTestTrieAndStorageManager()
+		rootHash, _ := tr.RootHash()
+		trieStorage.AddDirtyCheckpointHashes(rootHash, trie.GetDirtyHashes(tr))
+
+		trieStorage.PauseSnapshots()
+		iteratorChannels := &common.TrieIteratorChannels{
+			LeavesChan: nil,
+			ErrChan:    errChan.NewErrChanWrapper(),
+		}
+		trieStorage.SetCheckpoint(rootHash, []byte{}, iteratorChannels, nil, &trieMock.MockStatistics{})
+
+		require.Eventually(t, func() bool {
+			return trieStorage.GetSnapshotsStatus().NumRunningCheckpoints == 1
+		}, time.Second, time.Millisecond*10)
+
+		trieStorage.CancelSnapshots()
+		trie.WaitForOperationToComplete(trieStorage)
+
+		val, err := trieStorage.GetFromCheckpoint(rootHash)
+		assert.NotNil(t, err)
+		assert.Nil(t, val)
+		assert.Equal(t, trie.ErrSnapshotCanceled, iteratorChannels.ErrChan.ReadFromChanNonBlocking())
+
+		status := trieStorage.GetSnapshotsStatus()
+		assert.Zero(t, status.NumRunningCheckpoints)
+		assert.Equal(t, trie.ErrSnapshotCanceled.Error(), status.LastError)
+		assert.NotZero(t, status.LastErrorTimestamp)
+	})
+}
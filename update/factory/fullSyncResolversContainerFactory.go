@@ -10,9 +10,11 @@ import (
 	"github.com/multiversx/mx-chain-go/dataRetriever"
 	factoryDataRetriever "github.com/multiversx/mx-chain-go/dataRetriever/factory/resolverscontainer"
 	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers"
+	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/peersResponseTracker"
 	"github.com/multiversx/mx-chain-go/dataRetriever/resolvers/topicResolverSender"
 	"github.com/multiversx/mx-chain-go/epochStart/bootstrap/disabled"
 	"github.com/multiversx/mx-chain-go/process/factory"
+	antifloodDisabled "github.com/multiversx/mx-chain-go/process/throttle/antiflood/disabled"
 	"github.com/multiversx/mx-chain-go/sharding"
 	"github.com/multiversx/mx-chain-go/update"
 	"github.com/multiversx/mx-chain-go/update/genesis"
@@ -186,6 +188,8 @@ func (rcf *resolversContainerFactory) createTrieNodesResolver(baseTopic string,
 		PreferredPeersHolder:        disabled.NewPreferredPeersHolder(),
 		SelfShardIdProvider:         rcf.shardCoordinator,
 		PeersRatingHandler:          rcf.peersRatingHandler,
+		PeerReputationHandler:       &antifloodDisabled.PeerReputationHandler{},
+		PeersResponseTracker:        peersResponseTracker.NewDisabledPeersResponseTracker(),
 	}
 	resolverSender, err := topicResolverSender.NewTopicResolverSender(arg)
 	if err != nil {